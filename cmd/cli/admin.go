@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/api/respond"
+	"github.com/birddigital/eth-validator-monitor/internal/api/rest"
+	"github.com/birddigital/eth-validator-monitor/internal/collector"
+	"github.com/spf13/cobra"
+)
+
+var (
+	adminURL    string
+	adminAPIKey string
+)
+
+// newAdminCmd builds the admin command tree. Admin commands talk to a running server's
+// /api/admin endpoints and require an API key belonging to a user with the admin role.
+func newAdminCmd() *cobra.Command {
+	adminCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Control the running collector",
+		Long:  `Pause, resume, drain and re-collect on a running monitor server. Every action is recorded in the server's audit log.`,
+	}
+	adminCmd.PersistentFlags().StringVar(&adminURL, "server", getEnvDefault("MONITOR_API_URL", "http://localhost:8080"), "Monitor server base URL")
+	adminCmd.PersistentFlags().StringVar(&adminAPIKey, "api-key", os.Getenv("MONITOR_API_KEY"), "Admin API key (default $MONITOR_API_KEY)")
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show collector and worker pool statistics",
+		Run:   runAdminStatus,
+	}
+
+	pauseCmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Pause scheduled collection",
+		Run:   runAdminPause,
+	}
+
+	resumeCmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume scheduled collection",
+		Run:   runAdminResume,
+	}
+
+	drainCmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Pause collection and wait for the worker pool to empty",
+		Run:   runAdminDrain,
+	}
+
+	recollectCmd := &cobra.Command{
+		Use:   "recollect",
+		Short: "Re-collect a validator's snapshots for an epoch range",
+		Run:   runAdminRecollect,
+	}
+	recollectCmd.Flags().Int64("index", -1, "Validator index (required)")
+	recollectCmd.Flags().Int64("from", -1, "First epoch (required)")
+	recollectCmd.Flags().Int64("to", -1, "Last epoch (required)")
+
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Show the admin audit log",
+		Run:   runAdminAudit,
+	}
+	auditCmd.Flags().Int("limit", 50, "Maximum number of entries to show")
+	auditCmd.Flags().Int("offset", 0, "Offset for pagination")
+
+	adminCmd.AddCommand(statusCmd, pauseCmd, resumeCmd, drainCmd, recollectCmd, auditCmd)
+	return adminCmd
+}
+
+func runAdminStatus(cmd *cobra.Command, args []string) {
+	var status rest.CollectorStatus
+	adminRequest(http.MethodGet, "/api/admin/collector", nil, &status)
+	printCollectorStatus(&status)
+}
+
+func runAdminPause(cmd *cobra.Command, args []string) {
+	var status rest.CollectorStatus
+	adminRequest(http.MethodPost, "/api/admin/collector/pause", nil, &status)
+	fmt.Println("✓ Collection paused")
+	printCollectorStatus(&status)
+}
+
+func runAdminResume(cmd *cobra.Command, args []string) {
+	var status rest.CollectorStatus
+	adminRequest(http.MethodPost, "/api/admin/collector/resume", nil, &status)
+	fmt.Println("✓ Collection resumed")
+	printCollectorStatus(&status)
+}
+
+func runAdminDrain(cmd *cobra.Command, args []string) {
+	var status rest.CollectorStatus
+	adminRequest(http.MethodPost, "/api/admin/pool/drain", nil, &status)
+	fmt.Println("✓ Worker pool drained (collection stays paused until resumed)")
+	printCollectorStatus(&status)
+}
+
+func runAdminRecollect(cmd *cobra.Command, args []string) {
+	index, _ := cmd.Flags().GetInt64("index")
+	from, _ := cmd.Flags().GetInt64("from")
+	to, _ := cmd.Flags().GetInt64("to")
+
+	if index < 0 || from < 0 || to < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --index, --from and --to are required\n")
+		os.Exit(1)
+	}
+
+	req := rest.RecollectRequest{ValidatorIndex: &index, FromEpoch: &from, ToEpoch: &to}
+
+	var result collector.RecollectResult
+	adminRequest(http.MethodPost, "/api/admin/collector/recollect", req, &result)
+
+	fmt.Printf("✓ Validator %d re-collected for epochs %d-%d\n", result.ValidatorIndex, result.FromEpoch, result.ToEpoch)
+	fmt.Printf("  Epochs collected:  %d\n", result.Recollected)
+	fmt.Printf("  Snapshots replaced: %d\n", result.Replaced)
+	if len(result.UnavailableEpochs) > 0 {
+		fmt.Printf("  Unavailable epochs: %d (beacon node no longer has their state)\n", len(result.UnavailableEpochs))
+	}
+}
+
+func runAdminAudit(cmd *cobra.Command, args []string) {
+	limit, _ := cmd.Flags().GetInt("limit")
+	offset, _ := cmd.Flags().GetInt("offset")
+
+	query := url.Values{}
+	query.Set("limit", fmt.Sprint(limit))
+	query.Set("offset", fmt.Sprint(offset))
+
+	var entries []rest.AuditEntry
+	adminRequest(http.MethodGet, "/api/admin/audit?"+query.Encode(), nil, &entries)
+
+	if len(entries) == 0 {
+		fmt.Println("No admin actions recorded")
+		return
+	}
+
+	fmt.Printf("%-20s %-20s %-10s %-18s %-8s %s\n", "TIME", "ACTOR", "AUTH", "ACTION", "RESULT", "PARAMS")
+	fmt.Println(strings.Repeat("-", 120))
+
+	for _, entry := range entries {
+		result := "ok"
+		if !entry.Success {
+			result = "failed"
+		}
+
+		params := ""
+		if len(entry.Params) > 0 {
+			encoded, _ := json.Marshal(entry.Params)
+			params = string(encoded)
+		}
+		if entry.Error != nil {
+			params = strings.TrimSpace(params + " error=" + *entry.Error)
+		}
+
+		fmt.Printf("%-20s %-20s %-10s %-18s %-8s %s\n",
+			entry.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+			entry.Actor, entry.AuthMethod, entry.Action, result, params)
+	}
+}
+
+func printCollectorStatus(status *rest.CollectorStatus) {
+	state := "running"
+	if status.Paused {
+		state = "paused"
+	}
+
+	fmt.Println("\nCollector")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("State: %s\n", state)
+	fmt.Printf("Validators Monitored: %d\n", status.ValidatorsMonitored)
+	fmt.Printf("Collections: %d (errors: %d)\n", status.CollectionsCount, status.ErrorsCount)
+	if status.LastCollectionTime != nil {
+		fmt.Printf("Last Collection: %s\n", status.LastCollectionTime.Local().Format("2006-01-02 15:04:05"))
+	}
+
+	fmt.Println("\nWorker Pool")
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("Workers: %d active, %d busy\n", status.Pool.ActiveWorkers, status.Pool.BusyWorkers)
+	fmt.Printf("Queued: %d tasks, %d results\n", status.Pool.QueueSize, status.Pool.ResultQueueSize)
+	fmt.Printf("Processed: %d (failed: %d)\n", status.Pool.TasksProcessed, status.Pool.TasksFailed)
+
+	if len(status.Circuits) > 0 {
+		names := make([]string, 0, len(status.Circuits))
+		for name := range status.Circuits {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Println("\nCircuit Breakers")
+		fmt.Println(strings.Repeat("=", 60))
+		for _, name := range names {
+			fmt.Printf("%-12s %s\n", name, status.Circuits[name])
+		}
+	}
+}
+
+// adminRequest calls an admin endpoint and decodes the JSON response into out,
+// exiting with the server's error message on any non-2xx response
+func adminRequest(method, path string, body interface{}, out interface{}) {
+	if adminAPIKey == "" {
+		fmt.Fprintf(os.Stderr, "Error: an admin API key is required (--api-key or MONITOR_API_KEY)\n")
+		os.Exit(1)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			log.Fatalf("Failed to encode request: %v", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, strings.TrimRight(adminURL, "/")+path, reader)
+	if err != nil {
+		log.Fatalf("Failed to build request: %v", err)
+	}
+	req.Header.Set("X-API-Key", adminAPIKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// Drain and re-collection run synchronously on the server
+	client := &http.Client{Timeout: 5 * time.Minute}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Fatalf("Failed to reach server: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp respond.ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Message != "" {
+			log.Fatalf("Request failed (%d): %s", resp.StatusCode, errResp.Message)
+		}
+		log.Fatalf("Request failed: %s", resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		log.Fatalf("Failed to decode response: %v", err)
+	}
+}
+
+func getEnvDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
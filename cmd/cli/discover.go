@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/spf13/cobra"
+)
+
+// newDiscoverCmd builds the discover command tree. Discovery rules are evaluated by the server's
+// discovery job, which enrolls every matching validator under the rule's tag.
+func newDiscoverCmd() *cobra.Command {
+	discoverCmd := &cobra.Command{
+		Use:   "discover",
+		Short: "Manage validator discovery rules",
+		Long: `Enroll validators automatically by withdrawal address or credentials, block fee recipient,
+or depositor address. The server checks every rule periodically and enrolls new matches.`,
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a discovery rule",
+		Run:   runDiscoverAdd,
+	}
+	addCmd.Flags().String("kind", "", "Rule kind: withdrawal, fee_recipient or depositor (required)")
+	addCmd.Flags().String("value", "", "Execution address, or withdrawal credentials for withdrawal rules (required)")
+	addCmd.Flags().String("tag", "", "Tag given to enrolled validators (required)")
+	addCmd.Flags().Int64("from-block", 0, "Execution block depositor rules start searching from (default DISCOVERY_DEPOSIT_START_BLOCK)")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List discovery rules",
+		Run:   runDiscoverList,
+	}
+
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a discovery rule (enrolled validators stay monitored)",
+		Run:   runDiscoverRemove,
+	}
+	removeCmd.Flags().Int64("id", 0, "Rule ID (required)")
+
+	discoverCmd.AddCommand(addCmd, listCmd, removeCmd)
+	return discoverCmd
+}
+
+func runDiscoverAdd(cmd *cobra.Command, args []string) {
+	kind, _ := cmd.Flags().GetString("kind")
+	value, _ := cmd.Flags().GetString("value")
+	tag, _ := cmd.Flags().GetString("tag")
+	fromBlock, _ := cmd.Flags().GetInt64("from-block")
+
+	rule, err := models.NewDiscoveryRule(models.DiscoveryKind(kind), value, tag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if fromBlock > 0 {
+		if rule.Kind != models.DiscoveryKindDepositor {
+			fmt.Fprintf(os.Stderr, "Error: --from-block only applies to depositor rules\n")
+			os.Exit(1)
+		}
+		rule.NextBlock = fromBlock
+	}
+
+	pool := initDB()
+	defer pool.Close()
+
+	if err := repository.NewDiscoveryRepository(pool).CreateRule(context.Background(), rule); err != nil {
+		log.Fatalf("Failed to add discovery rule: %v", err)
+	}
+
+	fmt.Printf("✓ Discovery rule %d added\n", rule.ID)
+	fmt.Printf("  Kind: %s\n", rule.Kind)
+	fmt.Printf("  Value: %s\n", rule.Value)
+	fmt.Printf("  Tag: %s\n", rule.Tag)
+}
+
+func runDiscoverList(cmd *cobra.Command, args []string) {
+	pool := initDB()
+	defer pool.Close()
+
+	rules, err := repository.NewDiscoveryRepository(pool).ListRules(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to list discovery rules: %v", err)
+	}
+
+	if len(rules) == 0 {
+		fmt.Println("No discovery rules found")
+		return
+	}
+
+	fmt.Printf("%-6s %-14s %-68s %-20s %-9s %s\n", "ID", "KIND", "VALUE", "TAG", "ENROLLED", "LAST RUN")
+	for _, rule := range rules {
+		lastRun := "never"
+		if rule.LastRunAt != nil {
+			lastRun = rule.LastRunAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%-6d %-14s %-68s %-20s %-9d %s\n", rule.ID, rule.Kind, rule.Value, rule.Tag, rule.Enrolled, lastRun)
+	}
+}
+
+func runDiscoverRemove(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetInt64("id")
+	if id <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: --id is required\n")
+		os.Exit(1)
+	}
+
+	pool := initDB()
+	defer pool.Close()
+
+	deleted, err := repository.NewDiscoveryRepository(pool).DeleteRule(context.Background(), id)
+	if err != nil {
+		log.Fatalf("Failed to remove discovery rule: %v", err)
+	}
+	if !deleted {
+		fmt.Fprintf(os.Stderr, "Error: discovery rule %d not found\n", id)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Discovery rule %d removed\n", id)
+}
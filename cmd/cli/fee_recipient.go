@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/spf13/cobra"
+)
+
+// newFeeRecipientCmd builds the fee-recipient command tree. Per-validator addresses override the
+// addresses configured for the validator's tags with FEE_RECIPIENT_EXPECTED.
+func newFeeRecipientCmd() *cobra.Command {
+	feeRecipientCmd := &cobra.Command{
+		Use:   "fee-recipient",
+		Short: "Manage expected fee recipients",
+		Long: `Set the address a validator's execution rewards are expected to reach. The server checks every
+block the validator proposes and raises a critical alert when the rewards go elsewhere.`,
+	}
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set or clear a validator's expected fee recipient",
+		Run:   runFeeRecipientSet,
+	}
+	setCmd.Flags().Int64("index", -1, "Validator index (required)")
+	setCmd.Flags().String("address", "", "Expected execution address")
+	setCmd.Flags().Bool("clear", false, "Remove the override so the validator's tags apply again")
+
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show a validator's fee recipient checks",
+		Run:   runFeeRecipientHistory,
+	}
+	historyCmd.Flags().Int64("index", -1, "Validator index (required)")
+	historyCmd.Flags().Int("limit", 20, "Number of recent proposals to show")
+
+	feeRecipientCmd.AddCommand(setCmd, historyCmd)
+	return feeRecipientCmd
+}
+
+func runFeeRecipientSet(cmd *cobra.Command, args []string) {
+	index, _ := cmd.Flags().GetInt64("index")
+	address, _ := cmd.Flags().GetString("address")
+	clear, _ := cmd.Flags().GetBool("clear")
+
+	if index < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --index is required\n")
+		os.Exit(1)
+	}
+	if clear == (address != "") {
+		fmt.Fprintf(os.Stderr, "Error: exactly one of --address or --clear is required\n")
+		os.Exit(1)
+	}
+
+	var expected *string
+	if !clear {
+		parsed, err := models.ParseExecutionAddress(address)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		expected = &parsed
+	}
+
+	pool := initDB()
+	defer pool.Close()
+
+	if err := repository.NewFeeRecipientRepository(pool).SetExpected(context.Background(), index, expected); err != nil {
+		log.Fatalf("Failed to set expected fee recipient: %v", err)
+	}
+
+	if clear {
+		fmt.Printf("✓ Expected fee recipient of validator %d cleared\n", index)
+		return
+	}
+	fmt.Printf("✓ Validator %d is expected to pay %s\n", index, *expected)
+}
+
+func runFeeRecipientHistory(cmd *cobra.Command, args []string) {
+	index, _ := cmd.Flags().GetInt64("index")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	if index < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --index is required\n")
+		os.Exit(1)
+	}
+
+	pool := initDB()
+	defer pool.Close()
+
+	checks, err := repository.NewFeeRecipientRepository(pool).GetChecks(context.Background(), index, limit)
+	if err != nil {
+		log.Fatalf("Failed to get fee recipient checks: %v", err)
+	}
+
+	if len(checks) == 0 {
+		fmt.Printf("No proposals checked for validator %d\n", index)
+		return
+	}
+
+	fmt.Printf("%-10s %-20s %-13s %s\n", "SLOT", "TIME", "STATUS", "FEE RECIPIENT")
+	for _, c := range checks {
+		fmt.Printf("%-10d %-20s %-13s %s\n", c.Slot, c.Time.Format("2006-01-02 15:04:05"), c.Status, c.FeeRecipient)
+	}
+	fmt.Printf("\n%.1f%% compliant over %d proposals\n", models.FeeRecipientCompliance(checks), len(checks))
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/birddigital/eth-validator-monitor/internal/config"
 	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
 	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/services/income"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/spf13/cobra"
@@ -56,8 +58,10 @@ func main() {
 		Short: "Show validator statistics",
 		Run:   runStats,
 	}
-	statsCmd.Flags().Uint64("index", 0, "Validator index (required)")
+	statsCmd.Flags().Uint64("index", 0, "Validator index (required unless --portfolio is set)")
 	statsCmd.Flags().Int("days", 7, "Number of days of history")
+	statsCmd.Flags().StringSlice("windows", nil, "Income windows in days, e.g. 1d,30d (default from INCOME_WINDOWS)")
+	statsCmd.Flags().Bool("portfolio", false, "Show income for all monitored validators and per tag")
 
 	// Health check command
 	healthCmd := &cobra.Command{
@@ -70,6 +74,9 @@ func main() {
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(newAdminCmd())
+	rootCmd.AddCommand(newDiscoverCmd())
+	rootCmd.AddCommand(newFeeRecipientCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -174,18 +181,37 @@ func runList(cmd *cobra.Command, args []string) {
 func runStats(cmd *cobra.Command, args []string) {
 	index, _ := cmd.Flags().GetUint64("index")
 	days, _ := cmd.Flags().GetInt("days")
+	windowFlags, _ := cmd.Flags().GetStringSlice("windows")
+	portfolio, _ := cmd.Flags().GetBool("portfolio")
 
-	if index == 0 {
+	if index == 0 && !portfolio {
 		fmt.Fprintf(os.Stderr, "Error: --index is required\n")
 		os.Exit(1)
 	}
 
+	cfg := loadConfig()
+	if len(windowFlags) == 0 {
+		windowFlags = cfg.Income.Windows
+	}
+	windows, err := models.ParseIncomeWindows(windowFlags)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	pool := initDB()
 	defer pool.Close()
 
-	repo := repository.NewSnapshotRepository(pool)
+	incomeService := income.NewService(repository.NewRewardsLedgerRepository(pool), windows)
 	ctx := context.Background()
 
+	if portfolio {
+		printPortfolioIncome(ctx, incomeService)
+		return
+	}
+
+	repo := repository.NewSnapshotRepository(pool)
+
 	// Get latest snapshot
 	latest, err := repo.GetLatestSnapshot(ctx, int64(index))
 	if err != nil {
@@ -218,6 +244,54 @@ func runStats(cmd *cobra.Command, args []string) {
 		fmt.Printf("  First: %s\n", recent[len(recent)-1].Time.Format("2006-01-02 15:04:05"))
 		fmt.Printf("  Last:  %s\n", recent[0].Time.Format("2006-01-02 15:04:05"))
 	}
+
+	summaries, err := incomeService.ForValidator(ctx, int64(index), nil)
+	if err != nil {
+		log.Fatalf("Failed to get income: %v", err)
+	}
+	fmt.Println("\nConsensus Income:")
+	printIncome(summaries)
+}
+
+// printPortfolioIncome prints income across all monitored validators, then per tag
+func printPortfolioIncome(ctx context.Context, incomeService *income.Service) {
+	summaries, err := incomeService.ForPortfolio(ctx, nil)
+	if err != nil {
+		log.Fatalf("Failed to get portfolio income: %v", err)
+	}
+
+	fmt.Println("Portfolio Consensus Income")
+	fmt.Println(strings.Repeat("=", 60))
+	printIncome(summaries)
+
+	byTag, err := incomeService.ForTags(ctx, nil)
+	if err != nil {
+		log.Fatalf("Failed to get tag income: %v", err)
+	}
+
+	tags := make([]string, 0, len(byTag))
+	for tag := range byTag {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	for _, tag := range tags {
+		fmt.Printf("\nTag %q:\n", tag)
+		printIncome(byTag[tag])
+	}
+}
+
+// printIncome prints one row per income window
+func printIncome(summaries []*models.IncomeSummary) {
+	fmt.Printf("  %-8s %-8s %-16s %s\n", "WINDOW", "EPOCHS", "DAILY INCOME", "APR")
+	for _, s := range summaries {
+		if s.Epochs == 0 {
+			fmt.Printf("  %-8s %-8d %-16s %s\n", s.Window, s.Epochs, "-", "-")
+			continue
+		}
+		fmt.Printf("  %-8s %-8d %-16s %.2f%%\n", s.Window, s.Epochs,
+			fmt.Sprintf("%.6f ETH", float64(s.DailyIncome())/1e9), s.APR())
+	}
 }
 
 func runHealth(cmd *cobra.Command, args []string) {
@@ -239,11 +313,16 @@ func runHealth(cmd *cobra.Command, args []string) {
 	fmt.Println("\n✓ System is healthy")
 }
 
-func initDB() *pgxpool.Pool {
+func loadConfig() *config.Config {
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
+	return cfg
+}
+
+func initDB() *pgxpool.Pool {
+	cfg := loadConfig()
 
 	ctx := context.Background()
 	poolConfig, err := cfg.Database.BuildPoolConfig()
@@ -9,16 +9,19 @@ import (
 	"strings"
 	"time"
 
+	"github.com/birddigital/eth-validator-monitor/internal/api/rest"
 	"github.com/birddigital/eth-validator-monitor/internal/auth"
 	"github.com/birddigital/eth-validator-monitor/internal/beacon"
 	"github.com/birddigital/eth-validator-monitor/internal/cache"
 	"github.com/birddigital/eth-validator-monitor/internal/collector"
 	"github.com/birddigital/eth-validator-monitor/internal/config"
 	"github.com/birddigital/eth-validator-monitor/internal/database"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
 	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
 	"github.com/birddigital/eth-validator-monitor/internal/logger"
 	"github.com/birddigital/eth-validator-monitor/internal/metrics"
 	"github.com/birddigital/eth-validator-monitor/internal/server"
+	"github.com/birddigital/eth-validator-monitor/internal/services/admin"
 	"github.com/birddigital/eth-validator-monitor/internal/services/dashboard"
 	"github.com/birddigital/eth-validator-monitor/internal/services/health"
 	"github.com/birddigital/eth-validator-monitor/internal/services/validators"
@@ -28,6 +31,7 @@ import (
 	"github.com/birddigital/eth-validator-monitor/internal/web/sse"
 	"github.com/birddigital/eth-validator-monitor/graph"
 	"github.com/birddigital/eth-validator-monitor/graph/middleware"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -189,7 +193,7 @@ func main() {
 	healthMonitor := health.NewMonitor(dbPinger, redisClient, sseBroadcaster, healthCfg)
 
 	// Initialize dashboard service and handlers
-	dashboardService := dashboard.NewService(dashboardRepo)
+	dashboardService := dashboard.NewService(dashboardRepo, resolver.IncomeService, resolver.LuckService)
 	dashboardHandler := handlers.NewDashboardHandler(dashboardService, healthMonitor)
 
 	// Initialize validator list cache and service
@@ -198,10 +202,13 @@ func main() {
 	validatorListHandler := handlers.NewValidatorListHandler(validatorListService)
 
 	// Initialize validator detail handler
-	validatorDetailHandler := handlers.NewValidatorDetailHandler(validatorDetailRepo, logger.Logger)
+	validatorDetailHandler := handlers.NewValidatorDetailHandler(validatorDetailRepo, repository.NewRewardsLedgerRepository(pool), repository.NewCredentialRepository(pool), repository.NewFeeRecipientRepository(pool), repository.NewRelayRepository(pool), repository.NewDepositRepository(pool), logger.Logger)
 
 	// Initialize alerts handler
-	alertsHandler := handlers.NewAlertsHandler(alertRepo, logger.Logger)
+	alertsHandler := handlers.NewAlertsHandler(alertRepo, repository.NewDowntimeCostRepository(pool), logger.Logger)
+
+	// Initialize incidents handler
+	incidentsHandler := handlers.NewIncidentsHandler(repository.NewIncidentRepository(pool), repository.NewDowntimeCostRepository(pool), logger.Logger)
 
 	// Initialize SSE handler
 	sseHandler := handlers.NewSSEHandler(ctx)
@@ -215,9 +222,50 @@ func main() {
 	// Initialize API key handlers
 	apiKeyHandlers := server.NewAPIKeyHandlers(apiKeyRepo)
 
-	// Initialize beacon client (mock for development)
-	beaconClient := beacon.NewMockClient()
-	logger.Logger.Info().Msg("Mock beacon client initialized for development")
+	// Circuit breakers shared by the beacon client and the collector, so a failing node trips the
+	// breaker the collector checks before each round
+	errorRecovery := collector.NewErrorRecoveryWithConfig(
+		collector.DefaultBreakerConfig(),
+		map[string]collector.BreakerConfig{
+			"beacon":   breakerConfig(cfg.CircuitBreaker.Beacon),
+			"database": breakerConfig(cfg.CircuitBreaker.Database),
+			"cache":    breakerConfig(cfg.CircuitBreaker.Cache),
+		},
+	)
+
+	// Outcome of light client verification, shared with the beacon client so that with
+	// LIGHT_CLIENT_REJECT_UNVERIFIED it refuses data while the node fails verification
+	var beaconTrust *collector.BeaconTrust
+	if cfg.LightClient.Enabled {
+		beaconTrust = collector.NewBeaconTrust(cfg.LightClient.RejectUnverified)
+	}
+
+	// Initialize beacon client (BEACON_MOCK swaps in a mock for development)
+	var beaconClient beaconNode
+	if cfg.BeaconChain.Mock {
+		beaconClient = beacon.NewMockClient()
+		logger.Logger.Info().Msg("Mock beacon client initialized for development")
+	} else {
+		beaconConfig := collector.DefaultBeaconClientConfig(cfg.BeaconChain.NodeURL)
+		beaconConfig.CircuitBreaker = errorRecovery
+		beaconConfig.Trust = beaconTrust
+		beaconClient = collector.NewBeaconClientWithConfig(beaconConfig)
+		logger.Logger.Info().Str("url", cfg.BeaconChain.NodeURL).Msg("Beacon client initialized")
+	}
+
+	// Fork schedule and genesis validators root of the network the node serves, falling back to
+	// mainnet's
+	forks, err := beaconClient.GetForkSchedule(ctx)
+	if err != nil {
+		logger.Logger.Warn().Err(err).Msg("Failed to read the fork schedule from the beacon node, using mainnet's")
+		forks = types.MainnetForks
+	}
+	genesisValidatorsRoot := types.MainnetGenesisValidatorsRoot
+	if genesis, err := beaconClient.GetGenesis(ctx); err != nil {
+		logger.Logger.Warn().Err(err).Msg("Failed to read the genesis from the beacon node, using mainnet's validators root")
+	} else {
+		genesisValidatorsRoot = genesis.ValidatorsRoot
+	}
 
 	// Initialize Redis cache for collector
 	// Parse host and port from cfg.Redis.Addr (format: "host:port")
@@ -249,6 +297,17 @@ func main() {
 
 	// Initialize validator collector with SSE broadcaster
 	collectorConfig := collector.DefaultCollectorConfig()
+	collectorConfig.ErrorRecovery = errorRecovery
+	collectorConfig.GenesisTime = time.Unix(cfg.BeaconChain.GenesisTime, 0)
+	collectorConfig.HistoryClient = beaconClient
+	if cfg.Spool.Enabled {
+		spool, err := collector.OpenSnapshotSpool(cfg.Spool.Dir, int64(cfg.Spool.MaxSegmentMB)<<20)
+		if err != nil {
+			logger.Logger.Fatal().Err(err).Str("dir", cfg.Spool.Dir).Msg("Failed to open snapshot spool")
+		}
+		collectorConfig.Spool = spool
+		collectorConfig.SpoolReplayInterval = cfg.Spool.ReplayInterval
+	}
 	validatorCollector := collector.NewValidatorCollector(
 		ctx,
 		beaconClient,
@@ -258,6 +317,11 @@ func main() {
 		collectorConfig,
 	)
 
+	// Admin control plane for the collector (REST, GraphQL and CLI)
+	adminService := admin.NewService(validatorCollector, repository.NewAdminAuditRepository(pool))
+	resolver.Admin = adminService
+	resolver.Beacon = beaconClient
+
 	// Start collector in background
 	go func() {
 		logger.Logger.Info().Msg("Starting validator collector")
@@ -272,10 +336,229 @@ func main() {
 		if err := validatorCollector.Stop(); err != nil {
 			logger.Logger.Error().Err(err).Msg("Error stopping collector")
 		}
+		if err := validatorCollector.FlushSpool(); err != nil {
+			logger.Logger.Error().Err(err).Msg("Error flushing snapshot spool")
+		}
 	}()
 
+	// Periodic jobs run until shutdown, stopping before the collector
+	scheduler := collector.NewScheduler(ctx)
+	defer scheduler.Stop()
+
+	// Start snapshot gap repair job
+	if cfg.GapRepair.Enabled {
+		gapRepairJob := collector.NewGapRepairJob(beaconClient, pool, &collector.GapRepairConfig{
+			WindowEpochs:     int64(cfg.GapRepair.WindowEpochs),
+			MaxAttempts:      int32(cfg.GapRepair.MaxAttempts),
+			MaxRepairsPerRun: cfg.GapRepair.MaxRepairsPerRun,
+			GenesisTime:      time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("gap_repair", cfg.GapRepair.Interval, gapRepairJob.RunOnce)
+	}
+
+	// Start rewards ledger job
+	if cfg.RewardsLedger.Enabled {
+		rewardsLedgerJob := collector.NewRewardsLedgerJob(beaconClient, pool, &collector.RewardsLedgerConfig{
+			LookbackEpochs:  int64(cfg.RewardsLedger.LookbackEpochs),
+			MaxEpochsPerRun: cfg.RewardsLedger.MaxEpochsPerRun,
+			GenesisTime:     time.Unix(cfg.BeaconChain.GenesisTime, 0),
+			IncomeWindow:    models.IncomeWindow(cfg.Income.SnapshotWindow),
+		})
+		validatorCollector.SetIncomeSource(rewardsLedgerJob)
+		scheduler.Every("rewards_ledger", cfg.RewardsLedger.Interval, rewardsLedgerJob.RunOnce)
+	}
+
+	// Start network rank job
+	if cfg.NetworkRank.Enabled {
+		networkRankJob := collector.NewNetworkRankJob(beaconClient, pool, &collector.NetworkRankConfig{
+			SampleSize:  cfg.NetworkRank.SampleSize,
+			SizeRefresh: cfg.NetworkRank.SizeRefresh,
+			GenesisTime: time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		validatorCollector.SetNetworkRankSource(networkRankJob)
+		scheduler.Every("network_rank", cfg.NetworkRank.Interval, networkRankJob.RunOnce)
+	}
+
+	// Start attestation analysis job
+	if cfg.AttestationAnalysis.Enabled {
+		attestationAnalysisJob := collector.NewAttestationAnalysisJob(beaconClient, pool, &collector.AttestationAnalysisConfig{
+			LookbackEpochs:  int64(cfg.AttestationAnalysis.LookbackEpochs),
+			MaxEpochsPerRun: cfg.AttestationAnalysis.MaxEpochsPerRun,
+			GenesisTime:     time.Unix(cfg.BeaconChain.GenesisTime, 0),
+			ElectraEpoch:    types.ForkEpoch(forks, types.ForkElectra),
+		})
+		scheduler.Every("attestation_analysis", cfg.AttestationAnalysis.Interval, attestationAnalysisJob.RunOnce)
+	}
+
+	// Start proposal analysis job
+	if cfg.ProposalAnalysis.Enabled {
+		var relays types.RelaySource
+		if len(cfg.Relays.URLs) > 0 {
+			relays = collector.NewRelayClient(cfg.Relays.URLs, cfg.Relays.Timeout)
+		}
+		proposalAnalysisJob := collector.NewProposalAnalysisJob(beaconClient, relays, pool, &collector.ProposalAnalysisConfig{
+			LookbackEpochs: int64(cfg.ProposalAnalysis.LookbackEpochs),
+			LateThreshold:  cfg.ProposalAnalysis.LateThreshold,
+			GenesisTime:    time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Go(proposalAnalysisJob.WatchBlocks)
+		scheduler.Every("proposal_analysis", cfg.ProposalAnalysis.Interval, proposalAnalysisJob.RunOnce)
+	}
+
+	// Start relay monitor job; it needs relays to query
+	if cfg.RelayMonitor.Enabled && len(cfg.Relays.URLs) > 0 {
+		relayMonitorJob := collector.NewRelayMonitorJob(beaconClient, collector.NewRelayClient(cfg.Relays.URLs, cfg.Relays.Timeout), pool, &collector.RelayMonitorConfig{
+			LookbackEpochs:     int64(cfg.RelayMonitor.LookbackEpochs),
+			MaxSlotsPerRun:     cfg.RelayMonitor.MaxSlotsPerRun,
+			ShortfallTolerance: cfg.RelayMonitor.ShortfallTolerance,
+			MaxRetries:         cfg.RelayMonitor.MaxRetries,
+			GenesisTime:        time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("relay_monitor", cfg.RelayMonitor.Interval, relayMonitorJob.RunOnce)
+	}
+
+	// Start client diversity job
+	if cfg.ClientDiversity.Enabled {
+		clientDiversityJob := collector.NewClientDiversityJob(beaconClient, pool, &collector.ClientDiversityConfig{
+			LookbackEpochs:         int64(cfg.ClientDiversity.LookbackEpochs),
+			SupermajorityThreshold: cfg.ClientDiversity.SupermajorityThreshold,
+			FleetThreshold:         cfg.ClientDiversity.FleetThreshold,
+			GenesisTime:            time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Go(clientDiversityJob.CollectBlocks)
+		scheduler.Every("client_diversity", cfg.ClientDiversity.Interval, clientDiversityJob.RunOnce)
+	}
+
+	// Start signer health job; it needs endpoints to scrape and reports into the health monitor
+	if cfg.SignerHealth.Enabled && len(cfg.SignerHealth.Endpoints) > 0 {
+		signerHealthJob := collector.NewSignerHealthJob(collector.NewSignerClient(cfg.SignerHealth.Timeout), pool, &collector.SignerHealthConfig{
+			Targets: cfg.SignerHealth.Targets(),
+		})
+		healthMonitor.AddCheck(func(ctx context.Context) *health.ComponentStatus {
+			h := signerHealthJob.Health()
+			return &health.ComponentStatus{Name: "signers", Status: h.Status, Message: h.Message, LastCheck: h.CheckedAt}
+		})
+		scheduler.Every("signer_health", cfg.SignerHealth.Interval, signerHealthJob.RunOnce)
+	}
+
+	// Start beacon verification job; it reports into the health monitor and records its outcome in
+	// beaconTrust. It queries the node through a client of its own, which keeps working while the
+	// shared client refuses the node's data.
+	if cfg.LightClient.Enabled {
+		var verifierClient types.LightClient = beaconClient
+		if !cfg.BeaconChain.Mock {
+			verifierConfig := collector.DefaultBeaconClientConfig(cfg.BeaconChain.NodeURL)
+			verifierConfig.CircuitBreaker = errorRecovery
+			verifierClient = collector.NewBeaconClientWithConfig(verifierConfig)
+		}
+		beaconVerificationJob := collector.NewBeaconVerificationJob(verifierClient, beaconTrust, pool, &collector.BeaconVerificationConfig{
+			TrustedCheckpoint:     cfg.LightClient.TrustedCheckpoint,
+			AncestorDepth:         cfg.LightClient.AncestorDepth,
+			Forks:                 forks,
+			GenesisValidatorsRoot: genesisValidatorsRoot,
+		})
+		healthMonitor.AddCheck(func(ctx context.Context) *health.ComponentStatus {
+			v := beaconVerificationJob.Status()
+			return &health.ComponentStatus{Name: "beacon_verification", Status: v.Status, Message: v.Message, LastCheck: v.CheckedAt}
+		})
+		scheduler.Every("beacon_verification", cfg.LightClient.Interval, beaconVerificationJob.RunOnce)
+	}
+
+	// Start incident job
+	if cfg.Incidents.Enabled {
+		incidentJob := collector.NewIncidentJob(pool, &collector.IncidentConfig{
+			Window:    cfg.Incidents.Window,
+			MinAlerts: cfg.Incidents.MinAlerts,
+			Lookback:  cfg.Incidents.Lookback,
+		})
+		scheduler.Every("incident", cfg.Incidents.Interval, incidentJob.RunOnce)
+	}
+
+	// Start health monitor once all component checks are registered
+	healthMonitor.Start()
+	defer healthMonitor.Stop()
+
+	// Start anomaly detection job
+	if cfg.AnomalyDetection.Enabled {
+		anomalyDetectionJob := collector.NewAnomalyDetectionJob(pool, &collector.AnomalyDetectionConfig{
+			Lookback:     cfg.AnomalyDetection.Lookback,
+			RecentWindow: cfg.AnomalyDetection.RecentWindow,
+			Alpha:        cfg.AnomalyDetection.Alpha,
+			Threshold:    cfg.AnomalyDetection.Threshold,
+			MinSamples:   cfg.AnomalyDetection.MinSamples,
+		})
+		scheduler.Every("anomaly_detection", cfg.AnomalyDetection.Interval, anomalyDetectionJob.RunOnce)
+	}
+
+	// Start effective balance job
+	if cfg.EffectiveBalance.Enabled {
+		effectiveBalanceJob := collector.NewEffectiveBalanceJob(pool, &collector.EffectiveBalanceConfig{
+			LookbackEpochs: int64(cfg.EffectiveBalance.LookbackEpochs),
+			GenesisTime:    time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("effective_balance", cfg.EffectiveBalance.Interval, effectiveBalanceJob.RunOnce)
+	}
+
+	// Start credential monitor job
+	if cfg.CredentialMonitor.Enabled {
+		credentialMonitorJob := collector.NewCredentialMonitorJob(beaconClient, beaconClient, pool, &collector.CredentialMonitorConfig{
+			LookbackEpochs: int64(cfg.CredentialMonitor.LookbackEpochs),
+			MaxSlotsPerRun: cfg.CredentialMonitor.MaxSlotsPerRun,
+			Allowlist:      cfg.CredentialMonitor.AllowlistByGroup(),
+			GenesisTime:    time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("credential_monitor", cfg.CredentialMonitor.Interval, credentialMonitorJob.RunOnce)
+	}
+
+	// Start fee recipient verification job
+	if cfg.FeeRecipient.Enabled {
+		feeRecipientJob := collector.NewFeeRecipientJob(beaconClient, pool, &collector.FeeRecipientConfig{
+			LookbackEpochs: int64(cfg.FeeRecipient.LookbackEpochs),
+			MaxSlotsPerRun: cfg.FeeRecipient.MaxSlotsPerRun,
+			Expected:       cfg.FeeRecipient.ExpectedByGroup(),
+			GenesisTime:    time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("fee_recipient", cfg.FeeRecipient.Interval, feeRecipientJob.RunOnce)
+	}
+
+	// Start validator discovery job; depositor rules need an execution client
+	if cfg.Discovery.Enabled {
+		var deposits types.DepositSource
+		if cfg.Execution.NodeURL != "" {
+			deposits = collector.NewExecutionClient(cfg.Execution.NodeURL, cfg.Execution.DepositContract, cfg.Execution.Timeout)
+		}
+		discoveryJob := collector.NewDiscoveryJob(beaconClient, deposits, pool, &collector.DiscoveryConfig{
+			LookbackEpochs:    int64(cfg.Discovery.LookbackEpochs),
+			MaxSlotsPerRun:    cfg.Discovery.MaxSlotsPerRun,
+			DepositStartBlock: int64(cfg.Discovery.DepositStartBlock),
+			MaxBlocksPerRun:   int64(cfg.Discovery.MaxBlocksPerRun),
+			LogChunkBlocks:    int64(cfg.Discovery.LogChunkBlocks),
+			GenesisTime:       time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("discovery", cfg.Discovery.Interval, discoveryJob.RunOnce)
+	}
+
+	// Start validator status job
+	if cfg.ValidatorStatus.Enabled {
+		validatorStatusJob := collector.NewValidatorStatusJob(beaconClient, pool, &collector.ValidatorStatusConfig{
+			GenesisTime: time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("validator_status", cfg.ValidatorStatus.Interval, validatorStatusJob.RunOnce)
+	}
+
+	// Start deposit monitor job
+	if cfg.DepositMonitor.Enabled {
+		depositMonitorJob := collector.NewDepositMonitorJob(beaconClient, pool, &collector.DepositMonitorConfig{
+			LookbackEpochs: int64(cfg.DepositMonitor.LookbackEpochs),
+			MaxSlotsPerRun: cfg.DepositMonitor.MaxSlotsPerRun,
+			GenesisTime:    time.Unix(cfg.BeaconChain.GenesisTime, 0),
+		})
+		scheduler.Every("deposit_monitor", cfg.DepositMonitor.Interval, depositMonitorJob.RunOnce)
+	}
+
 	// Register routes
-	registerRoutes(router, gqlSrv, cfg, jwtService, sessionStore, authService, authHandlers, apiKeyHandlers, apiKeyRepo, dashboardHandler, sseHandler, validatorListHandler, validatorDetailHandler, alertsHandler, settingsHandler, settingsContentHandler, settingsProfileHandler, settingsPasswordHandler, &logger.Logger)
+	registerRoutes(router, gqlSrv, cfg, jwtService, sessionStore, authService, authHandlers, apiKeyHandlers, apiKeyRepo, dashboardHandler, sseHandler, validatorListHandler, validatorDetailHandler, alertsHandler, incidentsHandler, settingsHandler, settingsContentHandler, settingsProfileHandler, settingsPasswordHandler, &logger.Logger)
+	registerAdminRoutes(router, rest.NewAdminHandler(adminService), sessionStore, apiKeyRepo, userRepo, &logger.Logger)
 
 	// Create HTTP server with graceful shutdown
 	port, _ := strconv.Atoi(cfg.Server.HTTPPort)
@@ -304,6 +587,53 @@ func main() {
 	logger.Logger.Info().Msg("Server stopped gracefully")
 }
 
+// beaconNode is everything the server reads from the beacon node
+type beaconNode interface {
+	types.BeaconClient
+	types.RewardsClient
+	types.DutiesClient
+	types.ProposalClient
+	types.FeeRecipientClient
+	types.DiscoveryClient
+	types.LightClient
+	types.BlockStream
+	types.SpecClient
+}
+
+// breakerConfig converts configured thresholds into collector circuit breaker settings
+func breakerConfig(t config.BreakerThresholds) collector.BreakerConfig {
+	bc := collector.DefaultBreakerConfig()
+	bc.ErrorThreshold = t.ErrorThreshold
+	bc.ErrorWindow = t.ErrorWindow
+	bc.OpenTimeout = t.OpenTimeout
+	return bc
+}
+
+// registerAdminRoutes mounts the collector admin control plane. Requests must be
+// authenticated by session or API key and the user must have the admin role.
+func registerAdminRoutes(
+	r chi.Router,
+	adminHandler *rest.AdminHandler,
+	sessionStore *auth.SessionStore,
+	apiKeyRepo *storage.APIKeyRepository,
+	userRepo *storage.UserRepository,
+	logger *zerolog.Logger,
+) {
+	r.Route("/api/admin", func(r chi.Router) {
+		if sessionStore != nil {
+			r.Use(auth.SessionMiddleware(sessionStore))
+		}
+		r.Use(auth.APIKeyMiddleware(apiKeyRepo))
+		r.Use(auth.RequireAnyAuth)
+		r.Use(auth.RequireRole(userRepo, auth.RoleAdmin))
+
+		adminHandler.Routes(r)
+	})
+
+	logger.Info().Str("route_group", "/api/admin").
+		Msg("Admin control plane routes registered")
+}
+
 // registerRoutes sets up all application routes
 func registerRoutes(
 	r chi.Router,
@@ -320,6 +650,7 @@ func registerRoutes(
 	validatorListHandler *handlers.ValidatorListHandler,
 	validatorDetailHandler *handlers.ValidatorDetailHandler,
 	alertsHandler *handlers.AlertsHandler,
+	incidentsHandler *handlers.IncidentsHandler,
 	settingsHandler *handlers.SettingsHandler,
 	settingsContentHandler *handlers.SettingsContentHandler,
 	settingsProfileHandler *handlers.SettingsProfileHandler,
@@ -435,15 +766,29 @@ func registerRoutes(
 	logger.Info().Str("route", "/api/alerts").
 		Msg("Alerts JSON API route with pagination registered")
 
+	// Incident routes; the session, when present, records who acknowledged or resolved an incident
+	r.Route("/incidents", func(r chi.Router) {
+		if sessionStore != nil {
+			r.Use(auth.SessionMiddleware(sessionStore))
+		}
+		r.Get("/", incidentsHandler.ServeHTTP)
+		r.Get("/{id}", incidentsHandler.ServeDetail)
+		r.Post("/{id}/acknowledge", incidentsHandler.HandleAcknowledge)
+		r.Post("/{id}/resolve", incidentsHandler.HandleResolve)
+	})
+	logger.Info().Str("route", "/incidents/*").
+		Msg("Incident routes registered (list, detail, acknowledge, resolve)")
+
 	// Validator detail page routes
 	r.Route("/validators/{index}", func(r chi.Router) {
 		r.Get("/", validatorDetailHandler.ServeHTTP)
 		r.Get("/sse", validatorDetailHandler.HandleSSE)
 		r.Get("/export", validatorDetailHandler.HandleExport)
+		r.Get("/rewards/export", validatorDetailHandler.HandleRewardsExport)
 		r.Get("/alerts", validatorDetailHandler.HandleAlertsPartial)
 	})
 	logger.Info().Str("route", "/validators/{index}/*").
-		Msg("Validator detail routes registered (page, SSE, export, alerts)")
+		Msg("Validator detail routes registered (page, SSE, export, rewards export, alerts)")
 
 	// Login page routes
 	r.Get("/login", loginHandler.ServeHTTP)
@@ -41,9 +41,21 @@ type Config struct {
 
 type ResolverRoot interface {
 	Alert() AlertResolver
+	AttestationMissCount() AttestationMissCountResolver
+	BlockClient() BlockClientResolver
+	ClientShare() ClientShareResolver
+	DiscoveryRule() DiscoveryRuleResolver
+	DowntimeCost() DowntimeCostResolver
+	EpochClientDiversity() EpochClientDiversityResolver
+	FeeRecipientCheck() FeeRecipientCheckResolver
+	Incident() IncidentResolver
+	IncidentEvent() IncidentEventResolver
 	Mutation() MutationResolver
 	NetworkStats() NetworkStatsResolver
+	ProposalLuck() ProposalLuckResolver
 	Query() QueryResolver
+	RelayProposal() RelayProposalResolver
+	RelaySummary() RelaySummaryResolver
 	Subscription() SubscriptionResolver
 	Validator() ValidatorResolver
 	AlertFilter() AlertFilterResolver
@@ -54,6 +66,17 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AdminAuditEntry struct {
+		Action     func(childComplexity int) int
+		Actor      func(childComplexity int) int
+		AuthMethod func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Error      func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Params     func(childComplexity int) int
+		Success    func(childComplexity int) int
+	}
+
 	Alert struct {
 		Acknowledged   func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
@@ -64,6 +87,12 @@ type ComplexityRoot struct {
 		ValidatorIndex func(childComplexity int) int
 	}
 
+	AttestationMissCount struct {
+		Blame  func(childComplexity int) int
+		Count  func(childComplexity int) int
+		Reason func(childComplexity int) int
+	}
+
 	AuthPayload struct {
 		AccessToken  func(childComplexity int) int
 		ExpiresAt    func(childComplexity int) int
@@ -77,6 +106,94 @@ type ComplexityRoot struct {
 		Withdrawable func(childComplexity int) int
 	}
 
+	BlockClient struct {
+		Client          func(childComplexity int) int
+		Epoch           func(childComplexity int) int
+		ExecutionClient func(childComplexity int) int
+		Graffiti        func(childComplexity int) int
+		ProposerIndex   func(childComplexity int) int
+		Slot            func(childComplexity int) int
+		Time            func(childComplexity int) int
+		Version         func(childComplexity int) int
+	}
+
+	CircuitStatus struct {
+		Component func(childComplexity int) int
+		State     func(childComplexity int) int
+	}
+
+	ClientDistribution struct {
+		Identified func(childComplexity int) int
+		Shares     func(childComplexity int) int
+		Total      func(childComplexity int) int
+	}
+
+	ClientShare struct {
+		Client func(childComplexity int) int
+		Count  func(childComplexity int) int
+		Share  func(childComplexity int) int
+	}
+
+	CollectorStatus struct {
+		Circuits            func(childComplexity int) int
+		CollectionsCount    func(childComplexity int) int
+		ErrorsCount         func(childComplexity int) int
+		LastCollectionTime  func(childComplexity int) int
+		Paused              func(childComplexity int) int
+		Pool                func(childComplexity int) int
+		ValidatorsMonitored func(childComplexity int) int
+	}
+
+	DiscoveryRule struct {
+		CreatedAt func(childComplexity int) int
+		Enrolled  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		LastRunAt func(childComplexity int) int
+		Tag       func(childComplexity int) int
+		Value     func(childComplexity int) int
+	}
+
+	DowntimeCost struct {
+		AttestationLoss func(childComplexity int) int
+		Epochs          func(childComplexity int) int
+		From            func(childComplexity int) int
+		MissedProposals func(childComplexity int) int
+		ProposalLoss    func(childComplexity int) int
+		SyncLoss        func(childComplexity int) int
+		To              func(childComplexity int) int
+		Total           func(childComplexity int) int
+		Validators      func(childComplexity int) int
+	}
+
+	DutyLuck struct {
+		Actual       func(childComplexity int) int
+		Expected     func(childComplexity int) int
+		ExpectedHigh func(childComplexity int) int
+		ExpectedLow  func(childComplexity int) int
+		Luck         func(childComplexity int) int
+		Percentile   func(childComplexity int) int
+	}
+
+	EpochClientDiversity struct {
+		Distribution func(childComplexity int) int
+		Epoch        func(childComplexity int) int
+		Time         func(childComplexity int) int
+	}
+
+	FeeRecipientCheck struct {
+		BlockHash      func(childComplexity int) int
+		Compliant      func(childComplexity int) int
+		Expected       func(childComplexity int) int
+		FeeRecipient   func(childComplexity int) int
+		PaymentGwei    func(childComplexity int) int
+		PaymentTo      func(childComplexity int) int
+		Slot           func(childComplexity int) int
+		Status         func(childComplexity int) int
+		Time           func(childComplexity int) int
+		ValidatorIndex func(childComplexity int) int
+	}
+
 	HistoricalSnapshot struct {
 		AttestationSuccess func(childComplexity int) int
 		Balance            func(childComplexity int) int
@@ -90,19 +207,67 @@ type ComplexityRoot struct {
 		Timestamp          func(childComplexity int) int
 	}
 
+	Incident struct {
+		AcknowledgedAt func(childComplexity int) int
+		AcknowledgedBy func(childComplexity int) int
+		AlertCount     func(childComplexity int) int
+		Alerts         func(childComplexity int) int
+		DowntimeCost   func(childComplexity int) int
+		FailureType    func(childComplexity int) int
+		GroupKind      func(childComplexity int) int
+		GroupValue     func(childComplexity int) int
+		ID             func(childComplexity int) int
+		LastAlertAt    func(childComplexity int) int
+		OpenAlerts     func(childComplexity int) int
+		ResolvedAt     func(childComplexity int) int
+		ResolvedBy     func(childComplexity int) int
+		Scope          func(childComplexity int) int
+		Severity       func(childComplexity int) int
+		StartedAt      func(childComplexity int) int
+		Status         func(childComplexity int) int
+		Timeline       func(childComplexity int) int
+		Title          func(childComplexity int) int
+		ValidatorCount func(childComplexity int) int
+	}
+
+	IncidentEvent struct {
+		Count   func(childComplexity int) int
+		Kind    func(childComplexity int) int
+		Message func(childComplexity int) int
+		Time    func(childComplexity int) int
+	}
+
+	IncomeWindowSummary struct {
+		Apr         func(childComplexity int) int
+		DailyIncome func(childComplexity int) int
+		Epochs      func(childComplexity int) int
+		Income      func(childComplexity int) int
+		Window      func(childComplexity int) int
+	}
+
 	Mutation struct {
-		AcknowledgeAlert    func(childComplexity int, id string) int
-		AddValidator        func(childComplexity int, input model.AddValidatorInput) int
-		Login               func(childComplexity int, input model.LoginInput) int
-		RefreshToken        func(childComplexity int, refreshToken string) int
-		Register            func(childComplexity int, input model.RegisterInput) int
-		RemoveValidator     func(childComplexity int, index int) int
-		UpdateValidatorName func(childComplexity int, index int, name string) int
+		AcknowledgeAlert        func(childComplexity int, id string) int
+		AcknowledgeIncident     func(childComplexity int, id string) int
+		AddDiscoveryRule        func(childComplexity int, input model.AddDiscoveryRuleInput) int
+		AddValidator            func(childComplexity int, input model.AddValidatorInput) int
+		DrainWorkerPool         func(childComplexity int) int
+		Login                   func(childComplexity int, input model.LoginInput) int
+		PauseCollector          func(childComplexity int) int
+		RecollectValidator      func(childComplexity int, validatorIndex int, fromEpoch int, toEpoch int) int
+		RefreshToken            func(childComplexity int, refreshToken string) int
+		Register                func(childComplexity int, input model.RegisterInput) int
+		RemoveDiscoveryRule     func(childComplexity int, id string) int
+		RemoveValidator         func(childComplexity int, index int) int
+		ResolveIncident         func(childComplexity int, id string) int
+		ResumeCollector         func(childComplexity int) int
+		SetExpectedFeeRecipient func(childComplexity int, validatorIndex int, address *string) int
+		UpdateValidatorName     func(childComplexity int, index int, name string) int
 	}
 
 	NetworkStats struct {
 		ActiveValidators  func(childComplexity int) int
 		AverageBalance    func(childComplexity int) int
+		ClientDiversity   func(childComplexity int) int
 		CurrentEpoch      func(childComplexity int) int
 		CurrentSlot       func(childComplexity int) int
 		ExitingValidators func(childComplexity int) int
@@ -114,6 +279,11 @@ type ComplexityRoot struct {
 		TotalValidators   func(childComplexity int) int
 	}
 
+	NodeAttestationMisses struct {
+		Misses func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Performance struct {
 		AttestationScore  func(childComplexity int) int
 		ConsecutiveMisses func(childComplexity int) int
@@ -128,14 +298,72 @@ type ComplexityRoot struct {
 		UptimePercentage  func(childComplexity int) int
 	}
 
+	ProposalLuck struct {
+		Epochs         func(childComplexity int) int
+		From           func(childComplexity int) int
+		Proposals      func(childComplexity int) int
+		SyncCommittees func(childComplexity int) int
+		Tag            func(childComplexity int) int
+		To             func(childComplexity int) int
+		Validators     func(childComplexity int) int
+	}
+
 	Query struct {
-		Alert      func(childComplexity int, id string) int
-		Alerts     func(childComplexity int, filter *models.AlertFilter) int
-		Health     func(childComplexity int) int
-		Me         func(childComplexity int) int
-		Network    func(childComplexity int) int
-		Validator  func(childComplexity int, index *int, pubkey *string) int
-		Validators func(childComplexity int, filter *models.ValidatorFilter) int
+		AdminAuditLog           func(childComplexity int, limit *int, offset *int) int
+		Alert                   func(childComplexity int, id string) int
+		Alerts                  func(childComplexity int, filter *models.AlertFilter) int
+		AttestationMissesByNode func(childComplexity int, from *types.Time, to *types.Time) int
+		ClientDiversity         func(childComplexity int, epochs *int) int
+		CollectorStatus         func(childComplexity int) int
+		DiscoveryRules          func(childComplexity int) int
+		DowntimeCost            func(childComplexity int, validatorIndex *int, tag *string, from *types.Time, to *types.Time) int
+		FeeRecipientChecks      func(childComplexity int, validatorIndex int, limit *int) int
+		FleetClientDistribution func(childComplexity int, tag *string) int
+		FleetClients            func(childComplexity int, tag *string) int
+		Health                  func(childComplexity int) int
+		Incident                func(childComplexity int, id string) int
+		Incidents               func(childComplexity int, status *string, limit *int, offset *int) int
+		Me                      func(childComplexity int) int
+		Network                 func(childComplexity int) int
+		PortfolioIncome         func(childComplexity int, windows []string) int
+		ProposalLuck            func(childComplexity int, from *types.Time, to *types.Time) int
+		RelayProposals          func(childComplexity int, validatorIndex *int, limit *int) int
+		RelaySummary            func(childComplexity int, from *types.Time, to *types.Time) int
+		RewardsLedger           func(childComplexity int, validatorIndex int, interval model.LedgerInterval, from *types.Time, to *types.Time) int
+		TagIncome               func(childComplexity int, windows []string) int
+		Validator               func(childComplexity int, index *int, pubkey *string) int
+		Validators              func(childComplexity int, filter *models.ValidatorFilter) int
+	}
+
+	RecollectResult struct {
+		FromEpoch         func(childComplexity int) int
+		Recollected       func(childComplexity int) int
+		Replaced          func(childComplexity int) int
+		ToEpoch           func(childComplexity int) int
+		UnavailableEpochs func(childComplexity int) int
+		ValidatorIndex    func(childComplexity int) int
+	}
+
+	RelayProposal struct {
+		BlockHash      func(childComplexity int) int
+		BuilderPubkey  func(childComplexity int) int
+		Promised       func(childComplexity int) int
+		Received       func(childComplexity int) int
+		Relays         func(childComplexity int) int
+		Shortfall      func(childComplexity int) int
+		Slot           func(childComplexity int) int
+		Source         func(childComplexity int) int
+		Time           func(childComplexity int) int
+		ValidatorIndex func(childComplexity int) int
+	}
+
+	RelaySummary struct {
+		Promised  func(childComplexity int) int
+		Proposals func(childComplexity int) int
+		Received  func(childComplexity int) int
+		Relay     func(childComplexity int) int
+		Source    func(childComplexity int) int
+		Verified  func(childComplexity int) int
 	}
 
 	Rewards struct {
@@ -144,11 +372,31 @@ type ComplexityRoot struct {
 		Expected      func(childComplexity int) int
 	}
 
+	RewardsPeriodSummary struct {
+		Actual             func(childComplexity int) int
+		AttestationRewards func(childComplexity int) int
+		Deposits           func(childComplexity int) int
+		Effectiveness      func(childComplexity int) int
+		Epochs             func(childComplexity int) int
+		Expected           func(childComplexity int) int
+		Other              func(childComplexity int) int
+		Penalties          func(childComplexity int) int
+		PeriodStart        func(childComplexity int) int
+		ProposalRewards    func(childComplexity int) int
+		SyncRewards        func(childComplexity int) int
+		Withdrawals        func(childComplexity int) int
+	}
+
 	Subscription struct {
 		NewAlerts        func(childComplexity int, severity *types.AlertSeverity) int
 		ValidatorUpdates func(childComplexity int, indices []int) int
 	}
 
+	TagIncome struct {
+		Tag     func(childComplexity int) int
+		Windows func(childComplexity int) int
+	}
+
 	User struct {
 		CreatedAt func(childComplexity int) int
 		Email     func(childComplexity int) int
@@ -159,20 +407,31 @@ type ComplexityRoot struct {
 	}
 
 	Validator struct {
-		ActivationEpoch func(childComplexity int) int
-		Alerts          func(childComplexity int) int
-		Balance         func(childComplexity int) int
-		CreatedAt       func(childComplexity int) int
-		ExitEpoch       func(childComplexity int) int
-		History         func(childComplexity int, from *types.Time, to *types.Time) int
-		Index           func(childComplexity int) int
-		Name            func(childComplexity int) int
-		Performance     func(childComplexity int) int
-		Pubkey          func(childComplexity int) int
-		Rewards         func(childComplexity int) int
-		Slashed         func(childComplexity int) int
-		Status          func(childComplexity int) int
-		UpdatedAt       func(childComplexity int) int
+		ActivationEpoch   func(childComplexity int) int
+		Alerts            func(childComplexity int) int
+		AttestationMisses func(childComplexity int, from *types.Time, to *types.Time) int
+		Balance           func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		ExitEpoch         func(childComplexity int) int
+		History           func(childComplexity int, from *types.Time, to *types.Time) int
+		Income            func(childComplexity int, windows []string) int
+		Index             func(childComplexity int) int
+		Name              func(childComplexity int) int
+		Performance       func(childComplexity int) int
+		Pubkey            func(childComplexity int) int
+		Rewards           func(childComplexity int) int
+		Slashed           func(childComplexity int) int
+		Status            func(childComplexity int) int
+		UpdatedAt         func(childComplexity int) int
+	}
+
+	WorkerPoolStats struct {
+		ActiveWorkers   func(childComplexity int) int
+		BusyWorkers     func(childComplexity int) int
+		QueueSize       func(childComplexity int) int
+		ResultQueueSize func(childComplexity int) int
+		TasksFailed     func(childComplexity int) int
+		TasksProcessed  func(childComplexity int) int
 	}
 }
 
@@ -185,6 +444,67 @@ type AlertResolver interface {
 	Acknowledged(ctx context.Context, obj *models.Alert) (bool, error)
 	CreatedAt(ctx context.Context, obj *models.Alert) (*types.Time, error)
 }
+type AttestationMissCountResolver interface {
+	Reason(ctx context.Context, obj *models.AttestationMissCount) (string, error)
+	Blame(ctx context.Context, obj *models.AttestationMissCount) (string, error)
+}
+type BlockClientResolver interface {
+	Time(ctx context.Context, obj *models.BlockClient) (*types.Time, error)
+
+	Client(ctx context.Context, obj *models.BlockClient) (string, error)
+}
+type ClientShareResolver interface {
+	Client(ctx context.Context, obj *models.ClientShare) (string, error)
+}
+type DiscoveryRuleResolver interface {
+	ID(ctx context.Context, obj *models.DiscoveryRule) (string, error)
+	Kind(ctx context.Context, obj *models.DiscoveryRule) (model.DiscoveryRuleKind, error)
+
+	LastRunAt(ctx context.Context, obj *models.DiscoveryRule) (*types.Time, error)
+	CreatedAt(ctx context.Context, obj *models.DiscoveryRule) (*types.Time, error)
+}
+type DowntimeCostResolver interface {
+	From(ctx context.Context, obj *models.DowntimeCost) (*types.Time, error)
+	To(ctx context.Context, obj *models.DowntimeCost) (*types.Time, error)
+
+	AttestationLoss(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error)
+
+	ProposalLoss(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error)
+	SyncLoss(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error)
+	Total(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error)
+}
+type EpochClientDiversityResolver interface {
+	Time(ctx context.Context, obj *models.EpochClientDiversity) (*types.Time, error)
+}
+type FeeRecipientCheckResolver interface {
+	Time(ctx context.Context, obj *models.FeeRecipientCheck) (*types.Time, error)
+
+	PaymentGwei(ctx context.Context, obj *models.FeeRecipientCheck) (*types.BigInt, error)
+	Status(ctx context.Context, obj *models.FeeRecipientCheck) (string, error)
+	Compliant(ctx context.Context, obj *models.FeeRecipientCheck) (bool, error)
+}
+type IncidentResolver interface {
+	ID(ctx context.Context, obj *models.Incident) (string, error)
+
+	GroupKind(ctx context.Context, obj *models.Incident) (string, error)
+
+	Severity(ctx context.Context, obj *models.Incident) (types.AlertSeverity, error)
+	Status(ctx context.Context, obj *models.Incident) (string, error)
+
+	StartedAt(ctx context.Context, obj *models.Incident) (*types.Time, error)
+	LastAlertAt(ctx context.Context, obj *models.Incident) (*types.Time, error)
+	AcknowledgedAt(ctx context.Context, obj *models.Incident) (*types.Time, error)
+
+	ResolvedAt(ctx context.Context, obj *models.Incident) (*types.Time, error)
+
+	Alerts(ctx context.Context, obj *models.Incident) ([]*models.Alert, error)
+	Timeline(ctx context.Context, obj *models.Incident) ([]*models.IncidentEvent, error)
+	DowntimeCost(ctx context.Context, obj *models.Incident) (*models.DowntimeCost, error)
+}
+type IncidentEventResolver interface {
+	Time(ctx context.Context, obj *models.IncidentEvent) (*types.Time, error)
+	Kind(ctx context.Context, obj *models.IncidentEvent) (string, error)
+}
 type MutationResolver interface {
 	Register(ctx context.Context, input model.RegisterInput) (*model.AuthPayload, error)
 	Login(ctx context.Context, input model.LoginInput) (*model.AuthPayload, error)
@@ -193,12 +513,26 @@ type MutationResolver interface {
 	RemoveValidator(ctx context.Context, index int) (bool, error)
 	UpdateValidatorName(ctx context.Context, index int, name string) (*models.Validator, error)
 	AcknowledgeAlert(ctx context.Context, id string) (*models.Alert, error)
+	AcknowledgeIncident(ctx context.Context, id string) (*models.Incident, error)
+	ResolveIncident(ctx context.Context, id string) (*models.Incident, error)
+	AddDiscoveryRule(ctx context.Context, input model.AddDiscoveryRuleInput) (*models.DiscoveryRule, error)
+	RemoveDiscoveryRule(ctx context.Context, id string) (bool, error)
+	SetExpectedFeeRecipient(ctx context.Context, validatorIndex int, address *string) (bool, error)
+	PauseCollector(ctx context.Context) (*model.CollectorStatus, error)
+	ResumeCollector(ctx context.Context) (*model.CollectorStatus, error)
+	DrainWorkerPool(ctx context.Context) (*model.CollectorStatus, error)
+	RecollectValidator(ctx context.Context, validatorIndex int, fromEpoch int, toEpoch int) (*model.RecollectResult, error)
 }
 type NetworkStatsResolver interface {
 	AverageBalance(ctx context.Context, obj *types.NetworkStats) (*types.BigInt, error)
 	TotalStaked(ctx context.Context, obj *types.NetworkStats) (*types.BigInt, error)
 
 	Timestamp(ctx context.Context, obj *types.NetworkStats) (*types.Time, error)
+	ClientDiversity(ctx context.Context, obj *types.NetworkStats) (*models.EpochClientDiversity, error)
+}
+type ProposalLuckResolver interface {
+	From(ctx context.Context, obj *models.ProposalLuck) (*types.Time, error)
+	To(ctx context.Context, obj *models.ProposalLuck) (*types.Time, error)
 }
 type QueryResolver interface {
 	Validator(ctx context.Context, index *int, pubkey *string) (*models.Validator, error)
@@ -208,6 +542,38 @@ type QueryResolver interface {
 	Alert(ctx context.Context, id string) (*models.Alert, error)
 	Health(ctx context.Context) (string, error)
 	Me(ctx context.Context) (*model.User, error)
+	RewardsLedger(ctx context.Context, validatorIndex int, interval model.LedgerInterval, from *types.Time, to *types.Time) ([]*model.RewardsPeriodSummary, error)
+	PortfolioIncome(ctx context.Context, windows []string) ([]*model.IncomeWindowSummary, error)
+	TagIncome(ctx context.Context, windows []string) ([]*model.TagIncome, error)
+	AttestationMissesByNode(ctx context.Context, from *types.Time, to *types.Time) ([]*model.NodeAttestationMisses, error)
+	DowntimeCost(ctx context.Context, validatorIndex *int, tag *string, from *types.Time, to *types.Time) (*models.DowntimeCost, error)
+	ProposalLuck(ctx context.Context, from *types.Time, to *types.Time) ([]*models.ProposalLuck, error)
+	DiscoveryRules(ctx context.Context) ([]*models.DiscoveryRule, error)
+	FeeRecipientChecks(ctx context.Context, validatorIndex int, limit *int) ([]*models.FeeRecipientCheck, error)
+	RelayProposals(ctx context.Context, validatorIndex *int, limit *int) ([]*models.RelayProposal, error)
+	RelaySummary(ctx context.Context, from *types.Time, to *types.Time) ([]*models.RelaySummary, error)
+	ClientDiversity(ctx context.Context, epochs *int) ([]*models.EpochClientDiversity, error)
+	FleetClients(ctx context.Context, tag *string) ([]*models.BlockClient, error)
+	FleetClientDistribution(ctx context.Context, tag *string) (*models.ClientDistribution, error)
+	Incidents(ctx context.Context, status *string, limit *int, offset *int) ([]*models.Incident, error)
+	Incident(ctx context.Context, id string) (*models.Incident, error)
+	CollectorStatus(ctx context.Context) (*model.CollectorStatus, error)
+	AdminAuditLog(ctx context.Context, limit *int, offset *int) ([]*model.AdminAuditEntry, error)
+}
+type RelayProposalResolver interface {
+	Time(ctx context.Context, obj *models.RelayProposal) (*types.Time, error)
+
+	Source(ctx context.Context, obj *models.RelayProposal) (string, error)
+
+	Promised(ctx context.Context, obj *models.RelayProposal) (*types.BigInt, error)
+	Received(ctx context.Context, obj *models.RelayProposal) (*types.BigInt, error)
+	Shortfall(ctx context.Context, obj *models.RelayProposal) (*types.BigInt, error)
+}
+type RelaySummaryResolver interface {
+	Source(ctx context.Context, obj *models.RelaySummary) (string, error)
+
+	Promised(ctx context.Context, obj *models.RelaySummary) (*types.BigInt, error)
+	Received(ctx context.Context, obj *models.RelaySummary) (*types.BigInt, error)
 }
 type SubscriptionResolver interface {
 	ValidatorUpdates(ctx context.Context, indices []int) (<-chan *models.Validator, error)
@@ -221,6 +587,8 @@ type ValidatorResolver interface {
 	Balance(ctx context.Context, obj *models.Validator) (*model.Balance, error)
 	Performance(ctx context.Context, obj *models.Validator) (*model.Performance, error)
 	Rewards(ctx context.Context, obj *models.Validator) (*model.Rewards, error)
+	Income(ctx context.Context, obj *models.Validator, windows []string) ([]*model.IncomeWindowSummary, error)
+	AttestationMisses(ctx context.Context, obj *models.Validator, from *types.Time, to *types.Time) ([]*models.AttestationMissCount, error)
 	Alerts(ctx context.Context, obj *models.Validator) ([]*models.Alert, error)
 	History(ctx context.Context, obj *models.Validator, from *types.Time, to *types.Time) ([]*model.HistoricalSnapshot, error)
 	CreatedAt(ctx context.Context, obj *models.Validator) (*types.Time, error)
@@ -258,6 +626,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AdminAuditEntry.action":
+		if e.complexity.AdminAuditEntry.Action == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.Action(childComplexity), true
+	case "AdminAuditEntry.actor":
+		if e.complexity.AdminAuditEntry.Actor == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.Actor(childComplexity), true
+	case "AdminAuditEntry.authMethod":
+		if e.complexity.AdminAuditEntry.AuthMethod == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.AuthMethod(childComplexity), true
+	case "AdminAuditEntry.createdAt":
+		if e.complexity.AdminAuditEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.CreatedAt(childComplexity), true
+	case "AdminAuditEntry.error":
+		if e.complexity.AdminAuditEntry.Error == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.Error(childComplexity), true
+	case "AdminAuditEntry.id":
+		if e.complexity.AdminAuditEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.ID(childComplexity), true
+	case "AdminAuditEntry.params":
+		if e.complexity.AdminAuditEntry.Params == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.Params(childComplexity), true
+	case "AdminAuditEntry.success":
+		if e.complexity.AdminAuditEntry.Success == nil {
+			break
+		}
+
+		return e.complexity.AdminAuditEntry.Success(childComplexity), true
+
 	case "Alert.acknowledged":
 		if e.complexity.Alert.Acknowledged == nil {
 			break
@@ -301,6 +718,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Alert.ValidatorIndex(childComplexity), true
 
+	case "AttestationMissCount.blame":
+		if e.complexity.AttestationMissCount.Blame == nil {
+			break
+		}
+
+		return e.complexity.AttestationMissCount.Blame(childComplexity), true
+	case "AttestationMissCount.count":
+		if e.complexity.AttestationMissCount.Count == nil {
+			break
+		}
+
+		return e.complexity.AttestationMissCount.Count(childComplexity), true
+	case "AttestationMissCount.reason":
+		if e.complexity.AttestationMissCount.Reason == nil {
+			break
+		}
+
+		return e.complexity.AttestationMissCount.Reason(childComplexity), true
+
 	case "AuthPayload.accessToken":
 		if e.complexity.AuthPayload.AccessToken == nil {
 			break
@@ -345,5976 +781,18493 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Balance.Withdrawable(childComplexity), true
 
-	case "HistoricalSnapshot.attestationSuccess":
-		if e.complexity.HistoricalSnapshot.AttestationSuccess == nil {
+	case "BlockClient.client":
+		if e.complexity.BlockClient.Client == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.AttestationSuccess(childComplexity), true
-	case "HistoricalSnapshot.balance":
-		if e.complexity.HistoricalSnapshot.Balance == nil {
+		return e.complexity.BlockClient.Client(childComplexity), true
+	case "BlockClient.epoch":
+		if e.complexity.BlockClient.Epoch == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.Balance(childComplexity), true
-	case "HistoricalSnapshot.effectiveBalance":
-		if e.complexity.HistoricalSnapshot.EffectiveBalance == nil {
+		return e.complexity.BlockClient.Epoch(childComplexity), true
+	case "BlockClient.executionClient":
+		if e.complexity.BlockClient.ExecutionClient == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.EffectiveBalance(childComplexity), true
-	case "HistoricalSnapshot.epoch":
-		if e.complexity.HistoricalSnapshot.Epoch == nil {
+		return e.complexity.BlockClient.ExecutionClient(childComplexity), true
+	case "BlockClient.graffiti":
+		if e.complexity.BlockClient.Graffiti == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.Epoch(childComplexity), true
-	case "HistoricalSnapshot.inclusionDelay":
-		if e.complexity.HistoricalSnapshot.InclusionDelay == nil {
+		return e.complexity.BlockClient.Graffiti(childComplexity), true
+	case "BlockClient.proposerIndex":
+		if e.complexity.BlockClient.ProposerIndex == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.InclusionDelay(childComplexity), true
-	case "HistoricalSnapshot.networkPercentile":
-		if e.complexity.HistoricalSnapshot.NetworkPercentile == nil {
+		return e.complexity.BlockClient.ProposerIndex(childComplexity), true
+	case "BlockClient.slot":
+		if e.complexity.BlockClient.Slot == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.NetworkPercentile(childComplexity), true
-	case "HistoricalSnapshot.performanceScore":
-		if e.complexity.HistoricalSnapshot.PerformanceScore == nil {
+		return e.complexity.BlockClient.Slot(childComplexity), true
+	case "BlockClient.time":
+		if e.complexity.BlockClient.Time == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.PerformanceScore(childComplexity), true
-	case "HistoricalSnapshot.proposalSuccess":
-		if e.complexity.HistoricalSnapshot.ProposalSuccess == nil {
+		return e.complexity.BlockClient.Time(childComplexity), true
+	case "BlockClient.version":
+		if e.complexity.BlockClient.Version == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.ProposalSuccess(childComplexity), true
-	case "HistoricalSnapshot.slot":
-		if e.complexity.HistoricalSnapshot.Slot == nil {
+		return e.complexity.BlockClient.Version(childComplexity), true
+
+	case "CircuitStatus.component":
+		if e.complexity.CircuitStatus.Component == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.Slot(childComplexity), true
-	case "HistoricalSnapshot.timestamp":
-		if e.complexity.HistoricalSnapshot.Timestamp == nil {
+		return e.complexity.CircuitStatus.Component(childComplexity), true
+	case "CircuitStatus.state":
+		if e.complexity.CircuitStatus.State == nil {
 			break
 		}
 
-		return e.complexity.HistoricalSnapshot.Timestamp(childComplexity), true
+		return e.complexity.CircuitStatus.State(childComplexity), true
 
-	case "Mutation.acknowledgeAlert":
-		if e.complexity.Mutation.AcknowledgeAlert == nil {
+	case "ClientDistribution.identified":
+		if e.complexity.ClientDistribution.Identified == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_acknowledgeAlert_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ClientDistribution.Identified(childComplexity), true
+	case "ClientDistribution.shares":
+		if e.complexity.ClientDistribution.Shares == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AcknowledgeAlert(childComplexity, args["id"].(string)), true
-	case "Mutation.addValidator":
-		if e.complexity.Mutation.AddValidator == nil {
+		return e.complexity.ClientDistribution.Shares(childComplexity), true
+	case "ClientDistribution.total":
+		if e.complexity.ClientDistribution.Total == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addValidator_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
-		}
+		return e.complexity.ClientDistribution.Total(childComplexity), true
 
-		return e.complexity.Mutation.AddValidator(childComplexity, args["input"].(model.AddValidatorInput)), true
-	case "Mutation.login":
-		if e.complexity.Mutation.Login == nil {
+	case "ClientShare.client":
+		if e.complexity.ClientShare.Client == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_login_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ClientShare.Client(childComplexity), true
+	case "ClientShare.count":
+		if e.complexity.ClientShare.Count == nil {
+			break
 		}
 
-		return e.complexity.Mutation.Login(childComplexity, args["input"].(model.LoginInput)), true
-	case "Mutation.refreshToken":
-		if e.complexity.Mutation.RefreshToken == nil {
+		return e.complexity.ClientShare.Count(childComplexity), true
+	case "ClientShare.share":
+		if e.complexity.ClientShare.Share == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_refreshToken_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ClientShare.Share(childComplexity), true
+
+	case "CollectorStatus.circuits":
+		if e.complexity.CollectorStatus.Circuits == nil {
+			break
 		}
 
-		return e.complexity.Mutation.RefreshToken(childComplexity, args["refreshToken"].(string)), true
-	case "Mutation.register":
-		if e.complexity.Mutation.Register == nil {
+		return e.complexity.CollectorStatus.Circuits(childComplexity), true
+	case "CollectorStatus.collectionsCount":
+		if e.complexity.CollectorStatus.CollectionsCount == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_register_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.CollectorStatus.CollectionsCount(childComplexity), true
+	case "CollectorStatus.errorsCount":
+		if e.complexity.CollectorStatus.ErrorsCount == nil {
+			break
 		}
 
-		return e.complexity.Mutation.Register(childComplexity, args["input"].(model.RegisterInput)), true
-	case "Mutation.removeValidator":
-		if e.complexity.Mutation.RemoveValidator == nil {
+		return e.complexity.CollectorStatus.ErrorsCount(childComplexity), true
+	case "CollectorStatus.lastCollectionTime":
+		if e.complexity.CollectorStatus.LastCollectionTime == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeValidator_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.CollectorStatus.LastCollectionTime(childComplexity), true
+	case "CollectorStatus.paused":
+		if e.complexity.CollectorStatus.Paused == nil {
+			break
 		}
 
-		return e.complexity.Mutation.RemoveValidator(childComplexity, args["index"].(int)), true
-	case "Mutation.updateValidatorName":
-		if e.complexity.Mutation.UpdateValidatorName == nil {
+		return e.complexity.CollectorStatus.Paused(childComplexity), true
+	case "CollectorStatus.pool":
+		if e.complexity.CollectorStatus.Pool == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateValidatorName_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.CollectorStatus.Pool(childComplexity), true
+	case "CollectorStatus.validatorsMonitored":
+		if e.complexity.CollectorStatus.ValidatorsMonitored == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateValidatorName(childComplexity, args["index"].(int), args["name"].(string)), true
+		return e.complexity.CollectorStatus.ValidatorsMonitored(childComplexity), true
 
-	case "NetworkStats.activeValidators":
-		if e.complexity.NetworkStats.ActiveValidators == nil {
+	case "DiscoveryRule.createdAt":
+		if e.complexity.DiscoveryRule.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.ActiveValidators(childComplexity), true
-	case "NetworkStats.averageBalance":
-		if e.complexity.NetworkStats.AverageBalance == nil {
+		return e.complexity.DiscoveryRule.CreatedAt(childComplexity), true
+	case "DiscoveryRule.enrolled":
+		if e.complexity.DiscoveryRule.Enrolled == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.AverageBalance(childComplexity), true
-	case "NetworkStats.currentEpoch":
-		if e.complexity.NetworkStats.CurrentEpoch == nil {
+		return e.complexity.DiscoveryRule.Enrolled(childComplexity), true
+	case "DiscoveryRule.id":
+		if e.complexity.DiscoveryRule.ID == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.CurrentEpoch(childComplexity), true
-	case "NetworkStats.currentSlot":
-		if e.complexity.NetworkStats.CurrentSlot == nil {
+		return e.complexity.DiscoveryRule.ID(childComplexity), true
+	case "DiscoveryRule.kind":
+		if e.complexity.DiscoveryRule.Kind == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.CurrentSlot(childComplexity), true
-	case "NetworkStats.exitingValidators":
-		if e.complexity.NetworkStats.ExitingValidators == nil {
+		return e.complexity.DiscoveryRule.Kind(childComplexity), true
+	case "DiscoveryRule.lastRunAt":
+		if e.complexity.DiscoveryRule.LastRunAt == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.ExitingValidators(childComplexity), true
-	case "NetworkStats.participationRate":
-		if e.complexity.NetworkStats.ParticipationRate == nil {
+		return e.complexity.DiscoveryRule.LastRunAt(childComplexity), true
+	case "DiscoveryRule.tag":
+		if e.complexity.DiscoveryRule.Tag == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.ParticipationRate(childComplexity), true
-	case "NetworkStats.pendingValidators":
-		if e.complexity.NetworkStats.PendingValidators == nil {
+		return e.complexity.DiscoveryRule.Tag(childComplexity), true
+	case "DiscoveryRule.value":
+		if e.complexity.DiscoveryRule.Value == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.PendingValidators(childComplexity), true
-	case "NetworkStats.slashedValidators":
-		if e.complexity.NetworkStats.SlashedValidators == nil {
+		return e.complexity.DiscoveryRule.Value(childComplexity), true
+
+	case "DowntimeCost.attestationLoss":
+		if e.complexity.DowntimeCost.AttestationLoss == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.SlashedValidators(childComplexity), true
-	case "NetworkStats.timestamp":
-		if e.complexity.NetworkStats.Timestamp == nil {
+		return e.complexity.DowntimeCost.AttestationLoss(childComplexity), true
+	case "DowntimeCost.epochs":
+		if e.complexity.DowntimeCost.Epochs == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.Timestamp(childComplexity), true
-	case "NetworkStats.totalStaked":
-		if e.complexity.NetworkStats.TotalStaked == nil {
+		return e.complexity.DowntimeCost.Epochs(childComplexity), true
+	case "DowntimeCost.from":
+		if e.complexity.DowntimeCost.From == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.TotalStaked(childComplexity), true
-	case "NetworkStats.totalValidators":
-		if e.complexity.NetworkStats.TotalValidators == nil {
+		return e.complexity.DowntimeCost.From(childComplexity), true
+	case "DowntimeCost.missedProposals":
+		if e.complexity.DowntimeCost.MissedProposals == nil {
 			break
 		}
 
-		return e.complexity.NetworkStats.TotalValidators(childComplexity), true
-
-	case "Performance.attestationScore":
-		if e.complexity.Performance.AttestationScore == nil {
+		return e.complexity.DowntimeCost.MissedProposals(childComplexity), true
+	case "DowntimeCost.proposalLoss":
+		if e.complexity.DowntimeCost.ProposalLoss == nil {
 			break
 		}
 
-		return e.complexity.Performance.AttestationScore(childComplexity), true
-	case "Performance.consecutiveMisses":
-		if e.complexity.Performance.ConsecutiveMisses == nil {
+		return e.complexity.DowntimeCost.ProposalLoss(childComplexity), true
+	case "DowntimeCost.syncLoss":
+		if e.complexity.DowntimeCost.SyncLoss == nil {
 			break
 		}
 
-		return e.complexity.Performance.ConsecutiveMisses(childComplexity), true
-	case "Performance.effectiveness":
-		if e.complexity.Performance.Effectiveness == nil {
+		return e.complexity.DowntimeCost.SyncLoss(childComplexity), true
+	case "DowntimeCost.to":
+		if e.complexity.DowntimeCost.To == nil {
 			break
 		}
 
-		return e.complexity.Performance.Effectiveness(childComplexity), true
-	case "Performance.inactivityScore":
-		if e.complexity.Performance.InactivityScore == nil {
+		return e.complexity.DowntimeCost.To(childComplexity), true
+	case "DowntimeCost.total":
+		if e.complexity.DowntimeCost.Total == nil {
 			break
 		}
 
-		return e.complexity.Performance.InactivityScore(childComplexity), true
-	case "Performance.networkAverage":
-		if e.complexity.Performance.NetworkAverage == nil {
+		return e.complexity.DowntimeCost.Total(childComplexity), true
+	case "DowntimeCost.validators":
+		if e.complexity.DowntimeCost.Validators == nil {
 			break
 		}
 
-		return e.complexity.Performance.NetworkAverage(childComplexity), true
-	case "Performance.networkPercentile":
-		if e.complexity.Performance.NetworkPercentile == nil {
+		return e.complexity.DowntimeCost.Validators(childComplexity), true
+
+	case "DutyLuck.actual":
+		if e.complexity.DutyLuck.Actual == nil {
 			break
 		}
 
-		return e.complexity.Performance.NetworkPercentile(childComplexity), true
-	case "Performance.proposalMissed":
-		if e.complexity.Performance.ProposalMissed == nil {
+		return e.complexity.DutyLuck.Actual(childComplexity), true
+	case "DutyLuck.expected":
+		if e.complexity.DutyLuck.Expected == nil {
 			break
 		}
 
-		return e.complexity.Performance.ProposalMissed(childComplexity), true
-	case "Performance.proposalSuccess":
-		if e.complexity.Performance.ProposalSuccess == nil {
+		return e.complexity.DutyLuck.Expected(childComplexity), true
+	case "DutyLuck.expectedHigh":
+		if e.complexity.DutyLuck.ExpectedHigh == nil {
 			break
 		}
 
-		return e.complexity.Performance.ProposalSuccess(childComplexity), true
-	case "Performance.slashingRisk":
-		if e.complexity.Performance.SlashingRisk == nil {
+		return e.complexity.DutyLuck.ExpectedHigh(childComplexity), true
+	case "DutyLuck.expectedLow":
+		if e.complexity.DutyLuck.ExpectedLow == nil {
 			break
 		}
 
-		return e.complexity.Performance.SlashingRisk(childComplexity), true
-	case "Performance.totalMissed":
-		if e.complexity.Performance.TotalMissed == nil {
+		return e.complexity.DutyLuck.ExpectedLow(childComplexity), true
+	case "DutyLuck.luck":
+		if e.complexity.DutyLuck.Luck == nil {
 			break
 		}
 
-		return e.complexity.Performance.TotalMissed(childComplexity), true
-	case "Performance.uptimePercentage":
-		if e.complexity.Performance.UptimePercentage == nil {
+		return e.complexity.DutyLuck.Luck(childComplexity), true
+	case "DutyLuck.percentile":
+		if e.complexity.DutyLuck.Percentile == nil {
 			break
 		}
 
-		return e.complexity.Performance.UptimePercentage(childComplexity), true
+		return e.complexity.DutyLuck.Percentile(childComplexity), true
 
-	case "Query.alert":
-		if e.complexity.Query.Alert == nil {
+	case "EpochClientDiversity.distribution":
+		if e.complexity.EpochClientDiversity.Distribution == nil {
 			break
 		}
 
-		args, err := ec.field_Query_alert_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.EpochClientDiversity.Distribution(childComplexity), true
+	case "EpochClientDiversity.epoch":
+		if e.complexity.EpochClientDiversity.Epoch == nil {
+			break
 		}
 
-		return e.complexity.Query.Alert(childComplexity, args["id"].(string)), true
-	case "Query.alerts":
-		if e.complexity.Query.Alerts == nil {
+		return e.complexity.EpochClientDiversity.Epoch(childComplexity), true
+	case "EpochClientDiversity.time":
+		if e.complexity.EpochClientDiversity.Time == nil {
 			break
 		}
 
-		args, err := ec.field_Query_alerts_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
-		}
+		return e.complexity.EpochClientDiversity.Time(childComplexity), true
 
-		return e.complexity.Query.Alerts(childComplexity, args["filter"].(*models.AlertFilter)), true
-	case "Query.health":
-		if e.complexity.Query.Health == nil {
+	case "FeeRecipientCheck.blockHash":
+		if e.complexity.FeeRecipientCheck.BlockHash == nil {
 			break
 		}
 
-		return e.complexity.Query.Health(childComplexity), true
-	case "Query.me":
-		if e.complexity.Query.Me == nil {
+		return e.complexity.FeeRecipientCheck.BlockHash(childComplexity), true
+	case "FeeRecipientCheck.compliant":
+		if e.complexity.FeeRecipientCheck.Compliant == nil {
 			break
 		}
 
-		return e.complexity.Query.Me(childComplexity), true
-	case "Query.network":
-		if e.complexity.Query.Network == nil {
+		return e.complexity.FeeRecipientCheck.Compliant(childComplexity), true
+	case "FeeRecipientCheck.expected":
+		if e.complexity.FeeRecipientCheck.Expected == nil {
 			break
 		}
 
-		return e.complexity.Query.Network(childComplexity), true
-	case "Query.validator":
-		if e.complexity.Query.Validator == nil {
+		return e.complexity.FeeRecipientCheck.Expected(childComplexity), true
+	case "FeeRecipientCheck.feeRecipient":
+		if e.complexity.FeeRecipientCheck.FeeRecipient == nil {
 			break
 		}
 
-		args, err := ec.field_Query_validator_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FeeRecipientCheck.FeeRecipient(childComplexity), true
+	case "FeeRecipientCheck.paymentGwei":
+		if e.complexity.FeeRecipientCheck.PaymentGwei == nil {
+			break
 		}
 
-		return e.complexity.Query.Validator(childComplexity, args["index"].(*int), args["pubkey"].(*string)), true
-	case "Query.validators":
-		if e.complexity.Query.Validators == nil {
+		return e.complexity.FeeRecipientCheck.PaymentGwei(childComplexity), true
+	case "FeeRecipientCheck.paymentTo":
+		if e.complexity.FeeRecipientCheck.PaymentTo == nil {
 			break
 		}
 
-		args, err := ec.field_Query_validators_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FeeRecipientCheck.PaymentTo(childComplexity), true
+	case "FeeRecipientCheck.slot":
+		if e.complexity.FeeRecipientCheck.Slot == nil {
+			break
 		}
 
-		return e.complexity.Query.Validators(childComplexity, args["filter"].(*models.ValidatorFilter)), true
-
-	case "Rewards.actual":
-		if e.complexity.Rewards.Actual == nil {
+		return e.complexity.FeeRecipientCheck.Slot(childComplexity), true
+	case "FeeRecipientCheck.status":
+		if e.complexity.FeeRecipientCheck.Status == nil {
 			break
 		}
 
-		return e.complexity.Rewards.Actual(childComplexity), true
-	case "Rewards.effectiveness":
-		if e.complexity.Rewards.Effectiveness == nil {
+		return e.complexity.FeeRecipientCheck.Status(childComplexity), true
+	case "FeeRecipientCheck.time":
+		if e.complexity.FeeRecipientCheck.Time == nil {
 			break
 		}
 
-		return e.complexity.Rewards.Effectiveness(childComplexity), true
-	case "Rewards.expected":
-		if e.complexity.Rewards.Expected == nil {
+		return e.complexity.FeeRecipientCheck.Time(childComplexity), true
+	case "FeeRecipientCheck.validatorIndex":
+		if e.complexity.FeeRecipientCheck.ValidatorIndex == nil {
 			break
 		}
 
-		return e.complexity.Rewards.Expected(childComplexity), true
+		return e.complexity.FeeRecipientCheck.ValidatorIndex(childComplexity), true
 
-	case "Subscription.newAlerts":
-		if e.complexity.Subscription.NewAlerts == nil {
+	case "HistoricalSnapshot.attestationSuccess":
+		if e.complexity.HistoricalSnapshot.AttestationSuccess == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_newAlerts_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.HistoricalSnapshot.AttestationSuccess(childComplexity), true
+	case "HistoricalSnapshot.balance":
+		if e.complexity.HistoricalSnapshot.Balance == nil {
+			break
 		}
 
-		return e.complexity.Subscription.NewAlerts(childComplexity, args["severity"].(*types.AlertSeverity)), true
-	case "Subscription.validatorUpdates":
-		if e.complexity.Subscription.ValidatorUpdates == nil {
+		return e.complexity.HistoricalSnapshot.Balance(childComplexity), true
+	case "HistoricalSnapshot.effectiveBalance":
+		if e.complexity.HistoricalSnapshot.EffectiveBalance == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_validatorUpdates_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.HistoricalSnapshot.EffectiveBalance(childComplexity), true
+	case "HistoricalSnapshot.epoch":
+		if e.complexity.HistoricalSnapshot.Epoch == nil {
+			break
 		}
 
-		return e.complexity.Subscription.ValidatorUpdates(childComplexity, args["indices"].([]int)), true
+		return e.complexity.HistoricalSnapshot.Epoch(childComplexity), true
+	case "HistoricalSnapshot.inclusionDelay":
+		if e.complexity.HistoricalSnapshot.InclusionDelay == nil {
+			break
+		}
 
-	case "User.createdAt":
-		if e.complexity.User.CreatedAt == nil {
+		return e.complexity.HistoricalSnapshot.InclusionDelay(childComplexity), true
+	case "HistoricalSnapshot.networkPercentile":
+		if e.complexity.HistoricalSnapshot.NetworkPercentile == nil {
 			break
 		}
 
-		return e.complexity.User.CreatedAt(childComplexity), true
-	case "User.email":
-		if e.complexity.User.Email == nil {
+		return e.complexity.HistoricalSnapshot.NetworkPercentile(childComplexity), true
+	case "HistoricalSnapshot.performanceScore":
+		if e.complexity.HistoricalSnapshot.PerformanceScore == nil {
 			break
 		}
 
-		return e.complexity.User.Email(childComplexity), true
-	case "User.id":
-		if e.complexity.User.ID == nil {
+		return e.complexity.HistoricalSnapshot.PerformanceScore(childComplexity), true
+	case "HistoricalSnapshot.proposalSuccess":
+		if e.complexity.HistoricalSnapshot.ProposalSuccess == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
-	case "User.lastLogin":
-		if e.complexity.User.LastLogin == nil {
+		return e.complexity.HistoricalSnapshot.ProposalSuccess(childComplexity), true
+	case "HistoricalSnapshot.slot":
+		if e.complexity.HistoricalSnapshot.Slot == nil {
 			break
 		}
 
-		return e.complexity.User.LastLogin(childComplexity), true
-	case "User.roles":
-		if e.complexity.User.Roles == nil {
+		return e.complexity.HistoricalSnapshot.Slot(childComplexity), true
+	case "HistoricalSnapshot.timestamp":
+		if e.complexity.HistoricalSnapshot.Timestamp == nil {
 			break
 		}
 
-		return e.complexity.User.Roles(childComplexity), true
-	case "User.username":
-		if e.complexity.User.Username == nil {
+		return e.complexity.HistoricalSnapshot.Timestamp(childComplexity), true
+
+	case "Incident.acknowledgedAt":
+		if e.complexity.Incident.AcknowledgedAt == nil {
 			break
 		}
 
-		return e.complexity.User.Username(childComplexity), true
+		return e.complexity.Incident.AcknowledgedAt(childComplexity), true
+	case "Incident.acknowledgedBy":
+		if e.complexity.Incident.AcknowledgedBy == nil {
+			break
+		}
 
-	case "Validator.activationEpoch":
-		if e.complexity.Validator.ActivationEpoch == nil {
+		return e.complexity.Incident.AcknowledgedBy(childComplexity), true
+	case "Incident.alertCount":
+		if e.complexity.Incident.AlertCount == nil {
 			break
 		}
 
-		return e.complexity.Validator.ActivationEpoch(childComplexity), true
-	case "Validator.alerts":
-		if e.complexity.Validator.Alerts == nil {
+		return e.complexity.Incident.AlertCount(childComplexity), true
+	case "Incident.alerts":
+		if e.complexity.Incident.Alerts == nil {
 			break
 		}
 
-		return e.complexity.Validator.Alerts(childComplexity), true
-	case "Validator.balance":
-		if e.complexity.Validator.Balance == nil {
+		return e.complexity.Incident.Alerts(childComplexity), true
+	case "Incident.downtimeCost":
+		if e.complexity.Incident.DowntimeCost == nil {
 			break
 		}
 
-		return e.complexity.Validator.Balance(childComplexity), true
-	case "Validator.createdAt":
-		if e.complexity.Validator.CreatedAt == nil {
+		return e.complexity.Incident.DowntimeCost(childComplexity), true
+	case "Incident.failureType":
+		if e.complexity.Incident.FailureType == nil {
 			break
 		}
 
-		return e.complexity.Validator.CreatedAt(childComplexity), true
-	case "Validator.exitEpoch":
-		if e.complexity.Validator.ExitEpoch == nil {
+		return e.complexity.Incident.FailureType(childComplexity), true
+	case "Incident.groupKind":
+		if e.complexity.Incident.GroupKind == nil {
 			break
 		}
 
-		return e.complexity.Validator.ExitEpoch(childComplexity), true
-	case "Validator.history":
-		if e.complexity.Validator.History == nil {
+		return e.complexity.Incident.GroupKind(childComplexity), true
+	case "Incident.groupValue":
+		if e.complexity.Incident.GroupValue == nil {
 			break
 		}
 
-		args, err := ec.field_Validator_history_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Incident.GroupValue(childComplexity), true
+	case "Incident.id":
+		if e.complexity.Incident.ID == nil {
+			break
 		}
 
-		return e.complexity.Validator.History(childComplexity, args["from"].(*types.Time), args["to"].(*types.Time)), true
-	case "Validator.index":
-		if e.complexity.Validator.Index == nil {
+		return e.complexity.Incident.ID(childComplexity), true
+	case "Incident.lastAlertAt":
+		if e.complexity.Incident.LastAlertAt == nil {
 			break
 		}
 
-		return e.complexity.Validator.Index(childComplexity), true
-	case "Validator.name":
-		if e.complexity.Validator.Name == nil {
+		return e.complexity.Incident.LastAlertAt(childComplexity), true
+	case "Incident.openAlerts":
+		if e.complexity.Incident.OpenAlerts == nil {
 			break
 		}
 
-		return e.complexity.Validator.Name(childComplexity), true
-	case "Validator.performance":
-		if e.complexity.Validator.Performance == nil {
+		return e.complexity.Incident.OpenAlerts(childComplexity), true
+	case "Incident.resolvedAt":
+		if e.complexity.Incident.ResolvedAt == nil {
 			break
 		}
 
-		return e.complexity.Validator.Performance(childComplexity), true
-	case "Validator.pubkey":
-		if e.complexity.Validator.Pubkey == nil {
+		return e.complexity.Incident.ResolvedAt(childComplexity), true
+	case "Incident.resolvedBy":
+		if e.complexity.Incident.ResolvedBy == nil {
 			break
 		}
 
-		return e.complexity.Validator.Pubkey(childComplexity), true
-	case "Validator.rewards":
-		if e.complexity.Validator.Rewards == nil {
+		return e.complexity.Incident.ResolvedBy(childComplexity), true
+	case "Incident.scope":
+		if e.complexity.Incident.Scope == nil {
 			break
 		}
 
-		return e.complexity.Validator.Rewards(childComplexity), true
-	case "Validator.slashed":
-		if e.complexity.Validator.Slashed == nil {
+		return e.complexity.Incident.Scope(childComplexity), true
+	case "Incident.severity":
+		if e.complexity.Incident.Severity == nil {
 			break
 		}
 
-		return e.complexity.Validator.Slashed(childComplexity), true
-	case "Validator.status":
-		if e.complexity.Validator.Status == nil {
+		return e.complexity.Incident.Severity(childComplexity), true
+	case "Incident.startedAt":
+		if e.complexity.Incident.StartedAt == nil {
 			break
 		}
 
-		return e.complexity.Validator.Status(childComplexity), true
-	case "Validator.updatedAt":
-		if e.complexity.Validator.UpdatedAt == nil {
+		return e.complexity.Incident.StartedAt(childComplexity), true
+	case "Incident.status":
+		if e.complexity.Incident.Status == nil {
 			break
 		}
 
-		return e.complexity.Validator.UpdatedAt(childComplexity), true
+		return e.complexity.Incident.Status(childComplexity), true
+	case "Incident.timeline":
+		if e.complexity.Incident.Timeline == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		return e.complexity.Incident.Timeline(childComplexity), true
+	case "Incident.title":
+		if e.complexity.Incident.Title == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputAddValidatorInput,
-		ec.unmarshalInputAlertFilter,
-		ec.unmarshalInputLoginInput,
-		ec.unmarshalInputRegisterInput,
-		ec.unmarshalInputValidatorFilter,
-	)
-	first := true
+		return e.complexity.Incident.Title(childComplexity), true
+	case "Incident.validatorCount":
+		if e.complexity.Incident.ValidatorCount == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		return e.complexity.Incident.ValidatorCount(childComplexity), true
 
-			return &response
+	case "IncidentEvent.count":
+		if e.complexity.IncidentEvent.Count == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.IncidentEvent.Count(childComplexity), true
+	case "IncidentEvent.kind":
+		if e.complexity.IncidentEvent.Kind == nil {
+			break
 		}
-	case ast.Subscription:
-		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
 
-		var buf bytes.Buffer
-		return func(ctx context.Context) *graphql.Response {
-			buf.Reset()
-			data := next(ctx)
+		return e.complexity.IncidentEvent.Kind(childComplexity), true
+	case "IncidentEvent.message":
+		if e.complexity.IncidentEvent.Message == nil {
+			break
+		}
 
-			if data == nil {
-				return nil
-			}
-			data.MarshalGQL(&buf)
+		return e.complexity.IncidentEvent.Message(childComplexity), true
+	case "IncidentEvent.time":
+		if e.complexity.IncidentEvent.Time == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.IncidentEvent.Time(childComplexity), true
+
+	case "IncomeWindowSummary.apr":
+		if e.complexity.IncomeWindowSummary.Apr == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.IncomeWindowSummary.Apr(childComplexity), true
+	case "IncomeWindowSummary.dailyIncome":
+		if e.complexity.IncomeWindowSummary.DailyIncome == nil {
+			break
+		}
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+		return e.complexity.IncomeWindowSummary.DailyIncome(childComplexity), true
+	case "IncomeWindowSummary.epochs":
+		if e.complexity.IncomeWindowSummary.Epochs == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.IncomeWindowSummary.Epochs(childComplexity), true
+	case "IncomeWindowSummary.income":
+		if e.complexity.IncomeWindowSummary.Income == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
-		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.IncomeWindowSummary.Income(childComplexity), true
+	case "IncomeWindowSummary.window":
+		if e.complexity.IncomeWindowSummary.Window == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		return e.complexity.IncomeWindowSummary.Window(childComplexity), true
 
-var sources = []*ast.Source{
-	{Name: "../schema.graphqls", Input: `# GraphQL schema for eth-validator-monitor
+	case "Mutation.acknowledgeAlert":
+		if e.complexity.Mutation.AcknowledgeAlert == nil {
+			break
+		}
 
-scalar Time
-scalar BigInt
+		args, err := ec.field_Mutation_acknowledgeAlert_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-# Enums
-enum ValidatorStatus {
-  PENDING
-  ACTIVE
-  EXITING
-  EXITED
-  SLASHED
-  UNKNOWN
-}
+		return e.complexity.Mutation.AcknowledgeAlert(childComplexity, args["id"].(string)), true
+	case "Mutation.acknowledgeIncident":
+		if e.complexity.Mutation.AcknowledgeIncident == nil {
+			break
+		}
 
-enum AlertSeverity {
-  CRITICAL
-  WARNING
-  INFO
-}
+		args, err := ec.field_Mutation_acknowledgeIncident_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-enum RiskLevel {
-  NONE
-  LOW
-  MEDIUM
-  HIGH
-}
+		return e.complexity.Mutation.AcknowledgeIncident(childComplexity, args["id"].(string)), true
+	case "Mutation.addDiscoveryRule":
+		if e.complexity.Mutation.AddDiscoveryRule == nil {
+			break
+		}
 
-# Types
-type Validator {
-  index: Int!
-  pubkey: String!
-  name: String
-  status: ValidatorStatus!
-  activationEpoch: Int
-  exitEpoch: Int
-  slashed: Boolean!
-  balance: Balance!
-  performance: Performance!
-  rewards: Rewards!
-  alerts: [Alert!]!
-  history(from: Time, to: Time): [HistoricalSnapshot!]!
-  createdAt: Time!
-  updatedAt: Time!
-}
+		args, err := ec.field_Mutation_addDiscoveryRule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type Balance {
-  current: BigInt!
-  effective: BigInt!
-  withdrawable: BigInt!
-}
+		return e.complexity.Mutation.AddDiscoveryRule(childComplexity, args["input"].(model.AddDiscoveryRuleInput)), true
+	case "Mutation.addValidator":
+		if e.complexity.Mutation.AddValidator == nil {
+			break
+		}
 
-type Performance {
-  uptimePercentage: Float!
-  consecutiveMisses: Int!
-  totalMissed: Int!
-  attestationScore: Float!
-  proposalSuccess: Int!
-  proposalMissed: Int!
-  effectiveness: Float!
-  networkAverage: Float!
-  networkPercentile: Float!
-  slashingRisk: RiskLevel!
-  inactivityScore: Int!
-}
+		args, err := ec.field_Mutation_addValidator_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type Rewards {
-  expected: BigInt!
-  actual: BigInt!
-  effectiveness: Float!
-}
+		return e.complexity.Mutation.AddValidator(childComplexity, args["input"].(model.AddValidatorInput)), true
+	case "Mutation.drainWorkerPool":
+		if e.complexity.Mutation.DrainWorkerPool == nil {
+			break
+		}
 
-type HistoricalSnapshot {
-  epoch: Int!
-  slot: Int!
-  timestamp: Time!
-  balance: BigInt!
-  effectiveBalance: BigInt!
-  attestationSuccess: Boolean
-  inclusionDelay: Int
-  proposalSuccess: Boolean
-  performanceScore: Float
-  networkPercentile: Float
-}
+		return e.complexity.Mutation.DrainWorkerPool(childComplexity), true
+	case "Mutation.login":
+		if e.complexity.Mutation.Login == nil {
+			break
+		}
 
-type Alert {
-  id: ID!
-  validatorIndex: Int!
-  severity: AlertSeverity!
-  type: String!
-  message: String!
-  acknowledged: Boolean!
-  createdAt: Time!
-}
+		args, err := ec.field_Mutation_login_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type NetworkStats {
-  currentEpoch: Int!
-  currentSlot: Int!
-  totalValidators: Int!
-  activeValidators: Int!
-  pendingValidators: Int!
-  exitingValidators: Int!
-  slashedValidators: Int!
-  averageBalance: BigInt!
-  totalStaked: BigInt!
-  participationRate: Float!
-  timestamp: Time!
-}
+		return e.complexity.Mutation.Login(childComplexity, args["input"].(model.LoginInput)), true
+	case "Mutation.pauseCollector":
+		if e.complexity.Mutation.PauseCollector == nil {
+			break
+		}
 
-# Inputs
-input AddValidatorInput {
-  pubkey: String
-  index: Int
-  name: String
-}
+		return e.complexity.Mutation.PauseCollector(childComplexity), true
+	case "Mutation.recollectValidator":
+		if e.complexity.Mutation.RecollectValidator == nil {
+			break
+		}
 
-input ValidatorFilter {
-  status: ValidatorStatus
-  slashed: Boolean
-  indices: [Int!]
-  pubkeys: [String!]
-  limit: Int
-  offset: Int
-}
+		args, err := ec.field_Mutation_recollectValidator_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-input AlertFilter {
-  validatorIndex: Int
-  severity: AlertSeverity
-  acknowledged: Boolean
-  from: Time
-  to: Time
-  limit: Int
-  offset: Int
-}
+		return e.complexity.Mutation.RecollectValidator(childComplexity, args["validatorIndex"].(int), args["fromEpoch"].(int), args["toEpoch"].(int)), true
+	case "Mutation.refreshToken":
+		if e.complexity.Mutation.RefreshToken == nil {
+			break
+		}
 
-# Authentication Types
-type User {
-  id: ID!
-  username: String!
-  email: String!
-  roles: [String!]!
-  createdAt: Time!
-  lastLogin: Time
-}
+		args, err := ec.field_Mutation_refreshToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type AuthPayload {
-  """Access token for API authentication"""
-  accessToken: String!
+		return e.complexity.Mutation.RefreshToken(childComplexity, args["refreshToken"].(string)), true
+	case "Mutation.register":
+		if e.complexity.Mutation.Register == nil {
+			break
+		}
 
-  """Refresh token for obtaining new access tokens"""
-  refreshToken: String!
+		args, err := ec.field_Mutation_register_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-  """User information"""
-  user: User!
+		return e.complexity.Mutation.Register(childComplexity, args["input"].(model.RegisterInput)), true
+	case "Mutation.removeDiscoveryRule":
+		if e.complexity.Mutation.RemoveDiscoveryRule == nil {
+			break
+		}
 
-  """Token expiration time (Unix timestamp)"""
-  expiresAt: Int!
-}
+		args, err := ec.field_Mutation_removeDiscoveryRule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-input RegisterInput {
-  username: String!
-  email: String!
-  password: String!
-}
+		return e.complexity.Mutation.RemoveDiscoveryRule(childComplexity, args["id"].(string)), true
+	case "Mutation.removeValidator":
+		if e.complexity.Mutation.RemoveValidator == nil {
+			break
+		}
 
-input LoginInput {
-  username: String!
-  password: String!
-}
+		args, err := ec.field_Mutation_removeValidator_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-# Queries
-type Query {
-  """
-  Get a single validator by index or pubkey
-  """
-  validator(index: Int, pubkey: String): Validator
+		return e.complexity.Mutation.RemoveValidator(childComplexity, args["index"].(int)), true
+	case "Mutation.resolveIncident":
+		if e.complexity.Mutation.ResolveIncident == nil {
+			break
+		}
 
-  """
-  Get multiple validators with optional filtering
-  """
-  validators(filter: ValidatorFilter): [Validator!]!
+		args, err := ec.field_Mutation_resolveIncident_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-  """
-  Get network-wide statistics
-  """
-  network: NetworkStats!
+		return e.complexity.Mutation.ResolveIncident(childComplexity, args["id"].(string)), true
+	case "Mutation.resumeCollector":
+		if e.complexity.Mutation.ResumeCollector == nil {
+			break
+		}
 
-  """
-  Get alerts with optional filtering
-  """
-  alerts(filter: AlertFilter): [Alert!]!
+		return e.complexity.Mutation.ResumeCollector(childComplexity), true
+	case "Mutation.setExpectedFeeRecipient":
+		if e.complexity.Mutation.SetExpectedFeeRecipient == nil {
+			break
+		}
 
-  """
-  Get a specific alert by ID
-  """
-  alert(id: ID!): Alert
+		args, err := ec.field_Mutation_setExpectedFeeRecipient_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-  """
-  Health check
-  """
-  health: String!
+		return e.complexity.Mutation.SetExpectedFeeRecipient(childComplexity, args["validatorIndex"].(int), args["address"].(*string)), true
+	case "Mutation.updateValidatorName":
+		if e.complexity.Mutation.UpdateValidatorName == nil {
+			break
+		}
 
-  """
-  Get current authenticated user
-  """
-  me: User!
-}
+		args, err := ec.field_Mutation_updateValidatorName_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-# Mutations
-type Mutation {
-  """
-  Register a new user account
-  """
-  register(input: RegisterInput!): AuthPayload!
+		return e.complexity.Mutation.UpdateValidatorName(childComplexity, args["index"].(int), args["name"].(string)), true
 
-  """
-  Login with username and password
-  """
-  login(input: LoginInput!): AuthPayload!
+	case "NetworkStats.activeValidators":
+		if e.complexity.NetworkStats.ActiveValidators == nil {
+			break
+		}
 
-  """
-  Refresh access token using refresh token
-  """
-  refreshToken(refreshToken: String!): AuthPayload!
+		return e.complexity.NetworkStats.ActiveValidators(childComplexity), true
+	case "NetworkStats.averageBalance":
+		if e.complexity.NetworkStats.AverageBalance == nil {
+			break
+		}
 
-  """
-  Add a new validator to monitor
-  """
-  addValidator(input: AddValidatorInput!): Validator!
+		return e.complexity.NetworkStats.AverageBalance(childComplexity), true
+	case "NetworkStats.clientDiversity":
+		if e.complexity.NetworkStats.ClientDiversity == nil {
+			break
+		}
 
-  """
-  Remove a validator from monitoring
-  """
-  removeValidator(index: Int!): Boolean!
+		return e.complexity.NetworkStats.ClientDiversity(childComplexity), true
+	case "NetworkStats.currentEpoch":
+		if e.complexity.NetworkStats.CurrentEpoch == nil {
+			break
+		}
 
-  """
-  Update validator name
-  """
-  updateValidatorName(index: Int!, name: String!): Validator!
+		return e.complexity.NetworkStats.CurrentEpoch(childComplexity), true
+	case "NetworkStats.currentSlot":
+		if e.complexity.NetworkStats.CurrentSlot == nil {
+			break
+		}
 
-  """
-  Acknowledge an alert
-  """
-  acknowledgeAlert(id: ID!): Alert!
-}
+		return e.complexity.NetworkStats.CurrentSlot(childComplexity), true
+	case "NetworkStats.exitingValidators":
+		if e.complexity.NetworkStats.ExitingValidators == nil {
+			break
+		}
 
-# Subscriptions
-type Subscription {
-  """
-  Subscribe to validator updates for specific validators
-  """
-  validatorUpdates(indices: [Int!]): Validator!
+		return e.complexity.NetworkStats.ExitingValidators(childComplexity), true
+	case "NetworkStats.participationRate":
+		if e.complexity.NetworkStats.ParticipationRate == nil {
+			break
+		}
 
-  """
-  Subscribe to new alerts
-  """
-  newAlerts(severity: AlertSeverity): Alert!
-}
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.NetworkStats.ParticipationRate(childComplexity), true
+	case "NetworkStats.pendingValidators":
+		if e.complexity.NetworkStats.PendingValidators == nil {
+			break
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.NetworkStats.PendingValidators(childComplexity), true
+	case "NetworkStats.slashedValidators":
+		if e.complexity.NetworkStats.SlashedValidators == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.NetworkStats.SlashedValidators(childComplexity), true
+	case "NetworkStats.timestamp":
+		if e.complexity.NetworkStats.Timestamp == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_acknowledgeAlert_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.NetworkStats.Timestamp(childComplexity), true
+	case "NetworkStats.totalStaked":
+		if e.complexity.NetworkStats.TotalStaked == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_addValidator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddValidatorInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAddValidatorInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.NetworkStats.TotalStaked(childComplexity), true
+	case "NetworkStats.totalValidators":
+		if e.complexity.NetworkStats.TotalValidators == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNLoginInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLoginInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.NetworkStats.TotalValidators(childComplexity), true
 
-func (ec *executionContext) field_Mutation_refreshToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "refreshToken", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["refreshToken"] = arg0
-	return args, nil
-}
+	case "NodeAttestationMisses.misses":
+		if e.complexity.NodeAttestationMisses.Misses == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_register_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRegisterInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRegisterInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.NodeAttestationMisses.Misses(childComplexity), true
+	case "NodeAttestationMisses.node":
+		if e.complexity.NodeAttestationMisses.Node == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_removeValidator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "index", ec.unmarshalNInt2int)
-	if err != nil {
-		return nil, err
-	}
-	args["index"] = arg0
-	return args, nil
-}
+		return e.complexity.NodeAttestationMisses.Node(childComplexity), true
 
-func (ec *executionContext) field_Mutation_updateValidatorName_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "index", ec.unmarshalNInt2int)
-	if err != nil {
-		return nil, err
-	}
-	args["index"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg1
-	return args, nil
-}
+	case "Performance.attestationScore":
+		if e.complexity.Performance.AttestationScore == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.AttestationScore(childComplexity), true
+	case "Performance.consecutiveMisses":
+		if e.complexity.Performance.ConsecutiveMisses == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_alert_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.ConsecutiveMisses(childComplexity), true
+	case "Performance.effectiveness":
+		if e.complexity.Performance.Effectiveness == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_alerts_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOAlertFilter2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertFilter)
-	if err != nil {
-		return nil, err
-	}
-	args["filter"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.Effectiveness(childComplexity), true
+	case "Performance.inactivityScore":
+		if e.complexity.Performance.InactivityScore == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_validator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "index", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["index"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pubkey", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["pubkey"] = arg1
-	return args, nil
-}
+		return e.complexity.Performance.InactivityScore(childComplexity), true
+	case "Performance.networkAverage":
+		if e.complexity.Performance.NetworkAverage == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_validators_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOValidatorFilter2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidatorFilter)
-	if err != nil {
-		return nil, err
-	}
-	args["filter"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.NetworkAverage(childComplexity), true
+	case "Performance.networkPercentile":
+		if e.complexity.Performance.NetworkPercentile == nil {
+			break
+		}
 
-func (ec *executionContext) field_Subscription_newAlerts_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "severity", ec.unmarshalOAlertSeverity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity)
-	if err != nil {
-		return nil, err
-	}
-	args["severity"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.NetworkPercentile(childComplexity), true
+	case "Performance.proposalMissed":
+		if e.complexity.Performance.ProposalMissed == nil {
+			break
+		}
 
-func (ec *executionContext) field_Subscription_validatorUpdates_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "indices", ec.unmarshalOInt2ᚕintᚄ)
-	if err != nil {
-		return nil, err
-	}
-	args["indices"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.ProposalMissed(childComplexity), true
+	case "Performance.proposalSuccess":
+		if e.complexity.Performance.ProposalSuccess == nil {
+			break
+		}
 
-func (ec *executionContext) field_Validator_history_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
-	if err != nil {
-		return nil, err
-	}
-	args["from"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
-	if err != nil {
-		return nil, err
-	}
-	args["to"] = arg1
-	return args, nil
-}
+		return e.complexity.Performance.ProposalSuccess(childComplexity), true
+	case "Performance.slashingRisk":
+		if e.complexity.Performance.SlashingRisk == nil {
+			break
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.SlashingRisk(childComplexity), true
+	case "Performance.totalMissed":
+		if e.complexity.Performance.TotalMissed == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.TotalMissed(childComplexity), true
+	case "Performance.uptimePercentage":
+		if e.complexity.Performance.UptimePercentage == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Performance.UptimePercentage(childComplexity), true
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+	case "ProposalLuck.epochs":
+		if e.complexity.ProposalLuck.Epochs == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.ProposalLuck.Epochs(childComplexity), true
+	case "ProposalLuck.from":
+		if e.complexity.ProposalLuck.From == nil {
+			break
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.complexity.ProposalLuck.From(childComplexity), true
+	case "ProposalLuck.proposals":
+		if e.complexity.ProposalLuck.Proposals == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.ProposalLuck.Proposals(childComplexity), true
+	case "ProposalLuck.syncCommittees":
+		if e.complexity.ProposalLuck.SyncCommittees == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.ProposalLuck.SyncCommittees(childComplexity), true
+	case "ProposalLuck.tag":
+		if e.complexity.ProposalLuck.Tag == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_id(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_id,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Alert().ID(ctx, obj)
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.ProposalLuck.Tag(childComplexity), true
+	case "ProposalLuck.to":
+		if e.complexity.ProposalLuck.To == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Alert_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ProposalLuck.To(childComplexity), true
+	case "ProposalLuck.validators":
+		if e.complexity.ProposalLuck.Validators == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_validatorIndex(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_validatorIndex,
-		func(ctx context.Context) (any, error) {
-			return obj.ValidatorIndex, nil
-		},
-		nil,
-		ec.marshalNInt2ᚖint64,
-		true,
-		true,
-	)
-}
+		return e.complexity.ProposalLuck.Validators(childComplexity), true
 
-func (ec *executionContext) fieldContext_Alert_validatorIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Query.adminAuditLog":
+		if e.complexity.Query.AdminAuditLog == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_severity(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_severity,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Alert().Severity(ctx, obj)
-		},
-		nil,
-		ec.marshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_adminAuditLog_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Alert_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type AlertSeverity does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.AdminAuditLog(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+	case "Query.alert":
+		if e.complexity.Query.Alert == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_type(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_type,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Alert().Type(ctx, obj)
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_alert_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Alert_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.Alert(childComplexity, args["id"].(string)), true
+	case "Query.alerts":
+		if e.complexity.Query.Alerts == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_message(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_message,
-		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_alerts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Alert_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.Alerts(childComplexity, args["filter"].(*models.AlertFilter)), true
+	case "Query.attestationMissesByNode":
+		if e.complexity.Query.AttestationMissesByNode == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_acknowledged(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_acknowledged,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Alert().Acknowledged(ctx, obj)
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_attestationMissesByNode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Alert_acknowledged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.AttestationMissesByNode(childComplexity, args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Query.clientDiversity":
+		if e.complexity.Query.ClientDiversity == nil {
+			break
+		}
 
-func (ec *executionContext) _Alert_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Alert_createdAt,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Alert().CreatedAt(ctx, obj)
-		},
-		nil,
-		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_clientDiversity_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Alert_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Alert",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.ClientDiversity(childComplexity, args["epochs"].(*int)), true
+	case "Query.collectorStatus":
+		if e.complexity.Query.CollectorStatus == nil {
+			break
+		}
 
-func (ec *executionContext) _AuthPayload_accessToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AuthPayload_accessToken,
-		func(ctx context.Context) (any, error) {
-			return obj.AccessToken, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.CollectorStatus(childComplexity), true
+	case "Query.discoveryRules":
+		if e.complexity.Query.DiscoveryRules == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_AuthPayload_accessToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.DiscoveryRules(childComplexity), true
+	case "Query.downtimeCost":
+		if e.complexity.Query.DowntimeCost == nil {
+			break
+		}
 
-func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AuthPayload_refreshToken,
-		func(ctx context.Context) (any, error) {
-			return obj.RefreshToken, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_downtimeCost_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AuthPayload_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.DowntimeCost(childComplexity, args["validatorIndex"].(*int), args["tag"].(*string), args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Query.feeRecipientChecks":
+		if e.complexity.Query.FeeRecipientChecks == nil {
+			break
+		}
 
-func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AuthPayload_user,
-		func(ctx context.Context) (any, error) {
-			return obj.User, nil
-		},
-		nil,
-		ec.marshalNUser2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐUser,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_feeRecipientChecks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "roles":
-				return ec.fieldContext_User_roles(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "lastLogin":
-				return ec.fieldContext_User_lastLogin(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.FeeRecipientChecks(childComplexity, args["validatorIndex"].(int), args["limit"].(*int)), true
+	case "Query.fleetClientDistribution":
+		if e.complexity.Query.FleetClientDistribution == nil {
+			break
+		}
 
-func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_AuthPayload_expiresAt,
-		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_fleetClientDistribution_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_AuthPayload_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.FleetClientDistribution(childComplexity, args["tag"].(*string)), true
+	case "Query.fleetClients":
+		if e.complexity.Query.FleetClients == nil {
+			break
+		}
 
-func (ec *executionContext) _Balance_current(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Balance_current,
-		func(ctx context.Context) (any, error) {
-			return obj.Current, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_fleetClients_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Balance_current(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Balance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.FleetClients(childComplexity, args["tag"].(*string)), true
+	case "Query.health":
+		if e.complexity.Query.Health == nil {
+			break
+		}
 
-func (ec *executionContext) _Balance_effective(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Balance_effective,
-		func(ctx context.Context) (any, error) {
-			return obj.Effective, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Health(childComplexity), true
+	case "Query.incident":
+		if e.complexity.Query.Incident == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Balance_effective(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Balance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_incident_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Balance_withdrawable(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Balance_withdrawable,
-		func(ctx context.Context) (any, error) {
-			return obj.Withdrawable, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Incident(childComplexity, args["id"].(string)), true
+	case "Query.incidents":
+		if e.complexity.Query.Incidents == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Balance_withdrawable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Balance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_incidents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_epoch(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_epoch,
-		func(ctx context.Context) (any, error) {
-			return obj.Epoch, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Incidents(childComplexity, args["status"].(*string), args["limit"].(*int), args["offset"].(*int)), true
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_epoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.Me(childComplexity), true
+	case "Query.network":
+		if e.complexity.Query.Network == nil {
+			break
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_slot(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_slot,
-		func(ctx context.Context) (any, error) {
-			return obj.Slot, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Network(childComplexity), true
+	case "Query.portfolioIncome":
+		if e.complexity.Query.PortfolioIncome == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_portfolioIncome_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_timestamp,
-		func(ctx context.Context) (any, error) {
-			return obj.Timestamp, nil
-		},
-		nil,
-		ec.marshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.PortfolioIncome(childComplexity, args["windows"].([]string)), true
+	case "Query.proposalLuck":
+		if e.complexity.Query.ProposalLuck == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_proposalLuck_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_balance(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_balance,
-		func(ctx context.Context) (any, error) {
-			return obj.Balance, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.ProposalLuck(childComplexity, args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Query.relayProposals":
+		if e.complexity.Query.RelayProposals == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_relayProposals_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_effectiveBalance(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_effectiveBalance,
-		func(ctx context.Context) (any, error) {
-			return obj.EffectiveBalance, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.RelayProposals(childComplexity, args["validatorIndex"].(*int), args["limit"].(*int)), true
+	case "Query.relaySummary":
+		if e.complexity.Query.RelaySummary == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_effectiveBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_relaySummary_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_attestationSuccess(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_attestationSuccess,
-		func(ctx context.Context) (any, error) {
-			return obj.AttestationSuccess, nil
-		},
-		nil,
-		ec.marshalOBoolean2ᚖbool,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.RelaySummary(childComplexity, args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Query.rewardsLedger":
+		if e.complexity.Query.RewardsLedger == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_attestationSuccess(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_rewardsLedger_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_inclusionDelay(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_inclusionDelay,
-		func(ctx context.Context) (any, error) {
-			return obj.InclusionDelay, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.RewardsLedger(childComplexity, args["validatorIndex"].(int), args["interval"].(model.LedgerInterval), args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Query.tagIncome":
+		if e.complexity.Query.TagIncome == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_inclusionDelay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_tagIncome_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_proposalSuccess(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_proposalSuccess,
-		func(ctx context.Context) (any, error) {
-			return obj.ProposalSuccess, nil
-		},
-		nil,
-		ec.marshalOBoolean2ᚖbool,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.TagIncome(childComplexity, args["windows"].([]string)), true
+	case "Query.validator":
+		if e.complexity.Query.Validator == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_proposalSuccess(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_validator_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_performanceScore(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_performanceScore,
-		func(ctx context.Context) (any, error) {
-			return obj.PerformanceScore, nil
-		},
-		nil,
-		ec.marshalOFloat2ᚖfloat64,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.Validator(childComplexity, args["index"].(*int), args["pubkey"].(*string)), true
+	case "Query.validators":
+		if e.complexity.Query.Validators == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_performanceScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_validators_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HistoricalSnapshot_networkPercentile(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_HistoricalSnapshot_networkPercentile,
-		func(ctx context.Context) (any, error) {
-			return obj.NetworkPercentile, nil
-		},
-		nil,
-		ec.marshalOFloat2ᚖfloat64,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.Validators(childComplexity, args["filter"].(*models.ValidatorFilter)), true
 
-func (ec *executionContext) fieldContext_HistoricalSnapshot_networkPercentile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "HistoricalSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "RecollectResult.fromEpoch":
+		if e.complexity.RecollectResult.FromEpoch == nil {
+			break
+		}
 
-func (ec *executionContext) _Mutation_register(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_register,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().Register(ctx, fc.Args["input"].(model.RegisterInput))
-		},
-		nil,
-		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload,
-		true,
-		true,
-	)
-}
+		return e.complexity.RecollectResult.FromEpoch(childComplexity), true
+	case "RecollectResult.recollected":
+		if e.complexity.RecollectResult.Recollected == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_register(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "accessToken":
-				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RecollectResult.Recollected(childComplexity), true
+	case "RecollectResult.replaced":
+		if e.complexity.RecollectResult.Replaced == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_register_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_login,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().Login(ctx, fc.Args["input"].(model.LoginInput))
-		},
-		nil,
-		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload,
-		true,
-		true,
-	)
-}
+		return e.complexity.RecollectResult.Replaced(childComplexity), true
+	case "RecollectResult.toEpoch":
+		if e.complexity.RecollectResult.ToEpoch == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "accessToken":
-				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RecollectResult.ToEpoch(childComplexity), true
+	case "RecollectResult.unavailableEpochs":
+		if e.complexity.RecollectResult.UnavailableEpochs == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_refreshToken,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RefreshToken(ctx, fc.Args["refreshToken"].(string))
-		},
-		nil,
-		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload,
-		true,
-		true,
-	)
-}
+		return e.complexity.RecollectResult.UnavailableEpochs(childComplexity), true
+	case "RecollectResult.validatorIndex":
+		if e.complexity.RecollectResult.ValidatorIndex == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "accessToken":
-				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RecollectResult.ValidatorIndex(childComplexity), true
+
+	case "RelayProposal.blockHash":
+		if e.complexity.RelayProposal.BlockHash == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_addValidator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_addValidator,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AddValidator(ctx, fc.Args["input"].(model.AddValidatorInput))
-		},
-		nil,
-		ec.marshalNValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelayProposal.BlockHash(childComplexity), true
+	case "RelayProposal.builderPubkey":
+		if e.complexity.RelayProposal.BuilderPubkey == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_addValidator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "index":
-				return ec.fieldContext_Validator_index(ctx, field)
-			case "pubkey":
-				return ec.fieldContext_Validator_pubkey(ctx, field)
-			case "name":
-				return ec.fieldContext_Validator_name(ctx, field)
-			case "status":
-				return ec.fieldContext_Validator_status(ctx, field)
-			case "activationEpoch":
-				return ec.fieldContext_Validator_activationEpoch(ctx, field)
-			case "exitEpoch":
-				return ec.fieldContext_Validator_exitEpoch(ctx, field)
-			case "slashed":
-				return ec.fieldContext_Validator_slashed(ctx, field)
-			case "balance":
-				return ec.fieldContext_Validator_balance(ctx, field)
-			case "performance":
-				return ec.fieldContext_Validator_performance(ctx, field)
-			case "rewards":
-				return ec.fieldContext_Validator_rewards(ctx, field)
-			case "alerts":
-				return ec.fieldContext_Validator_alerts(ctx, field)
-			case "history":
-				return ec.fieldContext_Validator_history(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Validator_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Validator_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RelayProposal.BuilderPubkey(childComplexity), true
+	case "RelayProposal.promised":
+		if e.complexity.RelayProposal.Promised == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addValidator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_removeValidator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_removeValidator,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RemoveValidator(ctx, fc.Args["index"].(int))
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelayProposal.Promised(childComplexity), true
+	case "RelayProposal.received":
+		if e.complexity.RelayProposal.Received == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_removeValidator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RelayProposal.Received(childComplexity), true
+	case "RelayProposal.relays":
+		if e.complexity.RelayProposal.Relays == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removeValidator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_updateValidatorName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_updateValidatorName,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateValidatorName(ctx, fc.Args["index"].(int), fc.Args["name"].(string))
-		},
-		nil,
-		ec.marshalNValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelayProposal.Relays(childComplexity), true
+	case "RelayProposal.shortfall":
+		if e.complexity.RelayProposal.Shortfall == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_updateValidatorName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "index":
-				return ec.fieldContext_Validator_index(ctx, field)
-			case "pubkey":
-				return ec.fieldContext_Validator_pubkey(ctx, field)
-			case "name":
-				return ec.fieldContext_Validator_name(ctx, field)
-			case "status":
-				return ec.fieldContext_Validator_status(ctx, field)
-			case "activationEpoch":
-				return ec.fieldContext_Validator_activationEpoch(ctx, field)
-			case "exitEpoch":
-				return ec.fieldContext_Validator_exitEpoch(ctx, field)
-			case "slashed":
-				return ec.fieldContext_Validator_slashed(ctx, field)
-			case "balance":
-				return ec.fieldContext_Validator_balance(ctx, field)
-			case "performance":
-				return ec.fieldContext_Validator_performance(ctx, field)
-			case "rewards":
-				return ec.fieldContext_Validator_rewards(ctx, field)
-			case "alerts":
-				return ec.fieldContext_Validator_alerts(ctx, field)
-			case "history":
-				return ec.fieldContext_Validator_history(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Validator_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Validator_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RelayProposal.Shortfall(childComplexity), true
+	case "RelayProposal.slot":
+		if e.complexity.RelayProposal.Slot == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateValidatorName_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_acknowledgeAlert(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_acknowledgeAlert,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AcknowledgeAlert(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelayProposal.Slot(childComplexity), true
+	case "RelayProposal.source":
+		if e.complexity.RelayProposal.Source == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Mutation_acknowledgeAlert(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Alert_id(ctx, field)
-			case "validatorIndex":
-				return ec.fieldContext_Alert_validatorIndex(ctx, field)
-			case "severity":
-				return ec.fieldContext_Alert_severity(ctx, field)
-			case "type":
-				return ec.fieldContext_Alert_type(ctx, field)
-			case "message":
-				return ec.fieldContext_Alert_message(ctx, field)
-			case "acknowledged":
-				return ec.fieldContext_Alert_acknowledged(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Alert_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.RelayProposal.Source(childComplexity), true
+	case "RelayProposal.time":
+		if e.complexity.RelayProposal.Time == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_acknowledgeAlert_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _NetworkStats_currentEpoch(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_currentEpoch,
-		func(ctx context.Context) (any, error) {
-			return obj.CurrentEpoch, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelayProposal.Time(childComplexity), true
+	case "RelayProposal.validatorIndex":
+		if e.complexity.RelayProposal.ValidatorIndex == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_currentEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RelayProposal.ValidatorIndex(childComplexity), true
 
-func (ec *executionContext) _NetworkStats_currentSlot(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_currentSlot,
-		func(ctx context.Context) (any, error) {
-			return obj.CurrentSlot, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+	case "RelaySummary.promised":
+		if e.complexity.RelaySummary.Promised == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_currentSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RelaySummary.Promised(childComplexity), true
+	case "RelaySummary.proposals":
+		if e.complexity.RelaySummary.Proposals == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_totalValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_totalValidators,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalValidators, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelaySummary.Proposals(childComplexity), true
+	case "RelaySummary.received":
+		if e.complexity.RelaySummary.Received == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_totalValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RelaySummary.Received(childComplexity), true
+	case "RelaySummary.relay":
+		if e.complexity.RelaySummary.Relay == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_activeValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_activeValidators,
-		func(ctx context.Context) (any, error) {
-			return obj.ActiveValidators, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelaySummary.Relay(childComplexity), true
+	case "RelaySummary.source":
+		if e.complexity.RelaySummary.Source == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_activeValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RelaySummary.Source(childComplexity), true
+	case "RelaySummary.verified":
+		if e.complexity.RelaySummary.Verified == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_pendingValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_pendingValidators,
-		func(ctx context.Context) (any, error) {
-			return obj.PendingValidators, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.RelaySummary.Verified(childComplexity), true
 
-func (ec *executionContext) fieldContext_NetworkStats_pendingValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Rewards.actual":
+		if e.complexity.Rewards.Actual == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_exitingValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_exitingValidators,
-		func(ctx context.Context) (any, error) {
-			return obj.ExitingValidators, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Rewards.Actual(childComplexity), true
+	case "Rewards.effectiveness":
+		if e.complexity.Rewards.Effectiveness == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_exitingValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Rewards.Effectiveness(childComplexity), true
+	case "Rewards.expected":
+		if e.complexity.Rewards.Expected == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_slashedValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_slashedValidators,
-		func(ctx context.Context) (any, error) {
-			return obj.SlashedValidators, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Rewards.Expected(childComplexity), true
 
-func (ec *executionContext) fieldContext_NetworkStats_slashedValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "RewardsPeriodSummary.actual":
+		if e.complexity.RewardsPeriodSummary.Actual == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_averageBalance(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_averageBalance,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.NetworkStats().AverageBalance(ctx, obj)
-		},
-		nil,
-		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		return e.complexity.RewardsPeriodSummary.Actual(childComplexity), true
+	case "RewardsPeriodSummary.attestationRewards":
+		if e.complexity.RewardsPeriodSummary.AttestationRewards == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_averageBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RewardsPeriodSummary.AttestationRewards(childComplexity), true
+	case "RewardsPeriodSummary.deposits":
+		if e.complexity.RewardsPeriodSummary.Deposits == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_totalStaked(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_totalStaked,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.NetworkStats().TotalStaked(ctx, obj)
-		},
-		nil,
-		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+		return e.complexity.RewardsPeriodSummary.Deposits(childComplexity), true
+	case "RewardsPeriodSummary.effectiveness":
+		if e.complexity.RewardsPeriodSummary.Effectiveness == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_totalStaked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RewardsPeriodSummary.Effectiveness(childComplexity), true
+	case "RewardsPeriodSummary.epochs":
+		if e.complexity.RewardsPeriodSummary.Epochs == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_participationRate(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_participationRate,
-		func(ctx context.Context) (any, error) {
-			return obj.ParticipationRate, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		return e.complexity.RewardsPeriodSummary.Epochs(childComplexity), true
+	case "RewardsPeriodSummary.expected":
+		if e.complexity.RewardsPeriodSummary.Expected == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_participationRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RewardsPeriodSummary.Expected(childComplexity), true
+	case "RewardsPeriodSummary.other":
+		if e.complexity.RewardsPeriodSummary.Other == nil {
+			break
+		}
 
-func (ec *executionContext) _NetworkStats_timestamp(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_NetworkStats_timestamp,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.NetworkStats().Timestamp(ctx, obj)
-		},
-		nil,
-		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		true,
-	)
-}
+		return e.complexity.RewardsPeriodSummary.Other(childComplexity), true
+	case "RewardsPeriodSummary.penalties":
+		if e.complexity.RewardsPeriodSummary.Penalties == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_NetworkStats_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NetworkStats",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RewardsPeriodSummary.Penalties(childComplexity), true
+	case "RewardsPeriodSummary.periodStart":
+		if e.complexity.RewardsPeriodSummary.PeriodStart == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_uptimePercentage(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_uptimePercentage,
-		func(ctx context.Context) (any, error) {
-			return obj.UptimePercentage, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		return e.complexity.RewardsPeriodSummary.PeriodStart(childComplexity), true
+	case "RewardsPeriodSummary.proposalRewards":
+		if e.complexity.RewardsPeriodSummary.ProposalRewards == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_uptimePercentage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RewardsPeriodSummary.ProposalRewards(childComplexity), true
+	case "RewardsPeriodSummary.syncRewards":
+		if e.complexity.RewardsPeriodSummary.SyncRewards == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_consecutiveMisses(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_consecutiveMisses,
-		func(ctx context.Context) (any, error) {
-			return obj.ConsecutiveMisses, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.RewardsPeriodSummary.SyncRewards(childComplexity), true
+	case "RewardsPeriodSummary.withdrawals":
+		if e.complexity.RewardsPeriodSummary.Withdrawals == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_consecutiveMisses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.RewardsPeriodSummary.Withdrawals(childComplexity), true
 
-func (ec *executionContext) _Performance_totalMissed(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_totalMissed,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalMissed, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+	case "Subscription.newAlerts":
+		if e.complexity.Subscription.NewAlerts == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_totalMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Subscription_newAlerts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Performance_attestationScore(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_attestationScore,
-		func(ctx context.Context) (any, error) {
-			return obj.AttestationScore, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		return e.complexity.Subscription.NewAlerts(childComplexity, args["severity"].(*types.AlertSeverity)), true
+	case "Subscription.validatorUpdates":
+		if e.complexity.Subscription.ValidatorUpdates == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_attestationScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Subscription_validatorUpdates_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Performance_proposalSuccess(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_proposalSuccess,
-		func(ctx context.Context) (any, error) {
-			return obj.ProposalSuccess, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Subscription.ValidatorUpdates(childComplexity, args["indices"].([]int)), true
 
-func (ec *executionContext) fieldContext_Performance_proposalSuccess(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "TagIncome.tag":
+		if e.complexity.TagIncome.Tag == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_proposalMissed(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_proposalMissed,
-		func(ctx context.Context) (any, error) {
-			return obj.ProposalMissed, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.TagIncome.Tag(childComplexity), true
+	case "TagIncome.windows":
+		if e.complexity.TagIncome.Windows == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_proposalMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TagIncome.Windows(childComplexity), true
 
-func (ec *executionContext) _Performance_effectiveness(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_effectiveness,
-		func(ctx context.Context) (any, error) {
-			return obj.Effectiveness, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+	case "User.createdAt":
+		if e.complexity.User.CreatedAt == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_effectiveness(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.User.CreatedAt(childComplexity), true
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_networkAverage(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_networkAverage,
-		func(ctx context.Context) (any, error) {
-			return obj.NetworkAverage, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		return e.complexity.User.Email(childComplexity), true
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_networkAverage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.User.ID(childComplexity), true
+	case "User.lastLogin":
+		if e.complexity.User.LastLogin == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_networkPercentile(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_networkPercentile,
-		func(ctx context.Context) (any, error) {
-			return obj.NetworkPercentile, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		return e.complexity.User.LastLogin(childComplexity), true
+	case "User.roles":
+		if e.complexity.User.Roles == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_networkPercentile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.User.Roles(childComplexity), true
+	case "User.username":
+		if e.complexity.User.Username == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_slashingRisk(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_slashingRisk,
-		func(ctx context.Context) (any, error) {
-			return obj.SlashingRisk, nil
-		},
-		nil,
-		ec.marshalNRiskLevel2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐRiskLevel,
-		true,
-		true,
-	)
-}
+		return e.complexity.User.Username(childComplexity), true
 
-func (ec *executionContext) fieldContext_Performance_slashingRisk(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RiskLevel does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Validator.activationEpoch":
+		if e.complexity.Validator.ActivationEpoch == nil {
+			break
+		}
 
-func (ec *executionContext) _Performance_inactivityScore(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Performance_inactivityScore,
-		func(ctx context.Context) (any, error) {
-			return obj.InactivityScore, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Validator.ActivationEpoch(childComplexity), true
+	case "Validator.alerts":
+		if e.complexity.Validator.Alerts == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Performance_inactivityScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Performance",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Validator.Alerts(childComplexity), true
+	case "Validator.attestationMisses":
+		if e.complexity.Validator.AttestationMisses == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_validator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_validator,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Validator(ctx, fc.Args["index"].(*int), fc.Args["pubkey"].(*string))
-		},
-		nil,
-		ec.marshalOValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
-		true,
-		false,
-	)
-}
+		args, err := ec.field_Validator_attestationMisses_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Query_validator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "index":
-				return ec.fieldContext_Validator_index(ctx, field)
-			case "pubkey":
-				return ec.fieldContext_Validator_pubkey(ctx, field)
-			case "name":
-				return ec.fieldContext_Validator_name(ctx, field)
-			case "status":
-				return ec.fieldContext_Validator_status(ctx, field)
-			case "activationEpoch":
-				return ec.fieldContext_Validator_activationEpoch(ctx, field)
-			case "exitEpoch":
-				return ec.fieldContext_Validator_exitEpoch(ctx, field)
-			case "slashed":
-				return ec.fieldContext_Validator_slashed(ctx, field)
-			case "balance":
-				return ec.fieldContext_Validator_balance(ctx, field)
-			case "performance":
-				return ec.fieldContext_Validator_performance(ctx, field)
-			case "rewards":
-				return ec.fieldContext_Validator_rewards(ctx, field)
-			case "alerts":
-				return ec.fieldContext_Validator_alerts(ctx, field)
-			case "history":
-				return ec.fieldContext_Validator_history(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Validator_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Validator_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Validator.AttestationMisses(childComplexity, args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Validator.balance":
+		if e.complexity.Validator.Balance == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_validator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_validators(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_validators,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Validators(ctx, fc.Args["filter"].(*models.ValidatorFilter))
-		},
-		nil,
-		ec.marshalNValidator2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidatorᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Validator.Balance(childComplexity), true
+	case "Validator.createdAt":
+		if e.complexity.Validator.CreatedAt == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_validators(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "index":
-				return ec.fieldContext_Validator_index(ctx, field)
-			case "pubkey":
-				return ec.fieldContext_Validator_pubkey(ctx, field)
-			case "name":
-				return ec.fieldContext_Validator_name(ctx, field)
-			case "status":
-				return ec.fieldContext_Validator_status(ctx, field)
-			case "activationEpoch":
-				return ec.fieldContext_Validator_activationEpoch(ctx, field)
-			case "exitEpoch":
-				return ec.fieldContext_Validator_exitEpoch(ctx, field)
-			case "slashed":
-				return ec.fieldContext_Validator_slashed(ctx, field)
-			case "balance":
-				return ec.fieldContext_Validator_balance(ctx, field)
-			case "performance":
-				return ec.fieldContext_Validator_performance(ctx, field)
-			case "rewards":
-				return ec.fieldContext_Validator_rewards(ctx, field)
-			case "alerts":
-				return ec.fieldContext_Validator_alerts(ctx, field)
-			case "history":
-				return ec.fieldContext_Validator_history(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Validator_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Validator_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Validator.CreatedAt(childComplexity), true
+	case "Validator.exitEpoch":
+		if e.complexity.Validator.ExitEpoch == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_validators_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_network(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_network,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Network(ctx)
-		},
-		nil,
-		ec.marshalNNetworkStats2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐNetworkStats,
-		true,
-		true,
-	)
-}
+		return e.complexity.Validator.ExitEpoch(childComplexity), true
+	case "Validator.history":
+		if e.complexity.Validator.History == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_network(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "currentEpoch":
-				return ec.fieldContext_NetworkStats_currentEpoch(ctx, field)
-			case "currentSlot":
-				return ec.fieldContext_NetworkStats_currentSlot(ctx, field)
-			case "totalValidators":
-				return ec.fieldContext_NetworkStats_totalValidators(ctx, field)
-			case "activeValidators":
-				return ec.fieldContext_NetworkStats_activeValidators(ctx, field)
-			case "pendingValidators":
-				return ec.fieldContext_NetworkStats_pendingValidators(ctx, field)
-			case "exitingValidators":
-				return ec.fieldContext_NetworkStats_exitingValidators(ctx, field)
-			case "slashedValidators":
-				return ec.fieldContext_NetworkStats_slashedValidators(ctx, field)
-			case "averageBalance":
-				return ec.fieldContext_NetworkStats_averageBalance(ctx, field)
-			case "totalStaked":
-				return ec.fieldContext_NetworkStats_totalStaked(ctx, field)
-			case "participationRate":
-				return ec.fieldContext_NetworkStats_participationRate(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_NetworkStats_timestamp(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type NetworkStats", field.Name)
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Validator_history_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query_alerts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_alerts,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Alerts(ctx, fc.Args["filter"].(*models.AlertFilter))
-		},
-		nil,
-		ec.marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Validator.History(childComplexity, args["from"].(*types.Time), args["to"].(*types.Time)), true
+	case "Validator.income":
+		if e.complexity.Validator.Income == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Alert_id(ctx, field)
-			case "validatorIndex":
-				return ec.fieldContext_Alert_validatorIndex(ctx, field)
-			case "severity":
-				return ec.fieldContext_Alert_severity(ctx, field)
-			case "type":
-				return ec.fieldContext_Alert_type(ctx, field)
-			case "message":
-				return ec.fieldContext_Alert_message(ctx, field)
-			case "acknowledged":
-				return ec.fieldContext_Alert_acknowledged(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Alert_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		args, err := ec.field_Validator_income_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_alerts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+
+		return e.complexity.Validator.Income(childComplexity, args["windows"].([]string)), true
+	case "Validator.index":
+		if e.complexity.Validator.Index == nil {
+			break
+		}
+
+		return e.complexity.Validator.Index(childComplexity), true
+	case "Validator.name":
+		if e.complexity.Validator.Name == nil {
+			break
+		}
+
+		return e.complexity.Validator.Name(childComplexity), true
+	case "Validator.performance":
+		if e.complexity.Validator.Performance == nil {
+			break
+		}
+
+		return e.complexity.Validator.Performance(childComplexity), true
+	case "Validator.pubkey":
+		if e.complexity.Validator.Pubkey == nil {
+			break
+		}
+
+		return e.complexity.Validator.Pubkey(childComplexity), true
+	case "Validator.rewards":
+		if e.complexity.Validator.Rewards == nil {
+			break
+		}
+
+		return e.complexity.Validator.Rewards(childComplexity), true
+	case "Validator.slashed":
+		if e.complexity.Validator.Slashed == nil {
+			break
+		}
+
+		return e.complexity.Validator.Slashed(childComplexity), true
+	case "Validator.status":
+		if e.complexity.Validator.Status == nil {
+			break
+		}
+
+		return e.complexity.Validator.Status(childComplexity), true
+	case "Validator.updatedAt":
+		if e.complexity.Validator.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Validator.UpdatedAt(childComplexity), true
+
+	case "WorkerPoolStats.activeWorkers":
+		if e.complexity.WorkerPoolStats.ActiveWorkers == nil {
+			break
+		}
+
+		return e.complexity.WorkerPoolStats.ActiveWorkers(childComplexity), true
+	case "WorkerPoolStats.busyWorkers":
+		if e.complexity.WorkerPoolStats.BusyWorkers == nil {
+			break
+		}
+
+		return e.complexity.WorkerPoolStats.BusyWorkers(childComplexity), true
+	case "WorkerPoolStats.queueSize":
+		if e.complexity.WorkerPoolStats.QueueSize == nil {
+			break
+		}
+
+		return e.complexity.WorkerPoolStats.QueueSize(childComplexity), true
+	case "WorkerPoolStats.resultQueueSize":
+		if e.complexity.WorkerPoolStats.ResultQueueSize == nil {
+			break
+		}
+
+		return e.complexity.WorkerPoolStats.ResultQueueSize(childComplexity), true
+	case "WorkerPoolStats.tasksFailed":
+		if e.complexity.WorkerPoolStats.TasksFailed == nil {
+			break
+		}
+
+		return e.complexity.WorkerPoolStats.TasksFailed(childComplexity), true
+	case "WorkerPoolStats.tasksProcessed":
+		if e.complexity.WorkerPoolStats.TasksProcessed == nil {
+			break
+		}
+
+		return e.complexity.WorkerPoolStats.TasksProcessed(childComplexity), true
+
 	}
-	return fc, nil
+	return 0, false
 }
 
-func (ec *executionContext) _Query_alert(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_alert,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Alert(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalOAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert,
-		true,
-		false,
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAddDiscoveryRuleInput,
+		ec.unmarshalInputAddValidatorInput,
+		ec.unmarshalInputAlertFilter,
+		ec.unmarshalInputLoginInput,
+		ec.unmarshalInputRegisterInput,
+		ec.unmarshalInputValidatorFilter,
 	)
-}
+	first := true
 
-func (ec *executionContext) fieldContext_Query_alert(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Alert_id(ctx, field)
-			case "validatorIndex":
-				return ec.fieldContext_Alert_validatorIndex(ctx, field)
-			case "severity":
-				return ec.fieldContext_Alert_severity(ctx, field)
-			case "type":
-				return ec.fieldContext_Alert_type(ctx, field)
-			case "message":
-				return ec.fieldContext_Alert_message(ctx, field)
-			case "acknowledged":
-				return ec.fieldContext_Alert_acknowledged(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Alert_createdAt(ctx, field)
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_alert_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
 	}
-	return fc, nil
 }
 
-func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_health,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Health(ctx)
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
 }
 
-func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "../schema.graphqls", Input: `# GraphQL schema for eth-validator-monitor
+
+scalar Time
+scalar BigInt
+
+# Enums
+enum ValidatorStatus {
+  PENDING
+  ACTIVE
+  EXITING
+  EXITED
+  SLASHED
+  UNKNOWN
+}
+
+enum AlertSeverity {
+  CRITICAL
+  WARNING
+  INFO
+}
+
+enum RiskLevel {
+  NONE
+  LOW
+  MEDIUM
+  HIGH
+}
+
+# Types
+type Validator {
+  index: Int!
+  pubkey: String!
+  name: String
+  status: ValidatorStatus!
+  activationEpoch: Int
+  exitEpoch: Int
+  slashed: Boolean!
+  balance: Balance!
+  performance: Performance!
+  rewards: Rewards!
+  """
+  Daily income and APR over trailing windows such as "7d" (defaults to the configured windows)
+  """
+  income(windows: [String!]): [IncomeWindowSummary!]!
+  """
+  Suboptimal attestations by root cause over [from, to) (defaults to the last 7 days)
+  """
+  attestationMisses(from: Time, to: Time): [AttestationMissCount!]!
+  alerts: [Alert!]!
+  history(from: Time, to: Time): [HistoricalSnapshot!]!
+  createdAt: Time!
+  updatedAt: Time!
+}
+
+type Balance {
+  current: BigInt!
+  effective: BigInt!
+  withdrawable: BigInt!
+}
+
+type Performance {
+  uptimePercentage: Float!
+  consecutiveMisses: Int!
+  totalMissed: Int!
+  attestationScore: Float!
+  proposalSuccess: Int!
+  proposalMissed: Int!
+  effectiveness: Float!
+  networkAverage: Float!
+  networkPercentile: Float!
+  slashingRisk: RiskLevel!
+  inactivityScore: Int!
+}
+
+"""
+Consensus rewards over the last 30 days, from the rewards ledger. Amounts are in Gwei.
+Expected assumes perfect attestations plus the proposal and sync duties actually assigned.
+"""
+type Rewards {
+  expected: BigInt!
+  actual: BigInt!
+  effectiveness: Float!
+}
+
+type HistoricalSnapshot {
+  epoch: Int!
+  slot: Int!
+  timestamp: Time!
+  balance: BigInt!
+  effectiveBalance: BigInt!
+  attestationSuccess: Boolean
+  inclusionDelay: Int
+  proposalSuccess: Boolean
+  performanceScore: Float
+  networkPercentile: Float
+}
+
+type Alert {
+  id: ID!
+  validatorIndex: Int!
+  severity: AlertSeverity!
+  type: String!
+  message: String!
+  acknowledged: Boolean!
+  createdAt: Time!
+}
+
+"""
+Correlated alerts of one failure type grouped into a single incident, scoped to the validator
+client holding the keys, a validator tag, or the whole fleet
+"""
+type Incident {
+  id: ID!
+  failureType: String!
+  """NODE, TAG or FLEET"""
+  groupKind: String!
+  """Validator client name or tag; empty for the fleet"""
+  groupValue: String!
+  scope: String!
+  title: String!
+  """Highest severity of the member alerts"""
+  severity: AlertSeverity!
+  """OPEN, ACKNOWLEDGED or RESOLVED"""
+  status: String!
+  alertCount: Int!
+  """Member alerts not yet resolved, ignored or dismissed"""
+  openAlerts: Int!
+  validatorCount: Int!
+  startedAt: Time!
+  lastAlertAt: Time!
+  acknowledgedAt: Time
+  acknowledgedBy: String
+  resolvedAt: Time
+  """Null when the incident resolved itself with its member alerts"""
+  resolvedBy: String
+  """Member alerts, oldest first"""
+  alerts: [Alert!]!
+  """Lifecycle and member alert events, oldest first, with alerts of the same minute collapsed"""
+  timeline: [IncidentEvent!]!
+  """Income the member validators lost from the start of the incident until its resolution or now"""
+  downtimeCost: DowntimeCost
+}
+
+type IncidentEvent {
+  time: Time!
+  """OPENED, ALERTS_RAISED, ALERTS_RESOLVED, ACKNOWLEDGED or RESOLVED"""
+  kind: String!
+  """Member alerts raised or resolved; zero for lifecycle events"""
+  count: Int!
+  message: String!
+}
+
+type NetworkStats {
+  currentEpoch: Int!
+  currentSlot: Int!
+  totalValidators: Int!
+  activeValidators: Int!
+  pendingValidators: Int!
+  exitingValidators: Int!
+  slashedValidators: Int!
+  averageBalance: BigInt!
+  totalStaked: BigInt!
+  participationRate: Float!
+  timestamp: Time!
+  """Consensus clients of the blocks in the latest collected epoch"""
+  clientDiversity: EpochClientDiversity
+}
+
+# Inputs
+input AddValidatorInput {
+  pubkey: String
+  index: Int
+  name: String
+}
+
+input ValidatorFilter {
+  status: ValidatorStatus
+  slashed: Boolean
+  indices: [Int!]
+  pubkeys: [String!]
+  limit: Int
+  offset: Int
+}
+
+input AlertFilter {
+  validatorIndex: Int
+  severity: AlertSeverity
+  acknowledged: Boolean
+  from: Time
+  to: Time
+  limit: Int
+  offset: Int
+}
+
+# Authentication Types
+type User {
+  id: ID!
+  username: String!
+  email: String!
+  roles: [String!]!
+  createdAt: Time!
+  lastLogin: Time
+}
+
+type AuthPayload {
+  """Access token for API authentication"""
+  accessToken: String!
+
+  """Refresh token for obtaining new access tokens"""
+  refreshToken: String!
+
+  """User information"""
+  user: User!
+
+  """Token expiration time (Unix timestamp)"""
+  expiresAt: Int!
+}
+
+"""Bucket size for rewards ledger summaries (UTC)"""
+enum LedgerInterval {
+  DAY
+  WEEK
+  MONTH
+}
+
+"""Rewards, penalties and transfers over one ledger period. Amounts are in Gwei."""
+type RewardsPeriodSummary {
+  periodStart: Time!
+  epochs: Int!
+  attestationRewards: BigInt!
+  proposalRewards: BigInt!
+  syncRewards: BigInt!
+  penalties: BigInt!
+  withdrawals: BigInt!
+  deposits: BigInt!
+  other: BigInt!
+  expected: BigInt!
+  actual: BigInt!
+  effectiveness: Float!
+}
+
+"""
+Consensus income over a trailing window, from the rewards ledger. Amounts are in Gwei.
+Withdrawals and deposits are not income, so a sweep or top-up does not change the figures.
+"""
+type IncomeWindowSummary {
+  """Window length in days, such as 30d"""
+  window: String!
+  """Epochs in the window with ledger entries; APR is annualised from these"""
+  epochs: Int!
+  income: BigInt!
+  dailyIncome: BigInt!
+  apr: Float!
+}
+
+"""Income for the monitored validators carrying a tag"""
+type TagIncome {
+  tag: String!
+  windows: [IncomeWindowSummary!]!
+}
+
+"""
+Suboptimal attestations with one root cause. reason is one of not_included, wrong_target,
+wrong_head, late_inclusion or missed_block; blame is node, network or chain.
+"""
+type AttestationMissCount {
+  reason: String!
+  blame: String!
+  count: Int!
+}
+
+"""Attestation misses by the validators running on one node, as named by their node:<name> tags"""
+type NodeAttestationMisses {
+  """Node name, or null for validators without a node tag"""
+  node: String
+  misses: [AttestationMissCount!]!
+}
+
+"""
+Consensus income lost to imperfect duty performance over [from, to). Amounts are in Gwei.
+proposalLoss values each missed proposal at the recent average proposal reward, so it is an estimate.
+"""
+type DowntimeCost {
+  from: Time!
+  to: Time!
+  """Validators with ledger entries in the range"""
+  validators: Int!
+  epochs: Int!
+  attestationLoss: BigInt!
+  missedProposals: Int!
+  proposalLoss: BigInt!
+  syncLoss: BigInt!
+  total: BigInt!
+}
+
+"""
+Selections for one duty compared with the number expected from effective balance. An actual count
+between expectedLow and expectedHigh is within the 95% range of chance.
+"""
+type DutyLuck {
+  expected: Float!
+  actual: Int!
+  """Actual selections as a percentage of expected"""
+  luck: Float!
+  """Percentile of the actual count among outcomes of chance alone (50 is exactly average luck)"""
+  percentile: Float!
+  expectedLow: Int!
+  expectedHigh: Int!
+}
+
+"""
+Block proposals and sync committee seats of monitored validators over [from, to) compared with their
+share of the network's active balance, which is estimated from the network rank sample
+"""
+type ProposalLuck {
+  """Tag, or null for all monitored validators"""
+  tag: String
+  from: Time!
+  to: Time!
+  """Validators with ledger entries in the range"""
+  validators: Int!
+  epochs: Int!
+  proposals: DutyLuck!
+  syncCommittees: DutyLuck!
+}
+
+# Admin Types
+"""Live state of the validator collector"""
+type CollectorStatus {
+  paused: Boolean!
+  validatorsMonitored: Int!
+  collectionsCount: Int!
+  errorsCount: Int!
+  lastCollectionTime: Time
+  pool: WorkerPoolStats!
+  circuits: [CircuitStatus!]!
+}
+
+type WorkerPoolStats {
+  tasksProcessed: Int!
+  tasksFailed: Int!
+  activeWorkers: Int!
+  busyWorkers: Int!
+  queueSize: Int!
+  resultQueueSize: Int!
+}
+
+type CircuitStatus {
+  component: String!
+  state: String!
+}
+
+"""Outcome of a forced re-collection"""
+type RecollectResult {
+  validatorIndex: Int!
+  fromEpoch: Int!
+  toEpoch: Int!
+  recollected: Int!
+  replaced: Int!
+  unavailableEpochs: [Int!]!
+}
+
+"""An operator action recorded in the admin audit trail"""
+type AdminAuditEntry {
+  id: ID!
+  actor: String!
+  authMethod: String!
+  action: String!
+  """Action parameters as a JSON object"""
+  params: String
+  success: Boolean!
+  error: String
+  createdAt: Time!
+}
+
+"""What a discovery rule matches validators by"""
+enum DiscoveryRuleKind {
+  """Withdrawal address or full withdrawal credentials"""
+  WITHDRAWAL
+  """Fee recipient of blocks the validator proposed"""
+  FEE_RECIPIENT
+  """Sender of the validator's deposits (needs an execution client)"""
+  DEPOSITOR
+}
+
+"""Enrolls every validator matching value as a monitored validator under tag"""
+type DiscoveryRule {
+  id: ID!
+  kind: DiscoveryRuleKind!
+  value: String!
+  tag: String!
+  """Validators enrolled by the rule so far"""
+  enrolled: Int!
+  lastRunAt: Time
+  createdAt: Time!
+}
+
+input AddDiscoveryRuleInput {
+  kind: DiscoveryRuleKind!
+  """Execution address, or 32-byte withdrawal credentials for WITHDRAWAL rules"""
+  value: String!
+  tag: String!
+  """Execution block DEPOSITOR rules start searching from (defaults to the configured start block)"""
+  fromBlock: Int
+}
+
+"""Where the execution rewards of a block proposed by a monitored validator went"""
+type FeeRecipientCheck {
+  validatorIndex: Int!
+  slot: Int!
+  time: Time!
+  blockHash: String!
+  feeRecipient: String!
+  """Addresses the rewards were expected to reach"""
+  expected: [String!]!
+  """Recipient of the block's final transaction, for builder blocks"""
+  paymentTo: String
+  """Value of the block's final transaction in Gwei, for builder blocks"""
+  paymentGwei: BigInt
+  """COMPLIANT, BUILDER_PAID or MISMATCH"""
+  status: String!
+  compliant: Boolean!
+}
+
+"""How a block proposed by a monitored validator was built. Amounts are in Gwei."""
+type RelayProposal {
+  validatorIndex: Int!
+  slot: Int!
+  time: Time!
+  blockHash: String!
+  """RELAY, LOCAL, or FALLBACK for a local block by a validator registered with a relay"""
+  source: String!
+  """Relays that reported delivering the payload"""
+  relays: [String!]!
+  builderPubkey: String
+  """Value of the winning bid"""
+  promised: BigInt
+  """Builder payment to the proposer, when the block shows it"""
+  received: BigInt
+  """Promised minus received, when both are known"""
+  shortfall: BigInt
+}
+
+"""
+Proposals of monitored validators by relay, or by source for locally built blocks. Amounts are in
+Gwei and cover only the proposals whose builder payment could be verified.
+"""
+type RelaySummary {
+  relay: String!
+  source: String!
+  proposals: Int!
+  verified: Int!
+  promised: BigInt!
+  received: BigInt!
+}
+
+"""The consensus client a canonical block's graffiti identifies"""
+type BlockClient {
+  slot: Int!
+  epoch: Int!
+  proposerIndex: Int!
+  time: Time!
+  graffiti: String!
+  """LIGHTHOUSE, PRYSM, TEKU, NIMBUS, LODESTAR, GRANDINE or UNKNOWN"""
+  client: String!
+  """Release, or commit prefix from a client version code; empty when the graffiti has neither"""
+  version: String!
+  """Execution client named by a client version code, empty otherwise"""
+  executionClient: String!
+}
+
+"""A consensus client's share of the identified blocks or validators"""
+type ClientShare {
+  """LIGHTHOUSE, PRYSM, TEKU, NIMBUS, LODESTAR or GRANDINE"""
+  client: String!
+  count: Int!
+  share: Float!
+}
+
+"""How blocks or validators are spread over consensus clients"""
+type ClientDistribution {
+  total: Int!
+  """Blocks or validators whose client is known"""
+  identified: Int!
+  """Identified clients, largest first"""
+  shares: [ClientShare!]!
+}
+
+"""Client distribution of the canonical blocks in an epoch"""
+type EpochClientDiversity {
+  epoch: Int!
+  time: Time!
+  distribution: ClientDistribution!
+}
+
+input RegisterInput {
+  username: String!
+  email: String!
+  password: String!
+}
+
+input LoginInput {
+  username: String!
+  password: String!
+}
+
+# Queries
+type Query {
+  """
+  Get a single validator by index or pubkey
+  """
+  validator(index: Int, pubkey: String): Validator
+
+  """
+  Get multiple validators with optional filtering
+  """
+  validators(filter: ValidatorFilter): [Validator!]!
+
+  """
+  Get network-wide statistics
+  """
+  network: NetworkStats!
+
+  """
+  Get alerts with optional filtering
+  """
+  alerts(filter: AlertFilter): [Alert!]!
+
+  """
+  Get a specific alert by ID
+  """
+  alert(id: ID!): Alert
+
+  """
+  Health check
+  """
+  health: String!
+
+  """
+  Get current authenticated user
+  """
+  me: User!
+
+  """
+  Per-period rewards ledger for a validator, oldest first (defaults to the last 30 days)
+  """
+  rewardsLedger(validatorIndex: Int!, interval: LedgerInterval!, from: Time, to: Time): [RewardsPeriodSummary!]!
+
+  """
+  Daily income and APR across all monitored validators (defaults to the configured windows)
+  """
+  portfolioIncome(windows: [String!]): [IncomeWindowSummary!]!
+
+  """
+  Daily income and APR per validator tag, ordered by tag (defaults to the configured windows)
+  """
+  tagIncome(windows: [String!]): [TagIncome!]!
+
+  """
+  Suboptimal attestations by root cause per node over [from, to) (defaults to the last 7 days), ordered by node
+  """
+  attestationMissesByNode(from: Time, to: Time): [NodeAttestationMisses!]!
+
+  """
+  Income lost to downtime over [from, to) (defaults to the last 24 hours) for one validator,
+  the monitored validators carrying a tag, or the whole fleet when neither is given
+  """
+  downtimeCost(validatorIndex: Int, tag: String, from: Time, to: Time): DowntimeCost!
+
+  """
+  Proposal and sync committee luck over [from, to) (defaults to the last 30 days) for all monitored
+  validators, followed by each tag in tag order
+  """
+  proposalLuck(from: Time, to: Time): [ProposalLuck!]!
+
+  """
+  Validator discovery rules, oldest first
+  """
+  discoveryRules: [DiscoveryRule!]!
+
+  """
+  A validator's fee recipient checks of its proposed blocks, newest first
+  """
+  feeRecipientChecks(validatorIndex: Int!, limit: Int): [FeeRecipientCheck!]!
+
+  """
+  How recent blocks of a validator, or of all monitored validators, were built, newest first
+  """
+  relayProposals(validatorIndex: Int, limit: Int): [RelayProposal!]!
+
+  """
+  Relay deliveries and locally built blocks over [from, to) (defaults to the last 30 days)
+  """
+  relaySummary(from: Time, to: Time): [RelaySummary!]!
+
+  """
+  Client distribution of each of the most recent collected epochs (defaults to 32), newest first
+  """
+  clientDiversity(epochs: Int): [EpochClientDiversity!]!
+
+  """
+  Monitored validators that have proposed, optionally only those carrying a tag, each with the
+  client of its latest identified proposal, ordered by validator index
+  """
+  fleetClients(tag: String): [BlockClient!]!
+
+  """
+  Consensus client distribution of the monitored validators that have proposed, optionally only
+  those carrying a tag
+  """
+  fleetClientDistribution(tag: String): ClientDistribution!
+
+  """
+  Incidents, optionally only those with a status (OPEN, ACKNOWLEDGED or RESOLVED), newest first
+  """
+  incidents(status: String, limit: Int, offset: Int): [Incident!]!
+
+  """
+  An incident by ID
+  """
+  incident(id: ID!): Incident
+
+  """
+  Live collector and worker pool statistics (admin only)
+  """
+  collectorStatus: CollectorStatus!
+
+  """
+  Admin action audit trail, most recent first (admin only)
+  """
+  adminAuditLog(limit: Int, offset: Int): [AdminAuditEntry!]!
+}
+
+# Mutations
+type Mutation {
+  """
+  Register a new user account
+  """
+  register(input: RegisterInput!): AuthPayload!
+
+  """
+  Login with username and password
+  """
+  login(input: LoginInput!): AuthPayload!
+
+  """
+  Refresh access token using refresh token
+  """
+  refreshToken(refreshToken: String!): AuthPayload!
+
+  """
+  Add a new validator to monitor
+  """
+  addValidator(input: AddValidatorInput!): Validator!
+
+  """
+  Remove a validator from monitoring
+  """
+  removeValidator(index: Int!): Boolean!
+
+  """
+  Update validator name
+  """
+  updateValidatorName(index: Int!, name: String!): Validator!
+
+  """
+  Acknowledge an alert
+  """
+  acknowledgeAlert(id: ID!): Alert!
+
+  """
+  Acknowledge an open incident and its alerts
+  """
+  acknowledgeIncident(id: ID!): Incident!
+
+  """
+  Resolve an incident and its alerts
+  """
+  resolveIncident(id: ID!): Incident!
+
+  """
+  Add a rule enrolling matching validators for monitoring (admin only)
+  """
+  addDiscoveryRule(input: AddDiscoveryRuleInput!): DiscoveryRule!
+
+  """
+  Remove a discovery rule; validators it enrolled stay monitored (admin only)
+  """
+  removeDiscoveryRule(id: ID!): Boolean!
+
+  """
+  Set the fee recipient expected for a validator, overriding its tags; a null address removes the
+  override (admin only)
+  """
+  setExpectedFeeRecipient(validatorIndex: Int!, address: String): Boolean!
+
+  """
+  Pause scheduled collection (admin only)
+  """
+  pauseCollector: CollectorStatus!
+
+  """
+  Resume scheduled collection (admin only)
+  """
+  resumeCollector: CollectorStatus!
+
+  """
+  Pause collection and wait for queued work to be stored (admin only)
+  """
+  drainWorkerPool: CollectorStatus!
+
+  """
+  Rebuild a validator's snapshots for an epoch range from beacon state (admin only)
+  """
+  recollectValidator(validatorIndex: Int!, fromEpoch: Int!, toEpoch: Int!): RecollectResult!
+}
+
+# Subscriptions
+type Subscription {
+  """
+  Subscribe to validator updates for specific validators
+  """
+  validatorUpdates(indices: [Int!]): Validator!
+
+  """
+  Subscribe to new alerts
+  """
+  newAlerts(severity: AlertSeverity): Alert!
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Mutation_acknowledgeAlert_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_acknowledgeIncident_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addDiscoveryRule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddDiscoveryRuleInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAddDiscoveryRuleInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addValidator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddValidatorInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAddValidatorInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNLoginInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLoginInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recollectValidator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "validatorIndex", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["validatorIndex"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "fromEpoch", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["fromEpoch"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "toEpoch", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["toEpoch"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_refreshToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "refreshToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["refreshToken"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_register_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRegisterInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRegisterInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeDiscoveryRule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeValidator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "index", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["index"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_resolveIncident_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setExpectedFeeRecipient_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "validatorIndex", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["validatorIndex"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "address", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["address"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateValidatorName_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "index", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["index"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_adminAuditLog_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_alert_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_alerts_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOAlertFilter2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_attestationMissesByNode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_clientDiversity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "epochs", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["epochs"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_downtimeCost_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "validatorIndex", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["validatorIndex"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "tag", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["tag"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_feeRecipientChecks_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "validatorIndex", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["validatorIndex"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_fleetClientDistribution_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tag", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_fleetClients_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tag", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["tag"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_incident_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_incidents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_portfolioIncome_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "windows", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["windows"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_proposalLuck_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_relayProposals_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "validatorIndex", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["validatorIndex"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_relaySummary_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_rewardsLedger_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "validatorIndex", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["validatorIndex"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "interval", ec.unmarshalNLedgerInterval2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLedgerInterval)
+	if err != nil {
+		return nil, err
+	}
+	args["interval"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_tagIncome_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "windows", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["windows"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_validator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "index", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["index"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "pubkey", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["pubkey"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_validators_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOValidatorFilter2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidatorFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_newAlerts_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "severity", ec.unmarshalOAlertSeverity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity)
+	if err != nil {
+		return nil, err
+	}
+	args["severity"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_validatorUpdates_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "indices", ec.unmarshalOInt2ᚕintᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["indices"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Validator_attestationMisses_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Validator_history_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Validator_income_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "windows", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["windows"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AdminAuditEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_actor(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_actor,
+		func(ctx context.Context) (any, error) {
+			return obj.Actor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_authMethod(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_authMethod,
+		func(ctx context.Context) (any, error) {
+			return obj.AuthMethod, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_authMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_action,
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_params(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_params,
+		func(ctx context.Context) (any, error) {
+			return obj.Params, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_params(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_success(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_error(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdminAuditEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AdminAuditEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdminAuditEntry_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdminAuditEntry_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdminAuditEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_id(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_id,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Alert().ID(ctx, obj)
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_validatorIndex(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_validatorIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidatorIndex, nil
+		},
+		nil,
+		ec.marshalNInt2ᚖint64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_validatorIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_severity(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_severity,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Alert().Severity(ctx, obj)
+		},
+		nil,
+		ec.marshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AlertSeverity does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_type(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_type,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Alert().Type(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_message(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_acknowledged(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_acknowledged,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Alert().Acknowledged(ctx, obj)
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_acknowledged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Alert_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Alert_createdAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Alert().CreatedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Alert_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Alert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AttestationMissCount_reason(ctx context.Context, field graphql.CollectedField, obj *models.AttestationMissCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AttestationMissCount_reason,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.AttestationMissCount().Reason(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AttestationMissCount_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AttestationMissCount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AttestationMissCount_blame(ctx context.Context, field graphql.CollectedField, obj *models.AttestationMissCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AttestationMissCount_blame,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.AttestationMissCount().Blame(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AttestationMissCount_blame(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AttestationMissCount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AttestationMissCount_count(ctx context.Context, field graphql.CollectedField, obj *models.AttestationMissCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AttestationMissCount_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AttestationMissCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AttestationMissCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_accessToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuthPayload_accessToken,
+		func(ctx context.Context) (any, error) {
+			return obj.AccessToken, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_accessToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuthPayload_refreshToken,
+		func(ctx context.Context) (any, error) {
+			return obj.RefreshToken, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuthPayload_user,
+		func(ctx context.Context) (any, error) {
+			return obj.User, nil
+		},
+		nil,
+		ec.marshalNUser2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐUser,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "lastLogin":
+				return ec.fieldContext_User_lastLogin(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AuthPayload_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Balance_current(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Balance_current,
+		func(ctx context.Context) (any, error) {
+			return obj.Current, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Balance_current(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Balance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Balance_effective(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Balance_effective,
+		func(ctx context.Context) (any, error) {
+			return obj.Effective, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Balance_effective(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Balance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Balance_withdrawable(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Balance_withdrawable,
+		func(ctx context.Context) (any, error) {
+			return obj.Withdrawable, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Balance_withdrawable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Balance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_slot(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_slot,
+		func(ctx context.Context) (any, error) {
+			return obj.Slot, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_epoch(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_epoch,
+		func(ctx context.Context) (any, error) {
+			return obj.Epoch, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_epoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_proposerIndex(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_proposerIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.ProposerIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_proposerIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_time(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_time,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.BlockClient().Time(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_graffiti(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_graffiti,
+		func(ctx context.Context) (any, error) {
+			return obj.Graffiti, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_graffiti(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_client(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_client,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.BlockClient().Client(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_client(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_version(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockClient_executionClient(ctx context.Context, field graphql.CollectedField, obj *models.BlockClient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BlockClient_executionClient,
+		func(ctx context.Context) (any, error) {
+			return obj.ExecutionClient, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BlockClient_executionClient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockClient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CircuitStatus_component(ctx context.Context, field graphql.CollectedField, obj *model.CircuitStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CircuitStatus_component,
+		func(ctx context.Context) (any, error) {
+			return obj.Component, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CircuitStatus_component(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CircuitStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CircuitStatus_state(ctx context.Context, field graphql.CollectedField, obj *model.CircuitStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CircuitStatus_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CircuitStatus_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CircuitStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ClientDistribution_total(ctx context.Context, field graphql.CollectedField, obj *models.ClientDistribution) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ClientDistribution_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ClientDistribution_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ClientDistribution",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ClientDistribution_identified(ctx context.Context, field graphql.CollectedField, obj *models.ClientDistribution) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ClientDistribution_identified,
+		func(ctx context.Context) (any, error) {
+			return obj.Identified, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ClientDistribution_identified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ClientDistribution",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ClientDistribution_shares(ctx context.Context, field graphql.CollectedField, obj *models.ClientDistribution) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ClientDistribution_shares,
+		func(ctx context.Context) (any, error) {
+			return obj.Shares, nil
+		},
+		nil,
+		ec.marshalNClientShare2ᚕgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientShareᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ClientDistribution_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ClientDistribution",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "client":
+				return ec.fieldContext_ClientShare_client(ctx, field)
+			case "count":
+				return ec.fieldContext_ClientShare_count(ctx, field)
+			case "share":
+				return ec.fieldContext_ClientShare_share(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClientShare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ClientShare_client(ctx context.Context, field graphql.CollectedField, obj *models.ClientShare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ClientShare_client,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.ClientShare().Client(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ClientShare_client(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ClientShare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ClientShare_count(ctx context.Context, field graphql.CollectedField, obj *models.ClientShare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ClientShare_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ClientShare_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ClientShare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ClientShare_share(ctx context.Context, field graphql.CollectedField, obj *models.ClientShare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ClientShare_share,
+		func(ctx context.Context) (any, error) {
+			return obj.Share, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ClientShare_share(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ClientShare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_paused(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_paused,
+		func(ctx context.Context) (any, error) {
+			return obj.Paused, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_paused(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_validatorsMonitored(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_validatorsMonitored,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidatorsMonitored, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_validatorsMonitored(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_collectionsCount(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_collectionsCount,
+		func(ctx context.Context) (any, error) {
+			return obj.CollectionsCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_collectionsCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_errorsCount(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_errorsCount,
+		func(ctx context.Context) (any, error) {
+			return obj.ErrorsCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_errorsCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_lastCollectionTime(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_lastCollectionTime,
+		func(ctx context.Context) (any, error) {
+			return obj.LastCollectionTime, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_lastCollectionTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_pool(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_pool,
+		func(ctx context.Context) (any, error) {
+			return obj.Pool, nil
+		},
+		nil,
+		ec.marshalNWorkerPoolStats2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐWorkerPoolStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_pool(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tasksProcessed":
+				return ec.fieldContext_WorkerPoolStats_tasksProcessed(ctx, field)
+			case "tasksFailed":
+				return ec.fieldContext_WorkerPoolStats_tasksFailed(ctx, field)
+			case "activeWorkers":
+				return ec.fieldContext_WorkerPoolStats_activeWorkers(ctx, field)
+			case "busyWorkers":
+				return ec.fieldContext_WorkerPoolStats_busyWorkers(ctx, field)
+			case "queueSize":
+				return ec.fieldContext_WorkerPoolStats_queueSize(ctx, field)
+			case "resultQueueSize":
+				return ec.fieldContext_WorkerPoolStats_resultQueueSize(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WorkerPoolStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CollectorStatus_circuits(ctx context.Context, field graphql.CollectedField, obj *model.CollectorStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CollectorStatus_circuits,
+		func(ctx context.Context) (any, error) {
+			return obj.Circuits, nil
+		},
+		nil,
+		ec.marshalNCircuitStatus2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCircuitStatusᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CollectorStatus_circuits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CollectorStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "component":
+				return ec.fieldContext_CircuitStatus_component(ctx, field)
+			case "state":
+				return ec.fieldContext_CircuitStatus_state(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CircuitStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_id(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_id,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DiscoveryRule().ID(ctx, obj)
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_kind(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_kind,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DiscoveryRule().Kind(ctx, obj)
+		},
+		nil,
+		ec.marshalNDiscoveryRuleKind2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐDiscoveryRuleKind,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DiscoveryRuleKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_value(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_tag(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_tag,
+		func(ctx context.Context) (any, error) {
+			return obj.Tag, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_enrolled(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_enrolled,
+		func(ctx context.Context) (any, error) {
+			return obj.Enrolled, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_enrolled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_lastRunAt(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_lastRunAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DiscoveryRule().LastRunAt(ctx, obj)
+		},
+		nil,
+		ec.marshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_lastRunAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscoveryRule_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.DiscoveryRule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscoveryRule_createdAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DiscoveryRule().CreatedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscoveryRule_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscoveryRule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_from(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_from,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DowntimeCost().From(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_from(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_to(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_to,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DowntimeCost().To(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_to(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_validators(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_validators,
+		func(ctx context.Context) (any, error) {
+			return obj.Validators, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_validators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_epochs(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_epochs,
+		func(ctx context.Context) (any, error) {
+			return obj.Epochs, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_epochs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_attestationLoss(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_attestationLoss,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DowntimeCost().AttestationLoss(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_attestationLoss(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_missedProposals(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_missedProposals,
+		func(ctx context.Context) (any, error) {
+			return obj.MissedProposals, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_missedProposals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_proposalLoss(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_proposalLoss,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DowntimeCost().ProposalLoss(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_proposalLoss(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_syncLoss(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_syncLoss,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DowntimeCost().SyncLoss(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_syncLoss(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DowntimeCost_total(ctx context.Context, field graphql.CollectedField, obj *models.DowntimeCost) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DowntimeCost_total,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DowntimeCost().Total(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DowntimeCost_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DowntimeCost",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DutyLuck_expected(ctx context.Context, field graphql.CollectedField, obj *models.DutyLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DutyLuck_expected,
+		func(ctx context.Context) (any, error) {
+			return obj.Expected, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DutyLuck_expected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DutyLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DutyLuck_actual(ctx context.Context, field graphql.CollectedField, obj *models.DutyLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DutyLuck_actual,
+		func(ctx context.Context) (any, error) {
+			return obj.Actual, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DutyLuck_actual(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DutyLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DutyLuck_luck(ctx context.Context, field graphql.CollectedField, obj *models.DutyLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DutyLuck_luck,
+		func(ctx context.Context) (any, error) {
+			return obj.Luck(), nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DutyLuck_luck(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DutyLuck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DutyLuck_percentile(ctx context.Context, field graphql.CollectedField, obj *models.DutyLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DutyLuck_percentile,
+		func(ctx context.Context) (any, error) {
+			return obj.Percentile, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DutyLuck_percentile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DutyLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DutyLuck_expectedLow(ctx context.Context, field graphql.CollectedField, obj *models.DutyLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DutyLuck_expectedLow,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpectedLow, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DutyLuck_expectedLow(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DutyLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DutyLuck_expectedHigh(ctx context.Context, field graphql.CollectedField, obj *models.DutyLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DutyLuck_expectedHigh,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpectedHigh, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DutyLuck_expectedHigh(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DutyLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EpochClientDiversity_epoch(ctx context.Context, field graphql.CollectedField, obj *models.EpochClientDiversity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EpochClientDiversity_epoch,
+		func(ctx context.Context) (any, error) {
+			return obj.Epoch, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EpochClientDiversity_epoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EpochClientDiversity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EpochClientDiversity_time(ctx context.Context, field graphql.CollectedField, obj *models.EpochClientDiversity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EpochClientDiversity_time,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.EpochClientDiversity().Time(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EpochClientDiversity_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EpochClientDiversity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EpochClientDiversity_distribution(ctx context.Context, field graphql.CollectedField, obj *models.EpochClientDiversity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EpochClientDiversity_distribution,
+		func(ctx context.Context) (any, error) {
+			return obj.Distribution, nil
+		},
+		nil,
+		ec.marshalNClientDistribution2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientDistribution,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EpochClientDiversity_distribution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EpochClientDiversity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_ClientDistribution_total(ctx, field)
+			case "identified":
+				return ec.fieldContext_ClientDistribution_identified(ctx, field)
+			case "shares":
+				return ec.fieldContext_ClientDistribution_shares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClientDistribution", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_validatorIndex(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_validatorIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidatorIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_validatorIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_slot(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_slot,
+		func(ctx context.Context) (any, error) {
+			return obj.Slot, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_time(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_time,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FeeRecipientCheck().Time(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_blockHash(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_blockHash,
+		func(ctx context.Context) (any, error) {
+			return obj.BlockHash, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_blockHash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_feeRecipient(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_feeRecipient,
+		func(ctx context.Context) (any, error) {
+			return obj.FeeRecipient, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_feeRecipient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_expected(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_expected,
+		func(ctx context.Context) (any, error) {
+			return obj.Expected, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_expected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_paymentTo(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_paymentTo,
+		func(ctx context.Context) (any, error) {
+			return obj.PaymentTo, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_paymentTo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_paymentGwei(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_paymentGwei,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FeeRecipientCheck().PaymentGwei(ctx, obj)
+		},
+		nil,
+		ec.marshalOBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_paymentGwei(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_status(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FeeRecipientCheck().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FeeRecipientCheck_compliant(ctx context.Context, field graphql.CollectedField, obj *models.FeeRecipientCheck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FeeRecipientCheck_compliant,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FeeRecipientCheck().Compliant(ctx, obj)
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FeeRecipientCheck_compliant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FeeRecipientCheck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_epoch(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_epoch,
+		func(ctx context.Context) (any, error) {
+			return obj.Epoch, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_epoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_slot(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_slot,
+		func(ctx context.Context) (any, error) {
+			return obj.Slot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_balance(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_balance,
+		func(ctx context.Context) (any, error) {
+			return obj.Balance, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_effectiveBalance(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_effectiveBalance,
+		func(ctx context.Context) (any, error) {
+			return obj.EffectiveBalance, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_effectiveBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_attestationSuccess(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_attestationSuccess,
+		func(ctx context.Context) (any, error) {
+			return obj.AttestationSuccess, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_attestationSuccess(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_inclusionDelay(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_inclusionDelay,
+		func(ctx context.Context) (any, error) {
+			return obj.InclusionDelay, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_inclusionDelay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_proposalSuccess(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_proposalSuccess,
+		func(ctx context.Context) (any, error) {
+			return obj.ProposalSuccess, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_proposalSuccess(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_performanceScore(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_performanceScore,
+		func(ctx context.Context) (any, error) {
+			return obj.PerformanceScore, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_performanceScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HistoricalSnapshot_networkPercentile(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HistoricalSnapshot_networkPercentile,
+		func(ctx context.Context) (any, error) {
+			return obj.NetworkPercentile, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HistoricalSnapshot_networkPercentile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HistoricalSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_id(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_id,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().ID(ctx, obj)
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_failureType(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_failureType,
+		func(ctx context.Context) (any, error) {
+			return obj.FailureType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_failureType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_groupKind(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_groupKind,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().GroupKind(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_groupKind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_groupValue(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_groupValue,
+		func(ctx context.Context) (any, error) {
+			return obj.GroupValue, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_groupValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_scope(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_scope,
+		func(ctx context.Context) (any, error) {
+			return obj.Scope(), nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_scope(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_title(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_title,
+		func(ctx context.Context) (any, error) {
+			return obj.Title(), nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_severity(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_severity,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().Severity(ctx, obj)
+		},
+		nil,
+		ec.marshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AlertSeverity does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_status(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_alertCount(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_alertCount,
+		func(ctx context.Context) (any, error) {
+			return obj.AlertCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_alertCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_openAlerts(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_openAlerts,
+		func(ctx context.Context) (any, error) {
+			return obj.OpenAlerts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_openAlerts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_validatorCount(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_validatorCount,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidatorCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_validatorCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_startedAt(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_startedAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().StartedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_startedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_lastAlertAt(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_lastAlertAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().LastAlertAt(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_lastAlertAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_acknowledgedAt(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_acknowledgedAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().AcknowledgedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_acknowledgedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_acknowledgedBy(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_acknowledgedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.AcknowledgedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_acknowledgedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_resolvedAt(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_resolvedAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().ResolvedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_resolvedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_resolvedBy(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_resolvedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.ResolvedBy, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_resolvedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_alerts(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_alerts,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().Alerts(ctx, obj)
+		},
+		nil,
+		ec.marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_alerts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "validatorIndex":
+				return ec.fieldContext_Alert_validatorIndex(ctx, field)
+			case "severity":
+				return ec.fieldContext_Alert_severity(ctx, field)
+			case "type":
+				return ec.fieldContext_Alert_type(ctx, field)
+			case "message":
+				return ec.fieldContext_Alert_message(ctx, field)
+			case "acknowledged":
+				return ec.fieldContext_Alert_acknowledged(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Alert_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_timeline(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_timeline,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().Timeline(ctx, obj)
+		},
+		nil,
+		ec.marshalNIncidentEvent2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncidentEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_timeline(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "time":
+				return ec.fieldContext_IncidentEvent_time(ctx, field)
+			case "kind":
+				return ec.fieldContext_IncidentEvent_kind(ctx, field)
+			case "count":
+				return ec.fieldContext_IncidentEvent_count(ctx, field)
+			case "message":
+				return ec.fieldContext_IncidentEvent_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IncidentEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Incident_downtimeCost(ctx context.Context, field graphql.CollectedField, obj *models.Incident) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Incident_downtimeCost,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Incident().DowntimeCost(ctx, obj)
+		},
+		nil,
+		ec.marshalODowntimeCost2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDowntimeCost,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Incident_downtimeCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Incident",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "from":
+				return ec.fieldContext_DowntimeCost_from(ctx, field)
+			case "to":
+				return ec.fieldContext_DowntimeCost_to(ctx, field)
+			case "validators":
+				return ec.fieldContext_DowntimeCost_validators(ctx, field)
+			case "epochs":
+				return ec.fieldContext_DowntimeCost_epochs(ctx, field)
+			case "attestationLoss":
+				return ec.fieldContext_DowntimeCost_attestationLoss(ctx, field)
+			case "missedProposals":
+				return ec.fieldContext_DowntimeCost_missedProposals(ctx, field)
+			case "proposalLoss":
+				return ec.fieldContext_DowntimeCost_proposalLoss(ctx, field)
+			case "syncLoss":
+				return ec.fieldContext_DowntimeCost_syncLoss(ctx, field)
+			case "total":
+				return ec.fieldContext_DowntimeCost_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DowntimeCost", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncidentEvent_time(ctx context.Context, field graphql.CollectedField, obj *models.IncidentEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncidentEvent_time,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.IncidentEvent().Time(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncidentEvent_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncidentEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncidentEvent_kind(ctx context.Context, field graphql.CollectedField, obj *models.IncidentEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncidentEvent_kind,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.IncidentEvent().Kind(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncidentEvent_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncidentEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncidentEvent_count(ctx context.Context, field graphql.CollectedField, obj *models.IncidentEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncidentEvent_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncidentEvent_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncidentEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncidentEvent_message(ctx context.Context, field graphql.CollectedField, obj *models.IncidentEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncidentEvent_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncidentEvent_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncidentEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncomeWindowSummary_window(ctx context.Context, field graphql.CollectedField, obj *model.IncomeWindowSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncomeWindowSummary_window,
+		func(ctx context.Context) (any, error) {
+			return obj.Window, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncomeWindowSummary_window(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncomeWindowSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncomeWindowSummary_epochs(ctx context.Context, field graphql.CollectedField, obj *model.IncomeWindowSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncomeWindowSummary_epochs,
+		func(ctx context.Context) (any, error) {
+			return obj.Epochs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncomeWindowSummary_epochs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncomeWindowSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncomeWindowSummary_income(ctx context.Context, field graphql.CollectedField, obj *model.IncomeWindowSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncomeWindowSummary_income,
+		func(ctx context.Context) (any, error) {
+			return obj.Income, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncomeWindowSummary_income(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncomeWindowSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncomeWindowSummary_dailyIncome(ctx context.Context, field graphql.CollectedField, obj *model.IncomeWindowSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncomeWindowSummary_dailyIncome,
+		func(ctx context.Context) (any, error) {
+			return obj.DailyIncome, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncomeWindowSummary_dailyIncome(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncomeWindowSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IncomeWindowSummary_apr(ctx context.Context, field graphql.CollectedField, obj *model.IncomeWindowSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_IncomeWindowSummary_apr,
+		func(ctx context.Context) (any, error) {
+			return obj.Apr, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_IncomeWindowSummary_apr(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IncomeWindowSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_register(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_register,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Register(ctx, fc.Args["input"].(model.RegisterInput))
+		},
+		nil,
+		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_register(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_register_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_login,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Login(ctx, fc.Args["input"].(model.LoginInput))
+		},
+		nil,
+		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_refreshToken,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RefreshToken(ctx, fc.Args["refreshToken"].(string))
+		},
+		nil,
+		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addValidator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addValidator,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddValidator(ctx, fc.Args["input"].(model.AddValidatorInput))
+		},
+		nil,
+		ec.marshalNValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addValidator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Validator_index(ctx, field)
+			case "pubkey":
+				return ec.fieldContext_Validator_pubkey(ctx, field)
+			case "name":
+				return ec.fieldContext_Validator_name(ctx, field)
+			case "status":
+				return ec.fieldContext_Validator_status(ctx, field)
+			case "activationEpoch":
+				return ec.fieldContext_Validator_activationEpoch(ctx, field)
+			case "exitEpoch":
+				return ec.fieldContext_Validator_exitEpoch(ctx, field)
+			case "slashed":
+				return ec.fieldContext_Validator_slashed(ctx, field)
+			case "balance":
+				return ec.fieldContext_Validator_balance(ctx, field)
+			case "performance":
+				return ec.fieldContext_Validator_performance(ctx, field)
+			case "rewards":
+				return ec.fieldContext_Validator_rewards(ctx, field)
+			case "income":
+				return ec.fieldContext_Validator_income(ctx, field)
+			case "attestationMisses":
+				return ec.fieldContext_Validator_attestationMisses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Validator_alerts(ctx, field)
+			case "history":
+				return ec.fieldContext_Validator_history(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Validator_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Validator_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addValidator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_removeValidator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_removeValidator,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RemoveValidator(ctx, fc.Args["index"].(int))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_removeValidator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeValidator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateValidatorName(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateValidatorName,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateValidatorName(ctx, fc.Args["index"].(int), fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalNValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateValidatorName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Validator_index(ctx, field)
+			case "pubkey":
+				return ec.fieldContext_Validator_pubkey(ctx, field)
+			case "name":
+				return ec.fieldContext_Validator_name(ctx, field)
+			case "status":
+				return ec.fieldContext_Validator_status(ctx, field)
+			case "activationEpoch":
+				return ec.fieldContext_Validator_activationEpoch(ctx, field)
+			case "exitEpoch":
+				return ec.fieldContext_Validator_exitEpoch(ctx, field)
+			case "slashed":
+				return ec.fieldContext_Validator_slashed(ctx, field)
+			case "balance":
+				return ec.fieldContext_Validator_balance(ctx, field)
+			case "performance":
+				return ec.fieldContext_Validator_performance(ctx, field)
+			case "rewards":
+				return ec.fieldContext_Validator_rewards(ctx, field)
+			case "income":
+				return ec.fieldContext_Validator_income(ctx, field)
+			case "attestationMisses":
+				return ec.fieldContext_Validator_attestationMisses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Validator_alerts(ctx, field)
+			case "history":
+				return ec.fieldContext_Validator_history(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Validator_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Validator_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateValidatorName_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acknowledgeAlert(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acknowledgeAlert,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AcknowledgeAlert(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acknowledgeAlert(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "validatorIndex":
+				return ec.fieldContext_Alert_validatorIndex(ctx, field)
+			case "severity":
+				return ec.fieldContext_Alert_severity(ctx, field)
+			case "type":
+				return ec.fieldContext_Alert_type(ctx, field)
+			case "message":
+				return ec.fieldContext_Alert_message(ctx, field)
+			case "acknowledged":
+				return ec.fieldContext_Alert_acknowledged(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Alert_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acknowledgeAlert_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acknowledgeIncident(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acknowledgeIncident,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AcknowledgeIncident(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNIncident2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acknowledgeIncident(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Incident_id(ctx, field)
+			case "failureType":
+				return ec.fieldContext_Incident_failureType(ctx, field)
+			case "groupKind":
+				return ec.fieldContext_Incident_groupKind(ctx, field)
+			case "groupValue":
+				return ec.fieldContext_Incident_groupValue(ctx, field)
+			case "scope":
+				return ec.fieldContext_Incident_scope(ctx, field)
+			case "title":
+				return ec.fieldContext_Incident_title(ctx, field)
+			case "severity":
+				return ec.fieldContext_Incident_severity(ctx, field)
+			case "status":
+				return ec.fieldContext_Incident_status(ctx, field)
+			case "alertCount":
+				return ec.fieldContext_Incident_alertCount(ctx, field)
+			case "openAlerts":
+				return ec.fieldContext_Incident_openAlerts(ctx, field)
+			case "validatorCount":
+				return ec.fieldContext_Incident_validatorCount(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_Incident_startedAt(ctx, field)
+			case "lastAlertAt":
+				return ec.fieldContext_Incident_lastAlertAt(ctx, field)
+			case "acknowledgedAt":
+				return ec.fieldContext_Incident_acknowledgedAt(ctx, field)
+			case "acknowledgedBy":
+				return ec.fieldContext_Incident_acknowledgedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Incident_resolvedAt(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_Incident_resolvedBy(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Incident_alerts(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Incident_timeline(ctx, field)
+			case "downtimeCost":
+				return ec.fieldContext_Incident_downtimeCost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Incident", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acknowledgeIncident_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_resolveIncident(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_resolveIncident,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ResolveIncident(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNIncident2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_resolveIncident(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Incident_id(ctx, field)
+			case "failureType":
+				return ec.fieldContext_Incident_failureType(ctx, field)
+			case "groupKind":
+				return ec.fieldContext_Incident_groupKind(ctx, field)
+			case "groupValue":
+				return ec.fieldContext_Incident_groupValue(ctx, field)
+			case "scope":
+				return ec.fieldContext_Incident_scope(ctx, field)
+			case "title":
+				return ec.fieldContext_Incident_title(ctx, field)
+			case "severity":
+				return ec.fieldContext_Incident_severity(ctx, field)
+			case "status":
+				return ec.fieldContext_Incident_status(ctx, field)
+			case "alertCount":
+				return ec.fieldContext_Incident_alertCount(ctx, field)
+			case "openAlerts":
+				return ec.fieldContext_Incident_openAlerts(ctx, field)
+			case "validatorCount":
+				return ec.fieldContext_Incident_validatorCount(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_Incident_startedAt(ctx, field)
+			case "lastAlertAt":
+				return ec.fieldContext_Incident_lastAlertAt(ctx, field)
+			case "acknowledgedAt":
+				return ec.fieldContext_Incident_acknowledgedAt(ctx, field)
+			case "acknowledgedBy":
+				return ec.fieldContext_Incident_acknowledgedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Incident_resolvedAt(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_Incident_resolvedBy(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Incident_alerts(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Incident_timeline(ctx, field)
+			case "downtimeCost":
+				return ec.fieldContext_Incident_downtimeCost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Incident", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resolveIncident_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addDiscoveryRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addDiscoveryRule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddDiscoveryRule(ctx, fc.Args["input"].(model.AddDiscoveryRuleInput))
+		},
+		nil,
+		ec.marshalNDiscoveryRule2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDiscoveryRule,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addDiscoveryRule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DiscoveryRule_id(ctx, field)
+			case "kind":
+				return ec.fieldContext_DiscoveryRule_kind(ctx, field)
+			case "value":
+				return ec.fieldContext_DiscoveryRule_value(ctx, field)
+			case "tag":
+				return ec.fieldContext_DiscoveryRule_tag(ctx, field)
+			case "enrolled":
+				return ec.fieldContext_DiscoveryRule_enrolled(ctx, field)
+			case "lastRunAt":
+				return ec.fieldContext_DiscoveryRule_lastRunAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_DiscoveryRule_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DiscoveryRule", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addDiscoveryRule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_removeDiscoveryRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_removeDiscoveryRule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RemoveDiscoveryRule(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_removeDiscoveryRule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeDiscoveryRule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setExpectedFeeRecipient(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setExpectedFeeRecipient,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SetExpectedFeeRecipient(ctx, fc.Args["validatorIndex"].(int), fc.Args["address"].(*string))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setExpectedFeeRecipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setExpectedFeeRecipient_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_pauseCollector(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_pauseCollector,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().PauseCollector(ctx)
+		},
+		nil,
+		ec.marshalNCollectorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCollectorStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_pauseCollector(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "paused":
+				return ec.fieldContext_CollectorStatus_paused(ctx, field)
+			case "validatorsMonitored":
+				return ec.fieldContext_CollectorStatus_validatorsMonitored(ctx, field)
+			case "collectionsCount":
+				return ec.fieldContext_CollectorStatus_collectionsCount(ctx, field)
+			case "errorsCount":
+				return ec.fieldContext_CollectorStatus_errorsCount(ctx, field)
+			case "lastCollectionTime":
+				return ec.fieldContext_CollectorStatus_lastCollectionTime(ctx, field)
+			case "pool":
+				return ec.fieldContext_CollectorStatus_pool(ctx, field)
+			case "circuits":
+				return ec.fieldContext_CollectorStatus_circuits(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CollectorStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_resumeCollector(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_resumeCollector,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().ResumeCollector(ctx)
+		},
+		nil,
+		ec.marshalNCollectorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCollectorStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_resumeCollector(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "paused":
+				return ec.fieldContext_CollectorStatus_paused(ctx, field)
+			case "validatorsMonitored":
+				return ec.fieldContext_CollectorStatus_validatorsMonitored(ctx, field)
+			case "collectionsCount":
+				return ec.fieldContext_CollectorStatus_collectionsCount(ctx, field)
+			case "errorsCount":
+				return ec.fieldContext_CollectorStatus_errorsCount(ctx, field)
+			case "lastCollectionTime":
+				return ec.fieldContext_CollectorStatus_lastCollectionTime(ctx, field)
+			case "pool":
+				return ec.fieldContext_CollectorStatus_pool(ctx, field)
+			case "circuits":
+				return ec.fieldContext_CollectorStatus_circuits(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CollectorStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_drainWorkerPool(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_drainWorkerPool,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().DrainWorkerPool(ctx)
+		},
+		nil,
+		ec.marshalNCollectorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCollectorStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_drainWorkerPool(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "paused":
+				return ec.fieldContext_CollectorStatus_paused(ctx, field)
+			case "validatorsMonitored":
+				return ec.fieldContext_CollectorStatus_validatorsMonitored(ctx, field)
+			case "collectionsCount":
+				return ec.fieldContext_CollectorStatus_collectionsCount(ctx, field)
+			case "errorsCount":
+				return ec.fieldContext_CollectorStatus_errorsCount(ctx, field)
+			case "lastCollectionTime":
+				return ec.fieldContext_CollectorStatus_lastCollectionTime(ctx, field)
+			case "pool":
+				return ec.fieldContext_CollectorStatus_pool(ctx, field)
+			case "circuits":
+				return ec.fieldContext_CollectorStatus_circuits(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CollectorStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recollectValidator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recollectValidator,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RecollectValidator(ctx, fc.Args["validatorIndex"].(int), fc.Args["fromEpoch"].(int), fc.Args["toEpoch"].(int))
+		},
+		nil,
+		ec.marshalNRecollectResult2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRecollectResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recollectValidator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "validatorIndex":
+				return ec.fieldContext_RecollectResult_validatorIndex(ctx, field)
+			case "fromEpoch":
+				return ec.fieldContext_RecollectResult_fromEpoch(ctx, field)
+			case "toEpoch":
+				return ec.fieldContext_RecollectResult_toEpoch(ctx, field)
+			case "recollected":
+				return ec.fieldContext_RecollectResult_recollected(ctx, field)
+			case "replaced":
+				return ec.fieldContext_RecollectResult_replaced(ctx, field)
+			case "unavailableEpochs":
+				return ec.fieldContext_RecollectResult_unavailableEpochs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecollectResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recollectValidator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_currentEpoch(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_currentEpoch,
+		func(ctx context.Context) (any, error) {
+			return obj.CurrentEpoch, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_currentEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_currentSlot(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_currentSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.CurrentSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_currentSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_totalValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_totalValidators,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalValidators, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_totalValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_activeValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_activeValidators,
+		func(ctx context.Context) (any, error) {
+			return obj.ActiveValidators, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_activeValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_pendingValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_pendingValidators,
+		func(ctx context.Context) (any, error) {
+			return obj.PendingValidators, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_pendingValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_exitingValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_exitingValidators,
+		func(ctx context.Context) (any, error) {
+			return obj.ExitingValidators, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_exitingValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_slashedValidators(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_slashedValidators,
+		func(ctx context.Context) (any, error) {
+			return obj.SlashedValidators, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_slashedValidators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_averageBalance(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_averageBalance,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.NetworkStats().AverageBalance(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_averageBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_totalStaked(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_totalStaked,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.NetworkStats().TotalStaked(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_totalStaked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_participationRate(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_participationRate,
+		func(ctx context.Context) (any, error) {
+			return obj.ParticipationRate, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_participationRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_timestamp(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_timestamp,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.NetworkStats().Timestamp(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NetworkStats_clientDiversity(ctx context.Context, field graphql.CollectedField, obj *types.NetworkStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NetworkStats_clientDiversity,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.NetworkStats().ClientDiversity(ctx, obj)
+		},
+		nil,
+		ec.marshalOEpochClientDiversity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐEpochClientDiversity,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_NetworkStats_clientDiversity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NetworkStats",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "epoch":
+				return ec.fieldContext_EpochClientDiversity_epoch(ctx, field)
+			case "time":
+				return ec.fieldContext_EpochClientDiversity_time(ctx, field)
+			case "distribution":
+				return ec.fieldContext_EpochClientDiversity_distribution(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EpochClientDiversity", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NodeAttestationMisses_node(ctx context.Context, field graphql.CollectedField, obj *model.NodeAttestationMisses) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NodeAttestationMisses_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_NodeAttestationMisses_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NodeAttestationMisses",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NodeAttestationMisses_misses(ctx context.Context, field graphql.CollectedField, obj *model.NodeAttestationMisses) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NodeAttestationMisses_misses,
+		func(ctx context.Context) (any, error) {
+			return obj.Misses, nil
+		},
+		nil,
+		ec.marshalNAttestationMissCount2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAttestationMissCountᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NodeAttestationMisses_misses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NodeAttestationMisses",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "reason":
+				return ec.fieldContext_AttestationMissCount_reason(ctx, field)
+			case "blame":
+				return ec.fieldContext_AttestationMissCount_blame(ctx, field)
+			case "count":
+				return ec.fieldContext_AttestationMissCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AttestationMissCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_uptimePercentage(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_uptimePercentage,
+		func(ctx context.Context) (any, error) {
+			return obj.UptimePercentage, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_uptimePercentage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_consecutiveMisses(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_consecutiveMisses,
+		func(ctx context.Context) (any, error) {
+			return obj.ConsecutiveMisses, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_consecutiveMisses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_totalMissed(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_totalMissed,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalMissed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_totalMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_attestationScore(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_attestationScore,
+		func(ctx context.Context) (any, error) {
+			return obj.AttestationScore, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_attestationScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_proposalSuccess(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_proposalSuccess,
+		func(ctx context.Context) (any, error) {
+			return obj.ProposalSuccess, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_proposalSuccess(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_proposalMissed(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_proposalMissed,
+		func(ctx context.Context) (any, error) {
+			return obj.ProposalMissed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_proposalMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_effectiveness(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_effectiveness,
+		func(ctx context.Context) (any, error) {
+			return obj.Effectiveness, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_effectiveness(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_networkAverage(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_networkAverage,
+		func(ctx context.Context) (any, error) {
+			return obj.NetworkAverage, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_networkAverage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_networkPercentile(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_networkPercentile,
+		func(ctx context.Context) (any, error) {
+			return obj.NetworkPercentile, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_networkPercentile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_slashingRisk(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_slashingRisk,
+		func(ctx context.Context) (any, error) {
+			return obj.SlashingRisk, nil
+		},
+		nil,
+		ec.marshalNRiskLevel2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐRiskLevel,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_slashingRisk(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RiskLevel does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Performance_inactivityScore(ctx context.Context, field graphql.CollectedField, obj *model.Performance) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Performance_inactivityScore,
+		func(ctx context.Context) (any, error) {
+			return obj.InactivityScore, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Performance_inactivityScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Performance",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_tag(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_tag,
+		func(ctx context.Context) (any, error) {
+			return obj.Tag, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_from(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_from,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.ProposalLuck().From(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_from(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_to(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_to,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.ProposalLuck().To(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_to(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_validators(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_validators,
+		func(ctx context.Context) (any, error) {
+			return obj.Validators, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_validators(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_epochs(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_epochs,
+		func(ctx context.Context) (any, error) {
+			return obj.Epochs, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_epochs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_proposals(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_proposals,
+		func(ctx context.Context) (any, error) {
+			return obj.Proposals, nil
+		},
+		nil,
+		ec.marshalNDutyLuck2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDutyLuck,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_proposals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "expected":
+				return ec.fieldContext_DutyLuck_expected(ctx, field)
+			case "actual":
+				return ec.fieldContext_DutyLuck_actual(ctx, field)
+			case "luck":
+				return ec.fieldContext_DutyLuck_luck(ctx, field)
+			case "percentile":
+				return ec.fieldContext_DutyLuck_percentile(ctx, field)
+			case "expectedLow":
+				return ec.fieldContext_DutyLuck_expectedLow(ctx, field)
+			case "expectedHigh":
+				return ec.fieldContext_DutyLuck_expectedHigh(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DutyLuck", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProposalLuck_syncCommittees(ctx context.Context, field graphql.CollectedField, obj *models.ProposalLuck) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProposalLuck_syncCommittees,
+		func(ctx context.Context) (any, error) {
+			return obj.SyncCommittees, nil
+		},
+		nil,
+		ec.marshalNDutyLuck2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDutyLuck,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProposalLuck_syncCommittees(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProposalLuck",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "expected":
+				return ec.fieldContext_DutyLuck_expected(ctx, field)
+			case "actual":
+				return ec.fieldContext_DutyLuck_actual(ctx, field)
+			case "luck":
+				return ec.fieldContext_DutyLuck_luck(ctx, field)
+			case "percentile":
+				return ec.fieldContext_DutyLuck_percentile(ctx, field)
+			case "expectedLow":
+				return ec.fieldContext_DutyLuck_expectedLow(ctx, field)
+			case "expectedHigh":
+				return ec.fieldContext_DutyLuck_expectedHigh(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DutyLuck", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_validator(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_validator,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Validator(ctx, fc.Args["index"].(*int), fc.Args["pubkey"].(*string))
+		},
+		nil,
+		ec.marshalOValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_validator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Validator_index(ctx, field)
+			case "pubkey":
+				return ec.fieldContext_Validator_pubkey(ctx, field)
+			case "name":
+				return ec.fieldContext_Validator_name(ctx, field)
+			case "status":
+				return ec.fieldContext_Validator_status(ctx, field)
+			case "activationEpoch":
+				return ec.fieldContext_Validator_activationEpoch(ctx, field)
+			case "exitEpoch":
+				return ec.fieldContext_Validator_exitEpoch(ctx, field)
+			case "slashed":
+				return ec.fieldContext_Validator_slashed(ctx, field)
+			case "balance":
+				return ec.fieldContext_Validator_balance(ctx, field)
+			case "performance":
+				return ec.fieldContext_Validator_performance(ctx, field)
+			case "rewards":
+				return ec.fieldContext_Validator_rewards(ctx, field)
+			case "income":
+				return ec.fieldContext_Validator_income(ctx, field)
+			case "attestationMisses":
+				return ec.fieldContext_Validator_attestationMisses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Validator_alerts(ctx, field)
+			case "history":
+				return ec.fieldContext_Validator_history(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Validator_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Validator_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_validator_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_validators(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_validators,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Validators(ctx, fc.Args["filter"].(*models.ValidatorFilter))
+		},
+		nil,
+		ec.marshalNValidator2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidatorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_validators(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Validator_index(ctx, field)
+			case "pubkey":
+				return ec.fieldContext_Validator_pubkey(ctx, field)
+			case "name":
+				return ec.fieldContext_Validator_name(ctx, field)
+			case "status":
+				return ec.fieldContext_Validator_status(ctx, field)
+			case "activationEpoch":
+				return ec.fieldContext_Validator_activationEpoch(ctx, field)
+			case "exitEpoch":
+				return ec.fieldContext_Validator_exitEpoch(ctx, field)
+			case "slashed":
+				return ec.fieldContext_Validator_slashed(ctx, field)
+			case "balance":
+				return ec.fieldContext_Validator_balance(ctx, field)
+			case "performance":
+				return ec.fieldContext_Validator_performance(ctx, field)
+			case "rewards":
+				return ec.fieldContext_Validator_rewards(ctx, field)
+			case "income":
+				return ec.fieldContext_Validator_income(ctx, field)
+			case "attestationMisses":
+				return ec.fieldContext_Validator_attestationMisses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Validator_alerts(ctx, field)
+			case "history":
+				return ec.fieldContext_Validator_history(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Validator_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Validator_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_validators_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_network(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_network,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Network(ctx)
+		},
+		nil,
+		ec.marshalNNetworkStats2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐNetworkStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_network(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "currentEpoch":
+				return ec.fieldContext_NetworkStats_currentEpoch(ctx, field)
+			case "currentSlot":
+				return ec.fieldContext_NetworkStats_currentSlot(ctx, field)
+			case "totalValidators":
+				return ec.fieldContext_NetworkStats_totalValidators(ctx, field)
+			case "activeValidators":
+				return ec.fieldContext_NetworkStats_activeValidators(ctx, field)
+			case "pendingValidators":
+				return ec.fieldContext_NetworkStats_pendingValidators(ctx, field)
+			case "exitingValidators":
+				return ec.fieldContext_NetworkStats_exitingValidators(ctx, field)
+			case "slashedValidators":
+				return ec.fieldContext_NetworkStats_slashedValidators(ctx, field)
+			case "averageBalance":
+				return ec.fieldContext_NetworkStats_averageBalance(ctx, field)
+			case "totalStaked":
+				return ec.fieldContext_NetworkStats_totalStaked(ctx, field)
+			case "participationRate":
+				return ec.fieldContext_NetworkStats_participationRate(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_NetworkStats_timestamp(ctx, field)
+			case "clientDiversity":
+				return ec.fieldContext_NetworkStats_clientDiversity(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NetworkStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_alerts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_alerts,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Alerts(ctx, fc.Args["filter"].(*models.AlertFilter))
+		},
+		nil,
+		ec.marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_alerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "validatorIndex":
+				return ec.fieldContext_Alert_validatorIndex(ctx, field)
+			case "severity":
+				return ec.fieldContext_Alert_severity(ctx, field)
+			case "type":
+				return ec.fieldContext_Alert_type(ctx, field)
+			case "message":
+				return ec.fieldContext_Alert_message(ctx, field)
+			case "acknowledged":
+				return ec.fieldContext_Alert_acknowledged(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Alert_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_alerts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_alert(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_alert,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Alert(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_alert(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "validatorIndex":
+				return ec.fieldContext_Alert_validatorIndex(ctx, field)
+			case "severity":
+				return ec.fieldContext_Alert_severity(ctx, field)
+			case "type":
+				return ec.fieldContext_Alert_type(ctx, field)
+			case "message":
+				return ec.fieldContext_Alert_message(ctx, field)
+			case "acknowledged":
+				return ec.fieldContext_Alert_acknowledged(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Alert_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_alert_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_health,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Health(ctx)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_me,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Me(ctx)
+		},
+		nil,
+		ec.marshalNUser2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐUser,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "lastLogin":
+				return ec.fieldContext_User_lastLogin(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_rewardsLedger(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_rewardsLedger,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RewardsLedger(ctx, fc.Args["validatorIndex"].(int), fc.Args["interval"].(model.LedgerInterval), fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNRewardsPeriodSummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewardsPeriodSummaryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_rewardsLedger(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "periodStart":
+				return ec.fieldContext_RewardsPeriodSummary_periodStart(ctx, field)
+			case "epochs":
+				return ec.fieldContext_RewardsPeriodSummary_epochs(ctx, field)
+			case "attestationRewards":
+				return ec.fieldContext_RewardsPeriodSummary_attestationRewards(ctx, field)
+			case "proposalRewards":
+				return ec.fieldContext_RewardsPeriodSummary_proposalRewards(ctx, field)
+			case "syncRewards":
+				return ec.fieldContext_RewardsPeriodSummary_syncRewards(ctx, field)
+			case "penalties":
+				return ec.fieldContext_RewardsPeriodSummary_penalties(ctx, field)
+			case "withdrawals":
+				return ec.fieldContext_RewardsPeriodSummary_withdrawals(ctx, field)
+			case "deposits":
+				return ec.fieldContext_RewardsPeriodSummary_deposits(ctx, field)
+			case "other":
+				return ec.fieldContext_RewardsPeriodSummary_other(ctx, field)
+			case "expected":
+				return ec.fieldContext_RewardsPeriodSummary_expected(ctx, field)
+			case "actual":
+				return ec.fieldContext_RewardsPeriodSummary_actual(ctx, field)
+			case "effectiveness":
+				return ec.fieldContext_RewardsPeriodSummary_effectiveness(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RewardsPeriodSummary", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_rewardsLedger_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_portfolioIncome(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_portfolioIncome,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PortfolioIncome(ctx, fc.Args["windows"].([]string))
+		},
+		nil,
+		ec.marshalNIncomeWindowSummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐIncomeWindowSummaryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_portfolioIncome(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "window":
+				return ec.fieldContext_IncomeWindowSummary_window(ctx, field)
+			case "epochs":
+				return ec.fieldContext_IncomeWindowSummary_epochs(ctx, field)
+			case "income":
+				return ec.fieldContext_IncomeWindowSummary_income(ctx, field)
+			case "dailyIncome":
+				return ec.fieldContext_IncomeWindowSummary_dailyIncome(ctx, field)
+			case "apr":
+				return ec.fieldContext_IncomeWindowSummary_apr(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IncomeWindowSummary", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_portfolioIncome_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_tagIncome(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_tagIncome,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().TagIncome(ctx, fc.Args["windows"].([]string))
+		},
+		nil,
+		ec.marshalNTagIncome2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐTagIncomeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_tagIncome(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tag":
+				return ec.fieldContext_TagIncome_tag(ctx, field)
+			case "windows":
+				return ec.fieldContext_TagIncome_windows(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TagIncome", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_tagIncome_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_attestationMissesByNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_attestationMissesByNode,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AttestationMissesByNode(ctx, fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNNodeAttestationMisses2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐNodeAttestationMissesᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_attestationMissesByNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_NodeAttestationMisses_node(ctx, field)
+			case "misses":
+				return ec.fieldContext_NodeAttestationMisses_misses(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NodeAttestationMisses", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_attestationMissesByNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_downtimeCost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_downtimeCost,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DowntimeCost(ctx, fc.Args["validatorIndex"].(*int), fc.Args["tag"].(*string), fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNDowntimeCost2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDowntimeCost,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_downtimeCost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "from":
+				return ec.fieldContext_DowntimeCost_from(ctx, field)
+			case "to":
+				return ec.fieldContext_DowntimeCost_to(ctx, field)
+			case "validators":
+				return ec.fieldContext_DowntimeCost_validators(ctx, field)
+			case "epochs":
+				return ec.fieldContext_DowntimeCost_epochs(ctx, field)
+			case "attestationLoss":
+				return ec.fieldContext_DowntimeCost_attestationLoss(ctx, field)
+			case "missedProposals":
+				return ec.fieldContext_DowntimeCost_missedProposals(ctx, field)
+			case "proposalLoss":
+				return ec.fieldContext_DowntimeCost_proposalLoss(ctx, field)
+			case "syncLoss":
+				return ec.fieldContext_DowntimeCost_syncLoss(ctx, field)
+			case "total":
+				return ec.fieldContext_DowntimeCost_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DowntimeCost", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_downtimeCost_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_proposalLuck(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_proposalLuck,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ProposalLuck(ctx, fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNProposalLuck2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐProposalLuckᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_proposalLuck(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tag":
+				return ec.fieldContext_ProposalLuck_tag(ctx, field)
+			case "from":
+				return ec.fieldContext_ProposalLuck_from(ctx, field)
+			case "to":
+				return ec.fieldContext_ProposalLuck_to(ctx, field)
+			case "validators":
+				return ec.fieldContext_ProposalLuck_validators(ctx, field)
+			case "epochs":
+				return ec.fieldContext_ProposalLuck_epochs(ctx, field)
+			case "proposals":
+				return ec.fieldContext_ProposalLuck_proposals(ctx, field)
+			case "syncCommittees":
+				return ec.fieldContext_ProposalLuck_syncCommittees(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProposalLuck", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_proposalLuck_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_discoveryRules(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_discoveryRules,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().DiscoveryRules(ctx)
+		},
+		nil,
+		ec.marshalNDiscoveryRule2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDiscoveryRuleᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_discoveryRules(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DiscoveryRule_id(ctx, field)
+			case "kind":
+				return ec.fieldContext_DiscoveryRule_kind(ctx, field)
+			case "value":
+				return ec.fieldContext_DiscoveryRule_value(ctx, field)
+			case "tag":
+				return ec.fieldContext_DiscoveryRule_tag(ctx, field)
+			case "enrolled":
+				return ec.fieldContext_DiscoveryRule_enrolled(ctx, field)
+			case "lastRunAt":
+				return ec.fieldContext_DiscoveryRule_lastRunAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_DiscoveryRule_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DiscoveryRule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_feeRecipientChecks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_feeRecipientChecks,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FeeRecipientChecks(ctx, fc.Args["validatorIndex"].(int), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNFeeRecipientCheck2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐFeeRecipientCheckᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_feeRecipientChecks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "validatorIndex":
+				return ec.fieldContext_FeeRecipientCheck_validatorIndex(ctx, field)
+			case "slot":
+				return ec.fieldContext_FeeRecipientCheck_slot(ctx, field)
+			case "time":
+				return ec.fieldContext_FeeRecipientCheck_time(ctx, field)
+			case "blockHash":
+				return ec.fieldContext_FeeRecipientCheck_blockHash(ctx, field)
+			case "feeRecipient":
+				return ec.fieldContext_FeeRecipientCheck_feeRecipient(ctx, field)
+			case "expected":
+				return ec.fieldContext_FeeRecipientCheck_expected(ctx, field)
+			case "paymentTo":
+				return ec.fieldContext_FeeRecipientCheck_paymentTo(ctx, field)
+			case "paymentGwei":
+				return ec.fieldContext_FeeRecipientCheck_paymentGwei(ctx, field)
+			case "status":
+				return ec.fieldContext_FeeRecipientCheck_status(ctx, field)
+			case "compliant":
+				return ec.fieldContext_FeeRecipientCheck_compliant(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FeeRecipientCheck", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_feeRecipientChecks_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_relayProposals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_relayProposals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RelayProposals(ctx, fc.Args["validatorIndex"].(*int), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNRelayProposal2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelayProposalᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_relayProposals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "validatorIndex":
+				return ec.fieldContext_RelayProposal_validatorIndex(ctx, field)
+			case "slot":
+				return ec.fieldContext_RelayProposal_slot(ctx, field)
+			case "time":
+				return ec.fieldContext_RelayProposal_time(ctx, field)
+			case "blockHash":
+				return ec.fieldContext_RelayProposal_blockHash(ctx, field)
+			case "source":
+				return ec.fieldContext_RelayProposal_source(ctx, field)
+			case "relays":
+				return ec.fieldContext_RelayProposal_relays(ctx, field)
+			case "builderPubkey":
+				return ec.fieldContext_RelayProposal_builderPubkey(ctx, field)
+			case "promised":
+				return ec.fieldContext_RelayProposal_promised(ctx, field)
+			case "received":
+				return ec.fieldContext_RelayProposal_received(ctx, field)
+			case "shortfall":
+				return ec.fieldContext_RelayProposal_shortfall(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelayProposal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_relayProposals_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_relaySummary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_relaySummary,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RelaySummary(ctx, fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNRelaySummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelaySummaryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_relaySummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "relay":
+				return ec.fieldContext_RelaySummary_relay(ctx, field)
+			case "source":
+				return ec.fieldContext_RelaySummary_source(ctx, field)
+			case "proposals":
+				return ec.fieldContext_RelaySummary_proposals(ctx, field)
+			case "verified":
+				return ec.fieldContext_RelaySummary_verified(ctx, field)
+			case "promised":
+				return ec.fieldContext_RelaySummary_promised(ctx, field)
+			case "received":
+				return ec.fieldContext_RelaySummary_received(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelaySummary", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_relaySummary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_clientDiversity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_clientDiversity,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ClientDiversity(ctx, fc.Args["epochs"].(*int))
+		},
+		nil,
+		ec.marshalNEpochClientDiversity2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐEpochClientDiversityᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_clientDiversity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "epoch":
+				return ec.fieldContext_EpochClientDiversity_epoch(ctx, field)
+			case "time":
+				return ec.fieldContext_EpochClientDiversity_time(ctx, field)
+			case "distribution":
+				return ec.fieldContext_EpochClientDiversity_distribution(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EpochClientDiversity", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_clientDiversity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fleetClients(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_fleetClients,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FleetClients(ctx, fc.Args["tag"].(*string))
+		},
+		nil,
+		ec.marshalNBlockClient2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐBlockClientᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_fleetClients(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_BlockClient_slot(ctx, field)
+			case "epoch":
+				return ec.fieldContext_BlockClient_epoch(ctx, field)
+			case "proposerIndex":
+				return ec.fieldContext_BlockClient_proposerIndex(ctx, field)
+			case "time":
+				return ec.fieldContext_BlockClient_time(ctx, field)
+			case "graffiti":
+				return ec.fieldContext_BlockClient_graffiti(ctx, field)
+			case "client":
+				return ec.fieldContext_BlockClient_client(ctx, field)
+			case "version":
+				return ec.fieldContext_BlockClient_version(ctx, field)
+			case "executionClient":
+				return ec.fieldContext_BlockClient_executionClient(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlockClient", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fleetClients_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fleetClientDistribution(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_fleetClientDistribution,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FleetClientDistribution(ctx, fc.Args["tag"].(*string))
+		},
+		nil,
+		ec.marshalNClientDistribution2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientDistribution,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_fleetClientDistribution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_ClientDistribution_total(ctx, field)
+			case "identified":
+				return ec.fieldContext_ClientDistribution_identified(ctx, field)
+			case "shares":
+				return ec.fieldContext_ClientDistribution_shares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClientDistribution", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fleetClientDistribution_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_incidents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_incidents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Incidents(ctx, fc.Args["status"].(*string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNIncident2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncidentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_incidents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Incident_id(ctx, field)
+			case "failureType":
+				return ec.fieldContext_Incident_failureType(ctx, field)
+			case "groupKind":
+				return ec.fieldContext_Incident_groupKind(ctx, field)
+			case "groupValue":
+				return ec.fieldContext_Incident_groupValue(ctx, field)
+			case "scope":
+				return ec.fieldContext_Incident_scope(ctx, field)
+			case "title":
+				return ec.fieldContext_Incident_title(ctx, field)
+			case "severity":
+				return ec.fieldContext_Incident_severity(ctx, field)
+			case "status":
+				return ec.fieldContext_Incident_status(ctx, field)
+			case "alertCount":
+				return ec.fieldContext_Incident_alertCount(ctx, field)
+			case "openAlerts":
+				return ec.fieldContext_Incident_openAlerts(ctx, field)
+			case "validatorCount":
+				return ec.fieldContext_Incident_validatorCount(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_Incident_startedAt(ctx, field)
+			case "lastAlertAt":
+				return ec.fieldContext_Incident_lastAlertAt(ctx, field)
+			case "acknowledgedAt":
+				return ec.fieldContext_Incident_acknowledgedAt(ctx, field)
+			case "acknowledgedBy":
+				return ec.fieldContext_Incident_acknowledgedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Incident_resolvedAt(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_Incident_resolvedBy(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Incident_alerts(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Incident_timeline(ctx, field)
+			case "downtimeCost":
+				return ec.fieldContext_Incident_downtimeCost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Incident", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_incidents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_incident(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_incident,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Incident(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOIncident2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_incident(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Incident_id(ctx, field)
+			case "failureType":
+				return ec.fieldContext_Incident_failureType(ctx, field)
+			case "groupKind":
+				return ec.fieldContext_Incident_groupKind(ctx, field)
+			case "groupValue":
+				return ec.fieldContext_Incident_groupValue(ctx, field)
+			case "scope":
+				return ec.fieldContext_Incident_scope(ctx, field)
+			case "title":
+				return ec.fieldContext_Incident_title(ctx, field)
+			case "severity":
+				return ec.fieldContext_Incident_severity(ctx, field)
+			case "status":
+				return ec.fieldContext_Incident_status(ctx, field)
+			case "alertCount":
+				return ec.fieldContext_Incident_alertCount(ctx, field)
+			case "openAlerts":
+				return ec.fieldContext_Incident_openAlerts(ctx, field)
+			case "validatorCount":
+				return ec.fieldContext_Incident_validatorCount(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_Incident_startedAt(ctx, field)
+			case "lastAlertAt":
+				return ec.fieldContext_Incident_lastAlertAt(ctx, field)
+			case "acknowledgedAt":
+				return ec.fieldContext_Incident_acknowledgedAt(ctx, field)
+			case "acknowledgedBy":
+				return ec.fieldContext_Incident_acknowledgedBy(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Incident_resolvedAt(ctx, field)
+			case "resolvedBy":
+				return ec.fieldContext_Incident_resolvedBy(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Incident_alerts(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Incident_timeline(ctx, field)
+			case "downtimeCost":
+				return ec.fieldContext_Incident_downtimeCost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Incident", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_incident_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_collectorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_collectorStatus,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().CollectorStatus(ctx)
+		},
+		nil,
+		ec.marshalNCollectorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCollectorStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_collectorStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "paused":
+				return ec.fieldContext_CollectorStatus_paused(ctx, field)
+			case "validatorsMonitored":
+				return ec.fieldContext_CollectorStatus_validatorsMonitored(ctx, field)
+			case "collectionsCount":
+				return ec.fieldContext_CollectorStatus_collectionsCount(ctx, field)
+			case "errorsCount":
+				return ec.fieldContext_CollectorStatus_errorsCount(ctx, field)
+			case "lastCollectionTime":
+				return ec.fieldContext_CollectorStatus_lastCollectionTime(ctx, field)
+			case "pool":
+				return ec.fieldContext_CollectorStatus_pool(ctx, field)
+			case "circuits":
+				return ec.fieldContext_CollectorStatus_circuits(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CollectorStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_adminAuditLog(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_adminAuditLog,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AdminAuditLog(ctx, fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNAdminAuditEntry2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAdminAuditEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_adminAuditLog(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AdminAuditEntry_id(ctx, field)
+			case "actor":
+				return ec.fieldContext_AdminAuditEntry_actor(ctx, field)
+			case "authMethod":
+				return ec.fieldContext_AdminAuditEntry_authMethod(ctx, field)
+			case "action":
+				return ec.fieldContext_AdminAuditEntry_action(ctx, field)
+			case "params":
+				return ec.fieldContext_AdminAuditEntry_params(ctx, field)
+			case "success":
+				return ec.fieldContext_AdminAuditEntry_success(ctx, field)
+			case "error":
+				return ec.fieldContext_AdminAuditEntry_error(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AdminAuditEntry_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AdminAuditEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_adminAuditLog_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecollectResult_validatorIndex(ctx context.Context, field graphql.CollectedField, obj *model.RecollectResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecollectResult_validatorIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidatorIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecollectResult_validatorIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecollectResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecollectResult_fromEpoch(ctx context.Context, field graphql.CollectedField, obj *model.RecollectResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecollectResult_fromEpoch,
+		func(ctx context.Context) (any, error) {
+			return obj.FromEpoch, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecollectResult_fromEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecollectResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecollectResult_toEpoch(ctx context.Context, field graphql.CollectedField, obj *model.RecollectResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecollectResult_toEpoch,
+		func(ctx context.Context) (any, error) {
+			return obj.ToEpoch, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecollectResult_toEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecollectResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecollectResult_recollected(ctx context.Context, field graphql.CollectedField, obj *model.RecollectResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecollectResult_recollected,
+		func(ctx context.Context) (any, error) {
+			return obj.Recollected, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecollectResult_recollected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecollectResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecollectResult_replaced(ctx context.Context, field graphql.CollectedField, obj *model.RecollectResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecollectResult_replaced,
+		func(ctx context.Context) (any, error) {
+			return obj.Replaced, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecollectResult_replaced(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecollectResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecollectResult_unavailableEpochs(ctx context.Context, field graphql.CollectedField, obj *model.RecollectResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecollectResult_unavailableEpochs,
+		func(ctx context.Context) (any, error) {
+			return obj.UnavailableEpochs, nil
+		},
+		nil,
+		ec.marshalNInt2ᚕintᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecollectResult_unavailableEpochs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecollectResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_validatorIndex(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_validatorIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.ValidatorIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_validatorIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_slot(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_slot,
+		func(ctx context.Context) (any, error) {
+			return obj.Slot, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_time(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_time,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelayProposal().Time(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_blockHash(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_blockHash,
+		func(ctx context.Context) (any, error) {
+			return obj.BlockHash, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_blockHash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_source(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_source,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelayProposal().Source(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_relays(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_relays,
+		func(ctx context.Context) (any, error) {
+			return obj.Relays, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_relays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_builderPubkey(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_builderPubkey,
+		func(ctx context.Context) (any, error) {
+			return obj.BuilderPubkey, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_builderPubkey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_promised(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_promised,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelayProposal().Promised(ctx, obj)
+		},
+		nil,
+		ec.marshalOBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_promised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_received(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_received,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelayProposal().Received(ctx, obj)
+		},
+		nil,
+		ec.marshalOBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_received(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayProposal_shortfall(ctx context.Context, field graphql.CollectedField, obj *models.RelayProposal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelayProposal_shortfall,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelayProposal().Shortfall(ctx, obj)
+		},
+		nil,
+		ec.marshalOBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelayProposal_shortfall(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayProposal",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelaySummary_relay(ctx context.Context, field graphql.CollectedField, obj *models.RelaySummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelaySummary_relay,
+		func(ctx context.Context) (any, error) {
+			return obj.Relay, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelaySummary_relay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelaySummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelaySummary_source(ctx context.Context, field graphql.CollectedField, obj *models.RelaySummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelaySummary_source,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelaySummary().Source(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelaySummary_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelaySummary",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelaySummary_proposals(ctx context.Context, field graphql.CollectedField, obj *models.RelaySummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelaySummary_proposals,
+		func(ctx context.Context) (any, error) {
+			return obj.Proposals, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelaySummary_proposals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelaySummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelaySummary_verified(ctx context.Context, field graphql.CollectedField, obj *models.RelaySummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelaySummary_verified,
+		func(ctx context.Context) (any, error) {
+			return obj.Verified, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelaySummary_verified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelaySummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelaySummary_promised(ctx context.Context, field graphql.CollectedField, obj *models.RelaySummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelaySummary_promised,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelaySummary().Promised(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelaySummary_promised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelaySummary",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelaySummary_received(ctx context.Context, field graphql.CollectedField, obj *models.RelaySummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RelaySummary_received,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RelaySummary().Received(ctx, obj)
+		},
+		nil,
+		ec.marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RelaySummary_received(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelaySummary",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rewards_expected(ctx context.Context, field graphql.CollectedField, obj *model.Rewards) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rewards_expected,
+		func(ctx context.Context) (any, error) {
+			return obj.Expected, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rewards_expected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rewards",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rewards_actual(ctx context.Context, field graphql.CollectedField, obj *model.Rewards) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rewards_actual,
+		func(ctx context.Context) (any, error) {
+			return obj.Actual, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rewards_actual(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rewards",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rewards_effectiveness(ctx context.Context, field graphql.CollectedField, obj *model.Rewards) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rewards_effectiveness,
+		func(ctx context.Context) (any, error) {
+			return obj.Effectiveness, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rewards_effectiveness(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rewards",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_periodStart(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_periodStart,
+		func(ctx context.Context) (any, error) {
+			return obj.PeriodStart, nil
+		},
+		nil,
+		ec.marshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_periodStart(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_epochs(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_epochs,
+		func(ctx context.Context) (any, error) {
+			return obj.Epochs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_epochs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_attestationRewards(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_attestationRewards,
+		func(ctx context.Context) (any, error) {
+			return obj.AttestationRewards, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_attestationRewards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_proposalRewards(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_proposalRewards,
+		func(ctx context.Context) (any, error) {
+			return obj.ProposalRewards, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_proposalRewards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_syncRewards(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_syncRewards,
+		func(ctx context.Context) (any, error) {
+			return obj.SyncRewards, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_syncRewards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_penalties(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_penalties,
+		func(ctx context.Context) (any, error) {
+			return obj.Penalties, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_penalties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_withdrawals(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_withdrawals,
+		func(ctx context.Context) (any, error) {
+			return obj.Withdrawals, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_withdrawals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_deposits(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_deposits,
+		func(ctx context.Context) (any, error) {
+			return obj.Deposits, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_deposits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_other(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_other,
+		func(ctx context.Context) (any, error) {
+			return obj.Other, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_other(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_expected(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_expected,
+		func(ctx context.Context) (any, error) {
+			return obj.Expected, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_expected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_actual(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_actual,
+		func(ctx context.Context) (any, error) {
+			return obj.Actual, nil
+		},
+		nil,
+		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_actual(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RewardsPeriodSummary_effectiveness(ctx context.Context, field graphql.CollectedField, obj *model.RewardsPeriodSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RewardsPeriodSummary_effectiveness,
+		func(ctx context.Context) (any, error) {
+			return obj.Effectiveness, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RewardsPeriodSummary_effectiveness(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RewardsPeriodSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_validatorUpdates(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_validatorUpdates,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().ValidatorUpdates(ctx, fc.Args["indices"].([]int))
+		},
+		nil,
+		ec.marshalNValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_validatorUpdates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Validator_index(ctx, field)
+			case "pubkey":
+				return ec.fieldContext_Validator_pubkey(ctx, field)
+			case "name":
+				return ec.fieldContext_Validator_name(ctx, field)
+			case "status":
+				return ec.fieldContext_Validator_status(ctx, field)
+			case "activationEpoch":
+				return ec.fieldContext_Validator_activationEpoch(ctx, field)
+			case "exitEpoch":
+				return ec.fieldContext_Validator_exitEpoch(ctx, field)
+			case "slashed":
+				return ec.fieldContext_Validator_slashed(ctx, field)
+			case "balance":
+				return ec.fieldContext_Validator_balance(ctx, field)
+			case "performance":
+				return ec.fieldContext_Validator_performance(ctx, field)
+			case "rewards":
+				return ec.fieldContext_Validator_rewards(ctx, field)
+			case "income":
+				return ec.fieldContext_Validator_income(ctx, field)
+			case "attestationMisses":
+				return ec.fieldContext_Validator_attestationMisses(ctx, field)
+			case "alerts":
+				return ec.fieldContext_Validator_alerts(ctx, field)
+			case "history":
+				return ec.fieldContext_Validator_history(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Validator_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Validator_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_validatorUpdates_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_newAlerts(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_newAlerts,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().NewAlerts(ctx, fc.Args["severity"].(*types.AlertSeverity))
+		},
+		nil,
+		ec.marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_newAlerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "validatorIndex":
+				return ec.fieldContext_Alert_validatorIndex(ctx, field)
+			case "severity":
+				return ec.fieldContext_Alert_severity(ctx, field)
+			case "type":
+				return ec.fieldContext_Alert_type(ctx, field)
+			case "message":
+				return ec.fieldContext_Alert_message(ctx, field)
+			case "acknowledged":
+				return ec.fieldContext_Alert_acknowledged(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Alert_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_newAlerts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagIncome_tag(ctx context.Context, field graphql.CollectedField, obj *model.TagIncome) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TagIncome_tag,
+		func(ctx context.Context) (any, error) {
+			return obj.Tag, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TagIncome_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagIncome",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagIncome_windows(ctx context.Context, field graphql.CollectedField, obj *model.TagIncome) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TagIncome_windows,
+		func(ctx context.Context) (any, error) {
+			return obj.Windows, nil
+		},
+		nil,
+		ec.marshalNIncomeWindowSummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐIncomeWindowSummaryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TagIncome_windows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagIncome",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "window":
+				return ec.fieldContext_IncomeWindowSummary_window(ctx, field)
+			case "epochs":
+				return ec.fieldContext_IncomeWindowSummary_epochs(ctx, field)
+			case "income":
+				return ec.fieldContext_IncomeWindowSummary_income(ctx, field)
+			case "dailyIncome":
+				return ec.fieldContext_IncomeWindowSummary_dailyIncome(ctx, field)
+			case "apr":
+				return ec.fieldContext_IncomeWindowSummary_apr(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IncomeWindowSummary", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_username(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_username,
+		func(ctx context.Context) (any, error) {
+			return obj.Username, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_username(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_roles,
+		func(ctx context.Context) (any, error) {
+			return obj.Roles, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_roles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_lastLogin(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_lastLogin,
+		func(ctx context.Context) (any, error) {
+			return obj.LastLogin, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_lastLogin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_index(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_index,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().Index(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_index(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_pubkey(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_pubkey,
+		func(ctx context.Context) (any, error) {
+			return obj.Pubkey, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_pubkey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_name(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_status(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNValidatorStatus2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐValidatorStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ValidatorStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_activationEpoch(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_activationEpoch,
+		func(ctx context.Context) (any, error) {
+			return obj.ActivationEpoch, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_activationEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_exitEpoch(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_exitEpoch,
+		func(ctx context.Context) (any, error) {
+			return obj.ExitEpoch, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_exitEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_slashed(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_slashed,
+		func(ctx context.Context) (any, error) {
+			return obj.Slashed, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_slashed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_balance(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_balance,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().Balance(ctx, obj)
+		},
+		nil,
+		ec.marshalNBalance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐBalance,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "current":
+				return ec.fieldContext_Balance_current(ctx, field)
+			case "effective":
+				return ec.fieldContext_Balance_effective(ctx, field)
+			case "withdrawable":
+				return ec.fieldContext_Balance_withdrawable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Balance", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_performance(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_performance,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().Performance(ctx, obj)
+		},
+		nil,
+		ec.marshalNPerformance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐPerformance,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_performance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "uptimePercentage":
+				return ec.fieldContext_Performance_uptimePercentage(ctx, field)
+			case "consecutiveMisses":
+				return ec.fieldContext_Performance_consecutiveMisses(ctx, field)
+			case "totalMissed":
+				return ec.fieldContext_Performance_totalMissed(ctx, field)
+			case "attestationScore":
+				return ec.fieldContext_Performance_attestationScore(ctx, field)
+			case "proposalSuccess":
+				return ec.fieldContext_Performance_proposalSuccess(ctx, field)
+			case "proposalMissed":
+				return ec.fieldContext_Performance_proposalMissed(ctx, field)
+			case "effectiveness":
+				return ec.fieldContext_Performance_effectiveness(ctx, field)
+			case "networkAverage":
+				return ec.fieldContext_Performance_networkAverage(ctx, field)
+			case "networkPercentile":
+				return ec.fieldContext_Performance_networkPercentile(ctx, field)
+			case "slashingRisk":
+				return ec.fieldContext_Performance_slashingRisk(ctx, field)
+			case "inactivityScore":
+				return ec.fieldContext_Performance_inactivityScore(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Performance", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_rewards(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_rewards,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().Rewards(ctx, obj)
+		},
+		nil,
+		ec.marshalNRewards2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewards,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_rewards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "expected":
+				return ec.fieldContext_Rewards_expected(ctx, field)
+			case "actual":
+				return ec.fieldContext_Rewards_actual(ctx, field)
+			case "effectiveness":
+				return ec.fieldContext_Rewards_effectiveness(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Rewards", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_income(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_income,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Validator().Income(ctx, obj, fc.Args["windows"].([]string))
+		},
+		nil,
+		ec.marshalNIncomeWindowSummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐIncomeWindowSummaryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_income(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "window":
+				return ec.fieldContext_IncomeWindowSummary_window(ctx, field)
+			case "epochs":
+				return ec.fieldContext_IncomeWindowSummary_epochs(ctx, field)
+			case "income":
+				return ec.fieldContext_IncomeWindowSummary_income(ctx, field)
+			case "dailyIncome":
+				return ec.fieldContext_IncomeWindowSummary_dailyIncome(ctx, field)
+			case "apr":
+				return ec.fieldContext_IncomeWindowSummary_apr(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IncomeWindowSummary", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Validator_income_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_attestationMisses(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_attestationMisses,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Validator().AttestationMisses(ctx, obj, fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNAttestationMissCount2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAttestationMissCountᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_attestationMisses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "reason":
+				return ec.fieldContext_AttestationMissCount_reason(ctx, field)
+			case "blame":
+				return ec.fieldContext_AttestationMissCount_blame(ctx, field)
+			case "count":
+				return ec.fieldContext_AttestationMissCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AttestationMissCount", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Validator_attestationMisses_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_alerts(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_alerts,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().Alerts(ctx, obj)
+		},
+		nil,
+		ec.marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_alerts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Alert_id(ctx, field)
+			case "validatorIndex":
+				return ec.fieldContext_Alert_validatorIndex(ctx, field)
+			case "severity":
+				return ec.fieldContext_Alert_severity(ctx, field)
+			case "type":
+				return ec.fieldContext_Alert_type(ctx, field)
+			case "message":
+				return ec.fieldContext_Alert_message(ctx, field)
+			case "acknowledged":
+				return ec.fieldContext_Alert_acknowledged(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Alert_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_history(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_history,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Validator().History(ctx, obj, fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
+		},
+		nil,
+		ec.marshalNHistoricalSnapshot2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshotᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "epoch":
+				return ec.fieldContext_HistoricalSnapshot_epoch(ctx, field)
+			case "slot":
+				return ec.fieldContext_HistoricalSnapshot_slot(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_HistoricalSnapshot_timestamp(ctx, field)
+			case "balance":
+				return ec.fieldContext_HistoricalSnapshot_balance(ctx, field)
+			case "effectiveBalance":
+				return ec.fieldContext_HistoricalSnapshot_effectiveBalance(ctx, field)
+			case "attestationSuccess":
+				return ec.fieldContext_HistoricalSnapshot_attestationSuccess(ctx, field)
+			case "inclusionDelay":
+				return ec.fieldContext_HistoricalSnapshot_inclusionDelay(ctx, field)
+			case "proposalSuccess":
+				return ec.fieldContext_HistoricalSnapshot_proposalSuccess(ctx, field)
+			case "performanceScore":
+				return ec.fieldContext_HistoricalSnapshot_performanceScore(ctx, field)
+			case "networkPercentile":
+				return ec.fieldContext_HistoricalSnapshot_networkPercentile(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HistoricalSnapshot", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Validator_history_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_createdAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().CreatedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Validator_updatedAt(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Validator_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Validator().UpdatedAt(ctx, obj)
+		},
+		nil,
+		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Validator_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Validator",
+		Field:      field,
+		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WorkerPoolStats_tasksProcessed(ctx context.Context, field graphql.CollectedField, obj *model.WorkerPoolStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WorkerPoolStats_tasksProcessed,
+		func(ctx context.Context) (any, error) {
+			return obj.TasksProcessed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WorkerPoolStats_tasksProcessed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WorkerPoolStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WorkerPoolStats_tasksFailed(ctx context.Context, field graphql.CollectedField, obj *model.WorkerPoolStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WorkerPoolStats_tasksFailed,
+		func(ctx context.Context) (any, error) {
+			return obj.TasksFailed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WorkerPoolStats_tasksFailed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WorkerPoolStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WorkerPoolStats_activeWorkers(ctx context.Context, field graphql.CollectedField, obj *model.WorkerPoolStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WorkerPoolStats_activeWorkers,
+		func(ctx context.Context) (any, error) {
+			return obj.ActiveWorkers, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WorkerPoolStats_activeWorkers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WorkerPoolStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WorkerPoolStats_busyWorkers(ctx context.Context, field graphql.CollectedField, obj *model.WorkerPoolStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WorkerPoolStats_busyWorkers,
+		func(ctx context.Context) (any, error) {
+			return obj.BusyWorkers, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WorkerPoolStats_busyWorkers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WorkerPoolStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WorkerPoolStats_queueSize(ctx context.Context, field graphql.CollectedField, obj *model.WorkerPoolStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WorkerPoolStats_queueSize,
+		func(ctx context.Context) (any, error) {
+			return obj.QueueSize, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WorkerPoolStats_queueSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WorkerPoolStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WorkerPoolStats_resultQueueSize(ctx context.Context, field graphql.CollectedField, obj *model.WorkerPoolStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WorkerPoolStats_resultQueueSize,
+		func(ctx context.Context) (any, error) {
+			return obj.ResultQueueSize, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WorkerPoolStats_resultQueueSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WorkerPoolStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	return fc, nil
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddDiscoveryRuleInput(ctx context.Context, obj any) (model.AddDiscoveryRuleInput, error) {
+	var it model.AddDiscoveryRuleInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"kind", "value", "tag", "fromBlock"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "kind":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			data, err := ec.unmarshalNDiscoveryRuleKind2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐDiscoveryRuleKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kind = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "tag":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tag"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tag = data
+		case "fromBlock":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromBlock"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FromBlock = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddValidatorInput(ctx context.Context, obj any) (model.AddValidatorInput, error) {
+	var it model.AddValidatorInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"pubkey", "index", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "pubkey":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pubkey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pubkey = data
+		case "index":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Index = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAlertFilter(ctx context.Context, obj any) (models.AlertFilter, error) {
+	var it models.AlertFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"validatorIndex", "severity", "acknowledged", "from", "to", "limit", "offset"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "validatorIndex":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validatorIndex"))
+			data, err := ec.unmarshalOInt2ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidatorIndex = data
+		case "severity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("severity"))
+			data, err := ec.unmarshalOAlertSeverity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.AlertFilter().Severity(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "acknowledged":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("acknowledged"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.AlertFilter().Acknowledged(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.AlertFilter().From(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "to":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			data, err := ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.AlertFilter().To(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoginInput(ctx context.Context, obj any) (model.LoginInput, error) {
+	var it model.LoginInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"username", "password"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "username":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Username = data
+		case "password":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Password = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterInput(ctx context.Context, obj any) (model.RegisterInput, error) {
+	var it model.RegisterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"username", "email", "password"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "username":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Username = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "password":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Password = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputValidatorFilter(ctx context.Context, obj any) (models.ValidatorFilter, error) {
+	var it models.ValidatorFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"status", "slashed", "indices", "pubkeys", "limit", "offset"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalOValidatorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐValidatorStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.ValidatorFilter().Status(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "slashed":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slashed"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slashed = data
+		case "indices":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("indices"))
+			data, err := ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.ValidatorFilter().Indices(ctx, &it, data); err != nil {
+				return it, err
+			}
+		case "pubkeys":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pubkeys"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pubkeys = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalOInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var adminAuditEntryImplementors = []string{"AdminAuditEntry"}
+
+func (ec *executionContext) _AdminAuditEntry(ctx context.Context, sel ast.SelectionSet, obj *model.AdminAuditEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, adminAuditEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdminAuditEntry")
+		case "id":
+			out.Values[i] = ec._AdminAuditEntry_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actor":
+			out.Values[i] = ec._AdminAuditEntry_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "authMethod":
+			out.Values[i] = ec._AdminAuditEntry_authMethod(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._AdminAuditEntry_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "params":
+			out.Values[i] = ec._AdminAuditEntry_params(ctx, field, obj)
+		case "success":
+			out.Values[i] = ec._AdminAuditEntry_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "error":
+			out.Values[i] = ec._AdminAuditEntry_error(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._AdminAuditEntry_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var alertImplementors = []string{"Alert"}
+
+func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, obj *models.Alert) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, alertImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Alert")
+		case "id":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alert_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "validatorIndex":
+			out.Values[i] = ec._Alert_validatorIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "severity":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alert_severity(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "type":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alert_type(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "message":
+			out.Values[i] = ec._Alert_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "acknowledged":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alert_acknowledged(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alert_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var attestationMissCountImplementors = []string{"AttestationMissCount"}
+
+func (ec *executionContext) _AttestationMissCount(ctx context.Context, sel ast.SelectionSet, obj *models.AttestationMissCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, attestationMissCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AttestationMissCount")
+		case "reason":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AttestationMissCount_reason(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "blame":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AttestationMissCount_blame(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "count":
+			out.Values[i] = ec._AttestationMissCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var authPayloadImplementors = []string{"AuthPayload"}
+
+func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionSet, obj *model.AuthPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, authPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuthPayload")
+		case "accessToken":
+			out.Values[i] = ec._AuthPayload_accessToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshToken":
+			out.Values[i] = ec._AuthPayload_refreshToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "user":
+			out.Values[i] = ec._AuthPayload_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._AuthPayload_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var balanceImplementors = []string{"Balance"}
+
+func (ec *executionContext) _Balance(ctx context.Context, sel ast.SelectionSet, obj *model.Balance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, balanceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Balance")
+		case "current":
+			out.Values[i] = ec._Balance_current(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effective":
+			out.Values[i] = ec._Balance_effective(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "withdrawable":
+			out.Values[i] = ec._Balance_withdrawable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var blockClientImplementors = []string{"BlockClient"}
+
+func (ec *executionContext) _BlockClient(ctx context.Context, sel ast.SelectionSet, obj *models.BlockClient) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, blockClientImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BlockClient")
+		case "slot":
+			out.Values[i] = ec._BlockClient_slot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "epoch":
+			out.Values[i] = ec._BlockClient_epoch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "proposerIndex":
+			out.Values[i] = ec._BlockClient_proposerIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "time":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BlockClient_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "graffiti":
+			out.Values[i] = ec._BlockClient_graffiti(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "client":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BlockClient_client(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "version":
+			out.Values[i] = ec._BlockClient_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "executionClient":
+			out.Values[i] = ec._BlockClient_executionClient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var circuitStatusImplementors = []string{"CircuitStatus"}
+
+func (ec *executionContext) _CircuitStatus(ctx context.Context, sel ast.SelectionSet, obj *model.CircuitStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, circuitStatusImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CircuitStatus")
+		case "component":
+			out.Values[i] = ec._CircuitStatus_component(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._CircuitStatus_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var clientDistributionImplementors = []string{"ClientDistribution"}
+
+func (ec *executionContext) _ClientDistribution(ctx context.Context, sel ast.SelectionSet, obj *models.ClientDistribution) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, clientDistributionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ClientDistribution")
+		case "total":
+			out.Values[i] = ec._ClientDistribution_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "identified":
+			out.Values[i] = ec._ClientDistribution_identified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shares":
+			out.Values[i] = ec._ClientDistribution_shares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var clientShareImplementors = []string{"ClientShare"}
+
+func (ec *executionContext) _ClientShare(ctx context.Context, sel ast.SelectionSet, obj *models.ClientShare) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, clientShareImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ClientShare")
+		case "client":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ClientShare_client(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "count":
+			out.Values[i] = ec._ClientShare_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "share":
+			out.Values[i] = ec._ClientShare_share(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var collectorStatusImplementors = []string{"CollectorStatus"}
+
+func (ec *executionContext) _CollectorStatus(ctx context.Context, sel ast.SelectionSet, obj *model.CollectorStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, collectorStatusImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CollectorStatus")
+		case "paused":
+			out.Values[i] = ec._CollectorStatus_paused(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "validatorsMonitored":
+			out.Values[i] = ec._CollectorStatus_validatorsMonitored(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "collectionsCount":
+			out.Values[i] = ec._CollectorStatus_collectionsCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "errorsCount":
+			out.Values[i] = ec._CollectorStatus_errorsCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastCollectionTime":
+			out.Values[i] = ec._CollectorStatus_lastCollectionTime(ctx, field, obj)
+		case "pool":
+			out.Values[i] = ec._CollectorStatus_pool(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "circuits":
+			out.Values[i] = ec._CollectorStatus_circuits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var discoveryRuleImplementors = []string{"DiscoveryRule"}
+
+func (ec *executionContext) _DiscoveryRule(ctx context.Context, sel ast.SelectionSet, obj *models.DiscoveryRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discoveryRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscoveryRule")
+		case "id":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscoveryRule_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscoveryRule_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "value":
+			out.Values[i] = ec._DiscoveryRule_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tag":
+			out.Values[i] = ec._DiscoveryRule_tag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "enrolled":
+			out.Values[i] = ec._DiscoveryRule_enrolled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lastRunAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscoveryRule_lastRunAt(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscoveryRule_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var downtimeCostImplementors = []string{"DowntimeCost"}
+
+func (ec *executionContext) _DowntimeCost(ctx context.Context, sel ast.SelectionSet, obj *models.DowntimeCost) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, downtimeCostImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DowntimeCost")
+		case "from":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DowntimeCost_from(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "to":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DowntimeCost_to(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "validators":
+			out.Values[i] = ec._DowntimeCost_validators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "epochs":
+			out.Values[i] = ec._DowntimeCost_epochs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "attestationLoss":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DowntimeCost_attestationLoss(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "missedProposals":
+			out.Values[i] = ec._DowntimeCost_missedProposals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "proposalLoss":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DowntimeCost_proposalLoss(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "syncLoss":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DowntimeCost_syncLoss(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "total":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DowntimeCost_total(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dutyLuckImplementors = []string{"DutyLuck"}
+
+func (ec *executionContext) _DutyLuck(ctx context.Context, sel ast.SelectionSet, obj *models.DutyLuck) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dutyLuckImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DutyLuck")
+		case "expected":
+			out.Values[i] = ec._DutyLuck_expected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actual":
+			out.Values[i] = ec._DutyLuck_actual(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "luck":
+			out.Values[i] = ec._DutyLuck_luck(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "percentile":
+			out.Values[i] = ec._DutyLuck_percentile(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expectedLow":
+			out.Values[i] = ec._DutyLuck_expectedLow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expectedHigh":
+			out.Values[i] = ec._DutyLuck_expectedHigh(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var epochClientDiversityImplementors = []string{"EpochClientDiversity"}
+
+func (ec *executionContext) _EpochClientDiversity(ctx context.Context, sel ast.SelectionSet, obj *models.EpochClientDiversity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, epochClientDiversityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EpochClientDiversity")
+		case "epoch":
+			out.Values[i] = ec._EpochClientDiversity_epoch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "time":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._EpochClientDiversity_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "distribution":
+			out.Values[i] = ec._EpochClientDiversity_distribution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var feeRecipientCheckImplementors = []string{"FeeRecipientCheck"}
+
+func (ec *executionContext) _FeeRecipientCheck(ctx context.Context, sel ast.SelectionSet, obj *models.FeeRecipientCheck) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feeRecipientCheckImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FeeRecipientCheck")
+		case "validatorIndex":
+			out.Values[i] = ec._FeeRecipientCheck_validatorIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "slot":
+			out.Values[i] = ec._FeeRecipientCheck_slot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "time":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FeeRecipientCheck_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "blockHash":
+			out.Values[i] = ec._FeeRecipientCheck_blockHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "feeRecipient":
+			out.Values[i] = ec._FeeRecipientCheck_feeRecipient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "expected":
+			out.Values[i] = ec._FeeRecipientCheck_expected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "paymentTo":
+			out.Values[i] = ec._FeeRecipientCheck_paymentTo(ctx, field, obj)
+		case "paymentGwei":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FeeRecipientCheck_paymentGwei(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FeeRecipientCheck_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "compliant":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FeeRecipientCheck_compliant(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var historicalSnapshotImplementors = []string{"HistoricalSnapshot"}
+
+func (ec *executionContext) _HistoricalSnapshot(ctx context.Context, sel ast.SelectionSet, obj *model.HistoricalSnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, historicalSnapshotImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HistoricalSnapshot")
+		case "epoch":
+			out.Values[i] = ec._HistoricalSnapshot_epoch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "slot":
+			out.Values[i] = ec._HistoricalSnapshot_slot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._HistoricalSnapshot_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "balance":
+			out.Values[i] = ec._HistoricalSnapshot_balance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveBalance":
+			out.Values[i] = ec._HistoricalSnapshot_effectiveBalance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "attestationSuccess":
+			out.Values[i] = ec._HistoricalSnapshot_attestationSuccess(ctx, field, obj)
+		case "inclusionDelay":
+			out.Values[i] = ec._HistoricalSnapshot_inclusionDelay(ctx, field, obj)
+		case "proposalSuccess":
+			out.Values[i] = ec._HistoricalSnapshot_proposalSuccess(ctx, field, obj)
+		case "performanceScore":
+			out.Values[i] = ec._HistoricalSnapshot_performanceScore(ctx, field, obj)
+		case "networkPercentile":
+			out.Values[i] = ec._HistoricalSnapshot_networkPercentile(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var incidentImplementors = []string{"Incident"}
+
+func (ec *executionContext) _Incident(ctx context.Context, sel ast.SelectionSet, obj *models.Incident) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, incidentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Incident")
+		case "id":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "failureType":
+			out.Values[i] = ec._Incident_failureType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "groupKind":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_groupKind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "groupValue":
+			out.Values[i] = ec._Incident_groupValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "scope":
+			out.Values[i] = ec._Incident_scope(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._Incident_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "severity":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_severity(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "alertCount":
+			out.Values[i] = ec._Incident_alertCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "openAlerts":
+			out.Values[i] = ec._Incident_openAlerts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "validatorCount":
+			out.Values[i] = ec._Incident_validatorCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "startedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_startedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "lastAlertAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_lastAlertAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "acknowledgedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_acknowledgedAt(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "acknowledgedBy":
+			out.Values[i] = ec._Incident_acknowledgedBy(ctx, field, obj)
+		case "resolvedAt":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_resolvedAt(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "resolvedBy":
+			out.Values[i] = ec._Incident_resolvedBy(ctx, field, obj)
+		case "alerts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_alerts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "timeline":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_timeline(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "downtimeCost":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Incident_downtimeCost(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var incidentEventImplementors = []string{"IncidentEvent"}
+
+func (ec *executionContext) _IncidentEvent(ctx context.Context, sel ast.SelectionSet, obj *models.IncidentEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, incidentEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IncidentEvent")
+		case "time":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._IncidentEvent_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._IncidentEvent_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "count":
+			out.Values[i] = ec._IncidentEvent_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "message":
+			out.Values[i] = ec._IncidentEvent_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var incomeWindowSummaryImplementors = []string{"IncomeWindowSummary"}
+
+func (ec *executionContext) _IncomeWindowSummary(ctx context.Context, sel ast.SelectionSet, obj *model.IncomeWindowSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, incomeWindowSummaryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IncomeWindowSummary")
+		case "window":
+			out.Values[i] = ec._IncomeWindowSummary_window(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "epochs":
+			out.Values[i] = ec._IncomeWindowSummary_epochs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "income":
+			out.Values[i] = ec._IncomeWindowSummary_income(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dailyIncome":
+			out.Values[i] = ec._IncomeWindowSummary_dailyIncome(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "apr":
+			out.Values[i] = ec._IncomeWindowSummary_apr(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "register":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_register(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "login":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_login(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshToken":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_refreshToken(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addValidator":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addValidator(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removeValidator":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeValidator(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateValidatorName":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateValidatorName(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "acknowledgeAlert":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acknowledgeAlert(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "acknowledgeIncident":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acknowledgeIncident(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resolveIncident":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resolveIncident(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addDiscoveryRule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addDiscoveryRule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removeDiscoveryRule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeDiscoveryRule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setExpectedFeeRecipient":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setExpectedFeeRecipient(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pauseCollector":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_pauseCollector(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resumeCollector":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resumeCollector(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "drainWorkerPool":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_drainWorkerPool(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recollectValidator":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recollectValidator(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_me,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Me(ctx)
-		},
-		nil,
-		ec.marshalNUser2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐUser,
-		true,
-		true,
-	)
-}
+var networkStatsImplementors = []string{"NetworkStats"}
+
+func (ec *executionContext) _NetworkStats(ctx context.Context, sel ast.SelectionSet, obj *types.NetworkStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, networkStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NetworkStats")
+		case "currentEpoch":
+			out.Values[i] = ec._NetworkStats_currentEpoch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "currentSlot":
+			out.Values[i] = ec._NetworkStats_currentSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalValidators":
+			out.Values[i] = ec._NetworkStats_totalValidators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "activeValidators":
+			out.Values[i] = ec._NetworkStats_activeValidators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pendingValidators":
+			out.Values[i] = ec._NetworkStats_pendingValidators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "exitingValidators":
+			out.Values[i] = ec._NetworkStats_exitingValidators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "slashedValidators":
+			out.Values[i] = ec._NetworkStats_slashedValidators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "averageBalance":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._NetworkStats_averageBalance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "totalStaked":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._NetworkStats_totalStaked(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "participationRate":
+			out.Values[i] = ec._NetworkStats_participationRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "timestamp":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._NetworkStats_timestamp(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "roles":
-				return ec.fieldContext_User_roles(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "lastLogin":
-				return ec.fieldContext_User_lastLogin(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "clientDiversity":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._NetworkStats_clientDiversity(ctx, field, obj)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___type,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___schema,
-		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
-		},
-		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Rewards_expected(ctx context.Context, field graphql.CollectedField, obj *model.Rewards) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Rewards_expected,
-		func(ctx context.Context) (any, error) {
-			return obj.Expected, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Rewards_expected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Rewards",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var nodeAttestationMissesImplementors = []string{"NodeAttestationMisses"}
 
-func (ec *executionContext) _Rewards_actual(ctx context.Context, field graphql.CollectedField, obj *model.Rewards) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Rewards_actual,
-		func(ctx context.Context) (any, error) {
-			return obj.Actual, nil
-		},
-		nil,
-		ec.marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _NodeAttestationMisses(ctx context.Context, sel ast.SelectionSet, obj *model.NodeAttestationMisses) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, nodeAttestationMissesImplementors)
 
-func (ec *executionContext) fieldContext_Rewards_actual(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Rewards",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type BigInt does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NodeAttestationMisses")
+		case "node":
+			out.Values[i] = ec._NodeAttestationMisses_node(ctx, field, obj)
+		case "misses":
+			out.Values[i] = ec._NodeAttestationMisses_misses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Rewards_effectiveness(ctx context.Context, field graphql.CollectedField, obj *model.Rewards) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Rewards_effectiveness,
-		func(ctx context.Context) (any, error) {
-			return obj.Effectiveness, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Rewards_effectiveness(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Rewards",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Subscription_validatorUpdates(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	return graphql.ResolveFieldStream(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Subscription_validatorUpdates,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Subscription().ValidatorUpdates(ctx, fc.Args["indices"].([]int))
-		},
-		nil,
-		ec.marshalNValidator2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐValidator,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Subscription_validatorUpdates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Subscription",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "index":
-				return ec.fieldContext_Validator_index(ctx, field)
-			case "pubkey":
-				return ec.fieldContext_Validator_pubkey(ctx, field)
-			case "name":
-				return ec.fieldContext_Validator_name(ctx, field)
-			case "status":
-				return ec.fieldContext_Validator_status(ctx, field)
-			case "activationEpoch":
-				return ec.fieldContext_Validator_activationEpoch(ctx, field)
-			case "exitEpoch":
-				return ec.fieldContext_Validator_exitEpoch(ctx, field)
-			case "slashed":
-				return ec.fieldContext_Validator_slashed(ctx, field)
-			case "balance":
-				return ec.fieldContext_Validator_balance(ctx, field)
-			case "performance":
-				return ec.fieldContext_Validator_performance(ctx, field)
-			case "rewards":
-				return ec.fieldContext_Validator_rewards(ctx, field)
-			case "alerts":
-				return ec.fieldContext_Validator_alerts(ctx, field)
-			case "history":
-				return ec.fieldContext_Validator_history(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Validator_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Validator_updatedAt(ctx, field)
+var performanceImplementors = []string{"Performance"}
+
+func (ec *executionContext) _Performance(ctx context.Context, sel ast.SelectionSet, obj *model.Performance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, performanceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Performance")
+		case "uptimePercentage":
+			out.Values[i] = ec._Performance_uptimePercentage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Validator", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "consecutiveMisses":
+			out.Values[i] = ec._Performance_consecutiveMisses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalMissed":
+			out.Values[i] = ec._Performance_totalMissed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "attestationScore":
+			out.Values[i] = ec._Performance_attestationScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposalSuccess":
+			out.Values[i] = ec._Performance_proposalSuccess(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposalMissed":
+			out.Values[i] = ec._Performance_proposalMissed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveness":
+			out.Values[i] = ec._Performance_effectiveness(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "networkAverage":
+			out.Values[i] = ec._Performance_networkAverage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "networkPercentile":
+			out.Values[i] = ec._Performance_networkPercentile(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "slashingRisk":
+			out.Values[i] = ec._Performance_slashingRisk(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "inactivityScore":
+			out.Values[i] = ec._Performance_inactivityScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_validatorUpdates_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Subscription_newAlerts(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	return graphql.ResolveFieldStream(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Subscription_newAlerts,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Subscription().NewAlerts(ctx, fc.Args["severity"].(*types.AlertSeverity))
-		},
-		nil,
-		ec.marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert,
-		true,
-		true,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Subscription_newAlerts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Subscription",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Alert_id(ctx, field)
-			case "validatorIndex":
-				return ec.fieldContext_Alert_validatorIndex(ctx, field)
-			case "severity":
-				return ec.fieldContext_Alert_severity(ctx, field)
-			case "type":
-				return ec.fieldContext_Alert_type(ctx, field)
-			case "message":
-				return ec.fieldContext_Alert_message(ctx, field)
-			case "acknowledged":
-				return ec.fieldContext_Alert_acknowledged(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Alert_createdAt(ctx, field)
+var proposalLuckImplementors = []string{"ProposalLuck"}
+
+func (ec *executionContext) _ProposalLuck(ctx context.Context, sel ast.SelectionSet, obj *models.ProposalLuck) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, proposalLuckImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProposalLuck")
+		case "tag":
+			out.Values[i] = ec._ProposalLuck_tag(ctx, field, obj)
+		case "from":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProposalLuck_from(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_newAlerts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_User_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "to":
+			field := field
 
-func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProposalLuck_to(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _User_username(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_User_username,
-		func(ctx context.Context) (any, error) {
-			return obj.Username, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext_User_username(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "validators":
+			out.Values[i] = ec._ProposalLuck_validators(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "epochs":
+			out.Values[i] = ec._ProposalLuck_epochs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "proposals":
+			out.Values[i] = ec._ProposalLuck_proposals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "syncCommittees":
+			out.Values[i] = ec._ProposalLuck_syncCommittees(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_User_email,
-		func(ctx context.Context) (any, error) {
-			return obj.Email, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_User_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_User_roles,
-		func(ctx context.Context) (any, error) {
-			return obj.Roles, nil
-		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_User_roles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_User_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
 
-func (ec *executionContext) fieldContext_User_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
 
-func (ec *executionContext) _User_lastLogin(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_User_lastLogin,
-		func(ctx context.Context) (any, error) {
-			return obj.LastLogin, nil
-		},
-		nil,
-		ec.marshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		false,
-	)
-}
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "validator":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_validator(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext_User_lastLogin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _Validator_index(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_index,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().Index(ctx, obj)
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "validators":
+			field := field
 
-func (ec *executionContext) fieldContext_Validator_index(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_validators(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Validator_pubkey(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_pubkey,
-		func(ctx context.Context) (any, error) {
-			return obj.Pubkey, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Validator_pubkey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "network":
+			field := field
 
-func (ec *executionContext) _Validator_name(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_network(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Validator_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _Validator_status(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_status,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().Status(ctx, obj)
-		},
-		nil,
-		ec.marshalNValidatorStatus2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐValidatorStatus,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "alerts":
+			field := field
 
-func (ec *executionContext) fieldContext_Validator_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ValidatorStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_alerts(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Validator_activationEpoch(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_activationEpoch,
-		func(ctx context.Context) (any, error) {
-			return obj.ActivationEpoch, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint64,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Validator_activationEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "alert":
+			field := field
 
-func (ec *executionContext) _Validator_exitEpoch(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_exitEpoch,
-		func(ctx context.Context) (any, error) {
-			return obj.ExitEpoch, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint64,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_alert(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "health":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_health(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Validator_exitEpoch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _Validator_slashed(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_slashed,
-		func(ctx context.Context) (any, error) {
-			return obj.Slashed, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "me":
+			field := field
 
-func (ec *executionContext) fieldContext_Validator_slashed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_me(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Validator_balance(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_balance,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().Balance(ctx, obj)
-		},
-		nil,
-		ec.marshalNBalance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐBalance,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Validator_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "current":
-				return ec.fieldContext_Balance_current(ctx, field)
-			case "effective":
-				return ec.fieldContext_Balance_effective(ctx, field)
-			case "withdrawable":
-				return ec.fieldContext_Balance_withdrawable(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "rewardsLedger":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_rewardsLedger(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Balance", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Validator_performance(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_performance,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().Performance(ctx, obj)
-		},
-		nil,
-		ec.marshalNPerformance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐPerformance,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Validator_performance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "uptimePercentage":
-				return ec.fieldContext_Performance_uptimePercentage(ctx, field)
-			case "consecutiveMisses":
-				return ec.fieldContext_Performance_consecutiveMisses(ctx, field)
-			case "totalMissed":
-				return ec.fieldContext_Performance_totalMissed(ctx, field)
-			case "attestationScore":
-				return ec.fieldContext_Performance_attestationScore(ctx, field)
-			case "proposalSuccess":
-				return ec.fieldContext_Performance_proposalSuccess(ctx, field)
-			case "proposalMissed":
-				return ec.fieldContext_Performance_proposalMissed(ctx, field)
-			case "effectiveness":
-				return ec.fieldContext_Performance_effectiveness(ctx, field)
-			case "networkAverage":
-				return ec.fieldContext_Performance_networkAverage(ctx, field)
-			case "networkPercentile":
-				return ec.fieldContext_Performance_networkPercentile(ctx, field)
-			case "slashingRisk":
-				return ec.fieldContext_Performance_slashingRisk(ctx, field)
-			case "inactivityScore":
-				return ec.fieldContext_Performance_inactivityScore(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "portfolioIncome":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_portfolioIncome(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Performance", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Validator_rewards(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_rewards,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().Rewards(ctx, obj)
-		},
-		nil,
-		ec.marshalNRewards2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewards,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Validator_rewards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "expected":
-				return ec.fieldContext_Rewards_expected(ctx, field)
-			case "actual":
-				return ec.fieldContext_Rewards_actual(ctx, field)
-			case "effectiveness":
-				return ec.fieldContext_Rewards_effectiveness(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "tagIncome":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_tagIncome(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Rewards", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Validator_alerts(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_alerts,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().Alerts(ctx, obj)
-		},
-		nil,
-		ec.marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "attestationMissesByNode":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_attestationMissesByNode(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Validator_alerts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Alert_id(ctx, field)
-			case "validatorIndex":
-				return ec.fieldContext_Alert_validatorIndex(ctx, field)
-			case "severity":
-				return ec.fieldContext_Alert_severity(ctx, field)
-			case "type":
-				return ec.fieldContext_Alert_type(ctx, field)
-			case "message":
-				return ec.fieldContext_Alert_message(ctx, field)
-			case "acknowledged":
-				return ec.fieldContext_Alert_acknowledged(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Alert_createdAt(ctx, field)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Validator_history(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_history,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Validator().History(ctx, obj, fc.Args["from"].(*types.Time), fc.Args["to"].(*types.Time))
-		},
-		nil,
-		ec.marshalNHistoricalSnapshot2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshotᚄ,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "downtimeCost":
+			field := field
 
-func (ec *executionContext) fieldContext_Validator_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "epoch":
-				return ec.fieldContext_HistoricalSnapshot_epoch(ctx, field)
-			case "slot":
-				return ec.fieldContext_HistoricalSnapshot_slot(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_HistoricalSnapshot_timestamp(ctx, field)
-			case "balance":
-				return ec.fieldContext_HistoricalSnapshot_balance(ctx, field)
-			case "effectiveBalance":
-				return ec.fieldContext_HistoricalSnapshot_effectiveBalance(ctx, field)
-			case "attestationSuccess":
-				return ec.fieldContext_HistoricalSnapshot_attestationSuccess(ctx, field)
-			case "inclusionDelay":
-				return ec.fieldContext_HistoricalSnapshot_inclusionDelay(ctx, field)
-			case "proposalSuccess":
-				return ec.fieldContext_HistoricalSnapshot_proposalSuccess(ctx, field)
-			case "performanceScore":
-				return ec.fieldContext_HistoricalSnapshot_performanceScore(ctx, field)
-			case "networkPercentile":
-				return ec.fieldContext_HistoricalSnapshot_networkPercentile(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_downtimeCost(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type HistoricalSnapshot", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Validator_history_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Validator_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_createdAt,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().CreatedAt(ctx, obj)
-		},
-		nil,
-		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Validator_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "proposalLuck":
+			field := field
 
-func (ec *executionContext) _Validator_updatedAt(ctx context.Context, field graphql.CollectedField, obj *models.Validator) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Validator_updatedAt,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Validator().UpdatedAt(ctx, obj)
-		},
-		nil,
-		ec.marshalNTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_proposalLuck(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Validator_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Validator",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "discoveryRules":
+			field := field
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_discoveryRules(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "feeRecipientChecks":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_feeRecipientChecks(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_isRepeatable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "relayProposals":
+			field := field
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_relayProposals(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_locations,
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "relaySummary":
+			field := field
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_relaySummary(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "clientDiversity":
+			field := field
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_clientDiversity(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "fleetClients":
+			field := field
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_fleetClients(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "fleetClientDistribution":
+			field := field
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_fleetClientDistribution(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "incidents":
+			field := field
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_incidents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "incident":
+			field := field
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_incident(ctx, field)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "collectorStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_collectorStatus(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "adminAuditLog":
+			field := field
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_adminAuditLog(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+var recollectResultImplementors = []string{"RecollectResult"}
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_defaultValue,
-		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _RecollectResult(ctx context.Context, sel ast.SelectionSet, obj *model.RecollectResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recollectResultImplementors)
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecollectResult")
+		case "validatorIndex":
+			out.Values[i] = ec._RecollectResult_validatorIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fromEpoch":
+			out.Values[i] = ec._RecollectResult_fromEpoch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "toEpoch":
+			out.Values[i] = ec._RecollectResult_toEpoch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recollected":
+			out.Values[i] = ec._RecollectResult_recollected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "replaced":
+			out.Values[i] = ec._RecollectResult_replaced(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unavailableEpochs":
+			out.Values[i] = ec._RecollectResult_unavailableEpochs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var relayProposalImplementors = []string{"RelayProposal"}
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _RelayProposal(ctx context.Context, sel ast.SelectionSet, obj *models.RelayProposal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relayProposalImplementors)
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelayProposal")
+		case "validatorIndex":
+			out.Values[i] = ec._RelayProposal_validatorIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "slot":
+			out.Values[i] = ec._RelayProposal_slot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "time":
+			field := field
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_types,
-		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelayProposal_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_queryType,
-		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "blockHash":
+			out.Values[i] = ec._RelayProposal_blockHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "source":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelayProposal_source(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_mutationType,
-		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "relays":
+			out.Values[i] = ec._RelayProposal_relays(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "builderPubkey":
+			out.Values[i] = ec._RelayProposal_builderPubkey(ctx, field, obj)
+		case "promised":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelayProposal_promised(ctx, field, obj)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_subscriptionType,
-		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_directives,
-		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
-		},
-		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "received":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelayProposal_received(ctx, field, obj)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_kind,
-		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
-		},
-		nil,
-		ec.marshalN__TypeKind2string,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
-		},
-	}
-	return fc, nil
-}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "shortfall":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelayProposal_shortfall(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_specifiedByURL,
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var relaySummaryImplementors = []string{"RelaySummary"}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_fields,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _RelaySummary(ctx context.Context, sel ast.SelectionSet, obj *models.RelaySummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relaySummaryImplementors)
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelaySummary")
+		case "relay":
+			out.Values[i] = ec._RelaySummary_relay(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_interfaces,
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+		case "source":
+			field := field
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelaySummary_source(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_possibleTypes,
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "proposals":
+			out.Values[i] = ec._RelaySummary_proposals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+		case "verified":
+			out.Values[i] = ec._RelaySummary_verified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "promised":
+			field := field
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelaySummary_promised(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "received":
+			field := field
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RelaySummary_received(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-// endregion **************************** field.gotpl *****************************
+	return out
+}
 
-// region    **************************** input.gotpl *****************************
+var rewardsImplementors = []string{"Rewards"}
 
-func (ec *executionContext) unmarshalInputAddValidatorInput(ctx context.Context, obj any) (model.AddValidatorInput, error) {
-	var it model.AddValidatorInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _Rewards(ctx context.Context, sel ast.SelectionSet, obj *model.Rewards) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rewardsImplementors)
 
-	fieldsInOrder := [...]string{"pubkey", "index", "name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "pubkey":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pubkey"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Rewards")
+		case "expected":
+			out.Values[i] = ec._Rewards_expected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Pubkey = data
-		case "index":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+		case "actual":
+			out.Values[i] = ec._Rewards_actual(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Index = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "effectiveness":
+			out.Values[i] = ec._Rewards_effectiveness(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputAlertFilter(ctx context.Context, obj any) (models.AlertFilter, error) {
-	var it models.AlertFilter
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"validatorIndex", "severity", "acknowledged", "from", "to", "limit", "offset"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "validatorIndex":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validatorIndex"))
-			data, err := ec.unmarshalOInt2ᚖint64(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var rewardsPeriodSummaryImplementors = []string{"RewardsPeriodSummary"}
+
+func (ec *executionContext) _RewardsPeriodSummary(ctx context.Context, sel ast.SelectionSet, obj *model.RewardsPeriodSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rewardsPeriodSummaryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RewardsPeriodSummary")
+		case "periodStart":
+			out.Values[i] = ec._RewardsPeriodSummary_periodStart(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ValidatorIndex = data
-		case "severity":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("severity"))
-			data, err := ec.unmarshalOAlertSeverity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity(ctx, v)
-			if err != nil {
-				return it, err
+		case "epochs":
+			out.Values[i] = ec._RewardsPeriodSummary_epochs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			if err = ec.resolvers.AlertFilter().Severity(ctx, &it, data); err != nil {
-				return it, err
+		case "attestationRewards":
+			out.Values[i] = ec._RewardsPeriodSummary_attestationRewards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-		case "acknowledged":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("acknowledged"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+		case "proposalRewards":
+			out.Values[i] = ec._RewardsPeriodSummary_proposalRewards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			if err = ec.resolvers.AlertFilter().Acknowledged(ctx, &it, data); err != nil {
-				return it, err
+		case "syncRewards":
+			out.Values[i] = ec._RewardsPeriodSummary_syncRewards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-		case "from":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
-			data, err := ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "penalties":
+			out.Values[i] = ec._RewardsPeriodSummary_penalties(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			if err = ec.resolvers.AlertFilter().From(ctx, &it, data); err != nil {
-				return it, err
+		case "withdrawals":
+			out.Values[i] = ec._RewardsPeriodSummary_withdrawals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-		case "to":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
-			data, err := ec.unmarshalOTime2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "deposits":
+			out.Values[i] = ec._RewardsPeriodSummary_deposits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			if err = ec.resolvers.AlertFilter().To(ctx, &it, data); err != nil {
-				return it, err
+		case "other":
+			out.Values[i] = ec._RewardsPeriodSummary_other(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-		case "limit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalOInt2int(ctx, v)
-			if err != nil {
-				return it, err
+		case "expected":
+			out.Values[i] = ec._RewardsPeriodSummary_expected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Limit = data
-		case "offset":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
-			data, err := ec.unmarshalOInt2int(ctx, v)
-			if err != nil {
-				return it, err
+		case "actual":
+			out.Values[i] = ec._RewardsPeriodSummary_actual(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Offset = data
+		case "effectiveness":
+			out.Values[i] = ec._RewardsPeriodSummary_effectiveness(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) unmarshalInputLoginInput(ctx context.Context, obj any) (model.LoginInput, error) {
-	var it model.LoginInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
 	}
 
-	fieldsInOrder := [...]string{"username", "password"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "username":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	switch fields[0].Name {
+	case "validatorUpdates":
+		return ec._Subscription_validatorUpdates(ctx, fields[0])
+	case "newAlerts":
+		return ec._Subscription_newAlerts(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var tagIncomeImplementors = []string{"TagIncome"}
+
+func (ec *executionContext) _TagIncome(ctx context.Context, sel ast.SelectionSet, obj *model.TagIncome) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tagIncomeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TagIncome")
+		case "tag":
+			out.Values[i] = ec._TagIncome_tag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Username = data
-		case "password":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "windows":
+			out.Values[i] = ec._TagIncome_windows(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Password = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRegisterInput(ctx context.Context, obj any) (model.RegisterInput, error) {
-	var it model.RegisterInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"username", "email", "password"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+	return out
+}
+
+var userImplementors = []string{"User"}
+
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("User")
+		case "id":
+			out.Values[i] = ec._User_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "username":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._User_username(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Username = data
 		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._User_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Email = data
-		case "password":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "roles":
+			out.Values[i] = ec._User_roles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Password = data
+		case "createdAt":
+			out.Values[i] = ec._User_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastLogin":
+			out.Values[i] = ec._User_lastLogin(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputValidatorFilter(ctx context.Context, obj any) (models.ValidatorFilter, error) {
-	var it models.ValidatorFilter
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"status", "slashed", "indices", "pubkeys", "limit", "offset"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalOValidatorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐValidatorStatus(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			if err = ec.resolvers.ValidatorFilter().Status(ctx, &it, data); err != nil {
-				return it, err
-			}
-		case "slashed":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slashed"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Slashed = data
-		case "indices":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("indices"))
-			data, err := ec.unmarshalOInt2ᚕintᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			if err = ec.resolvers.ValidatorFilter().Indices(ctx, &it, data); err != nil {
-				return it, err
-			}
-		case "pubkeys":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pubkeys"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var validatorImplementors = []string{"Validator"}
+
+func (ec *executionContext) _Validator(ctx context.Context, sel ast.SelectionSet, obj *models.Validator) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, validatorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Validator")
+		case "index":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Validator_index(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.Pubkeys = data
-		case "limit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalOInt2int(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.Limit = data
-		case "offset":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
-			data, err := ec.unmarshalOInt2int(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "pubkey":
+			out.Values[i] = ec._Validator_pubkey(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.Offset = data
-		}
-	}
+		case "name":
+			out.Values[i] = ec._Validator_name(ctx, field, obj)
+		case "status":
+			field := field
 
-	return it, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Validator_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-// endregion **************************** input.gotpl *****************************
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-// region    ************************** interface.gotpl ***************************
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-// endregion ************************** interface.gotpl ***************************
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "activationEpoch":
+			out.Values[i] = ec._Validator_activationEpoch(ctx, field, obj)
+		case "exitEpoch":
+			out.Values[i] = ec._Validator_exitEpoch(ctx, field, obj)
+		case "slashed":
+			out.Values[i] = ec._Validator_slashed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "balance":
+			field := field
 
-// region    **************************** object.gotpl ****************************
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Validator_balance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var alertImplementors = []string{"Alert"}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, obj *models.Alert) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, alertImplementors)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Alert")
-		case "id":
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "performance":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6323,7 +19276,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Alert_id(ctx, field, obj)
+				res = ec._Validator_performance(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6350,12 +19303,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "validatorIndex":
-			out.Values[i] = ec._Alert_validatorIndex(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "severity":
+		case "rewards":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6364,7 +19312,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Alert_severity(ctx, field, obj)
+				res = ec._Validator_rewards(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6391,7 +19339,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "type":
+		case "income":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6400,7 +19348,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Alert_type(ctx, field, obj)
+				res = ec._Validator_income(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6427,12 +19375,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "message":
-			out.Values[i] = ec._Alert_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "acknowledged":
+		case "attestationMisses":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6441,7 +19384,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Alert_acknowledged(ctx, field, obj)
+				res = ec._Validator_attestationMisses(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6468,7 +19411,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "createdAt":
+		case "alerts":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6477,7 +19420,7 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Alert_createdAt(ctx, field, obj)
+				res = ec._Validator_alerts(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6504,109 +19447,114 @@ func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, ob
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+		case "history":
+			field := field
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Validator_history(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-	return out
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-var authPayloadImplementors = []string{"AuthPayload"}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionSet, obj *model.AuthPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, authPayloadImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("AuthPayload")
-		case "accessToken":
-			out.Values[i] = ec._AuthPayload_accessToken(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "refreshToken":
-			out.Values[i] = ec._AuthPayload_refreshToken(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "user":
-			out.Values[i] = ec._AuthPayload_user(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "expiresAt":
-			out.Values[i] = ec._AuthPayload_expiresAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Validator_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-	return out
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "updatedAt":
+			field := field
 
-var balanceImplementors = []string{"Balance"}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Validator_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Balance(ctx context.Context, sel ast.SelectionSet, obj *model.Balance) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, balanceImplementors)
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Balance")
-		case "current":
-			out.Values[i] = ec._Balance_current(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "effective":
-			out.Values[i] = ec._Balance_effective(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "withdrawable":
-			out.Values[i] = ec._Balance_withdrawable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6630,52 +19578,47 @@ func (ec *executionContext) _Balance(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var historicalSnapshotImplementors = []string{"HistoricalSnapshot"}
+var workerPoolStatsImplementors = []string{"WorkerPoolStats"}
 
-func (ec *executionContext) _HistoricalSnapshot(ctx context.Context, sel ast.SelectionSet, obj *model.HistoricalSnapshot) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, historicalSnapshotImplementors)
+func (ec *executionContext) _WorkerPoolStats(ctx context.Context, sel ast.SelectionSet, obj *model.WorkerPoolStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, workerPoolStatsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HistoricalSnapshot")
-		case "epoch":
-			out.Values[i] = ec._HistoricalSnapshot_epoch(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("WorkerPoolStats")
+		case "tasksProcessed":
+			out.Values[i] = ec._WorkerPoolStats_tasksProcessed(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "slot":
-			out.Values[i] = ec._HistoricalSnapshot_slot(ctx, field, obj)
+		case "tasksFailed":
+			out.Values[i] = ec._WorkerPoolStats_tasksFailed(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "timestamp":
-			out.Values[i] = ec._HistoricalSnapshot_timestamp(ctx, field, obj)
+		case "activeWorkers":
+			out.Values[i] = ec._WorkerPoolStats_activeWorkers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "balance":
-			out.Values[i] = ec._HistoricalSnapshot_balance(ctx, field, obj)
+		case "busyWorkers":
+			out.Values[i] = ec._WorkerPoolStats_busyWorkers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "effectiveBalance":
-			out.Values[i] = ec._HistoricalSnapshot_effectiveBalance(ctx, field, obj)
+		case "queueSize":
+			out.Values[i] = ec._WorkerPoolStats_queueSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resultQueueSize":
+			out.Values[i] = ec._WorkerPoolStats_resultQueueSize(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "attestationSuccess":
-			out.Values[i] = ec._HistoricalSnapshot_attestationSuccess(ctx, field, obj)
-		case "inclusionDelay":
-			out.Values[i] = ec._HistoricalSnapshot_inclusionDelay(ctx, field, obj)
-		case "proposalSuccess":
-			out.Values[i] = ec._HistoricalSnapshot_proposalSuccess(ctx, field, obj)
-		case "performanceScore":
-			out.Values[i] = ec._HistoricalSnapshot_performanceScore(ctx, field, obj)
-		case "networkPercentile":
-			out.Values[i] = ec._HistoricalSnapshot_networkPercentile(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6699,71 +19642,36 @@ func (ec *executionContext) _HistoricalSnapshot(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "register":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_register(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "login":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_login(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "refreshToken":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_refreshToken(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "addValidator":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addValidator(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "removeValidator":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removeValidator(ctx, field)
-			})
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updateValidatorName":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateValidatorName(ctx, field)
-			})
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "acknowledgeAlert":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_acknowledgeAlert(ctx, field)
-			})
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -6790,165 +19698,31 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var networkStatsImplementors = []string{"NetworkStats"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _NetworkStats(ctx context.Context, sel ast.SelectionSet, obj *types.NetworkStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, networkStatsImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("NetworkStats")
-		case "currentEpoch":
-			out.Values[i] = ec._NetworkStats_currentEpoch(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "currentSlot":
-			out.Values[i] = ec._NetworkStats_currentSlot(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "totalValidators":
-			out.Values[i] = ec._NetworkStats_totalValidators(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "activeValidators":
-			out.Values[i] = ec._NetworkStats_activeValidators(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "pendingValidators":
-			out.Values[i] = ec._NetworkStats_pendingValidators(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "exitingValidators":
-			out.Values[i] = ec._NetworkStats_exitingValidators(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "slashedValidators":
-			out.Values[i] = ec._NetworkStats_slashedValidators(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "averageBalance":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._NetworkStats_averageBalance(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "totalStaked":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._NetworkStats_totalStaked(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "participationRate":
-			out.Values[i] = ec._NetworkStats_participationRate(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "timestamp":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._NetworkStats_timestamp(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6972,72 +19746,41 @@ func (ec *executionContext) _NetworkStats(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var performanceImplementors = []string{"Performance"}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _Performance(ctx context.Context, sel ast.SelectionSet, obj *model.Performance) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, performanceImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Performance")
-		case "uptimePercentage":
-			out.Values[i] = ec._Performance_uptimePercentage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "consecutiveMisses":
-			out.Values[i] = ec._Performance_consecutiveMisses(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalMissed":
-			out.Values[i] = ec._Performance_totalMissed(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "attestationScore":
-			out.Values[i] = ec._Performance_attestationScore(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "proposalSuccess":
-			out.Values[i] = ec._Performance_proposalSuccess(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "proposalMissed":
-			out.Values[i] = ec._Performance_proposalMissed(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "effectiveness":
-			out.Values[i] = ec._Performance_effectiveness(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "networkAverage":
-			out.Values[i] = ec._Performance_networkAverage(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "networkPercentile":
-			out.Values[i] = ec._Performance_networkPercentile(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "slashingRisk":
-			out.Values[i] = ec._Performance_slashingRisk(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "inactivityScore":
-			out.Values[i] = ec._Performance_inactivityScore(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7061,181 +19804,38 @@ func (ec *executionContext) _Performance(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "validator":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_validator(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "validators":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_validators(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "network":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_network(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "alerts":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_alerts(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "alert":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_alert(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "health":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_health(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "me":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_me(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7259,29 +19859,35 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var rewardsImplementors = []string{"Rewards"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _Rewards(ctx context.Context, sel ast.SelectionSet, obj *model.Rewards) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, rewardsImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Rewards")
-		case "expected":
-			out.Values[i] = ec._Rewards_expected(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "actual":
-			out.Values[i] = ec._Rewards_actual(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "effectiveness":
-			out.Values[i] = ec._Rewards_effectiveness(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7308,66 +19914,42 @@ func (ec *executionContext) _Rewards(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var subscriptionImplementors = []string{"Subscription"}
-
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
-	}
-
-	switch fields[0].Name {
-	case "validatorUpdates":
-		return ec._Subscription_validatorUpdates(ctx, fields[0])
-	case "newAlerts":
-		return ec._Subscription_newAlerts(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
-	}
-}
-
-var userImplementors = []string{"User"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("User")
-		case "id":
-			out.Values[i] = ec._User_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "username":
-			out.Values[i] = ec._User_username(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "email":
-			out.Values[i] = ec._User_email(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "roles":
-			out.Values[i] = ec._User_roles(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._User_createdAt(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "lastLogin":
-			out.Values[i] = ec._User_lastLogin(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7391,725 +19973,850 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var validatorImplementors = []string{"Validator"}
+// endregion **************************** object.gotpl ****************************
 
-func (ec *executionContext) _Validator(ctx context.Context, sel ast.SelectionSet, obj *models.Validator) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, validatorImplementors)
+// region    ***************************** type.gotpl *****************************
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Validator")
-		case "index":
-			field := field
+func (ec *executionContext) unmarshalNAddDiscoveryRuleInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAddDiscoveryRuleInput(ctx context.Context, v any) (model.AddDiscoveryRuleInput, error) {
+	res, err := ec.unmarshalInputAddDiscoveryRuleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_index(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) unmarshalNAddValidatorInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAddValidatorInput(ctx context.Context, v any) (model.AddValidatorInput, error) {
+	res, err := ec.unmarshalInputAddValidatorInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAdminAuditEntry2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAdminAuditEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AdminAuditEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAdminAuditEntry2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAdminAuditEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	}
+	wg.Wait()
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "pubkey":
-			out.Values[i] = ec._Validator_pubkey(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Validator_name(ctx, field, obj)
-		case "status":
-			field := field
+	return ret
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_status(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNAdminAuditEntry2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAdminAuditEntry(ctx context.Context, sel ast.SelectionSet, v *model.AdminAuditEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AdminAuditEntry(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAlert2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert(ctx context.Context, sel ast.SelectionSet, v models.Alert) graphql.Marshaler {
+	return ec._Alert(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Alert) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	return ret
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "activationEpoch":
-			out.Values[i] = ec._Validator_activationEpoch(ctx, field, obj)
-		case "exitEpoch":
-			out.Values[i] = ec._Validator_exitEpoch(ctx, field, obj)
-		case "slashed":
-			out.Values[i] = ec._Validator_slashed(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "balance":
-			field := field
+func (ec *executionContext) marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert(ctx context.Context, sel ast.SelectionSet, v *models.Alert) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Alert(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_balance(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) unmarshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity(ctx context.Context, v any) (types.AlertSeverity, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := types.AlertSeverity(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity(ctx context.Context, sel ast.SelectionSet, v types.AlertSeverity) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNAttestationMissCount2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAttestationMissCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.AttestationMissCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAttestationMissCount2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAttestationMissCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	}
+	wg.Wait()
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "performance":
-			field := field
+	return ret
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_performance(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) marshalNAttestationMissCount2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAttestationMissCount(ctx context.Context, sel ast.SelectionSet, v *models.AttestationMissCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AttestationMissCount(ctx, sel, v)
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) marshalNAuthPayload2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v model.AuthPayload) graphql.Marshaler {
+	return ec._AuthPayload(ctx, sel, &v)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v *model.AuthPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuthPayload(ctx, sel, v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "rewards":
-			field := field
+func (ec *executionContext) marshalNBalance2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐBalance(ctx context.Context, sel ast.SelectionSet, v model.Balance) graphql.Marshaler {
+	return ec._Balance(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_rewards(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) marshalNBalance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐBalance(ctx context.Context, sel ast.SelectionSet, v *model.Balance) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Balance(ctx, sel, v)
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) unmarshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, v any) (types.BigInt, error) {
+	var res types.BigInt
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, sel ast.SelectionSet, v types.BigInt) graphql.Marshaler {
+	return v
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "alerts":
-			field := field
+func (ec *executionContext) unmarshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, v any) (*types.BigInt, error) {
+	var res = new(types.BigInt)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_alerts(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, sel ast.SelectionSet, v *types.BigInt) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalNBlockClient2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐBlockClientᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.BlockClient) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNBlockClient2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐBlockClient(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "history":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_history(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return ret
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) marshalNBlockClient2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐBlockClient(ctx context.Context, sel ast.SelectionSet, v *models.BlockClient) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BlockClient(ctx, sel, v)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "createdAt":
-			field := field
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_createdAt(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNCircuitStatus2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCircuitStatusᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CircuitStatus) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNCircuitStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCircuitStatus(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "updatedAt":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Validator_updatedAt(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return ret
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) marshalNCircuitStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCircuitStatus(ctx context.Context, sel ast.SelectionSet, v *model.CircuitStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CircuitStatus(ctx, sel, v)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) marshalNClientDistribution2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientDistribution(ctx context.Context, sel ast.SelectionSet, v models.ClientDistribution) graphql.Marshaler {
+	return ec._ClientDistribution(ctx, sel, &v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNClientDistribution2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientDistribution(ctx context.Context, sel ast.SelectionSet, v *models.ClientDistribution) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._ClientDistribution(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNClientShare2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientShare(ctx context.Context, sel ast.SelectionSet, v models.ClientShare) graphql.Marshaler {
+	return ec._ClientShare(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNClientShare2ᚕgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientShareᚄ(ctx context.Context, sel ast.SelectionSet, v []models.ClientShare) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNClientShare2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐClientShare(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) marshalNCollectorStatus2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCollectorStatus(ctx context.Context, sel ast.SelectionSet, v model.CollectorStatus) graphql.Marshaler {
+	return ec._CollectorStatus(ctx, sel, &v)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNCollectorStatus2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐCollectorStatus(ctx context.Context, sel ast.SelectionSet, v *model.CollectorStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CollectorStatus(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNDiscoveryRule2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDiscoveryRule(ctx context.Context, sel ast.SelectionSet, v models.DiscoveryRule) graphql.Marshaler {
+	return ec._DiscoveryRule(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDiscoveryRule2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDiscoveryRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.DiscoveryRule) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNDiscoveryRule2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDiscoveryRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDiscoveryRule2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDiscoveryRule(ctx context.Context, sel ast.SelectionSet, v *models.DiscoveryRule) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._DiscoveryRule(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNDiscoveryRuleKind2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐDiscoveryRuleKind(ctx context.Context, v any) (model.DiscoveryRuleKind, error) {
+	var res model.DiscoveryRuleKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNDiscoveryRuleKind2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐDiscoveryRuleKind(ctx context.Context, sel ast.SelectionSet, v model.DiscoveryRuleKind) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNDowntimeCost2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDowntimeCost(ctx context.Context, sel ast.SelectionSet, v models.DowntimeCost) graphql.Marshaler {
+	return ec._DowntimeCost(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNDowntimeCost2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDowntimeCost(ctx context.Context, sel ast.SelectionSet, v *models.DowntimeCost) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._DowntimeCost(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNDutyLuck2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDutyLuck(ctx context.Context, sel ast.SelectionSet, v models.DutyLuck) graphql.Marshaler {
+	return ec._DutyLuck(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNEpochClientDiversity2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐEpochClientDiversityᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.EpochClientDiversity) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNEpochClientDiversity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐEpochClientDiversity(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	return out
-}
+	}
+	wg.Wait()
 
-var __FieldImplementors = []string{"__Field"}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+	return ret
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNEpochClientDiversity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐEpochClientDiversity(ctx context.Context, sel ast.SelectionSet, v *models.EpochClientDiversity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._EpochClientDiversity(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFeeRecipientCheck2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐFeeRecipientCheckᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.FeeRecipientCheck) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFeeRecipientCheck2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐFeeRecipientCheck(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNFeeRecipientCheck2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐFeeRecipientCheck(ctx context.Context, sel ast.SelectionSet, v *models.FeeRecipientCheck) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._FeeRecipientCheck(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-
-	return out
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNHistoricalSnapshot2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshotᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HistoricalSnapshot) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNHistoricalSnapshot2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshot(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNHistoricalSnapshot2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshot(ctx context.Context, sel ast.SelectionSet, v *model.HistoricalSnapshot) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._HistoricalSnapshot(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNIncident2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident(ctx context.Context, sel ast.SelectionSet, v models.Incident) graphql.Marshaler {
+	return ec._Incident(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNIncident2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncidentᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Incident) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNIncident2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNIncident2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident(ctx context.Context, sel ast.SelectionSet, v *models.Incident) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._Incident(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNIncidentEvent2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncidentEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.IncidentEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNIncidentEvent2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncidentEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-// region    ***************************** type.gotpl *****************************
+	}
+	wg.Wait()
 
-func (ec *executionContext) unmarshalNAddValidatorInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAddValidatorInput(ctx context.Context, v any) (model.AddValidatorInput, error) {
-	res, err := ec.unmarshalInputAddValidatorInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNAlert2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert(ctx context.Context, sel ast.SelectionSet, v models.Alert) graphql.Marshaler {
-	return ec._Alert(ctx, sel, &v)
+func (ec *executionContext) marshalNIncidentEvent2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncidentEvent(ctx context.Context, sel ast.SelectionSet, v *models.IncidentEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._IncidentEvent(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlertᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Alert) graphql.Marshaler {
+func (ec *executionContext) marshalNIncomeWindowSummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐIncomeWindowSummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.IncomeWindowSummary) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8133,7 +20840,7 @@ func (ec *executionContext) marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋeth
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert(ctx, sel, v[i])
+			ret[i] = ec.marshalNIncomeWindowSummary2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐIncomeWindowSummary(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8153,25 +20860,24 @@ func (ec *executionContext) marshalNAlert2ᚕᚖgithubᚗcomᚋbirddigitalᚋeth
 	return ret
 }
 
-func (ec *executionContext) marshalNAlert2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐAlert(ctx context.Context, sel ast.SelectionSet, v *models.Alert) graphql.Marshaler {
+func (ec *executionContext) marshalNIncomeWindowSummary2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐIncomeWindowSummary(ctx context.Context, sel ast.SelectionSet, v *model.IncomeWindowSummary) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Alert(ctx, sel, v)
+	return ec._IncomeWindowSummary(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity(ctx context.Context, v any) (types.AlertSeverity, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := types.AlertSeverity(tmp)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐAlertSeverity(ctx context.Context, sel ast.SelectionSet, v types.AlertSeverity) graphql.Marshaler {
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalString(string(v))
+	res := graphql.MarshalInt(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -8180,93 +20886,245 @@ func (ec *executionContext) marshalNAlertSeverity2githubᚗcomᚋbirddigitalᚋe
 	return res
 }
 
-func (ec *executionContext) marshalNAuthPayload2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v model.AuthPayload) graphql.Marshaler {
-	return ec._AuthPayload(ctx, sel, &v)
+func (ec *executionContext) unmarshalNInt2int64(ctx context.Context, v any) (int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAuthPayload2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v *model.AuthPayload) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt64(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
 	}
-	return ec._AuthPayload(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) marshalNBalance2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐBalance(ctx context.Context, sel ast.SelectionSet, v model.Balance) graphql.Marshaler {
-	return ec._Balance(ctx, sel, &v)
+func (ec *executionContext) unmarshalNInt2ᚕintᚄ(ctx context.Context, v any) ([]int, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNBalance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐBalance(ctx context.Context, sel ast.SelectionSet, v *model.Balance) graphql.Marshaler {
+func (ec *executionContext) marshalNInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2ᚖint64(ctx context.Context, v any) (*int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2ᚖint64(ctx context.Context, sel ast.SelectionSet, v *int64) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Balance(ctx, sel, v)
+	_ = sel
+	res := graphql.MarshalInt64(*v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
 }
 
-func (ec *executionContext) unmarshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, v any) (types.BigInt, error) {
-	var res types.BigInt
+func (ec *executionContext) unmarshalNLedgerInterval2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLedgerInterval(ctx context.Context, v any) (model.LedgerInterval, error) {
+	var res model.LedgerInterval
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBigInt2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, sel ast.SelectionSet, v types.BigInt) graphql.Marshaler {
+func (ec *executionContext) marshalNLedgerInterval2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLedgerInterval(ctx context.Context, sel ast.SelectionSet, v model.LedgerInterval) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, v any) (*types.BigInt, error) {
-	var res = new(types.BigInt)
-	err := res.UnmarshalGQL(v)
+func (ec *executionContext) unmarshalNLoginInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLoginInput(ctx context.Context, v any) (model.LoginInput, error) {
+	res, err := ec.unmarshalInputLoginInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, sel ast.SelectionSet, v *types.BigInt) graphql.Marshaler {
+func (ec *executionContext) marshalNNetworkStats2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐNetworkStats(ctx context.Context, sel ast.SelectionSet, v types.NetworkStats) graphql.Marshaler {
+	return ec._NetworkStats(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNetworkStats2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐNetworkStats(ctx context.Context, sel ast.SelectionSet, v *types.NetworkStats) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return v
+	return ec._NetworkStats(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNNodeAttestationMisses2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐNodeAttestationMissesᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.NodeAttestationMisses) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNNodeAttestationMisses2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐNodeAttestationMisses(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNNodeAttestationMisses2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐNodeAttestationMisses(ctx context.Context, sel ast.SelectionSet, v *model.NodeAttestationMisses) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._NodeAttestationMisses(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPerformance2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐPerformance(ctx context.Context, sel ast.SelectionSet, v model.Performance) graphql.Marshaler {
+	return ec._Performance(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPerformance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐPerformance(ctx context.Context, sel ast.SelectionSet, v *model.Performance) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Performance(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNProposalLuck2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐProposalLuckᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ProposalLuck) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProposalLuck2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐProposalLuck(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNProposalLuck2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐProposalLuck(ctx context.Context, sel ast.SelectionSet, v *models.ProposalLuck) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._ProposalLuck(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNRecollectResult2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRecollectResult(ctx context.Context, sel ast.SelectionSet, v model.RecollectResult) graphql.Marshaler {
+	return ec._RecollectResult(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNRecollectResult2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRecollectResult(ctx context.Context, sel ast.SelectionSet, v *model.RecollectResult) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return graphql.WrapContextMarshaler(ctx, res)
+	return ec._RecollectResult(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNHistoricalSnapshot2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshotᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HistoricalSnapshot) graphql.Marshaler {
+func (ec *executionContext) unmarshalNRegisterInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRegisterInput(ctx context.Context, v any) (model.RegisterInput, error) {
+	res, err := ec.unmarshalInputRegisterInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRelayProposal2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelayProposalᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RelayProposal) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8290,7 +21148,7 @@ func (ec *executionContext) marshalNHistoricalSnapshot2ᚕᚖgithubᚗcomᚋbird
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNHistoricalSnapshot2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshot(ctx, sel, v[i])
+			ret[i] = ec.marshalNRelayProposal2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelayProposal(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8310,120 +21168,136 @@ func (ec *executionContext) marshalNHistoricalSnapshot2ᚕᚖgithubᚗcomᚋbird
 	return ret
 }
 
-func (ec *executionContext) marshalNHistoricalSnapshot2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐHistoricalSnapshot(ctx context.Context, sel ast.SelectionSet, v *model.HistoricalSnapshot) graphql.Marshaler {
+func (ec *executionContext) marshalNRelayProposal2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelayProposal(ctx context.Context, sel ast.SelectionSet, v *models.RelayProposal) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._HistoricalSnapshot(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._RelayProposal(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalNRelaySummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelaySummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RelaySummary) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return res
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRelaySummary2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelaySummary(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
-}
 
-func (ec *executionContext) unmarshalNInt2ᚖint64(ctx context.Context, v any) (*int64, error) {
-	res, err := graphql.UnmarshalInt64(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2ᚖint64(ctx context.Context, sel ast.SelectionSet, v *int64) graphql.Marshaler {
+func (ec *executionContext) marshalNRelaySummary2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐRelaySummary(ctx context.Context, sel ast.SelectionSet, v *models.RelaySummary) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	_ = sel
-	res := graphql.MarshalInt64(*v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNLoginInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐLoginInput(ctx context.Context, v any) (model.LoginInput, error) {
-	res, err := ec.unmarshalInputLoginInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._RelaySummary(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNNetworkStats2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐNetworkStats(ctx context.Context, sel ast.SelectionSet, v types.NetworkStats) graphql.Marshaler {
-	return ec._NetworkStats(ctx, sel, &v)
+func (ec *executionContext) marshalNRewards2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewards(ctx context.Context, sel ast.SelectionSet, v model.Rewards) graphql.Marshaler {
+	return ec._Rewards(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNNetworkStats2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐNetworkStats(ctx context.Context, sel ast.SelectionSet, v *types.NetworkStats) graphql.Marshaler {
+func (ec *executionContext) marshalNRewards2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewards(ctx context.Context, sel ast.SelectionSet, v *model.Rewards) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._NetworkStats(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNPerformance2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐPerformance(ctx context.Context, sel ast.SelectionSet, v model.Performance) graphql.Marshaler {
-	return ec._Performance(ctx, sel, &v)
+	return ec._Rewards(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPerformance2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐPerformance(ctx context.Context, sel ast.SelectionSet, v *model.Performance) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalNRewardsPeriodSummary2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewardsPeriodSummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RewardsPeriodSummary) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRewardsPeriodSummary2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewardsPeriodSummary(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return ec._Performance(ctx, sel, v)
-}
+	wg.Wait()
 
-func (ec *executionContext) unmarshalNRegisterInput2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRegisterInput(ctx context.Context, v any) (model.RegisterInput, error) {
-	res, err := ec.unmarshalInputRegisterInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) marshalNRewards2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewards(ctx context.Context, sel ast.SelectionSet, v model.Rewards) graphql.Marshaler {
-	return ec._Rewards(ctx, sel, &v)
+	return ret
 }
 
-func (ec *executionContext) marshalNRewards2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewards(ctx context.Context, sel ast.SelectionSet, v *model.Rewards) graphql.Marshaler {
+func (ec *executionContext) marshalNRewardsPeriodSummary2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐRewardsPeriodSummary(ctx context.Context, sel ast.SelectionSet, v *model.RewardsPeriodSummary) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Rewards(ctx, sel, v)
+	return ec._RewardsPeriodSummary(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNRiskLevel2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐRiskLevel(ctx context.Context, v any) (types.RiskLevel, error) {
@@ -8489,6 +21363,60 @@ func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
+func (ec *executionContext) marshalNTagIncome2ᚕᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐTagIncomeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TagIncome) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTagIncome2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐTagIncome(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTagIncome2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐTagIncome(ctx context.Context, sel ast.SelectionSet, v *model.TagIncome) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TagIncome(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNTime2githubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐTime(ctx context.Context, v any) (types.Time, error) {
 	var res types.Time
 	err := res.UnmarshalGQL(v)
@@ -8604,6 +21532,16 @@ func (ec *executionContext) marshalNValidatorStatus2githubᚗcomᚋbirddigital
 	return res
 }
 
+func (ec *executionContext) marshalNWorkerPoolStats2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋgraphᚋmodelᚐWorkerPoolStats(ctx context.Context, sel ast.SelectionSet, v *model.WorkerPoolStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WorkerPoolStats(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -8891,6 +21829,22 @@ func (ec *executionContext) marshalOAlertSeverity2ᚖgithubᚗcomᚋbirddigital
 	return res
 }
 
+func (ec *executionContext) unmarshalOBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, v any) (*types.BigInt, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(types.BigInt)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBigInt2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋpkgᚋtypesᚐBigInt(ctx context.Context, sel ast.SelectionSet, v *types.BigInt) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8921,6 +21875,20 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalODowntimeCost2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐDowntimeCost(ctx context.Context, sel ast.SelectionSet, v *models.DowntimeCost) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DowntimeCost(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOEpochClientDiversity2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐEpochClientDiversity(ctx context.Context, sel ast.SelectionSet, v *models.EpochClientDiversity) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._EpochClientDiversity(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
 	if v == nil {
 		return nil, nil
@@ -8938,6 +21906,13 @@ func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel as
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) marshalOIncident2ᚖgithubᚗcomᚋbirddigitalᚋethᚑvalidatorᚑmonitorᚋinternalᚋdatabaseᚋmodelsᚐIncident(ctx context.Context, sel ast.SelectionSet, v *models.Incident) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Incident(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v any) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -5,7 +5,10 @@ import (
 	"github.com/birddigital/eth-validator-monitor/graph/resolver"
 	"github.com/birddigital/eth-validator-monitor/internal/auth"
 	"github.com/birddigital/eth-validator-monitor/internal/config"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
 	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/services/income"
+	"github.com/birddigital/eth-validator-monitor/internal/services/luck"
 	"github.com/birddigital/eth-validator-monitor/internal/storage"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
@@ -23,13 +26,24 @@ var (
 // NewResolver creates a new GraphQL resolver with database pool
 // Note: Cache is optional and will be nil if Redis is not configured
 func NewResolver(pool *pgxpool.Pool) *resolver.Resolver {
+	ledgerRepo := repository.NewRewardsLedgerRepository(pool)
 	return &resolver.Resolver{
-		DB:              pool,
-		ValidatorRepo:   repository.NewValidatorRepository(pool),
-		SnapshotRepo:    repository.NewSnapshotRepository(pool),
-		AlertRepo:       repository.NewAlertRepository(pool),
-		PerformanceRepo: repository.NewPerformanceRepository(pool),
-		Cache:           nil, // Cache initialization requires Redis config
+		DB:                  pool,
+		ValidatorRepo:       repository.NewValidatorRepository(pool),
+		SnapshotRepo:        repository.NewSnapshotRepository(pool),
+		AlertRepo:           repository.NewAlertRepository(pool),
+		PerformanceRepo:     repository.NewPerformanceRepository(pool),
+		RewardsLedgerRepo:   ledgerRepo,
+		AttestationMissRepo: repository.NewAttestationMissRepository(pool),
+		DowntimeCostRepo:    repository.NewDowntimeCostRepository(pool),
+		DiscoveryRepo:       repository.NewDiscoveryRepository(pool),
+		FeeRecipientRepo:    repository.NewFeeRecipientRepository(pool),
+		RelayRepo:           repository.NewRelayRepository(pool),
+		ClientRepo:          repository.NewClientRepository(pool),
+		IncidentRepo:        repository.NewIncidentRepository(pool),
+		IncomeService:       income.NewService(ledgerRepo, nil),
+		LuckService:         luck.NewService(repository.NewLuckRepository(pool)),
+		Cache:               nil, // Cache initialization requires Redis config
 	}
 }
 
@@ -41,16 +55,29 @@ func NewResolverWithAuth(
 	cfg *config.Config,
 	log *zerolog.Logger,
 ) *resolver.Resolver {
+	// Windows are checked by config validation
+	windows, _ := models.ParseIncomeWindows(cfg.Income.Windows)
+	ledgerRepo := repository.NewRewardsLedgerRepository(pool)
 	return &resolver.Resolver{
-		DB:              pool,
-		ValidatorRepo:   repository.NewValidatorRepository(pool),
-		SnapshotRepo:    repository.NewSnapshotRepository(pool),
-		AlertRepo:       repository.NewAlertRepository(pool),
-		PerformanceRepo: repository.NewPerformanceRepository(pool),
-		UserRepo:        userRepo,
-		Cache:           nil, // Cache initialization requires Redis config
-		JWTService:      jwtService,
-		Config:          cfg,
-		Logger:          log,
+		DB:                  pool,
+		ValidatorRepo:       repository.NewValidatorRepository(pool),
+		SnapshotRepo:        repository.NewSnapshotRepository(pool),
+		AlertRepo:           repository.NewAlertRepository(pool),
+		PerformanceRepo:     repository.NewPerformanceRepository(pool),
+		RewardsLedgerRepo:   ledgerRepo,
+		AttestationMissRepo: repository.NewAttestationMissRepository(pool),
+		DowntimeCostRepo:    repository.NewDowntimeCostRepository(pool),
+		DiscoveryRepo:       repository.NewDiscoveryRepository(pool),
+		FeeRecipientRepo:    repository.NewFeeRecipientRepository(pool),
+		RelayRepo:           repository.NewRelayRepository(pool),
+		ClientRepo:          repository.NewClientRepository(pool),
+		IncidentRepo:        repository.NewIncidentRepository(pool),
+		IncomeService:       income.NewService(ledgerRepo, windows),
+		LuckService:         luck.NewService(repository.NewLuckRepository(pool)),
+		UserRepo:            userRepo,
+		Cache:               nil, // Cache initialization requires Redis config
+		JWTService:          jwtService,
+		Config:              cfg,
+		Logger:              log,
 	}
 }
@@ -3,15 +3,43 @@
 package model
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
 	"github.com/birddigital/eth-validator-monitor/pkg/types"
 )
 
+type AddDiscoveryRuleInput struct {
+	Kind DiscoveryRuleKind `json:"kind"`
+	// Execution address, or 32-byte withdrawal credentials for WITHDRAWAL rules
+	Value string `json:"value"`
+	Tag   string `json:"tag"`
+	// Execution block DEPOSITOR rules start searching from (defaults to the configured start block)
+	FromBlock *int `json:"fromBlock,omitempty"`
+}
+
 type AddValidatorInput struct {
 	Pubkey *string `json:"pubkey,omitempty"`
 	Index  *int    `json:"index,omitempty"`
 	Name   *string `json:"name,omitempty"`
 }
 
+// An operator action recorded in the admin audit trail
+type AdminAuditEntry struct {
+	ID         string `json:"id"`
+	Actor      string `json:"actor"`
+	AuthMethod string `json:"authMethod"`
+	Action     string `json:"action"`
+	// Action parameters as a JSON object
+	Params    *string    `json:"params,omitempty"`
+	Success   bool       `json:"success"`
+	Error     *string    `json:"error,omitempty"`
+	CreatedAt types.Time `json:"createdAt"`
+}
+
 type AuthPayload struct {
 	// Access token for API authentication
 	AccessToken string `json:"accessToken"`
@@ -29,6 +57,22 @@ type Balance struct {
 	Withdrawable types.BigInt `json:"withdrawable"`
 }
 
+type CircuitStatus struct {
+	Component string `json:"component"`
+	State     string `json:"state"`
+}
+
+// Live state of the validator collector
+type CollectorStatus struct {
+	Paused              bool             `json:"paused"`
+	ValidatorsMonitored int              `json:"validatorsMonitored"`
+	CollectionsCount    int              `json:"collectionsCount"`
+	ErrorsCount         int              `json:"errorsCount"`
+	LastCollectionTime  *types.Time      `json:"lastCollectionTime,omitempty"`
+	Pool                *WorkerPoolStats `json:"pool"`
+	Circuits            []*CircuitStatus `json:"circuits"`
+}
+
 type HistoricalSnapshot struct {
 	Epoch              int          `json:"epoch"`
 	Slot               int          `json:"slot"`
@@ -42,6 +86,18 @@ type HistoricalSnapshot struct {
 	NetworkPercentile  *float64     `json:"networkPercentile,omitempty"`
 }
 
+// Consensus income over a trailing window, from the rewards ledger. Amounts are in Gwei.
+// Withdrawals and deposits are not income, so a sweep or top-up does not change the figures.
+type IncomeWindowSummary struct {
+	// Window length in days, such as 30d
+	Window string `json:"window"`
+	// Epochs in the window with ledger entries; APR is annualised from these
+	Epochs      int          `json:"epochs"`
+	Income      types.BigInt `json:"income"`
+	DailyIncome types.BigInt `json:"dailyIncome"`
+	Apr         float64      `json:"apr"`
+}
+
 type LoginInput struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -50,6 +106,13 @@ type LoginInput struct {
 type Mutation struct {
 }
 
+// Attestation misses by the validators running on one node, as named by their node:<name> tags
+type NodeAttestationMisses struct {
+	// Node name, or null for validators without a node tag
+	Node   *string                        `json:"node,omitempty"`
+	Misses []*models.AttestationMissCount `json:"misses"`
+}
+
 type Performance struct {
 	UptimePercentage  float64         `json:"uptimePercentage"`
 	ConsecutiveMisses int             `json:"consecutiveMisses"`
@@ -67,21 +130,55 @@ type Performance struct {
 type Query struct {
 }
 
+// Outcome of a forced re-collection
+type RecollectResult struct {
+	ValidatorIndex    int   `json:"validatorIndex"`
+	FromEpoch         int   `json:"fromEpoch"`
+	ToEpoch           int   `json:"toEpoch"`
+	Recollected       int   `json:"recollected"`
+	Replaced          int   `json:"replaced"`
+	UnavailableEpochs []int `json:"unavailableEpochs"`
+}
+
 type RegisterInput struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+// Consensus rewards over the last 30 days, from the rewards ledger. Amounts are in Gwei.
+// Expected assumes perfect attestations plus the proposal and sync duties actually assigned.
 type Rewards struct {
 	Expected      types.BigInt `json:"expected"`
 	Actual        types.BigInt `json:"actual"`
 	Effectiveness float64      `json:"effectiveness"`
 }
 
+// Rewards, penalties and transfers over one ledger period. Amounts are in Gwei.
+type RewardsPeriodSummary struct {
+	PeriodStart        types.Time   `json:"periodStart"`
+	Epochs             int          `json:"epochs"`
+	AttestationRewards types.BigInt `json:"attestationRewards"`
+	ProposalRewards    types.BigInt `json:"proposalRewards"`
+	SyncRewards        types.BigInt `json:"syncRewards"`
+	Penalties          types.BigInt `json:"penalties"`
+	Withdrawals        types.BigInt `json:"withdrawals"`
+	Deposits           types.BigInt `json:"deposits"`
+	Other              types.BigInt `json:"other"`
+	Expected           types.BigInt `json:"expected"`
+	Actual             types.BigInt `json:"actual"`
+	Effectiveness      float64      `json:"effectiveness"`
+}
+
 type Subscription struct {
 }
 
+// Income for the monitored validators carrying a tag
+type TagIncome struct {
+	Tag     string                 `json:"tag"`
+	Windows []*IncomeWindowSummary `json:"windows"`
+}
+
 type User struct {
 	ID        string      `json:"id"`
 	Username  string      `json:"username"`
@@ -90,3 +187,131 @@ type User struct {
 	CreatedAt types.Time  `json:"createdAt"`
 	LastLogin *types.Time `json:"lastLogin,omitempty"`
 }
+
+type WorkerPoolStats struct {
+	TasksProcessed  int `json:"tasksProcessed"`
+	TasksFailed     int `json:"tasksFailed"`
+	ActiveWorkers   int `json:"activeWorkers"`
+	BusyWorkers     int `json:"busyWorkers"`
+	QueueSize       int `json:"queueSize"`
+	ResultQueueSize int `json:"resultQueueSize"`
+}
+
+// What a discovery rule matches validators by
+type DiscoveryRuleKind string
+
+const (
+	// Withdrawal address or full withdrawal credentials
+	DiscoveryRuleKindWithdrawal DiscoveryRuleKind = "WITHDRAWAL"
+	// Fee recipient of blocks the validator proposed
+	DiscoveryRuleKindFeeRecipient DiscoveryRuleKind = "FEE_RECIPIENT"
+	// Sender of the validator's deposits (needs an execution client)
+	DiscoveryRuleKindDepositor DiscoveryRuleKind = "DEPOSITOR"
+)
+
+var AllDiscoveryRuleKind = []DiscoveryRuleKind{
+	DiscoveryRuleKindWithdrawal,
+	DiscoveryRuleKindFeeRecipient,
+	DiscoveryRuleKindDepositor,
+}
+
+func (e DiscoveryRuleKind) IsValid() bool {
+	switch e {
+	case DiscoveryRuleKindWithdrawal, DiscoveryRuleKindFeeRecipient, DiscoveryRuleKindDepositor:
+		return true
+	}
+	return false
+}
+
+func (e DiscoveryRuleKind) String() string {
+	return string(e)
+}
+
+func (e *DiscoveryRuleKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiscoveryRuleKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiscoveryRuleKind", str)
+	}
+	return nil
+}
+
+func (e DiscoveryRuleKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DiscoveryRuleKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DiscoveryRuleKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Bucket size for rewards ledger summaries (UTC)
+type LedgerInterval string
+
+const (
+	LedgerIntervalDay   LedgerInterval = "DAY"
+	LedgerIntervalWeek  LedgerInterval = "WEEK"
+	LedgerIntervalMonth LedgerInterval = "MONTH"
+)
+
+var AllLedgerInterval = []LedgerInterval{
+	LedgerIntervalDay,
+	LedgerIntervalWeek,
+	LedgerIntervalMonth,
+}
+
+func (e LedgerInterval) IsValid() bool {
+	switch e {
+	case LedgerIntervalDay, LedgerIntervalWeek, LedgerIntervalMonth:
+		return true
+	}
+	return false
+}
+
+func (e LedgerInterval) String() string {
+	return string(e)
+}
+
+func (e *LedgerInterval) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LedgerInterval(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LedgerInterval", str)
+	}
+	return nil
+}
+
+func (e LedgerInterval) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LedgerInterval) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LedgerInterval) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,121 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/birddigital/eth-validator-monitor/graph/model"
+	"github.com/birddigital/eth-validator-monitor/internal/auth"
+	"github.com/birddigital/eth-validator-monitor/internal/collector"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/google/uuid"
+)
+
+// requireAdmin returns an error unless the request is authenticated as an admin
+// and the admin control plane is available
+func (r *Resolver) requireAdmin(ctx context.Context) error {
+	if err := r.requireAdminRole(ctx); err != nil {
+		return err
+	}
+
+	if r.Admin == nil {
+		return errors.New("admin control plane is not available")
+	}
+	return nil
+}
+
+// requireAdminRole returns an error unless the request is authenticated as an admin
+func (r *Resolver) requireAdminRole(ctx context.Context) error {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok || userID == uuid.Nil {
+		return errors.New("unauthorized: no valid authentication")
+	}
+
+	allowed, err := auth.UserHasRole(ctx, r.UserRepo, auth.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return auth.ErrForbidden
+	}
+	return nil
+}
+
+// mapCollectorStatus converts collector statistics to the GraphQL model
+func mapCollectorStatus(stats collector.CollectorStats) *model.CollectorStatus {
+	status := &model.CollectorStatus{
+		Paused:              stats.Paused,
+		ValidatorsMonitored: stats.ValidatorsMonitored,
+		CollectionsCount:    int(stats.CollectionsCount),
+		ErrorsCount:         int(stats.ErrorsCount),
+		Pool: &model.WorkerPoolStats{
+			TasksProcessed:  int(stats.PoolStats.TasksProcessed),
+			TasksFailed:     int(stats.PoolStats.TasksFailed),
+			ActiveWorkers:   int(stats.PoolStats.ActiveWorkers),
+			BusyWorkers:     int(stats.PoolStats.BusyWorkers),
+			QueueSize:       stats.PoolStats.QueueSize,
+			ResultQueueSize: stats.PoolStats.ResultQueueSize,
+		},
+		Circuits: make([]*model.CircuitStatus, 0, len(stats.CircuitStates)),
+	}
+
+	if !stats.LastCollectionTime.IsZero() {
+		last := types.Time(stats.LastCollectionTime)
+		status.LastCollectionTime = &last
+	}
+
+	for component, state := range stats.CircuitStates {
+		status.Circuits = append(status.Circuits, &model.CircuitStatus{
+			Component: component,
+			State:     state.String(),
+		})
+	}
+	sort.Slice(status.Circuits, func(i, j int) bool {
+		return status.Circuits[i].Component < status.Circuits[j].Component
+	})
+
+	return status
+}
+
+// mapRecollectResult converts a re-collection result to the GraphQL model
+func mapRecollectResult(result *collector.RecollectResult) *model.RecollectResult {
+	unavailable := make([]int, 0, len(result.UnavailableEpochs))
+	for _, epoch := range result.UnavailableEpochs {
+		unavailable = append(unavailable, int(epoch))
+	}
+
+	return &model.RecollectResult{
+		ValidatorIndex:    int(result.ValidatorIndex),
+		FromEpoch:         int(result.FromEpoch),
+		ToEpoch:           int(result.ToEpoch),
+		Recollected:       result.Recollected,
+		Replaced:          int(result.Replaced),
+		UnavailableEpochs: unavailable,
+	}
+}
+
+// mapAdminAuditEntry converts an audit trail entry to the GraphQL model
+func mapAdminAuditEntry(action *models.AdminAction) *model.AdminAuditEntry {
+	entry := &model.AdminAuditEntry{
+		ID:         strconv.FormatInt(action.ID, 10),
+		Actor:      action.Actor,
+		AuthMethod: action.AuthMethod,
+		Action:     string(action.Action),
+		Success:    action.Success,
+		Error:      action.Error,
+		CreatedAt:  types.Time(action.CreatedAt),
+	}
+
+	if action.Params != nil {
+		if encoded, err := json.Marshal(action.Params); err == nil {
+			params := string(encoded)
+			entry.Params = &params
+		}
+	}
+
+	return entry
+}
@@ -0,0 +1,18 @@
+package resolver
+
+import (
+	"strings"
+
+	"github.com/birddigital/eth-validator-monitor/graph/model"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+)
+
+// discoveryKind converts a GraphQL discovery rule kind to the stored kind
+func discoveryKind(kind model.DiscoveryRuleKind) models.DiscoveryKind {
+	return models.DiscoveryKind(strings.ToLower(string(kind)))
+}
+
+// mapDiscoveryKind converts a stored discovery rule kind to the GraphQL enum
+func mapDiscoveryKind(kind models.DiscoveryKind) model.DiscoveryRuleKind {
+	return model.DiscoveryRuleKind(strings.ToUpper(string(kind)))
+}
@@ -0,0 +1,60 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/auth"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/google/uuid"
+)
+
+// transitionIncident applies a lifecycle action to an incident as the authenticated user and
+// returns the incident. An incident already past the action is returned as is.
+func (r *Resolver) transitionIncident(ctx context.Context, id string,
+	apply func(ctx context.Context, id int64, by string) (bool, error)) (*models.Incident, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok || userID == uuid.Nil {
+		return nil, errors.New("unauthorized: no valid authentication")
+	}
+	by, _ := auth.GetUsernameFromContext(ctx)
+
+	incidentID, err := parseIncidentID(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := apply(ctx, incidentID, by); err != nil {
+		return nil, err
+	}
+
+	incident, err := r.IncidentRepo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	if incident == nil {
+		return nil, fmt.Errorf("incident not found: %s", id)
+	}
+	return incident, nil
+}
+
+// parseIncidentID converts a GraphQL incident ID to the stored ID
+func parseIncidentID(id string) (int64, error) {
+	incidentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid incident id: %s", id)
+	}
+	return incidentID, nil
+}
+
+// optionalTime converts an optional timestamp to the GraphQL scalar
+func optionalTime(t *time.Time) *types.Time {
+	if t == nil {
+		return nil
+	}
+	gt := types.Time(*t)
+	return &gt
+}
@@ -5,8 +5,12 @@ import (
 	"github.com/birddigital/eth-validator-monitor/internal/cache"
 	"github.com/birddigital/eth-validator-monitor/internal/config"
 	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/services/admin"
+	"github.com/birddigital/eth-validator-monitor/internal/services/income"
+	"github.com/birddigital/eth-validator-monitor/internal/services/luck"
 	"github.com/birddigital/eth-validator-monitor/internal/storage"
 	"github.com/birddigital/eth-validator-monitor/graph/dataloader"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 )
@@ -20,15 +24,35 @@ type Resolver struct {
 	DB *pgxpool.Pool
 
 	// Repositories
-	ValidatorRepo   *repository.ValidatorRepository
-	SnapshotRepo    *repository.SnapshotRepository
-	AlertRepo       *repository.AlertRepository
-	PerformanceRepo *repository.PerformanceRepository
-	UserRepo        *storage.UserRepository
+	ValidatorRepo       *repository.ValidatorRepository
+	SnapshotRepo        *repository.SnapshotRepository
+	AlertRepo           *repository.AlertRepository
+	PerformanceRepo     *repository.PerformanceRepository
+	RewardsLedgerRepo   *repository.RewardsLedgerRepository
+	AttestationMissRepo *repository.AttestationMissRepository
+	DowntimeCostRepo    *repository.DowntimeCostRepository
+	DiscoveryRepo       *repository.DiscoveryRepository
+	FeeRecipientRepo    *repository.FeeRecipientRepository
+	RelayRepo           *repository.RelayRepository
+	ClientRepo          *repository.ClientRepository
+	IncidentRepo        *repository.IncidentRepository
+	UserRepo            *storage.UserRepository
 
 	// Cache
 	Cache *cache.RedisCache
 
+	// Daily income and APR over trailing windows
+	IncomeService *income.Service
+
+	// Proposal and sync committee luck
+	LuckService *luck.Service
+
+	// Collector admin control plane (nil when the collector is not running in-process)
+	Admin *admin.Service
+
+	// Beacon node used to resolve the index and pubkey of added validators (nil rejects additions)
+	Beacon types.BeaconClient
+
 	// Authentication
 	JWTService *auth.JWTService
 
@@ -0,0 +1,102 @@
+package resolver
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/graph/model"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// rewardsWindow is the period covered by Validator.rewards and the default rewardsLedger range
+const rewardsWindow = 30 * 24 * time.Hour
+
+// ledgerRange resolves optional rewardsLedger bounds, defaulting to the last rewardsWindow
+func ledgerRange(from, to *types.Time) (time.Time, time.Time) {
+	end := time.Now()
+	if to != nil {
+		end = to.ToTime()
+	}
+
+	start := end.Add(-rewardsWindow)
+	if from != nil {
+		start = from.ToTime()
+	}
+
+	return start, end
+}
+
+// missWindow is the default range of attestation miss counts
+const missWindow = 7 * 24 * time.Hour
+
+// missRange resolves optional attestation miss bounds, defaulting to the last missWindow
+func missRange(from, to *types.Time) (time.Time, time.Time) {
+	end := time.Now()
+	if to != nil {
+		end = to.ToTime()
+	}
+
+	start := end.Add(-missWindow)
+	if from != nil {
+		start = from.ToTime()
+	}
+
+	return start, end
+}
+
+// downtimeWindow is the default range of downtimeCost
+const downtimeWindow = 24 * time.Hour
+
+// downtimeRange resolves optional downtimeCost bounds, defaulting to the last downtimeWindow
+func downtimeRange(from, to *types.Time) (time.Time, time.Time) {
+	end := time.Now()
+	if to != nil {
+		end = to.ToTime()
+	}
+
+	start := end.Add(-downtimeWindow)
+	if from != nil {
+		start = from.ToTime()
+	}
+
+	return start, end
+}
+
+// mapRewardsSummary converts a ledger summary to the GraphQL model
+func mapRewardsSummary(s *models.RewardLedgerSummary) *model.RewardsPeriodSummary {
+	return &model.RewardsPeriodSummary{
+		PeriodStart:        types.Time(s.PeriodStart),
+		Epochs:             int(s.Epochs),
+		AttestationRewards: gweiToBigInt(s.AttestationRewards),
+		ProposalRewards:    gweiToBigInt(s.ProposalRewards),
+		SyncRewards:        gweiToBigInt(s.SyncRewards),
+		Penalties:          gweiToBigInt(s.Penalties),
+		Withdrawals:        gweiToBigInt(s.Withdrawals),
+		Deposits:           gweiToBigInt(s.Deposits),
+		Other:              gweiToBigInt(s.Other),
+		Expected:           gweiToBigInt(s.Expected()),
+		Actual:             gweiToBigInt(s.Actual()),
+		Effectiveness:      s.Effectiveness(),
+	}
+}
+
+// mapIncomeSummaries converts income summaries to the GraphQL model
+func mapIncomeSummaries(summaries []*models.IncomeSummary) []*model.IncomeWindowSummary {
+	result := make([]*model.IncomeWindowSummary, 0, len(summaries))
+	for _, s := range summaries {
+		result = append(result, &model.IncomeWindowSummary{
+			Window:      string(s.Window),
+			Epochs:      int(s.Epochs),
+			Income:      gweiToBigInt(s.Income),
+			DailyIncome: gweiToBigInt(s.DailyIncome()),
+			Apr:         s.APR(),
+		})
+	}
+	return result
+}
+
+// gweiToBigInt converts a Gwei amount to the BigInt scalar
+func gweiToBigInt(gwei int64) types.BigInt {
+	return types.BigInt(*big.NewInt(gwei))
+}
@@ -7,6 +7,10 @@ package resolver
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/birddigital/eth-validator-monitor/graph/generated"
 	"github.com/birddigital/eth-validator-monitor/graph/model"
@@ -16,42 +20,340 @@ import (
 
 // ID is the resolver for the id field.
 func (r *alertResolver) ID(ctx context.Context, obj *models.Alert) (string, error) {
-	panic(fmt.Errorf("not implemented: ID - id"))
+	return strconv.Itoa(int(obj.ID)), nil
 }
 
 // Severity is the resolver for the severity field.
 func (r *alertResolver) Severity(ctx context.Context, obj *models.Alert) (types.AlertSeverity, error) {
-	panic(fmt.Errorf("not implemented: Severity - severity"))
+	return types.AlertSeverity(strings.ToUpper(string(obj.Severity))), nil
 }
 
 // Type is the resolver for the type field.
 func (r *alertResolver) Type(ctx context.Context, obj *models.Alert) (string, error) {
-	panic(fmt.Errorf("not implemented: Type - type"))
+	return obj.AlertType, nil
 }
 
 // Acknowledged is the resolver for the acknowledged field.
 func (r *alertResolver) Acknowledged(ctx context.Context, obj *models.Alert) (bool, error) {
-	panic(fmt.Errorf("not implemented: Acknowledged - acknowledged"))
+	return obj.AcknowledgedAt != nil, nil
 }
 
 // CreatedAt is the resolver for the createdAt field.
 func (r *alertResolver) CreatedAt(ctx context.Context, obj *models.Alert) (*types.Time, error) {
-	panic(fmt.Errorf("not implemented: CreatedAt - createdAt"))
+	t := types.Time(obj.CreatedAt)
+	return &t, nil
+}
+
+// Reason is the resolver for the reason field.
+func (r *attestationMissCountResolver) Reason(ctx context.Context, obj *models.AttestationMissCount) (string, error) {
+	return string(obj.Reason), nil
+}
+
+// Blame is the resolver for the blame field.
+func (r *attestationMissCountResolver) Blame(ctx context.Context, obj *models.AttestationMissCount) (string, error) {
+	return string(obj.Blame()), nil
+}
+
+// Time is the resolver for the time field.
+func (r *blockClientResolver) Time(ctx context.Context, obj *models.BlockClient) (*types.Time, error) {
+	t := types.Time(obj.Time)
+	return &t, nil
+}
+
+// Client is the resolver for the client field.
+func (r *blockClientResolver) Client(ctx context.Context, obj *models.BlockClient) (string, error) {
+	return strings.ToUpper(string(obj.Client)), nil
+}
+
+// Client is the resolver for the client field.
+func (r *clientShareResolver) Client(ctx context.Context, obj *models.ClientShare) (string, error) {
+	return strings.ToUpper(string(obj.Client)), nil
+}
+
+// ID is the resolver for the id field.
+func (r *discoveryRuleResolver) ID(ctx context.Context, obj *models.DiscoveryRule) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *discoveryRuleResolver) Kind(ctx context.Context, obj *models.DiscoveryRule) (model.DiscoveryRuleKind, error) {
+	return mapDiscoveryKind(obj.Kind), nil
+}
+
+// LastRunAt is the resolver for the lastRunAt field.
+func (r *discoveryRuleResolver) LastRunAt(ctx context.Context, obj *models.DiscoveryRule) (*types.Time, error) {
+	if obj.LastRunAt == nil {
+		return nil, nil
+	}
+	t := types.Time(*obj.LastRunAt)
+	return &t, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *discoveryRuleResolver) CreatedAt(ctx context.Context, obj *models.DiscoveryRule) (*types.Time, error) {
+	t := types.Time(obj.CreatedAt)
+	return &t, nil
+}
+
+// From is the resolver for the from field.
+func (r *downtimeCostResolver) From(ctx context.Context, obj *models.DowntimeCost) (*types.Time, error) {
+	t := types.Time(obj.From)
+	return &t, nil
+}
+
+// To is the resolver for the to field.
+func (r *downtimeCostResolver) To(ctx context.Context, obj *models.DowntimeCost) (*types.Time, error) {
+	t := types.Time(obj.To)
+	return &t, nil
+}
+
+// AttestationLoss is the resolver for the attestationLoss field.
+func (r *downtimeCostResolver) AttestationLoss(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error) {
+	v := gweiToBigInt(obj.AttestationLoss)
+	return &v, nil
+}
+
+// ProposalLoss is the resolver for the proposalLoss field.
+func (r *downtimeCostResolver) ProposalLoss(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error) {
+	v := gweiToBigInt(obj.ProposalLoss)
+	return &v, nil
+}
+
+// SyncLoss is the resolver for the syncLoss field.
+func (r *downtimeCostResolver) SyncLoss(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error) {
+	v := gweiToBigInt(obj.SyncLoss)
+	return &v, nil
+}
+
+// Total is the resolver for the total field.
+func (r *downtimeCostResolver) Total(ctx context.Context, obj *models.DowntimeCost) (*types.BigInt, error) {
+	v := gweiToBigInt(obj.Total())
+	return &v, nil
+}
+
+// Time is the resolver for the time field.
+func (r *epochClientDiversityResolver) Time(ctx context.Context, obj *models.EpochClientDiversity) (*types.Time, error) {
+	t := types.Time(obj.Time)
+	return &t, nil
+}
+
+// Time is the resolver for the time field.
+func (r *feeRecipientCheckResolver) Time(ctx context.Context, obj *models.FeeRecipientCheck) (*types.Time, error) {
+	t := types.Time(obj.Time)
+	return &t, nil
+}
+
+// PaymentGwei is the resolver for the paymentGwei field.
+func (r *feeRecipientCheckResolver) PaymentGwei(ctx context.Context, obj *models.FeeRecipientCheck) (*types.BigInt, error) {
+	if obj.PaymentGwei == nil {
+		return nil, nil
+	}
+	v := gweiToBigInt(*obj.PaymentGwei)
+	return &v, nil
+}
+
+// Status is the resolver for the status field.
+func (r *feeRecipientCheckResolver) Status(ctx context.Context, obj *models.FeeRecipientCheck) (string, error) {
+	return strings.ToUpper(string(obj.Status)), nil
+}
+
+// Compliant is the resolver for the compliant field.
+func (r *feeRecipientCheckResolver) Compliant(ctx context.Context, obj *models.FeeRecipientCheck) (bool, error) {
+	return obj.Status.Compliant(), nil
+}
+
+// ID is the resolver for the id field.
+func (r *incidentResolver) ID(ctx context.Context, obj *models.Incident) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
+}
+
+// GroupKind is the resolver for the groupKind field.
+func (r *incidentResolver) GroupKind(ctx context.Context, obj *models.Incident) (string, error) {
+	return strings.ToUpper(string(obj.GroupKind)), nil
+}
+
+// Severity is the resolver for the severity field.
+func (r *incidentResolver) Severity(ctx context.Context, obj *models.Incident) (types.AlertSeverity, error) {
+	return types.AlertSeverity(strings.ToUpper(string(obj.Severity))), nil
+}
+
+// Status is the resolver for the status field.
+func (r *incidentResolver) Status(ctx context.Context, obj *models.Incident) (string, error) {
+	return strings.ToUpper(string(obj.Status)), nil
+}
+
+// StartedAt is the resolver for the startedAt field.
+func (r *incidentResolver) StartedAt(ctx context.Context, obj *models.Incident) (*types.Time, error) {
+	t := types.Time(obj.StartedAt)
+	return &t, nil
+}
+
+// LastAlertAt is the resolver for the lastAlertAt field.
+func (r *incidentResolver) LastAlertAt(ctx context.Context, obj *models.Incident) (*types.Time, error) {
+	t := types.Time(obj.LastAlertAt)
+	return &t, nil
+}
+
+// AcknowledgedAt is the resolver for the acknowledgedAt field.
+func (r *incidentResolver) AcknowledgedAt(ctx context.Context, obj *models.Incident) (*types.Time, error) {
+	return optionalTime(obj.AcknowledgedAt), nil
+}
+
+// ResolvedAt is the resolver for the resolvedAt field.
+func (r *incidentResolver) ResolvedAt(ctx context.Context, obj *models.Incident) (*types.Time, error) {
+	return optionalTime(obj.ResolvedAt), nil
+}
+
+// Alerts is the resolver for the alerts field.
+func (r *incidentResolver) Alerts(ctx context.Context, obj *models.Incident) ([]*models.Alert, error) {
+	alerts, err := r.IncidentRepo.ListAlerts(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if alerts == nil {
+		alerts = []*models.Alert{}
+	}
+	return alerts, nil
+}
+
+// Timeline is the resolver for the timeline field.
+func (r *incidentResolver) Timeline(ctx context.Context, obj *models.Incident) ([]*models.IncidentEvent, error) {
+	alerts, err := r.IncidentRepo.ListAlerts(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := models.IncidentTimeline(obj, alerts)
+	timeline := make([]*models.IncidentEvent, len(events))
+	for i := range events {
+		timeline[i] = &events[i]
+	}
+	return timeline, nil
+}
+
+// DowntimeCost is the resolver for the downtimeCost field.
+func (r *incidentResolver) DowntimeCost(ctx context.Context, obj *models.Incident) (*models.DowntimeCost, error) {
+	alerts, err := r.IncidentRepo.ListAlerts(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	validators := models.IncidentValidators(alerts)
+	if len(validators) == 0 {
+		return nil, nil
+	}
+
+	to := time.Now()
+	if obj.ResolvedAt != nil {
+		to = *obj.ResolvedAt
+	}
+	return r.DowntimeCostRepo.Cost(ctx, models.DowntimeScope{Validators: validators}, obj.StartedAt, to)
+}
+
+// Time is the resolver for the time field.
+func (r *incidentEventResolver) Time(ctx context.Context, obj *models.IncidentEvent) (*types.Time, error) {
+	t := types.Time(obj.Time)
+	return &t, nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *incidentEventResolver) Kind(ctx context.Context, obj *models.IncidentEvent) (string, error) {
+	return strings.ToUpper(string(obj.Kind)), nil
 }
 
 // AddValidator is the resolver for the addValidator field.
 func (r *mutationResolver) AddValidator(ctx context.Context, input model.AddValidatorInput) (*models.Validator, error) {
-	panic(fmt.Errorf("not implemented: AddValidator - addValidator"))
+	if input.Pubkey == nil && input.Index == nil {
+		return nil, fmt.Errorf("either pubkey or index is required")
+	}
+
+	// The collector monitors by index and other jobs match by pubkey, so both are resolved from
+	// the beacon node whichever was given
+	if r.Beacon == nil {
+		return nil, fmt.Errorf("no beacon node to resolve the validator")
+	}
+	var data *types.ValidatorData
+	var err error
+	if input.Index != nil {
+		data, err = r.Beacon.GetValidator(ctx, *input.Index)
+	} else {
+		data, err = r.Beacon.GetValidatorByPubkey(ctx, *input.Pubkey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve validator: %w", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("validator not found on the beacon chain")
+	}
+	if input.Pubkey != nil && !strings.EqualFold(*input.Pubkey, data.Validator.Pubkey) {
+		return nil, fmt.Errorf("validator %d has pubkey %s, not %s", data.Index, data.Validator.Pubkey, *input.Pubkey)
+	}
+
+	// A validator removed from monitoring keeps its row and history, so adding it again resumes it
+	existing, err := r.ValidatorRepo.GetValidatorByIndex(ctx, int64(data.Index))
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing.Monitored = true
+		if input.Name != nil {
+			existing.Name = input.Name
+		}
+		if err := r.ValidatorRepo.UpdateValidator(ctx, existing); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	validator := &models.Validator{
+		ValidatorIndex: int64(data.Index),
+		Pubkey:         data.Validator.Pubkey,
+		Name:           input.Name,
+		Monitored:      true,
+	}
+	if credentials := data.Validator.WithdrawalCredentials; credentials != "" {
+		validator.WithdrawalCredentials = &credentials
+	}
+	if err := r.ValidatorRepo.CreateValidator(ctx, validator); err != nil {
+		return nil, err
+	}
+
+	return validator, nil
 }
 
 // RemoveValidator is the resolver for the removeValidator field.
 func (r *mutationResolver) RemoveValidator(ctx context.Context, index int) (bool, error) {
-	panic(fmt.Errorf("not implemented: RemoveValidator - removeValidator"))
+	validator, err := r.ValidatorRepo.GetValidatorByIndex(ctx, int64(index))
+	if err != nil {
+		return false, err
+	}
+	if validator == nil {
+		return false, nil
+	}
+
+	validator.Monitored = false
+	if err := r.ValidatorRepo.UpdateValidator(ctx, validator); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
 // UpdateValidatorName is the resolver for the updateValidatorName field.
 func (r *mutationResolver) UpdateValidatorName(ctx context.Context, index int, name string) (*models.Validator, error) {
-	panic(fmt.Errorf("not implemented: UpdateValidatorName - updateValidatorName"))
+	validator, err := r.ValidatorRepo.GetValidatorByIndex(ctx, int64(index))
+	if err != nil {
+		return nil, err
+	}
+	if validator == nil {
+		return nil, fmt.Errorf("validator %d not found", index)
+	}
+
+	validator.Name = &name
+	if err := r.ValidatorRepo.UpdateValidator(ctx, validator); err != nil {
+		return nil, err
+	}
+
+	return validator, nil
 }
 
 // AcknowledgeAlert is the resolver for the acknowledgeAlert field.
@@ -59,6 +361,124 @@ func (r *mutationResolver) AcknowledgeAlert(ctx context.Context, id string) (*mo
 	panic(fmt.Errorf("not implemented: AcknowledgeAlert - acknowledgeAlert"))
 }
 
+// AcknowledgeIncident is the resolver for the acknowledgeIncident field.
+func (r *mutationResolver) AcknowledgeIncident(ctx context.Context, id string) (*models.Incident, error) {
+	return r.transitionIncident(ctx, id, r.IncidentRepo.Acknowledge)
+}
+
+// ResolveIncident is the resolver for the resolveIncident field.
+func (r *mutationResolver) ResolveIncident(ctx context.Context, id string) (*models.Incident, error) {
+	return r.transitionIncident(ctx, id, r.IncidentRepo.Resolve)
+}
+
+// AddDiscoveryRule is the resolver for the addDiscoveryRule field.
+func (r *mutationResolver) AddDiscoveryRule(ctx context.Context, input model.AddDiscoveryRuleInput) (*models.DiscoveryRule, error) {
+	if err := r.requireAdminRole(ctx); err != nil {
+		return nil, err
+	}
+
+	rule, err := models.NewDiscoveryRule(discoveryKind(input.Kind), input.Value, input.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if input.FromBlock != nil {
+		if rule.Kind != models.DiscoveryKindDepositor {
+			return nil, fmt.Errorf("fromBlock only applies to DEPOSITOR rules")
+		}
+		if *input.FromBlock < 0 {
+			return nil, fmt.Errorf("fromBlock must not be negative")
+		}
+		rule.NextBlock = int64(*input.FromBlock)
+	}
+
+	if err := r.DiscoveryRepo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// RemoveDiscoveryRule is the resolver for the removeDiscoveryRule field.
+func (r *mutationResolver) RemoveDiscoveryRule(ctx context.Context, id string) (bool, error) {
+	if err := r.requireAdminRole(ctx); err != nil {
+		return false, err
+	}
+
+	ruleID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid discovery rule id: %s", id)
+	}
+
+	return r.DiscoveryRepo.DeleteRule(ctx, ruleID)
+}
+
+// SetExpectedFeeRecipient is the resolver for the setExpectedFeeRecipient field.
+func (r *mutationResolver) SetExpectedFeeRecipient(ctx context.Context, validatorIndex int, address *string) (bool, error) {
+	if err := r.requireAdminRole(ctx); err != nil {
+		return false, err
+	}
+
+	if address != nil {
+		parsed, err := models.ParseExecutionAddress(*address)
+		if err != nil {
+			return false, err
+		}
+		address = &parsed
+	}
+
+	if err := r.FeeRecipientRepo.SetExpected(ctx, int64(validatorIndex), address); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PauseCollector is the resolver for the pauseCollector field.
+func (r *mutationResolver) PauseCollector(ctx context.Context) (*model.CollectorStatus, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return mapCollectorStatus(r.Admin.Pause(ctx)), nil
+}
+
+// ResumeCollector is the resolver for the resumeCollector field.
+func (r *mutationResolver) ResumeCollector(ctx context.Context) (*model.CollectorStatus, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return mapCollectorStatus(r.Admin.Resume(ctx)), nil
+}
+
+// DrainWorkerPool is the resolver for the drainWorkerPool field.
+func (r *mutationResolver) DrainWorkerPool(ctx context.Context) (*model.CollectorStatus, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	stats, err := r.Admin.DrainPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapCollectorStatus(stats), nil
+}
+
+// RecollectValidator is the resolver for the recollectValidator field.
+func (r *mutationResolver) RecollectValidator(ctx context.Context, validatorIndex int, fromEpoch int, toEpoch int) (*model.RecollectResult, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := r.Admin.Recollect(ctx, int64(validatorIndex), int64(fromEpoch), int64(toEpoch))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapRecollectResult(result), nil
+}
+
 // AverageBalance is the resolver for the averageBalance field.
 func (r *networkStatsResolver) AverageBalance(ctx context.Context, obj *types.NetworkStats) (*types.BigInt, error) {
 	panic(fmt.Errorf("not implemented: AverageBalance - averageBalance"))
@@ -74,6 +494,31 @@ func (r *networkStatsResolver) Timestamp(ctx context.Context, obj *types.Network
 	panic(fmt.Errorf("not implemented: Timestamp - timestamp"))
 }
 
+// ClientDiversity is the resolver for the clientDiversity field.
+func (r *networkStatsResolver) ClientDiversity(ctx context.Context, obj *types.NetworkStats) (*models.EpochClientDiversity, error) {
+	diversity, err := r.ClientRepo.EpochDiversity(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(diversity) == 0 {
+		return nil, nil
+	}
+
+	return diversity[0], nil
+}
+
+// From is the resolver for the from field.
+func (r *proposalLuckResolver) From(ctx context.Context, obj *models.ProposalLuck) (*types.Time, error) {
+	t := types.Time(obj.From)
+	return &t, nil
+}
+
+// To is the resolver for the to field.
+func (r *proposalLuckResolver) To(ctx context.Context, obj *models.ProposalLuck) (*types.Time, error) {
+	t := types.Time(obj.To)
+	return &t, nil
+}
+
 // Validator is the resolver for the validator field.
 func (r *queryResolver) Validator(ctx context.Context, index *int, pubkey *string) (*models.Validator, error) {
 	panic(fmt.Errorf("not implemented: Validator - validator"))
@@ -104,6 +549,357 @@ func (r *queryResolver) Health(ctx context.Context) (string, error) {
 	panic(fmt.Errorf("not implemented: Health - health"))
 }
 
+// RewardsLedger is the resolver for the rewardsLedger field.
+func (r *queryResolver) RewardsLedger(ctx context.Context, validatorIndex int, interval model.LedgerInterval, from *types.Time, to *types.Time) ([]*model.RewardsPeriodSummary, error) {
+	if !interval.IsValid() {
+		return nil, fmt.Errorf("invalid interval: %s", interval)
+	}
+
+	start, end := ledgerRange(from, to)
+	summaries, err := r.RewardsLedgerRepo.Summarize(ctx, int64(validatorIndex), models.LedgerPeriod(strings.ToLower(string(interval))), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]*model.RewardsPeriodSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		periods = append(periods, mapRewardsSummary(summary))
+	}
+
+	return periods, nil
+}
+
+// PortfolioIncome is the resolver for the portfolioIncome field.
+func (r *queryResolver) PortfolioIncome(ctx context.Context, windows []string) ([]*model.IncomeWindowSummary, error) {
+	parsed, err := models.ParseIncomeWindows(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := r.IncomeService.ForPortfolio(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapIncomeSummaries(summaries), nil
+}
+
+// TagIncome is the resolver for the tagIncome field.
+func (r *queryResolver) TagIncome(ctx context.Context, windows []string) ([]*model.TagIncome, error) {
+	parsed, err := models.ParseIncomeWindows(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	byTag, err := r.IncomeService.ForTags(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*model.TagIncome, 0, len(byTag))
+	for tag, summaries := range byTag {
+		tags = append(tags, &model.TagIncome{Tag: tag, Windows: mapIncomeSummaries(summaries)})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Tag < tags[j].Tag })
+
+	return tags, nil
+}
+
+// AttestationMissesByNode is the resolver for the attestationMissesByNode field.
+func (r *queryResolver) AttestationMissesByNode(ctx context.Context, from *types.Time, to *types.Time) ([]*model.NodeAttestationMisses, error) {
+	start, end := missRange(from, to)
+	byNode, err := r.AttestationMissRepo.CountsByNode(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*model.NodeAttestationMisses, 0, len(byNode))
+	for node, counts := range byNode {
+		entry := &model.NodeAttestationMisses{Misses: counts}
+		if node != "" {
+			name := node
+			entry.Node = &name
+		}
+		nodes = append(nodes, entry)
+	}
+	// Validators without a node tag sort last
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Node == nil || nodes[j].Node == nil {
+			return nodes[j].Node == nil && nodes[i].Node != nil
+		}
+		return *nodes[i].Node < *nodes[j].Node
+	})
+
+	return nodes, nil
+}
+
+// DowntimeCost is the resolver for the downtimeCost field.
+func (r *queryResolver) DowntimeCost(ctx context.Context, validatorIndex *int, tag *string, from *types.Time, to *types.Time) (*models.DowntimeCost, error) {
+	if validatorIndex != nil && tag != nil {
+		return nil, fmt.Errorf("validatorIndex and tag cannot both be set")
+	}
+
+	var scope models.DowntimeScope
+	if validatorIndex != nil {
+		index := int64(*validatorIndex)
+		scope.ValidatorIndex = &index
+	}
+	scope.Tag = tag
+
+	start, end := downtimeRange(from, to)
+	return r.DowntimeCostRepo.Cost(ctx, scope, start, end)
+}
+
+// ProposalLuck is the resolver for the proposalLuck field.
+func (r *queryResolver) ProposalLuck(ctx context.Context, from *types.Time, to *types.Time) ([]*models.ProposalLuck, error) {
+	start, end := ledgerRange(from, to)
+	return r.LuckService.ForPeriod(ctx, start, end)
+}
+
+// DiscoveryRules is the resolver for the discoveryRules field.
+func (r *queryResolver) DiscoveryRules(ctx context.Context) ([]*models.DiscoveryRule, error) {
+	rules, err := r.DiscoveryRepo.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		rules = []*models.DiscoveryRule{}
+	}
+
+	return rules, nil
+}
+
+// FeeRecipientChecks is the resolver for the feeRecipientChecks field.
+func (r *queryResolver) FeeRecipientChecks(ctx context.Context, validatorIndex int, limit *int) ([]*models.FeeRecipientCheck, error) {
+	l := 50
+	if limit != nil && *limit > 0 && *limit <= 1000 {
+		l = *limit
+	}
+
+	checks, err := r.FeeRecipientRepo.GetChecks(ctx, int64(validatorIndex), l)
+	if err != nil {
+		return nil, err
+	}
+	if checks == nil {
+		checks = []*models.FeeRecipientCheck{}
+	}
+
+	return checks, nil
+}
+
+// RelayProposals is the resolver for the relayProposals field.
+func (r *queryResolver) RelayProposals(ctx context.Context, validatorIndex *int, limit *int) ([]*models.RelayProposal, error) {
+	l := 50
+	if limit != nil && *limit > 0 && *limit <= 1000 {
+		l = *limit
+	}
+
+	var index *int64
+	if validatorIndex != nil {
+		v := int64(*validatorIndex)
+		index = &v
+	}
+
+	proposals, err := r.RelayRepo.GetProposals(ctx, index, l)
+	if err != nil {
+		return nil, err
+	}
+	if proposals == nil {
+		proposals = []*models.RelayProposal{}
+	}
+
+	return proposals, nil
+}
+
+// RelaySummary is the resolver for the relaySummary field.
+func (r *queryResolver) RelaySummary(ctx context.Context, from *types.Time, to *types.Time) ([]*models.RelaySummary, error) {
+	start, end := ledgerRange(from, to)
+	summaries, err := r.RelayRepo.Summarize(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if summaries == nil {
+		summaries = []*models.RelaySummary{}
+	}
+
+	return summaries, nil
+}
+
+// ClientDiversity is the resolver for the clientDiversity field.
+func (r *queryResolver) ClientDiversity(ctx context.Context, epochs *int) ([]*models.EpochClientDiversity, error) {
+	n := 32
+	if epochs != nil && *epochs > 0 && *epochs <= 1000 {
+		n = *epochs
+	}
+
+	diversity, err := r.ClientRepo.EpochDiversity(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	if diversity == nil {
+		diversity = []*models.EpochClientDiversity{}
+	}
+
+	return diversity, nil
+}
+
+// FleetClients is the resolver for the fleetClients field.
+func (r *queryResolver) FleetClients(ctx context.Context, tag *string) ([]*models.BlockClient, error) {
+	clients, err := r.ClientRepo.ValidatorClients(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	if clients == nil {
+		clients = []*models.BlockClient{}
+	}
+
+	return clients, nil
+}
+
+// FleetClientDistribution is the resolver for the fleetClientDistribution field.
+func (r *queryResolver) FleetClientDistribution(ctx context.Context, tag *string) (*models.ClientDistribution, error) {
+	clients, err := r.ClientRepo.ValidatorClients(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[types.ConsensusClient]int64)
+	for _, c := range clients {
+		counts[c.Client]++
+	}
+	return models.NewClientDistribution(counts), nil
+}
+
+// Incidents is the resolver for the incidents field.
+func (r *queryResolver) Incidents(ctx context.Context, status *string, limit *int, offset *int) ([]*models.Incident, error) {
+	filter := &models.IncidentFilter{}
+	if status != nil {
+		s := models.IncidentStatus(strings.ToLower(*status))
+		switch s {
+		case models.IncidentStatusOpen, models.IncidentStatusAcknowledged, models.IncidentStatusResolved:
+			filter.Status = &s
+		default:
+			return nil, fmt.Errorf("invalid incident status: %s", *status)
+		}
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+
+	incidents, err := r.IncidentRepo.ListIncidents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if incidents == nil {
+		incidents = []*models.Incident{}
+	}
+	return incidents, nil
+}
+
+// Incident is the resolver for the incident field.
+func (r *queryResolver) Incident(ctx context.Context, id string) (*models.Incident, error) {
+	incidentID, err := parseIncidentID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.IncidentRepo.GetIncident(ctx, incidentID)
+}
+
+// CollectorStatus is the resolver for the collectorStatus field.
+func (r *queryResolver) CollectorStatus(ctx context.Context) (*model.CollectorStatus, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return mapCollectorStatus(r.Admin.Status()), nil
+}
+
+// AdminAuditLog is the resolver for the adminAuditLog field.
+func (r *queryResolver) AdminAuditLog(ctx context.Context, limit *int, offset *int) ([]*model.AdminAuditEntry, error) {
+	if err := r.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var l, o int
+	if limit != nil {
+		l = *limit
+	}
+	if offset != nil {
+		o = *offset
+	}
+
+	actions, err := r.Admin.AuditLog(ctx, l, o)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.AdminAuditEntry, 0, len(actions))
+	for _, action := range actions {
+		entries = append(entries, mapAdminAuditEntry(action))
+	}
+
+	return entries, nil
+}
+
+// Time is the resolver for the time field.
+func (r *relayProposalResolver) Time(ctx context.Context, obj *models.RelayProposal) (*types.Time, error) {
+	t := types.Time(obj.Time)
+	return &t, nil
+}
+
+// Source is the resolver for the source field.
+func (r *relayProposalResolver) Source(ctx context.Context, obj *models.RelayProposal) (string, error) {
+	return strings.ToUpper(string(obj.Source)), nil
+}
+
+// Promised is the resolver for the promised field.
+func (r *relayProposalResolver) Promised(ctx context.Context, obj *models.RelayProposal) (*types.BigInt, error) {
+	if obj.PromisedGwei == nil {
+		return nil, nil
+	}
+	v := gweiToBigInt(*obj.PromisedGwei)
+	return &v, nil
+}
+
+// Received is the resolver for the received field.
+func (r *relayProposalResolver) Received(ctx context.Context, obj *models.RelayProposal) (*types.BigInt, error) {
+	if obj.ReceivedGwei == nil {
+		return nil, nil
+	}
+	v := gweiToBigInt(*obj.ReceivedGwei)
+	return &v, nil
+}
+
+// Shortfall is the resolver for the shortfall field.
+func (r *relayProposalResolver) Shortfall(ctx context.Context, obj *models.RelayProposal) (*types.BigInt, error) {
+	shortfall, ok := obj.Shortfall()
+	if !ok {
+		return nil, nil
+	}
+	v := gweiToBigInt(shortfall)
+	return &v, nil
+}
+
+// Source is the resolver for the source field.
+func (r *relaySummaryResolver) Source(ctx context.Context, obj *models.RelaySummary) (string, error) {
+	return strings.ToUpper(string(obj.Source)), nil
+}
+
+// Promised is the resolver for the promised field.
+func (r *relaySummaryResolver) Promised(ctx context.Context, obj *models.RelaySummary) (*types.BigInt, error) {
+	v := gweiToBigInt(obj.PromisedGwei)
+	return &v, nil
+}
+
+// Received is the resolver for the received field.
+func (r *relaySummaryResolver) Received(ctx context.Context, obj *models.RelaySummary) (*types.BigInt, error) {
+	v := gweiToBigInt(obj.ReceivedGwei)
+	return &v, nil
+}
+
 // ValidatorUpdates is the resolver for the validatorUpdates field.
 func (r *subscriptionResolver) ValidatorUpdates(ctx context.Context, indices []int) (<-chan *models.Validator, error) {
 	panic(fmt.Errorf("not implemented: ValidatorUpdates - validatorUpdates"))
@@ -121,7 +917,11 @@ func (r *validatorResolver) Index(ctx context.Context, obj *models.Validator) (i
 
 // Status is the resolver for the status field.
 func (r *validatorResolver) Status(ctx context.Context, obj *models.Validator) (types.ValidatorStatus, error) {
-	panic(fmt.Errorf("not implemented: Status - status"))
+	status := types.StatusUnknown
+	if obj.Status != nil {
+		status = obj.Status.Phase()
+	}
+	return types.ValidatorStatus(strings.ToUpper(string(status))), nil
 }
 
 // Balance is the resolver for the balance field.
@@ -136,7 +936,46 @@ func (r *validatorResolver) Performance(ctx context.Context, obj *models.Validat
 
 // Rewards is the resolver for the rewards field.
 func (r *validatorResolver) Rewards(ctx context.Context, obj *models.Validator) (*model.Rewards, error) {
-	panic(fmt.Errorf("not implemented: Rewards - rewards"))
+	end := time.Now()
+	perf, err := r.RewardsLedgerRepo.Performance(ctx, obj.ValidatorIndex, end.Add(-rewardsWindow), end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Rewards{
+		Expected:      types.BigInt(*perf.ExpectedRewards),
+		Actual:        types.BigInt(*perf.ActualRewards),
+		Effectiveness: perf.Effectiveness,
+	}, nil
+}
+
+// Income is the resolver for the income field.
+func (r *validatorResolver) Income(ctx context.Context, obj *models.Validator, windows []string) ([]*model.IncomeWindowSummary, error) {
+	parsed, err := models.ParseIncomeWindows(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := r.IncomeService.ForValidator(ctx, obj.ValidatorIndex, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapIncomeSummaries(summaries), nil
+}
+
+// AttestationMisses is the resolver for the attestationMisses field.
+func (r *validatorResolver) AttestationMisses(ctx context.Context, obj *models.Validator, from *types.Time, to *types.Time) ([]*models.AttestationMissCount, error) {
+	start, end := missRange(from, to)
+	counts, err := r.AttestationMissRepo.CountsByValidator(ctx, []int64{obj.ValidatorIndex}, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if misses := counts[obj.ValidatorIndex]; misses != nil {
+		return misses, nil
+	}
+	return []*models.AttestationMissCount{}, nil
 }
 
 // Alerts is the resolver for the alerts field.
@@ -192,15 +1031,57 @@ func (r *validatorFilterResolver) Indices(ctx context.Context, obj *models.Valid
 // Alert returns generated.AlertResolver implementation.
 func (r *Resolver) Alert() generated.AlertResolver { return &alertResolver{r} }
 
+// AttestationMissCount returns generated.AttestationMissCountResolver implementation.
+func (r *Resolver) AttestationMissCount() generated.AttestationMissCountResolver {
+	return &attestationMissCountResolver{r}
+}
+
+// BlockClient returns generated.BlockClientResolver implementation.
+func (r *Resolver) BlockClient() generated.BlockClientResolver { return &blockClientResolver{r} }
+
+// ClientShare returns generated.ClientShareResolver implementation.
+func (r *Resolver) ClientShare() generated.ClientShareResolver { return &clientShareResolver{r} }
+
+// DiscoveryRule returns generated.DiscoveryRuleResolver implementation.
+func (r *Resolver) DiscoveryRule() generated.DiscoveryRuleResolver { return &discoveryRuleResolver{r} }
+
+// DowntimeCost returns generated.DowntimeCostResolver implementation.
+func (r *Resolver) DowntimeCost() generated.DowntimeCostResolver { return &downtimeCostResolver{r} }
+
+// EpochClientDiversity returns generated.EpochClientDiversityResolver implementation.
+func (r *Resolver) EpochClientDiversity() generated.EpochClientDiversityResolver {
+	return &epochClientDiversityResolver{r}
+}
+
+// FeeRecipientCheck returns generated.FeeRecipientCheckResolver implementation.
+func (r *Resolver) FeeRecipientCheck() generated.FeeRecipientCheckResolver {
+	return &feeRecipientCheckResolver{r}
+}
+
+// Incident returns generated.IncidentResolver implementation.
+func (r *Resolver) Incident() generated.IncidentResolver { return &incidentResolver{r} }
+
+// IncidentEvent returns generated.IncidentEventResolver implementation.
+func (r *Resolver) IncidentEvent() generated.IncidentEventResolver { return &incidentEventResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // NetworkStats returns generated.NetworkStatsResolver implementation.
 func (r *Resolver) NetworkStats() generated.NetworkStatsResolver { return &networkStatsResolver{r} }
 
+// ProposalLuck returns generated.ProposalLuckResolver implementation.
+func (r *Resolver) ProposalLuck() generated.ProposalLuckResolver { return &proposalLuckResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// RelayProposal returns generated.RelayProposalResolver implementation.
+func (r *Resolver) RelayProposal() generated.RelayProposalResolver { return &relayProposalResolver{r} }
+
+// RelaySummary returns generated.RelaySummaryResolver implementation.
+func (r *Resolver) RelaySummary() generated.RelaySummaryResolver { return &relaySummaryResolver{r} }
+
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -216,9 +1097,21 @@ func (r *Resolver) ValidatorFilter() generated.ValidatorFilterResolver {
 }
 
 type alertResolver struct{ *Resolver }
+type attestationMissCountResolver struct{ *Resolver }
+type blockClientResolver struct{ *Resolver }
+type clientShareResolver struct{ *Resolver }
+type discoveryRuleResolver struct{ *Resolver }
+type downtimeCostResolver struct{ *Resolver }
+type epochClientDiversityResolver struct{ *Resolver }
+type feeRecipientCheckResolver struct{ *Resolver }
+type incidentResolver struct{ *Resolver }
+type incidentEventResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type networkStatsResolver struct{ *Resolver }
+type proposalLuckResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type relayProposalResolver struct{ *Resolver }
+type relaySummaryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type validatorResolver struct{ *Resolver }
 type alertFilterResolver struct{ *Resolver }
@@ -0,0 +1,41 @@
+// Package respond writes the JSON responses shared by the REST and auth handlers
+package respond
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ErrorResponse is the standard error response with optional field-level errors
+type ErrorResponse struct {
+	Error   string            `json:"error"`
+	Message string            `json:"message,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"` // Field-specific errors
+}
+
+// JSON sends a JSON response with the given status code
+func JSON(w http.ResponseWriter, data interface{}, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		// Log error but can't change headers at this point
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// Error sends a standard error response
+func Error(w http.ResponseWriter, message string, statusCode int) {
+	JSON(w, ErrorResponse{
+		Error:   http.StatusText(statusCode),
+		Message: message,
+	}, statusCode)
+}
+
+// ValidationError sends a validation error with field-level details
+func ValidationError(w http.ResponseWriter, message string, fields map[string]string, statusCode int) {
+	JSON(w, ErrorResponse{
+		Error:   "Validation Error",
+		Message: message,
+		Fields:  fields,
+	}, statusCode)
+}
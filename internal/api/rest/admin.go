@@ -0,0 +1,201 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/api/respond"
+	"github.com/birddigital/eth-validator-monitor/internal/collector"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/internal/services/admin"
+	"github.com/go-chi/chi/v5"
+)
+
+// AdminHandler serves the collector admin control plane. Routes must be mounted
+// behind authentication and an admin role check.
+type AdminHandler struct {
+	service *admin.Service
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(service *admin.Service) *AdminHandler {
+	return &AdminHandler{
+		service: service,
+	}
+}
+
+// Routes registers the admin endpoints on a router
+func (h *AdminHandler) Routes(r chi.Router) {
+	r.Get("/collector", h.HandleStatus)
+	r.Post("/collector/pause", h.HandlePause)
+	r.Post("/collector/resume", h.HandleResume)
+	r.Post("/collector/recollect", h.HandleRecollect)
+	r.Post("/pool/drain", h.HandleDrain)
+	r.Get("/audit", h.HandleAuditLog)
+}
+
+// CollectorStatus is the JSON representation of collector.CollectorStats
+type CollectorStatus struct {
+	Paused              bool              `json:"paused"`
+	ValidatorsMonitored int               `json:"validators_monitored"`
+	CollectionsCount    uint64            `json:"collections_count"`
+	ErrorsCount         uint64            `json:"errors_count"`
+	LastCollectionTime  *time.Time        `json:"last_collection_time,omitempty"`
+	Pool                PoolStatus        `json:"pool"`
+	Circuits            map[string]string `json:"circuits"`
+}
+
+// PoolStatus is the JSON representation of collector.PoolStats
+type PoolStatus struct {
+	TasksProcessed  uint64 `json:"tasks_processed"`
+	TasksFailed     uint64 `json:"tasks_failed"`
+	ActiveWorkers   int32  `json:"active_workers"`
+	BusyWorkers     int32  `json:"busy_workers"`
+	QueueSize       int    `json:"queue_size"`
+	ResultQueueSize int    `json:"result_queue_size"`
+}
+
+// RecollectRequest is the request body for POST /api/admin/collector/recollect
+type RecollectRequest struct {
+	ValidatorIndex *int64 `json:"validator_index"`
+	FromEpoch      *int64 `json:"from_epoch"`
+	ToEpoch        *int64 `json:"to_epoch"`
+}
+
+// AuditEntry is the JSON representation of an admin audit trail entry
+type AuditEntry struct {
+	ID         int64                  `json:"id"`
+	Actor      string                 `json:"actor"`
+	AuthMethod string                 `json:"auth_method"`
+	Action     string                 `json:"action"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+	Success    bool                   `json:"success"`
+	Error      *string                `json:"error,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// HandleStatus handles GET /api/admin/collector
+func (h *AdminHandler) HandleStatus(w http.ResponseWriter, r *http.Request) {
+	respond.JSON(w, NewCollectorStatus(h.service.Status()), http.StatusOK)
+}
+
+// HandlePause handles POST /api/admin/collector/pause
+func (h *AdminHandler) HandlePause(w http.ResponseWriter, r *http.Request) {
+	respond.JSON(w, NewCollectorStatus(h.service.Pause(r.Context())), http.StatusOK)
+}
+
+// HandleResume handles POST /api/admin/collector/resume
+func (h *AdminHandler) HandleResume(w http.ResponseWriter, r *http.Request) {
+	respond.JSON(w, NewCollectorStatus(h.service.Resume(r.Context())), http.StatusOK)
+}
+
+// HandleDrain handles POST /api/admin/pool/drain
+func (h *AdminHandler) HandleDrain(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.service.DrainPool(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error().Err(err).Msg("Worker pool drain failed")
+		respond.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	respond.JSON(w, NewCollectorStatus(stats), http.StatusOK)
+}
+
+// HandleRecollect handles POST /api/admin/collector/recollect
+func (h *AdminHandler) HandleRecollect(w http.ResponseWriter, r *http.Request) {
+	var req RecollectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.ValidatorIndex == nil || req.FromEpoch == nil || req.ToEpoch == nil {
+		respond.Error(w, "validator_index, from_epoch and to_epoch are required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.Recollect(r.Context(), *req.ValidatorIndex, *req.FromEpoch, *req.ToEpoch)
+	switch {
+	case errors.Is(err, collector.ErrInvalidEpochRange):
+		respond.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, collector.ErrValidatorNotFound):
+		respond.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, collector.ErrRecollectUnsupported):
+		respond.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	case err != nil:
+		logger.FromContext(r.Context()).Error().Err(err).Msg("Re-collection failed")
+		respond.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	respond.JSON(w, result, http.StatusOK)
+}
+
+// HandleAuditLog handles GET /api/admin/audit?limit=&offset=
+func (h *AdminHandler) HandleAuditLog(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+	actions, err := h.service.AuditLog(r.Context(), limit, offset)
+	if err != nil {
+		logger.FromContext(r.Context()).Error().Err(err).Msg("Failed to list admin audit log")
+		respond.Error(w, "Failed to list audit log", http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]AuditEntry, 0, len(actions))
+	for _, action := range actions {
+		entries = append(entries, newAuditEntry(action))
+	}
+
+	respond.JSON(w, entries, http.StatusOK)
+}
+
+// NewCollectorStatus converts collector statistics to their JSON representation
+func NewCollectorStatus(stats collector.CollectorStats) CollectorStatus {
+	status := CollectorStatus{
+		Paused:              stats.Paused,
+		ValidatorsMonitored: stats.ValidatorsMonitored,
+		CollectionsCount:    stats.CollectionsCount,
+		ErrorsCount:         stats.ErrorsCount,
+		Pool: PoolStatus{
+			TasksProcessed:  stats.PoolStats.TasksProcessed,
+			TasksFailed:     stats.PoolStats.TasksFailed,
+			ActiveWorkers:   stats.PoolStats.ActiveWorkers,
+			BusyWorkers:     stats.PoolStats.BusyWorkers,
+			QueueSize:       stats.PoolStats.QueueSize,
+			ResultQueueSize: stats.PoolStats.ResultQueueSize,
+		},
+		Circuits: make(map[string]string, len(stats.CircuitStates)),
+	}
+
+	if !stats.LastCollectionTime.IsZero() {
+		last := stats.LastCollectionTime
+		status.LastCollectionTime = &last
+	}
+	for component, state := range stats.CircuitStates {
+		status.Circuits[component] = state.String()
+	}
+
+	return status
+}
+
+// newAuditEntry converts an audit trail entry to its JSON representation
+func newAuditEntry(action *models.AdminAction) AuditEntry {
+	return AuditEntry{
+		ID:         action.ID,
+		Actor:      action.Actor,
+		AuthMethod: action.AuthMethod,
+		Action:     string(action.Action),
+		Params:     action.Params,
+		Success:    action.Success,
+		Error:      action.Error,
+		CreatedAt:  action.CreatedAt,
+	}
+}
@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/collector"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/services/admin"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubCollector struct {
+	paused bool
+}
+
+func (s *stubCollector) Pause() bool                         { s.paused = true; return true }
+func (s *stubCollector) Resume() bool                        { s.paused = false; return true }
+func (s *stubCollector) DrainPool(ctx context.Context) error { s.paused = true; return nil }
+
+func (s *stubCollector) Recollect(ctx context.Context, validatorIndex, fromEpoch, toEpoch int64) (*collector.RecollectResult, error) {
+	if toEpoch < fromEpoch {
+		return nil, collector.ErrInvalidEpochRange
+	}
+	if validatorIndex == 404 {
+		return nil, collector.ErrValidatorNotFound
+	}
+	return &collector.RecollectResult{
+		ValidatorIndex:    validatorIndex,
+		FromEpoch:         fromEpoch,
+		ToEpoch:           toEpoch,
+		Recollected:       int(toEpoch - fromEpoch + 1),
+		UnavailableEpochs: []int64{},
+	}, nil
+}
+
+func (s *stubCollector) Stats() collector.CollectorStats {
+	return collector.CollectorStats{
+		Paused:              s.paused,
+		ValidatorsMonitored: 3,
+		LastCollectionTime:  time.Now(),
+		PoolStats:           collector.PoolStats{ActiveWorkers: 4},
+		CircuitStates:       map[string]collector.CircuitState{collector.ComponentDatabase: collector.CircuitOpen},
+	}
+}
+
+type stubAuditLog struct {
+	actions []*models.AdminAction
+}
+
+func (s *stubAuditLog) RecordAction(ctx context.Context, action *models.AdminAction) error {
+	action.ID = int64(len(s.actions) + 1)
+	s.actions = append(s.actions, action)
+	return nil
+}
+
+func (s *stubAuditLog) ListActions(ctx context.Context, limit, offset int) ([]*models.AdminAction, error) {
+	return s.actions, nil
+}
+
+func newTestAdminRouter() (http.Handler, *stubAuditLog) {
+	audit := &stubAuditLog{}
+	handler := NewAdminHandler(admin.NewService(&stubCollector{}, audit))
+
+	r := chi.NewRouter()
+	r.Route("/api/admin", handler.Routes)
+	return r, audit
+}
+
+func TestAdminHandler_PauseAndStatus(t *testing.T) {
+	router, audit := newTestAdminRouter()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/admin/collector/pause", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status CollectorStatus
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+	assert.True(t, status.Paused)
+	assert.Equal(t, 3, status.ValidatorsMonitored)
+	assert.Equal(t, int32(4), status.Pool.ActiveWorkers)
+	assert.Equal(t, "open", status.Circuits[collector.ComponentDatabase])
+	assert.NotNil(t, status.LastCollectionTime)
+
+	require.Len(t, audit.actions, 1)
+	assert.Equal(t, models.AdminActionPauseCollector, audit.actions[0].Action)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/admin/collector", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Reading status is not an audited action
+	assert.Len(t, audit.actions, 1)
+}
+
+func TestAdminHandler_Recollect(t *testing.T) {
+	router, _ := newTestAdminRouter()
+
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"valid range", `{"validator_index": 1, "from_epoch": 10, "to_epoch": 12}`, http.StatusOK},
+		{"missing field", `{"validator_index": 1, "from_epoch": 10}`, http.StatusBadRequest},
+		{"invalid JSON", `{`, http.StatusBadRequest},
+		{"reversed range", `{"validator_index": 1, "from_epoch": 12, "to_epoch": 10}`, http.StatusBadRequest},
+		{"unknown validator", `{"validator_index": 404, "from_epoch": 10, "to_epoch": 12}`, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/collector/recollect", strings.NewReader(tt.body))
+			router.ServeHTTP(rec, req)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/collector/recollect", strings.NewReader(tests[0].body))
+	router.ServeHTTP(rec, req)
+
+	var result collector.RecollectResult
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&result))
+	assert.Equal(t, 3, result.Recollected)
+}
+
+func TestAdminHandler_AuditLog(t *testing.T) {
+	router, _ := newTestAdminRouter()
+
+	for _, path := range []string{"/api/admin/collector/pause", "/api/admin/pool/drain", "/api/admin/collector/resume"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+		require.Equal(t, http.StatusOK, rec.Code, path)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/admin/audit?limit=10", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var entries []AuditEntry
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&entries))
+	require.Len(t, entries, 3)
+	assert.Equal(t, "pause_collector", entries[0].Action)
+	assert.Equal(t, "drain_pool", entries[1].Action)
+	assert.Equal(t, "resume_collector", entries[2].Action)
+	assert.Equal(t, "unknown", entries[0].Actor)
+}
@@ -86,3 +86,25 @@ func GetRolesFromContext(ctx context.Context) ([]string, bool) {
 	// Would need to query database if needed
 	return nil, false
 }
+
+// Authentication methods reported by GetAuthMethodFromContext
+const (
+	AuthMethodSession = "session"
+	AuthMethodJWT     = "jwt"
+	AuthMethodAPIKey  = "api_key"
+)
+
+// GetAuthMethodFromContext reports how the request was authenticated, using the
+// same precedence as GetUserIDFromContext
+func GetAuthMethodFromContext(ctx context.Context) (string, bool) {
+	if userID, ok := GetSessionUserIDFromContext(ctx); ok && userID != uuid.Nil {
+		return AuthMethodSession, true
+	}
+	if userID, ok := GetJWTUserIDFromContext(ctx); ok && userID != uuid.Nil {
+		return AuthMethodJWT, true
+	}
+	if userID, ok := GetAPIKeyUserIDFromContext(ctx); ok && userID != uuid.Nil {
+		return AuthMethodAPIKey, true
+	}
+	return "", false
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/birddigital/eth-validator-monitor/internal/storage"
+	"github.com/google/uuid"
+)
+
+// RoleAdmin grants access to the collector admin control plane
+const RoleAdmin = "admin"
+
+// ErrForbidden is returned when an authenticated user lacks a required role
+var ErrForbidden = errors.New("forbidden: insufficient role")
+
+// UserHasRole reports whether the authenticated user has a role. JWT roles are taken
+// from the token claims; session and API key users are looked up so that role changes
+// and deactivation take effect immediately.
+func UserHasRole(ctx context.Context, userRepo *storage.UserRepository, role string) (bool, error) {
+	if method, ok := GetAuthMethodFromContext(ctx); ok && method == AuthMethodJWT {
+		return HasRole(ctx, role), nil
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok || userID == uuid.Nil {
+		return false, nil
+	}
+
+	user, err := userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up user roles: %w", err)
+	}
+	if !user.IsActive {
+		return false, nil
+	}
+
+	for _, r := range user.Roles {
+		if r == role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RequireRole middleware ensures the authenticated user has the given role.
+// It must run after the session, JWT or API key middleware.
+func RequireRole(userRepo *storage.UserRepository, role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := GetUserIDFromContext(r.Context())
+			if !ok || userID == uuid.Nil {
+				http.Error(w, "Unauthorized: authentication required", http.StatusUnauthorized)
+				return
+			}
+
+			allowed, err := UserHasRole(r.Context(), userRepo, role)
+			if err != nil {
+				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				return
+			}
+			if !allowed {
+				http.Error(w, "Forbidden: "+role+" role required", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
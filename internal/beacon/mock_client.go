@@ -3,8 +3,11 @@ package beacon
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"math/big"
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/birddigital/eth-validator-monitor/pkg/types"
@@ -48,9 +51,22 @@ func (m *MockClient) GetValidatorBalance(ctx context.Context, index int, epoch i
 	return big.NewInt(32_000_000_000 + rand.Int63n(1_000_000_000)), nil
 }
 
-// GetValidatorByPubkey retrieves mock validator by pubkey
+// GetValidatorByPubkey retrieves mock validator by pubkey. Pubkeys built by GetValidator map back to
+// their index; any other pubkey maps to a stable index derived from its hash.
 func (m *MockClient) GetValidatorByPubkey(ctx context.Context, pubkey string) (*types.ValidatorData, error) {
-	return m.GetValidator(ctx, 0)
+	index, err := strconv.ParseInt(strings.TrimPrefix(pubkey, "0x"), 10, 64)
+	if err != nil {
+		h := fnv.New32a()
+		h.Write([]byte(strings.ToLower(pubkey)))
+		index = int64(h.Sum32() % 1_000_000)
+	}
+
+	validator, err := m.GetValidator(ctx, int(index))
+	if err != nil {
+		return nil, err
+	}
+	validator.Validator.Pubkey = pubkey
+	return validator, nil
 }
 
 // GetAttestations returns mock attestations
@@ -123,6 +139,287 @@ func (m *MockClient) GetNetworkStats(ctx context.Context) (*types.NetworkStats,
 		AverageBalance:     big.NewInt(32_500_000_000),
 		TotalStaked:        big.NewInt(30_400_000_000_000_000),
 		ParticipationRate:  0.95,
+		ClientDiversity:    mockClientDiversity(m.epoch - 1),
 		Timestamp:          time.Now(),
 	}, nil
 }
+
+// mockGraffiti is the graffiti of mock blocks, cycled through by slot
+var mockGraffiti = []string{
+	"Lighthouse/v5.1.3-441fc16",
+	"Lighthouse/v5.1.3-441fc16",
+	"Lighthouse/v5.1.3-441fc16",
+	"Prysm/v5.0.3",
+	"Prysm/v5.0.3",
+	"teku/v24.4.0",
+	"Nimbus/v24.4.0",
+	"",
+}
+
+// mockClientDiversity returns the client distribution of the mock blocks in an epoch
+func mockClientDiversity(epoch int) *types.ClientDiversity {
+	counts := make(map[types.ConsensusClient]int)
+	for slot := epoch * types.SlotsPerEpoch; slot < (epoch+1)*types.SlotsPerEpoch; slot++ {
+		counts[types.FingerprintGraffiti(mockGraffiti[slot%len(mockGraffiti)]).Client]++
+	}
+	return types.NewClientDiversity(epoch, counts)
+}
+
+// mockEpochReward is the attestation reward the mock pays every validator each epoch, in Gwei
+const mockEpochReward = 11_000
+
+// mockNetworkSize is the number of validators in the mock validator set
+const mockNetworkSize = 1_000_000
+
+// GetValidatorBalances returns mock balances that grow by mockEpochReward every epoch.
+// Indices beyond the mock validator set are omitted.
+func (m *MockClient) GetValidatorBalances(ctx context.Context, epoch int, indices []int) ([]types.ValidatorEpochBalance, error) {
+	balances := make([]types.ValidatorEpochBalance, 0, len(indices))
+	for _, index := range indices {
+		if index >= mockNetworkSize {
+			continue
+		}
+		balances = append(balances, types.ValidatorEpochBalance{
+			Index:            index,
+			Balance:          32_000_000_000 + int64(epoch%100_000)*mockEpochReward,
+			EffectiveBalance: 32_000_000_000,
+			Status:           "active_ongoing",
+		})
+	}
+	return balances, nil
+}
+
+// GetAttestationRewards returns mock attestation rewards slightly below the ideal
+func (m *MockClient) GetAttestationRewards(ctx context.Context, epoch int, indices []int) (*types.AttestationRewards, error) {
+	rewards := &types.AttestationRewards{
+		IdealRewards: []types.IdealAttestationReward{
+			{EffectiveBalance: 32_000_000_000, Head: 3_000, Target: 5_600, Source: 3_000},
+		},
+	}
+	for _, index := range indices {
+		rewards.TotalRewards = append(rewards.TotalRewards, types.ValidatorAttestationReward{
+			ValidatorIndex: index,
+			Head:           2_800,
+			Target:         5_400,
+			Source:         2_800,
+		})
+	}
+	return rewards, nil
+}
+
+// GetBlockRewards returns no block rewards (mock validators never propose)
+func (m *MockClient) GetBlockRewards(ctx context.Context, slot int) (*types.BlockReward, error) {
+	return nil, nil
+}
+
+// GetSyncCommitteeRewards returns no sync committee rewards
+func (m *MockClient) GetSyncCommitteeRewards(ctx context.Context, slot int, indices []int) ([]types.SyncCommitteeReward, error) {
+	return nil, nil
+}
+
+// GetBlock returns no block, as for a missed slot
+func (m *MockClient) GetBlock(ctx context.Context, slot int) (*types.Block, error) {
+	return nil, nil
+}
+
+// GetPendingDeposits returns an empty deposit queue
+func (m *MockClient) GetPendingDeposits(ctx context.Context, slot int) ([]types.PendingDeposit, error) {
+	return nil, nil
+}
+
+// GetPendingPartialWithdrawals returns an empty partial withdrawal queue
+func (m *MockClient) GetPendingPartialWithdrawals(ctx context.Context, slot int) ([]types.PendingPartialWithdrawal, error) {
+	return nil, nil
+}
+
+// GetPendingConsolidations returns an empty consolidation queue
+func (m *MockClient) GetPendingConsolidations(ctx context.Context, slot int) ([]types.PendingConsolidation, error) {
+	return nil, nil
+}
+
+// GetLightClientBootstrap returns nil; the mock serves no light client data, having no sync
+// committee signatures to verify
+func (m *MockClient) GetLightClientBootstrap(ctx context.Context, blockRoot string) (*types.LightClientBootstrap, error) {
+	return nil, nil
+}
+
+// GetLightClientUpdates returns no updates
+func (m *MockClient) GetLightClientUpdates(ctx context.Context, startPeriod, count int64) ([]types.LightClientUpdate, error) {
+	return nil, nil
+}
+
+// GetLightClientFinalityUpdate returns nil
+func (m *MockClient) GetLightClientFinalityUpdate(ctx context.Context) (*types.LightClientUpdate, error) {
+	return nil, nil
+}
+
+// GetBlockHeader returns nil; the mock's blocks have no headers to verify
+func (m *MockClient) GetBlockHeader(ctx context.Context, blockRoot string) (*types.BeaconBlockHeader, error) {
+	return nil, nil
+}
+
+// GetForkSchedule returns the mainnet fork schedule
+func (m *MockClient) GetForkSchedule(ctx context.Context) ([]types.Fork, error) {
+	return types.MainnetForks, nil
+}
+
+// GetGenesis returns the mainnet genesis
+func (m *MockClient) GetGenesis(ctx context.Context) (*types.Genesis, error) {
+	return &types.Genesis{
+		Time:           types.MainnetGenesisTime,
+		ValidatorsRoot: types.MainnetGenesisValidatorsRoot,
+		ForkVersion:    "0x00000000",
+	}, nil
+}
+
+// mockCommitteeLength is the size of the single committee the mock assigns per slot
+const mockCommitteeLength = 128
+
+// mockBlockRoot returns the mock root of the block at a slot
+func mockBlockRoot(slot int) string {
+	return fmt.Sprintf("0x%064x", slot)
+}
+
+// GetAttesterDuties assigns each validator to a slot of the epoch by index
+func (m *MockClient) GetAttesterDuties(ctx context.Context, epoch int, indices []int) ([]types.AttesterDuty, error) {
+	duties := make([]types.AttesterDuty, len(indices))
+	for i, index := range indices {
+		duties[i] = types.AttesterDuty{
+			ValidatorIndex:          index,
+			Slot:                    epoch*types.SlotsPerEpoch + index%types.SlotsPerEpoch,
+			CommitteeLength:         mockCommitteeLength,
+			ValidatorCommitteeIndex: index % mockCommitteeLength,
+		}
+	}
+	return duties, nil
+}
+
+// GetBlockRoot returns a mock root; the mock chain has a block in every slot
+func (m *MockClient) GetBlockRoot(ctx context.Context, slot int) (string, bool, error) {
+	return mockBlockRoot(slot), true, nil
+}
+
+// GetBlockAttestations returns a single attestation from the whole committee of the previous
+// slot, voting for the canonical head and target
+func (m *MockClient) GetBlockAttestations(ctx context.Context, slot int) ([]types.Attestation, bool, error) {
+	if slot == 0 {
+		return nil, true, nil
+	}
+	duty := slot - 1
+	bits := strings.Repeat("ff", mockCommitteeLength/8) + "01"
+	var committeeBits string
+	if duty/types.SlotsPerEpoch >= types.MainnetElectraEpoch {
+		committeeBits = "0x01" + strings.Repeat("00", 7)
+	}
+	return []types.Attestation{{
+		AggregationBits: "0x" + bits,
+		CommitteeBits:   committeeBits,
+		Data: types.AttestationData{
+			Slot:            duty,
+			BeaconBlockRoot: mockBlockRoot(duty),
+			Target: types.Checkpoint{
+				Epoch: duty / types.SlotsPerEpoch,
+				Root:  mockBlockRoot(duty / types.SlotsPerEpoch * types.SlotsPerEpoch),
+			},
+		},
+	}}, true, nil
+}
+
+// GetCommittees returns a single committee of mockCommitteeLength in every slot of the epoch
+func (m *MockClient) GetCommittees(ctx context.Context, epoch int) ([]types.Committee, error) {
+	committees := make([]types.Committee, types.SlotsPerEpoch)
+	for i := range committees {
+		committees[i] = types.Committee{Slot: epoch*types.SlotsPerEpoch + i, Size: mockCommitteeLength}
+	}
+	return committees, nil
+}
+
+// GetProposerDuties assigns every slot of the epoch to a pseudo-random validator
+func (m *MockClient) GetProposerDuties(ctx context.Context, epoch int) ([]types.ProposerDuty, error) {
+	duties := make([]types.ProposerDuty, types.SlotsPerEpoch)
+	for i := range duties {
+		slot := epoch*types.SlotsPerEpoch + i
+		index := (slot * 7919) % mockNetworkSize
+		duties[i] = types.ProposerDuty{
+			ValidatorIndex: index,
+			Pubkey:         fmt.Sprintf("0x%096d", index),
+			Slot:           slot,
+		}
+	}
+	return duties, nil
+}
+
+// GetBlockHeaders returns the canonical mock block at a slot
+func (m *MockClient) GetBlockHeaders(ctx context.Context, slot int) ([]types.BlockHeader, error) {
+	return []types.BlockHeader{{
+		Root:          mockBlockRoot(slot),
+		Slot:          slot,
+		ProposerIndex: (slot * 7919) % mockNetworkSize,
+		Canonical:     true,
+	}}, nil
+}
+
+// SubscribeToBlockEvents creates a channel that emits a mock block event every 12 seconds
+func (m *MockClient) SubscribeToBlockEvents(ctx context.Context) (<-chan types.BlockEvent, error) {
+	ch := make(chan types.BlockEvent, 10)
+
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(12 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				slot := int(now.Unix() / 12)
+				select {
+				case ch <- types.BlockEvent{Slot: slot, Block: mockBlockRoot(slot), Timestamp: now}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// SubscribeToBlocks creates a channel that emits a mock block every 12 seconds, closed when ctx
+// is done
+func (m *MockClient) SubscribeToBlocks(ctx context.Context) <-chan *types.Block {
+	ch := make(chan *types.Block, 10)
+
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(12 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				slot := int(now.Unix() / 12)
+				block := &types.Block{
+					Slot:          slot,
+					ProposerIndex: (slot * 7919) % mockNetworkSize,
+					Graffiti:      mockGraffiti[slot%len(mockGraffiti)],
+				}
+				select {
+				case ch <- block:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ScanValidators returns no validators
+func (m *MockClient) ScanValidators(ctx context.Context, ids []string, fn func(types.ValidatorIdentity) error) error {
+	return nil
+}
@@ -96,9 +96,10 @@ func (c *ValidatorListCache) InvalidateAll(ctx context.Context) error {
 
 func (c *ValidatorListCache) buildCacheKey(filter repository.ValidatorListFilter) string {
 	// Create deterministic hash of filter params
-	data := fmt.Sprintf("%s:%s:%s:%s:%d:%d",
+	data := fmt.Sprintf("%s:%s:%s:%s:%s:%d:%d",
 		filter.Search,
 		filter.Status,
+		filter.Credentials,
 		filter.SortBy,
 		filter.SortOrder,
 		filter.Limit,
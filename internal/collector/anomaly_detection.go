@@ -0,0 +1,311 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var performanceAnomalies = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_performance_anomalies_total",
+		Help: "Total performance anomalies started by metric and subject (validator, tag)",
+	},
+	[]string{"metric", "subject"},
+)
+
+// minGroupSize is the number of monitored validators a tag needs before its average is checked;
+// a tag on a single validator would only repeat that validator's anomalies
+const minGroupSize = 2
+
+// Floors on the baseline deviation, so a flat history does not turn noise into an anomaly
+const (
+	minEffectivenessDeviation  = 1.0  // Percentage points
+	minInclusionDelayDeviation = 0.1  // Slots
+	minIncomeDeviationRatio    = 0.02 // Fraction of the baseline income
+)
+
+// AnomalyDetectionConfig contains configuration for the anomaly detection job
+type AnomalyDetectionConfig struct {
+	Lookback     time.Duration // History used to build the baseline
+	RecentWindow time.Duration // Most recent hours compared with the baseline
+	Alpha        float64       // EWMA smoothing factor for the baseline
+	Threshold    float64       // z-score beyond which the recent window is anomalous
+	MinSamples   int           // Hours of history required before a series is checked
+}
+
+// DefaultAnomalyDetectionConfig returns default anomaly detection configuration
+func DefaultAnomalyDetectionConfig() *AnomalyDetectionConfig {
+	return &AnomalyDetectionConfig{
+		Lookback:     7 * 24 * time.Hour,
+		RecentWindow: 6 * time.Hour,
+		Alpha:        0.1,
+		Threshold:    3,
+		MinSamples:   24,
+	}
+}
+
+// AnomalyDetectionJob compares the recent hourly performance of each monitored validator, and of
+// the validators sharing each tag, with an exponentially weighted baseline of their own history.
+// It flags effectiveness drift, unusual inclusion delays and attestation income shortfalls that
+// fixed thresholds miss, and raises an alert when an anomaly starts.
+type AnomalyDetectionJob struct {
+	validatorRepo *repository.ValidatorRepository
+	anomalyRepo   *repository.AnomalyRepository
+	alertRepo     *repository.AlertRepository
+	config        *AnomalyDetectionConfig
+}
+
+// NewAnomalyDetectionJob creates a new anomaly detection job
+func NewAnomalyDetectionJob(pool *pgxpool.Pool, config *AnomalyDetectionConfig) *AnomalyDetectionJob {
+	return &AnomalyDetectionJob{
+		validatorRepo: repository.NewValidatorRepository(pool),
+		anomalyRepo:   repository.NewAnomalyRepository(pool),
+		alertRepo:     repository.NewAlertRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce checks the window ending at the last full hour for every monitored validator and tag
+func (j *AnomalyDetectionJob) RunOnce(ctx context.Context) error {
+	windowEnd := time.Now().UTC().Truncate(time.Hour)
+
+	samples, err := j.anomalyRepo.HourlySamples(ctx, windowEnd.Add(-j.config.Lookback), windowEnd)
+	if err != nil {
+		return err
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+
+	var anomalies []*models.PerformanceAnomaly
+	members := make(map[string][][]*models.PerformanceSample)
+	for _, v := range validators {
+		series := samples[v.ValidatorIndex]
+		for _, tag := range v.Tags {
+			members[tag] = append(members[tag], series)
+		}
+		for _, metric := range models.AnomalyMetrics {
+			if a := detectAnomaly(metric, series, windowEnd, j.config); a != nil {
+				index := v.ValidatorIndex
+				a.ValidatorIndex = &index
+				anomalies = append(anomalies, a)
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(members))
+	for tag, group := range members {
+		if len(group) >= minGroupSize {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		series := groupSamples(members[tag])
+		for _, metric := range models.AnomalyMetrics {
+			if a := detectAnomaly(metric, series, windowEnd, j.config); a != nil {
+				name := tag
+				a.Tag = &name
+				anomalies = append(anomalies, a)
+			}
+		}
+	}
+
+	for _, a := range anomalies {
+		onset, err := j.anomalyRepo.Record(ctx, a)
+		if err != nil {
+			return err
+		}
+		if !onset {
+			continue
+		}
+
+		subject := "validator"
+		if a.Tag != nil {
+			subject = "tag"
+		}
+		performanceAnomalies.WithLabelValues(string(a.Metric), subject).Inc()
+		j.raiseAlert(ctx, a)
+	}
+
+	return nil
+}
+
+// raiseAlert creates a performance degradation alert carrying the baseline and observed values
+func (j *AnomalyDetectionJob) raiseAlert(ctx context.Context, a *models.PerformanceAnomaly) {
+	details := models.JSONB{
+		"metric":       string(a.Metric),
+		"window_start": a.WindowStart,
+		"window_end":   a.WindowEnd,
+		"baseline":     a.Baseline,
+		"deviation":    a.Deviation,
+		"observed":     a.Observed,
+		"z_score":      a.ZScore,
+	}
+	if a.Tag != nil {
+		details["tag"] = *a.Tag
+	}
+
+	alert := &models.Alert{
+		ValidatorIndex: a.ValidatorIndex,
+		AlertType:      string(types.AlertTypePerformanceDegr),
+		Severity:       models.SeverityWarning,
+		Title:          "Performance anomaly: " + a.Metric.Description(),
+		Message:        a.Summary(),
+		Source:         "anomaly_detection",
+		Details:        details,
+		Status:         models.AlertStatusNew,
+	}
+	if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+		logger.FromContext(ctx).Error().
+			Err(err).
+			Str("metric", string(a.Metric)).
+			Msg("Failed to create performance anomaly alert")
+	}
+}
+
+// detectAnomaly compares the mean of a series over the recent window ending at windowEnd with an
+// EWMA baseline of the hours before it. It returns nil when the window is not worse than the baseline
+// by at least the threshold in standard deviations, or when there is too little data to judge.
+func detectAnomaly(metric models.AnomalyMetric, samples []*models.PerformanceSample, windowEnd time.Time, config *AnomalyDetectionConfig) *models.PerformanceAnomaly {
+	windowStart := windowEnd.Add(-config.RecentWindow)
+
+	var history, recent []float64
+	for _, s := range samples {
+		v, ok := s.Value(metric)
+		if !ok || !s.Time.Before(windowEnd) {
+			continue
+		}
+		if s.Time.Before(windowStart) {
+			history = append(history, v)
+		} else {
+			recent = append(recent, v)
+		}
+	}
+
+	// At least half the window must have data, so one bad hour is not read as a trend
+	minRecent := int(config.RecentWindow/time.Hour) / 2
+	if minRecent < 1 {
+		minRecent = 1
+	}
+	if len(history) < config.MinSamples || len(recent) < minRecent {
+		return nil
+	}
+
+	baseline, deviation := ewma(history, config.Alpha)
+	deviation = math.Max(deviation, minDeviation(metric, baseline))
+
+	var sum float64
+	for _, v := range recent {
+		sum += v
+	}
+	observed := sum / float64(len(recent))
+
+	z := (observed - baseline) / deviation
+	if metric.HigherIsWorse() && z < config.Threshold || !metric.HigherIsWorse() && z > -config.Threshold {
+		return nil
+	}
+
+	return &models.PerformanceAnomaly{
+		Metric:      metric,
+		WindowStart: windowStart,
+		WindowEnd:   windowEnd,
+		Baseline:    baseline,
+		Deviation:   deviation,
+		Observed:    observed,
+		ZScore:      z,
+	}
+}
+
+// ewma returns the exponentially weighted mean and standard deviation of a series, oldest first
+func ewma(values []float64, alpha float64) (mean, stddev float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	mean = values[0]
+	var variance float64
+	for _, v := range values[1:] {
+		diff := v - mean
+		incr := alpha * diff
+		mean += incr
+		variance = (1 - alpha) * (variance + diff*incr)
+	}
+
+	return mean, math.Sqrt(variance)
+}
+
+// minDeviation returns the smallest standard deviation used for a metric's z-score
+func minDeviation(metric models.AnomalyMetric, baseline float64) float64 {
+	switch metric {
+	case models.AnomalyMetricEffectiveness:
+		return minEffectivenessDeviation
+	case models.AnomalyMetricInclusionDelay:
+		return minInclusionDelayDeviation
+	default:
+		return math.Max(math.Abs(baseline)*minIncomeDeviationRatio, 1)
+	}
+}
+
+// groupSamples averages the series of a group of validators hour by hour, per metric
+func groupSamples(members [][]*models.PerformanceSample) []*models.PerformanceSample {
+	type total struct {
+		sum   float64
+		count int
+	}
+	byHour := make(map[time.Time]map[models.AnomalyMetric]*total)
+	for _, series := range members {
+		for _, s := range series {
+			totals, ok := byHour[s.Time]
+			if !ok {
+				totals = make(map[models.AnomalyMetric]*total)
+				byHour[s.Time] = totals
+			}
+			for _, metric := range models.AnomalyMetrics {
+				if v, ok := s.Value(metric); ok {
+					if totals[metric] == nil {
+						totals[metric] = &total{}
+					}
+					totals[metric].sum += v
+					totals[metric].count++
+				}
+			}
+		}
+	}
+
+	group := make([]*models.PerformanceSample, 0, len(byHour))
+	for hour, totals := range byHour {
+		s := &models.PerformanceSample{Time: hour}
+		for metric, t := range totals {
+			avg := t.sum / float64(t.count)
+			switch metric {
+			case models.AnomalyMetricEffectiveness:
+				s.Effectiveness = &avg
+			case models.AnomalyMetricInclusionDelay:
+				s.InclusionDelay = &avg
+			case models.AnomalyMetricAttestationIncome:
+				s.AttestationIncome = &avg
+			}
+		}
+		group = append(group, s)
+	}
+	sort.Slice(group, func(a, b int) bool { return group[a].Time.Before(group[b].Time) })
+
+	return group
+}
@@ -0,0 +1,130 @@
+package collector
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEWMA(t *testing.T) {
+	mean, stddev := ewma([]float64{5, 5, 5, 5}, 0.1)
+	assert.Equal(t, 5.0, mean)
+	assert.Zero(t, stddev)
+
+	mean, stddev = ewma([]float64{10, 20}, 0.5)
+	assert.Equal(t, 15.0, mean)
+	assert.InDelta(t, math.Sqrt(25), stddev, 1e-9)
+
+	mean, stddev = ewma(nil, 0.1)
+	assert.Zero(t, mean)
+	assert.Zero(t, stddev)
+}
+
+// hourlySeries builds one sample per hour ending at end, setting the metric from values
+func hourlySeries(end time.Time, metric models.AnomalyMetric, values []float64) []*models.PerformanceSample {
+	samples := make([]*models.PerformanceSample, 0, len(values))
+	start := end.Add(-time.Duration(len(values)) * time.Hour)
+	for i, v := range values {
+		s := &models.PerformanceSample{Time: start.Add(time.Duration(i) * time.Hour)}
+		value := v
+		switch metric {
+		case models.AnomalyMetricEffectiveness:
+			s.Effectiveness = &value
+		case models.AnomalyMetricInclusionDelay:
+			s.InclusionDelay = &value
+		case models.AnomalyMetricAttestationIncome:
+			s.AttestationIncome = &value
+		}
+		samples = append(samples, s)
+	}
+	return samples
+}
+
+// history returns n hourly values alternating around a mean
+func history(n int, mean, spread float64) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		if i%2 == 0 {
+			values[i] = mean + spread
+		} else {
+			values[i] = mean - spread
+		}
+	}
+	return values
+}
+
+func TestDetectAnomaly(t *testing.T) {
+	config := DefaultAnomalyDetectionConfig()
+	end := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		metric  models.AnomalyMetric
+		values  []float64
+		anomaly bool
+	}{
+		{name: "steady effectiveness", metric: models.AnomalyMetricEffectiveness, values: append(history(48, 98, 0.5), 98, 97.5, 98.5, 98, 97.5, 98.5)},
+		{name: "effectiveness drift", metric: models.AnomalyMetricEffectiveness, values: append(history(48, 98, 0.5), 95, 94, 93, 93, 92, 92), anomaly: true},
+		{name: "effectiveness improves", metric: models.AnomalyMetricEffectiveness, values: append(history(48, 90, 0.5), 99, 99, 99, 99, 99, 99)},
+		{name: "inclusion delay rises", metric: models.AnomalyMetricInclusionDelay, values: append(history(48, 1.02, 0.02), 1.6, 1.5, 1.7, 1.6, 1.5, 1.6), anomaly: true},
+		{name: "inclusion delay falls", metric: models.AnomalyMetricInclusionDelay, values: append(history(48, 1.5, 0.02), 1, 1, 1, 1, 1, 1)},
+		{name: "flat history within floor", metric: models.AnomalyMetricInclusionDelay, values: append(history(48, 1, 0), 1.2, 1.2, 1.2, 1.2, 1.2, 1.2)},
+		{name: "income shortfall", metric: models.AnomalyMetricAttestationIncome, values: append(history(48, 14000, 100), 12000, 12000, 12500, 12000, 12000, 12000), anomaly: true},
+		{name: "too little history", metric: models.AnomalyMetricEffectiveness, values: append(history(10, 98, 0.5), 90, 90, 90, 90, 90, 90)},
+		{name: "too little recent data", metric: models.AnomalyMetricEffectiveness, values: append(history(48, 98, 0.5), 90, 90)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples := hourlySeries(end, tt.metric, tt.values)
+			a := detectAnomaly(tt.metric, samples, end, config)
+			if !tt.anomaly {
+				assert.Nil(t, a)
+				return
+			}
+
+			require.NotNil(t, a)
+			assert.Equal(t, tt.metric, a.Metric)
+			assert.Equal(t, end.Add(-6*time.Hour), a.WindowStart)
+			assert.Equal(t, end, a.WindowEnd)
+			assert.InDelta(t, a.Baseline+a.ZScore*a.Deviation, a.Observed, 1e-9)
+			assert.GreaterOrEqual(t, math.Abs(a.ZScore), config.Threshold)
+		})
+	}
+}
+
+func TestDetectAnomaly_IgnoresOtherMetricsAndLaterHours(t *testing.T) {
+	config := DefaultAnomalyDetectionConfig()
+	end := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	values := append(history(48, 98, 0.5), 98, 98, 98, 98, 98, 98)
+	samples := hourlySeries(end, models.AnomalyMetricEffectiveness, values)
+	// The hour in progress is outside the window
+	bad := 50.0
+	samples = append(samples, &models.PerformanceSample{Time: end, Effectiveness: &bad})
+
+	assert.Nil(t, detectAnomaly(models.AnomalyMetricEffectiveness, samples, end, config))
+	assert.Nil(t, detectAnomaly(models.AnomalyMetricInclusionDelay, samples, end, config))
+}
+
+func TestGroupSamples(t *testing.T) {
+	hour := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	a, b, c := 90.0, 100.0, 1.5
+
+	group := groupSamples([][]*models.PerformanceSample{
+		{{Time: hour, Effectiveness: &a}, {Time: hour.Add(time.Hour), Effectiveness: &a}},
+		{{Time: hour, Effectiveness: &b, InclusionDelay: &c}},
+		nil,
+	})
+
+	require.Len(t, group, 2)
+	assert.Equal(t, hour, group[0].Time)
+	assert.Equal(t, 95.0, *group[0].Effectiveness)
+	assert.Equal(t, 1.5, *group[0].InclusionDelay)
+	assert.Nil(t, group[0].AttestationIncome)
+	assert.Equal(t, 90.0, *group[1].Effectiveness)
+}
@@ -0,0 +1,337 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var attestationMisses = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_attestation_misses_total",
+		Help: "Total suboptimal attestations by root cause and blame",
+	},
+	[]string{"reason", "blame"},
+)
+
+// maxHeadLookback bounds how far back the canonical head is searched from an empty slot
+const maxHeadLookback = 4 * types.SlotsPerEpoch
+
+// AttestationAnalysisConfig contains configuration for the attestation analysis job
+type AttestationAnalysisConfig struct {
+	LookbackEpochs  int64
+	MaxEpochsPerRun int
+	GenesisTime     time.Time
+	ElectraEpoch    int64 // From this epoch attestations aggregate committees, so their sizes are fetched
+}
+
+// DefaultAttestationAnalysisConfig returns default attestation analysis configuration
+func DefaultAttestationAnalysisConfig() *AttestationAnalysisConfig {
+	return &AttestationAnalysisConfig{
+		LookbackEpochs:  225, // ~1 day
+		MaxEpochsPerRun: 8,
+		GenesisTime:     time.Unix(types.MainnetGenesisTime, 0),
+		ElectraEpoch:    types.MainnetElectraEpoch,
+	}
+}
+
+// AttestationAnalysisJob checks the attestation duties of monitored validators against the
+// canonical chain and records the root cause of every suboptimal attestation
+type AttestationAnalysisJob struct {
+	client        types.DutiesClient
+	validatorRepo *repository.ValidatorRepository
+	missRepo      *repository.AttestationMissRepository
+	config        *AttestationAnalysisConfig
+}
+
+// NewAttestationAnalysisJob creates a new attestation analysis job
+func NewAttestationAnalysisJob(client types.DutiesClient, pool *pgxpool.Pool, config *AttestationAnalysisConfig) *AttestationAnalysisJob {
+	return &AttestationAnalysisJob{
+		client:        client,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		missRepo:      repository.NewAttestationMissRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce analyses every epoch in the lookback window not analysed yet, newest first, up to
+// MaxEpochsPerRun epochs
+func (j *AttestationAnalysisJob) RunOnce(ctx context.Context) error {
+	// An attestation can be included up to the end of the epoch after its duty
+	toEpoch := types.EpochAtTime(j.config.GenesisTime, time.Now()) - 2
+	fromEpoch := toEpoch - j.config.LookbackEpochs + 1
+	if fromEpoch < 1 {
+		fromEpoch = 1
+	}
+	if toEpoch < fromEpoch {
+		return nil
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	indices := make([]int, len(validators))
+	for i, v := range validators {
+		indices[i] = int(v.ValidatorIndex)
+	}
+
+	analyzed, err := j.missRepo.AnalyzedEpochs(ctx, fromEpoch, toEpoch)
+	if err != nil {
+		return err
+	}
+
+	processed := 0
+	for epoch := toEpoch; epoch >= fromEpoch && processed < j.config.MaxEpochsPerRun; epoch-- {
+		if analyzed[epoch] {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		duties, misses, err := j.analyzeEpoch(ctx, epoch, indices)
+		if errors.Is(err, types.ErrStateUnavailable) {
+			logger.FromContext(ctx).Debug().Err(err).Int64("epoch", epoch).Msg("Skipping attestation analysis for epoch with unavailable state")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to analyse epoch %d: %w", epoch, err)
+		}
+
+		if err := j.missRepo.SaveEpoch(ctx, epoch, duties, misses); err != nil {
+			return err
+		}
+		for _, m := range misses {
+			attestationMisses.WithLabelValues(string(m.Reason), string(m.Reason.Blame())).Inc()
+		}
+		processed++
+	}
+
+	logger.FromContext(ctx).Info().
+		Int("validator_count", len(validators)).
+		Int("epochs_analyzed", processed).
+		Int64("to_epoch", toEpoch).
+		Msg("Attestation analysis updated")
+
+	return nil
+}
+
+// analyzeEpoch fetches the duties for an epoch and the canonical blocks in their inclusion window,
+// and classifies each duty. It returns the number of duties checked and the misses found.
+func (j *AttestationAnalysisJob) analyzeEpoch(ctx context.Context, epoch int64, indices []int) (int, []*models.AttestationMiss, error) {
+	duties, err := j.client.GetAttesterDuties(ctx, int(epoch), indices)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(duties) == 0 {
+		return 0, nil, nil
+	}
+
+	chain := &canonicalChain{roots: make(map[int]string)}
+	firstSlot := int(epoch) * types.SlotsPerEpoch
+	lastSlot := firstSlot + 2*types.SlotsPerEpoch - 1
+
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		root, found, err := j.client.GetBlockRoot(ctx, slot)
+		if err != nil {
+			return 0, nil, err
+		}
+		if found {
+			chain.roots[slot] = root
+		}
+	}
+
+	// The head at the start of the epoch may be a block from an earlier epoch
+	chain.from = firstSlot
+	if _, ok := chain.roots[firstSlot]; !ok {
+		for slot := firstSlot - 1; slot >= 0 && slot >= firstSlot-maxHeadLookback; slot-- {
+			root, found, err := j.client.GetBlockRoot(ctx, slot)
+			if err != nil {
+				return 0, nil, err
+			}
+			if found {
+				chain.roots[slot] = root
+				chain.from = slot
+				break
+			}
+		}
+	}
+
+	// From Electra an attestation concatenates the bits of several committees, so locating a
+	// member needs the sizes of the committees before its own
+	var sizes committeeSizes
+	if epoch >= j.config.ElectraEpoch {
+		committees, err := j.client.GetCommittees(ctx, int(epoch))
+		if err != nil {
+			return 0, nil, err
+		}
+		sizes = make(committeeSizes, len(committees))
+		for _, committee := range committees {
+			sizes[committeeKey{slot: committee.Slot, index: committee.Index}] = committee.Size
+		}
+	}
+
+	var blocks []includedAttestations
+	for slot := firstSlot + 1; slot <= lastSlot; slot++ {
+		if _, ok := chain.roots[slot]; !ok {
+			continue
+		}
+		attestations, found, err := j.client.GetBlockAttestations(ctx, slot)
+		if err != nil {
+			return 0, nil, err
+		}
+		if found {
+			blocks = append(blocks, includedAttestations{slot: slot, attestations: attestations})
+		}
+	}
+
+	return len(duties), classifyAttestations(epoch, j.config.GenesisTime, duties, chain, blocks, sizes), nil
+}
+
+// canonicalChain holds the canonical block roots from slot from onwards; empty slots are absent
+type canonicalChain struct {
+	from  int
+	roots map[int]string
+}
+
+// headAt returns the canonical head at a slot: the root of the latest block at or before it
+func (c *canonicalChain) headAt(slot int) (string, bool) {
+	for s := slot; s >= c.from; s-- {
+		if root, ok := c.roots[s]; ok {
+			return root, true
+		}
+	}
+	return "", false
+}
+
+// nextBlock returns the first slot after slot with a canonical block
+func (c *canonicalChain) nextBlock(slot, limit int) (int, bool) {
+	for s := slot + 1; s <= limit; s++ {
+		if _, ok := c.roots[s]; ok {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// committeeKey identifies a beacon committee by slot and index
+type committeeKey struct {
+	slot  int
+	index int
+}
+
+// committeeSizes maps beacon committees to their sizes
+type committeeSizes map[committeeKey]int
+
+// includedAttestations are the attestations included in the canonical block at a slot
+type includedAttestations struct {
+	slot         int
+	attestations []types.Attestation
+}
+
+// classifyAttestations finds the first inclusion of each duty's attestation in blocks (ordered by
+// slot) and returns a miss for every duty that was not performed optimally. sizes is only needed
+// for attestations from Electra on.
+func classifyAttestations(epoch int64, genesis time.Time, duties []types.AttesterDuty, chain *canonicalChain, blocks []includedAttestations, sizes committeeSizes) []*models.AttestationMiss {
+	epochTime := types.EpochStartTime(genesis, epoch)
+	targetRoot, targetKnown := chain.headAt(int(epoch) * types.SlotsPerEpoch)
+	lastSlot := (int(epoch)+2)*types.SlotsPerEpoch - 1
+
+	var misses []*models.AttestationMiss
+	for _, duty := range duties {
+		miss := &models.AttestationMiss{
+			ValidatorIndex: int64(duty.ValidatorIndex),
+			Epoch:          epoch,
+			Time:           epochTime,
+			Slot:           int64(duty.Slot),
+		}
+
+		vote, inclusionSlot, included := findInclusion(duty, blocks, sizes)
+		if !included {
+			miss.Reason = models.AttestationMissNotIncluded
+			misses = append(misses, miss)
+			continue
+		}
+
+		slot := int64(inclusionSlot)
+		delay := int32(inclusionSlot - duty.Slot)
+		miss.InclusionSlot, miss.InclusionDelay = &slot, &delay
+
+		headRoot, headKnown := chain.headAt(duty.Slot)
+		earliest, _ := chain.nextBlock(duty.Slot, lastSlot)
+
+		switch {
+		case targetKnown && vote.Target.Root != targetRoot:
+			miss.Reason = models.AttestationMissWrongTarget
+		case headKnown && vote.BeaconBlockRoot != headRoot:
+			miss.Reason = models.AttestationMissWrongHead
+		case inclusionSlot > earliest:
+			miss.Reason = models.AttestationMissLateInclusion
+		case delay > 1:
+			miss.Reason = models.AttestationMissMissedBlock
+		default:
+			continue // Optimal
+		}
+		misses = append(misses, miss)
+	}
+
+	return misses
+}
+
+// findInclusion returns the vote and slot of the first block including the duty's attestation
+func findInclusion(duty types.AttesterDuty, blocks []includedAttestations, sizes committeeSizes) (types.AttestationData, int, bool) {
+	for _, block := range blocks {
+		if block.slot <= duty.Slot {
+			continue
+		}
+		for _, a := range block.attestations {
+			if a.Data.Slot != duty.Slot {
+				continue
+			}
+			if position, ok := memberPosition(a, duty, sizes); ok && a.HasAggregationBit(position) {
+				return a.Data, block.slot, true
+			}
+		}
+	}
+	return types.AttestationData{}, 0, false
+}
+
+// memberPosition returns the position of the duty's validator in the attestation's aggregation
+// bits. Before Electra an attestation covers the one committee in its data index. From Electra it
+// covers the committees set in its committee bits, their members concatenated in index order, so
+// the position is offset by the sizes of the committees before the duty's.
+func memberPosition(a types.Attestation, duty types.AttesterDuty, sizes committeeSizes) (int, bool) {
+	if a.CommitteeBits == "" {
+		return duty.ValidatorCommitteeIndex, a.Data.Index == duty.CommitteeIndex
+	}
+
+	offset := 0
+	for _, index := range a.CommitteeIndices() {
+		if index == duty.CommitteeIndex {
+			return offset + duty.ValidatorCommitteeIndex, true
+		}
+		size, ok := sizes[committeeKey{slot: duty.Slot, index: index}]
+		if !ok {
+			return 0, false
+		}
+		offset += size
+	}
+	return 0, false
+}
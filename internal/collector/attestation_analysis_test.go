@@ -0,0 +1,257 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestation_HasAggregationBit(t *testing.T) {
+	// 0x09 sets positions 0 and 3; 0x06 sets position 9 and the length marker at position 10
+	a := types.Attestation{AggregationBits: "0x0906"}
+	for position, want := range map[int]bool{0: true, 1: false, 3: true, 8: false, 9: true, 10: false, 15: false, -1: false} {
+		assert.Equal(t, want, a.HasAggregationBit(position), "position %d", position)
+	}
+
+	assert.False(t, types.Attestation{AggregationBits: "0x"}.HasAggregationBit(0))
+	assert.False(t, types.Attestation{AggregationBits: "0xzz"}.HasAggregationBit(0))
+}
+
+func TestClassifyAttestations(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	const epoch = 100
+	first := epoch * types.SlotsPerEpoch
+
+	// Blocks in every slot except first+6 and first+7
+	chain := &canonicalChain{from: first, roots: make(map[int]string)}
+	for slot := first; slot < first+2*types.SlotsPerEpoch; slot++ {
+		if slot != first+6 && slot != first+7 {
+			chain.roots[slot] = rootFor(slot)
+		}
+	}
+
+	vote := func(slot int) types.AttestationData {
+		head, _ := chain.headAt(slot)
+		return types.AttestationData{Slot: slot, Index: 0, BeaconBlockRoot: head, Target: types.Checkpoint{Epoch: epoch, Root: rootFor(first)}}
+	}
+	// Committee bits "0x07": positions 0 and 1 of a two-member committee
+	include := func(data types.AttestationData) types.Attestation {
+		return types.Attestation{AggregationBits: "0x07", Data: data}
+	}
+
+	wrongHead := vote(first + 2)
+	wrongHead.BeaconBlockRoot = rootFor(first + 1)
+	wrongTarget := vote(first + 3)
+	wrongTarget.Target.Root = rootFor(first - 1)
+
+	blocks := []includedAttestations{
+		{slot: first + 2, attestations: []types.Attestation{include(vote(first + 1))}},
+		{slot: first + 3, attestations: []types.Attestation{include(wrongHead)}},
+		{slot: first + 4, attestations: []types.Attestation{include(wrongTarget)}},
+		{slot: first + 8, attestations: []types.Attestation{include(vote(first + 5))}}, // Slots 6 and 7 were missed
+		{slot: first + 12, attestations: []types.Attestation{include(vote(first + 10))}},
+	}
+
+	duties := []types.AttesterDuty{
+		{ValidatorIndex: 1, Slot: first + 1, ValidatorCommitteeIndex: 1}, // Optimal
+		{ValidatorIndex: 2, Slot: first + 2, ValidatorCommitteeIndex: 0},
+		{ValidatorIndex: 3, Slot: first + 3, ValidatorCommitteeIndex: 1},
+		{ValidatorIndex: 4, Slot: first + 5, ValidatorCommitteeIndex: 0},
+		{ValidatorIndex: 5, Slot: first + 10, ValidatorCommitteeIndex: 1},
+		{ValidatorIndex: 6, Slot: first + 11, ValidatorCommitteeIndex: 0},
+		{ValidatorIndex: 7, Slot: first + 1, ValidatorCommitteeIndex: 2}, // Bit not set
+	}
+
+	misses := classifyAttestations(epoch, genesis, duties, chain, blocks, nil)
+	require.Len(t, misses, 6)
+
+	reasons := make(map[int64]models.AttestationMissReason)
+	for _, m := range misses {
+		reasons[m.ValidatorIndex] = m.Reason
+		assert.Equal(t, int64(epoch), m.Epoch)
+		assert.Equal(t, types.EpochStartTime(genesis, epoch), m.Time)
+	}
+	assert.Equal(t, map[int64]models.AttestationMissReason{
+		2: models.AttestationMissWrongHead,
+		3: models.AttestationMissWrongTarget,
+		4: models.AttestationMissMissedBlock,
+		5: models.AttestationMissLateInclusion,
+		6: models.AttestationMissNotIncluded,
+		7: models.AttestationMissNotIncluded,
+	}, reasons)
+
+	for _, m := range misses {
+		switch m.ValidatorIndex {
+		case 4:
+			assert.Equal(t, int32(3), *m.InclusionDelay)
+			assert.Equal(t, int64(first+8), *m.InclusionSlot)
+		case 6:
+			assert.Nil(t, m.InclusionSlot)
+		}
+	}
+}
+
+func TestAttestationMissReason_Blame(t *testing.T) {
+	assert.Equal(t, models.MissBlameNode, models.AttestationMissNotIncluded.Blame())
+	assert.Equal(t, models.MissBlameNode, models.AttestationMissWrongTarget.Blame())
+	assert.Equal(t, models.MissBlameNetwork, models.AttestationMissWrongHead.Blame())
+	assert.Equal(t, models.MissBlameNetwork, models.AttestationMissLateInclusion.Blame())
+	assert.Equal(t, models.MissBlameChain, models.AttestationMissMissedBlock.Blame())
+}
+
+func TestBeaconClient_DutiesEndpoints(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/duties/attester/100", func(w http.ResponseWriter, r *http.Request) {
+		var ids []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ids))
+		assert.Equal(t, []string{"1"}, ids)
+		w.Write([]byte(`{"data":[{"pubkey":"0xaa","validator_index":"1","committee_index":"3","committee_length":"128","committees_at_slot":"64","validator_committee_index":"17","slot":"3205"}]}`))
+	})
+	mux.HandleFunc("/eth/v1/beacon/blocks/3206/root", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"root":"0xabc"}}`))
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/3206/attestations", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"aggregation_bits":"0x0906","signature":"0x1","data":{"slot":"3205","index":"3","beacon_block_root":"0xabc",
+			"source":{"epoch":"98","root":"0xs"},"target":{"epoch":"100","root":"0xt"}}}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewBeaconClientWithoutRetry(server.URL, 5*time.Second)
+	ctx := context.Background()
+
+	duties, err := client.GetAttesterDuties(ctx, 100, []int{1})
+	require.NoError(t, err)
+	assert.Equal(t, []types.AttesterDuty{{ValidatorIndex: 1, Slot: 3205, CommitteeIndex: 3, CommitteeLength: 128, ValidatorCommitteeIndex: 17}}, duties)
+
+	root, found, err := client.GetBlockRoot(ctx, 3206)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "0xabc", root)
+
+	_, found, err = client.GetBlockRoot(ctx, 3207)
+	require.NoError(t, err)
+	assert.False(t, found, "empty slot")
+
+	attestations, found, err := client.GetBlockAttestations(ctx, 3206)
+	require.NoError(t, err)
+	assert.True(t, found)
+	require.Len(t, attestations, 1)
+	assert.Equal(t, 3205, attestations[0].Data.Slot)
+	assert.Equal(t, 3, attestations[0].Data.Index)
+	assert.Equal(t, types.Checkpoint{Epoch: 100, Root: "0xt"}, attestations[0].Data.Target)
+}
+
+func TestAnalyzeEpoch_ElectraAttestations(t *testing.T) {
+	// The fixture block at slot 12800002 aggregates committees 1, 3 and 4 of slot 12800001, of
+	// sizes 3, 2 and 4, so their members take aggregation bits 0-2, 3-4 and 5-8
+	const epoch = 400000
+	first := epoch * types.SlotsPerEpoch
+	fixture := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFile(w, r, filepath.Join("testdata", "electra", name))
+		}
+	}
+
+	var requests []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/duties/attester/400000", fixture("attester_duties.json"))
+	mux.HandleFunc("/eth/v1/beacon/states/12800000/committees", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "400000", r.URL.Query().Get("epoch"))
+		fixture("committees.json")(w, r)
+	})
+	mux.HandleFunc("/eth/v1/beacon/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		var slot int
+		fmt.Sscanf(r.URL.Path, "/eth/v1/beacon/blocks/%d/root", &slot)
+		w.Write([]byte(`{"data":{"root":"` + rootFor(slot) + `"}}`))
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		if r.URL.Path == "/eth/v2/beacon/blocks/12800002/attestations" {
+			fixture("block_attestations.json")(w, r)
+			return
+		}
+		w.Write([]byte(`{"version":"electra","data":[]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := DefaultAttestationAnalysisConfig()
+	job := &AttestationAnalysisJob{client: NewBeaconClientWithoutRetry(server.URL, 5*time.Second), config: config}
+
+	duties, misses, err := job.analyzeEpoch(context.Background(), epoch, []int{11, 12, 13, 14, 15})
+	require.NoError(t, err)
+	assert.Equal(t, 5, duties)
+	assert.Contains(t, requests, fmt.Sprintf("/eth/v2/beacon/blocks/%d/attestations", first+2))
+
+	reasons := make(map[int64]models.AttestationMissReason)
+	for _, m := range misses {
+		reasons[m.ValidatorIndex] = m.Reason
+	}
+	assert.Equal(t, map[int64]models.AttestationMissReason{
+		13: models.AttestationMissNotIncluded, // Committee 2 is not aggregated
+		14: models.AttestationMissNotIncluded, // Bit 8 is not set
+	}, reasons, "validators 11, 12 and 15 sit at bits 4, 5 and 2")
+}
+
+func TestAttestation_CommitteeIndices(t *testing.T) {
+	assert.Equal(t, []int{1, 3, 4, 63}, types.Attestation{CommitteeBits: "0x1a00000000000080"}.CommitteeIndices())
+	assert.Nil(t, types.Attestation{}.CommitteeIndices(), "attestations before Electra have no committee bits")
+}
+
+func rootFor(slot int) string {
+	return fmt.Sprintf("0x%064x", slot)
+}
+
+func TestBeaconClient_GetForkSchedule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/config/spec", r.URL.Path)
+		w.Write([]byte(`{"data":{
+			"ALTAIR_FORK_VERSION":"0x01017000","ALTAIR_FORK_EPOCH":"0",
+			"DENEB_FORK_VERSION":"0x05017000","DENEB_FORK_EPOCH":"29696",
+			"ELECTRA_FORK_VERSION":"0x06017000","ELECTRA_FORK_EPOCH":"115968",
+			"FULU_FORK_VERSION":"0x07017000","FULU_FORK_EPOCH":"18446744073709551615",
+			"SECONDS_PER_SLOT":"12"}}`))
+	}))
+	defer server.Close()
+
+	forks, err := NewBeaconClientWithoutRetry(server.URL, 5*time.Second).GetForkSchedule(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []types.Fork{
+		{Name: types.ForkAltair, Version: "0x01017000", Epoch: 0},
+		{Name: types.ForkDeneb, Version: "0x05017000", Epoch: 29696},
+		{Name: types.ForkElectra, Version: "0x06017000", Epoch: 115968},
+	}, forks)
+
+	assert.Equal(t, int64(115968), types.ForkEpoch(forks, types.ForkElectra))
+	assert.Equal(t, int64(math.MaxInt64), types.ForkEpoch(forks, types.ForkFulu), "far future forks are unscheduled")
+}
+
+func TestBeaconClient_GetGenesis(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/genesis", r.URL.Path)
+		w.Write([]byte(`{"data":{"genesis_time":"1695902400",
+			"genesis_validators_root":"0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1",
+			"genesis_fork_version":"0x01017000"}}`))
+	}))
+	defer server.Close()
+
+	genesis, err := NewBeaconClientWithoutRetry(server.URL, 5*time.Second).GetGenesis(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &types.Genesis{
+		Time:           1695902400,
+		ValidatorsRoot: "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1",
+		ForkVersion:    "0x01017000",
+	}, genesis)
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/birddigital/eth-validator-monitor/pkg/types"
@@ -20,6 +21,9 @@ type BeaconClientImpl struct {
 	timeout       time.Duration
 	useRetry      bool
 	metrics       *HTTPMetrics
+	breaker       *ErrorRecovery
+	trust         *BeaconTrust
+	blocks        *blockStream
 }
 
 // BeaconClientConfig configures the beacon client
@@ -31,6 +35,8 @@ type BeaconClientConfig struct {
 	EnableLogging  bool
 	VerboseLogging bool
 	EnableMetrics  bool
+	CircuitBreaker *ErrorRecovery // Optional; shared with the collector so it can see beacon state
+	Trust          *BeaconTrust   // Optional; refuses requests while the node fails light client verification. The verification job needs a client without it.
 }
 
 // DefaultBeaconClientConfig returns default configuration
@@ -107,6 +113,9 @@ func NewBeaconClientWithConfig(config BeaconClientConfig) *BeaconClientImpl {
 		timeout:     config.Timeout,
 		useRetry:    config.EnableRetry,
 		metrics:     metrics,
+		breaker:     config.CircuitBreaker,
+		trust:       config.Trust,
+		blocks:      newBlockStream(),
 	}
 }
 
@@ -119,15 +128,67 @@ func NewBeaconClientWithoutRetry(baseURL string, timeout time.Duration) *BeaconC
 		},
 		timeout:  timeout,
 		useRetry: false,
+		blocks:   newBlockStream(),
 	}
 }
 
 // doRequest executes an HTTP request with optional retry logic
 func (c *BeaconClientImpl) doRequest(req *http.Request) (*http.Response, error) {
-	if c.useRetry && c.retryClient != nil {
-		return c.retryClient.Do(req)
+	return c.guard(req, func() (*http.Response, error) {
+		if c.useRetry && c.retryClient != nil {
+			return c.retryClient.Do(req)
+		}
+		return c.httpClient.Do(req)
+	})
+}
+
+// doSingleRequest executes an HTTP request without retries, for per-slot and streaming calls
+func (c *BeaconClientImpl) doSingleRequest(req *http.Request) (*http.Response, error) {
+	return c.guard(req, func() (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+}
+
+// guard refuses requests to a node that failed light client verification, then runs the request
+// through the circuit breaker for its endpoint group.
+// Transport errors and 5xx responses count as failures; 4xx responses do not.
+func (c *BeaconClientImpl) guard(req *http.Request, do func() (*http.Response, error)) (*http.Response, error) {
+	if c.trust != nil {
+		if err := c.trust.Allow(); err != nil {
+			return nil, err
+		}
+	}
+	if c.breaker == nil {
+		return do()
+	}
+
+	component := beaconComponent(req.URL.Path)
+	if err := c.breaker.Allow(component); err != nil {
+		return nil, err
+	}
+
+	resp, err := do()
+	outcome := err
+	if err == nil && resp.StatusCode >= http.StatusInternalServerError {
+		outcome = fmt.Errorf("beacon node returned status %d", resp.StatusCode)
+	}
+	c.breaker.Record(component, outcome)
+
+	return resp, err
+}
+
+// beaconComponent maps a beacon API path to its circuit breaker component
+func beaconComponent(path string) string {
+	switch {
+	case strings.Contains(path, "/validators"):
+		return ComponentBeaconValidators
+	case strings.Contains(path, "/beacon/blocks/"):
+		return ComponentBeaconBlocks
+	case strings.HasSuffix(path, "/events"):
+		return ComponentBeaconEvents
+	default:
+		return ComponentBeaconNode
 	}
-	return c.httpClient.Do(req)
 }
 
 // GetMetrics returns the HTTP metrics snapshot if metrics are enabled
@@ -174,7 +235,8 @@ func (c *BeaconClientImpl) GetValidator(ctx context.Context, index int) (*types.
 func (c *BeaconClientImpl) GetValidatorBalance(ctx context.Context, index int, epoch int) (*big.Int, error) {
 	stateID := "head"
 	if epoch > 0 {
-		stateID = fmt.Sprintf("%d", epoch)
+		// State IDs are slot numbers; use the first slot of the epoch
+		stateID = fmt.Sprintf("%d", epoch*types.SlotsPerEpoch)
 	}
 
 	url := fmt.Sprintf("%s/eth/v1/beacon/states/%s/validators/%d", c.baseURL, stateID, index)
@@ -190,6 +252,10 @@ func (c *BeaconClientImpl) GetValidatorBalance(ctx context.Context, index int, e
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound && epoch > 0 {
+		return nil, fmt.Errorf("validator %d balance at epoch %d: %w", index, epoch, types.ErrStateUnavailable)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("unexpected status code %d for validator %d balance at epoch %d: %s", resp.StatusCode, index, epoch, string(body))
@@ -254,7 +320,7 @@ func (c *BeaconClientImpl) GetAttestations(ctx context.Context, epoch int) ([]ty
 			return nil, fmt.Errorf("failed to create request for slot %d: %w", slot, err)
 		}
 
-		resp, err := c.httpClient.Do(req)
+		resp, err := c.doSingleRequest(req)
 		if err != nil {
 			// Skip slots without blocks or network errors
 			continue
@@ -298,7 +364,7 @@ func (c *BeaconClientImpl) GetProposals(ctx context.Context, epoch int) ([]types
 			continue
 		}
 
-		resp, err := c.httpClient.Do(req)
+		resp, err := c.doSingleRequest(req)
 		if err != nil {
 			continue
 		}
@@ -357,7 +423,7 @@ func (c *BeaconClientImpl) SubscribeToHeadEvents(ctx context.Context) (<-chan ty
 		req.Header.Set("Cache-Control", "no-cache")
 		req.Header.Set("Connection", "keep-alive")
 
-		resp, err := c.httpClient.Do(req)
+		resp, err := c.doSingleRequest(req)
 		if err != nil {
 			return
 		}
@@ -592,6 +658,7 @@ func (c *BeaconClientImpl) GetNetworkStats(ctx context.Context) (*types.NetworkS
 		AverageBalance:    averageBalance,
 		TotalStaked:       totalBalance,
 		ParticipationRate: float64(activeValidators) / float64(totalValidators),
+		ClientDiversity:   c.blocks.diversity(int64(currentEpoch)),
 		Timestamp:         time.Now(),
 	}, nil
 }
@@ -0,0 +1,130 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// GetBlock retrieves the proposer, graffiti, fee recipient, final transaction, withdrawals,
+// deposits, credential changes and execution layer requests in the block at a slot. Fetched blocks
+// are published to the client's block subscribers.
+func (c *BeaconClientImpl) GetBlock(ctx context.Context, slot int) (*types.Block, error) {
+	url := fmt.Sprintf("%s/eth/v2/beacon/blocks/%d", c.baseURL, slot)
+
+	var result struct {
+		Data struct {
+			Message struct {
+				ProposerIndex int64 `json:"proposer_index,string"`
+				Body          struct {
+					Graffiti string `json:"graffiti"`
+					Deposits []struct {
+						Data struct {
+							Pubkey                string `json:"pubkey"`
+							WithdrawalCredentials string `json:"withdrawal_credentials"`
+							Amount                int64  `json:"amount,string"`
+							Signature             string `json:"signature"`
+						} `json:"data"`
+					} `json:"deposits"`
+					ExecutionPayload struct {
+						FeeRecipient string   `json:"fee_recipient"`
+						BlockHash    string   `json:"block_hash"`
+						Transactions []string `json:"transactions"`
+						Withdrawals  []struct {
+							Index          int64  `json:"index,string"`
+							ValidatorIndex int64  `json:"validator_index,string"`
+							Address        string `json:"address"`
+							Amount         int64  `json:"amount,string"`
+						} `json:"withdrawals"`
+					} `json:"execution_payload"`
+					BLSToExecutionChanges []struct {
+						Message struct {
+							ValidatorIndex     int64  `json:"validator_index,string"`
+							FromBLSPubkey      string `json:"from_bls_pubkey"`
+							ToExecutionAddress string `json:"to_execution_address"`
+						} `json:"message"`
+					} `json:"bls_to_execution_changes"`
+					ExecutionRequests struct {
+						Deposits []struct {
+							Pubkey                string `json:"pubkey"`
+							WithdrawalCredentials string `json:"withdrawal_credentials"`
+							Amount                int64  `json:"amount,string"`
+							Signature             string `json:"signature"`
+						} `json:"deposits"`
+						Withdrawals []struct {
+							SourceAddress   string `json:"source_address"`
+							ValidatorPubkey string `json:"validator_pubkey"`
+							Amount          int64  `json:"amount,string"`
+						} `json:"withdrawals"`
+						Consolidations []types.ConsolidationRequest `json:"consolidations"`
+					} `json:"execution_requests"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", slot, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	body := result.Data.Message.Body
+	block := &types.Block{
+		Slot:           slot,
+		ProposerIndex:  int(result.Data.Message.ProposerIndex),
+		Graffiti:       types.DecodeGraffiti(body.Graffiti),
+		FeeRecipient:   body.ExecutionPayload.FeeRecipient,
+		BlockHash:      body.ExecutionPayload.BlockHash,
+		Consolidations: body.ExecutionRequests.Consolidations,
+	}
+	if txs := body.ExecutionPayload.Transactions; len(txs) > 0 {
+		// Left nil when the transaction cannot be decoded, so callers treat the payment as unknown
+		block.FinalTransaction, _ = decodePayloadTransaction(txs[len(txs)-1])
+	}
+	for _, w := range body.ExecutionPayload.Withdrawals {
+		block.Withdrawals = append(block.Withdrawals, types.Withdrawal{
+			Index:          w.Index,
+			ValidatorIndex: int(w.ValidatorIndex),
+			Address:        w.Address,
+			Amount:         w.Amount,
+		})
+	}
+	for _, d := range body.Deposits {
+		block.Deposits = append(block.Deposits, types.Deposit{
+			Pubkey:                d.Data.Pubkey,
+			WithdrawalCredentials: d.Data.WithdrawalCredentials,
+			Amount:                d.Data.Amount,
+			Signature:             d.Data.Signature,
+		})
+	}
+	for _, d := range body.ExecutionRequests.Deposits {
+		block.Deposits = append(block.Deposits, types.Deposit{
+			Pubkey:                d.Pubkey,
+			WithdrawalCredentials: d.WithdrawalCredentials,
+			Amount:                d.Amount,
+			Signature:             d.Signature,
+		})
+	}
+	for _, c := range body.BLSToExecutionChanges {
+		block.CredentialChanges = append(block.CredentialChanges, types.CredentialChange{
+			ValidatorIndex:     int(c.Message.ValidatorIndex),
+			FromBLSPubkey:      c.Message.FromBLSPubkey,
+			ToExecutionAddress: c.Message.ToExecutionAddress,
+		})
+	}
+	for _, w := range body.ExecutionRequests.Withdrawals {
+		block.WithdrawalRequests = append(block.WithdrawalRequests, types.WithdrawalRequest{
+			SourceAddress:   w.SourceAddress,
+			ValidatorPubkey: w.ValidatorPubkey,
+			Amount:          w.Amount,
+		})
+	}
+
+	c.blocks.publish(block)
+	return block, nil
+}
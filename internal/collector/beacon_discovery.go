@@ -0,0 +1,160 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// ScanValidators streams the validators in the head state matching ids, or every validator when
+// ids is empty. The full validator set runs to hundreds of megabytes, so entries are decoded one
+// at a time rather than into a single slice.
+func (c *BeaconClientImpl) ScanValidators(ctx context.Context, ids []string, fn func(types.ValidatorIdentity) error) error {
+	if len(ids) == 0 {
+		return c.scanValidators(ctx, nil, fn)
+	}
+
+	for start := 0; start < len(ids); start += validatorIDsPerRequest {
+		end := min(start+validatorIDsPerRequest, len(ids))
+		if err := c.scanValidators(ctx, ids[start:end], fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanValidators fetches one page of the head state's validators and decodes it entry by entry
+func (c *BeaconClientImpl) scanValidators(ctx context.Context, ids []string, fn func(types.ValidatorIdentity) error) error {
+	body, err := json.Marshal(struct {
+		IDs []string `json:"ids,omitempty"`
+	}{IDs: ids})
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/head/validators", c.baseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	if err := seekJSONArray(decoder, "data"); err != nil {
+		return fmt.Errorf("failed to decode validators: %w", err)
+	}
+
+	for decoder.More() {
+		var v struct {
+			Index     int64  `json:"index,string"`
+			Status    string `json:"status"`
+			Validator struct {
+				Pubkey                     string `json:"pubkey"`
+				WithdrawalCredentials      string `json:"withdrawal_credentials"`
+				Slashed                    bool   `json:"slashed"`
+				ActivationEligibilityEpoch string `json:"activation_eligibility_epoch"`
+				ActivationEpoch            string `json:"activation_epoch"`
+				ExitEpoch                  string `json:"exit_epoch"`
+				WithdrawableEpoch          string `json:"withdrawable_epoch"`
+			} `json:"validator"`
+		}
+		if err := decoder.Decode(&v); err != nil {
+			return fmt.Errorf("failed to decode validator: %w", err)
+		}
+
+		identity := types.ValidatorIdentity{
+			Index:                 v.Index,
+			Pubkey:                v.Validator.Pubkey,
+			WithdrawalCredentials: v.Validator.WithdrawalCredentials,
+			Status:                v.Status,
+			Slashed:               v.Validator.Slashed,
+		}
+		epochs := []struct {
+			value string
+			dst   **int64
+		}{
+			{v.Validator.ActivationEligibilityEpoch, &identity.ActivationEligibilityEpoch},
+			{v.Validator.ActivationEpoch, &identity.ActivationEpoch},
+			{v.Validator.ExitEpoch, &identity.ExitEpoch},
+			{v.Validator.WithdrawableEpoch, &identity.WithdrawableEpoch},
+		}
+		for _, e := range epochs {
+			epoch, err := parseEpoch(e.value)
+			if err != nil {
+				return fmt.Errorf("failed to decode validator %d: %w", v.Index, err)
+			}
+			*e.dst = epoch
+		}
+
+		if err := fn(identity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseEpoch parses an epoch of the beacon API, returning nil when it is absent or
+// FAR_FUTURE_EPOCH, which marks an epoch the validator has not been scheduled for
+func parseEpoch(s string) (*int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	epoch, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch %q: %w", s, err)
+	}
+	if epoch > math.MaxInt64 {
+		return nil, nil
+	}
+	e := int64(epoch)
+	return &e, nil
+}
+
+// seekJSONArray advances decoder past the opening bracket of the array under key in the top-level
+// object, skipping any other fields before it
+func seekJSONArray(decoder *json.Decoder, key string) error {
+	if tok, err := decoder.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("expected an object")
+	}
+
+	for decoder.More() {
+		tok, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if tok != key {
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if tok, err := decoder.Token(); err != nil || tok != json.Delim('[') {
+			return fmt.Errorf("expected %q to be an array", key)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("missing %q", key)
+}
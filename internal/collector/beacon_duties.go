@@ -0,0 +1,151 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// GetAttesterDuties retrieves the committee assignments of the given validators in an epoch
+func (c *BeaconClientImpl) GetAttesterDuties(ctx context.Context, epoch int, indices []int) ([]types.AttesterDuty, error) {
+	url := fmt.Sprintf("%s/eth/v1/validator/duties/attester/%d", c.baseURL, epoch)
+
+	var result struct {
+		Data []struct {
+			ValidatorIndex          int64 `json:"validator_index,string"`
+			Slot                    int64 `json:"slot,string"`
+			CommitteeIndex          int64 `json:"committee_index,string"`
+			CommitteeLength         int64 `json:"committee_length,string"`
+			ValidatorCommitteeIndex int64 `json:"validator_committee_index,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodPost, url, indexStrings(indices), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attester duties for epoch %d: %w", epoch, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("attester duties for epoch %d: %w", epoch, types.ErrStateUnavailable)
+	}
+
+	duties := make([]types.AttesterDuty, 0, len(result.Data))
+	for _, d := range result.Data {
+		duties = append(duties, types.AttesterDuty{
+			ValidatorIndex:          int(d.ValidatorIndex),
+			Slot:                    int(d.Slot),
+			CommitteeIndex:          int(d.CommitteeIndex),
+			CommitteeLength:         int(d.CommitteeLength),
+			ValidatorCommitteeIndex: int(d.ValidatorCommitteeIndex),
+		})
+	}
+
+	return duties, nil
+}
+
+// GetBlockRoot retrieves the root of the canonical block at a slot
+func (c *BeaconClientImpl) GetBlockRoot(ctx context.Context, slot int) (string, bool, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/blocks/%d/root", c.baseURL, slot)
+
+	var result struct {
+		Data struct {
+			Root string `json:"root"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get block root for slot %d: %w", slot, err)
+	}
+	if !found {
+		return "", false, nil
+	}
+
+	return result.Data.Root, true, nil
+}
+
+// GetBlockAttestations retrieves the attestations included in the canonical block at a slot, in the
+// format of the block's fork
+func (c *BeaconClientImpl) GetBlockAttestations(ctx context.Context, slot int) ([]types.Attestation, bool, error) {
+	url := fmt.Sprintf("%s/eth/v2/beacon/blocks/%d/attestations", c.baseURL, slot)
+
+	var result struct {
+		Data []struct {
+			AggregationBits string `json:"aggregation_bits"`
+			CommitteeBits   string `json:"committee_bits"`
+			Signature       string `json:"signature"`
+			Data            struct {
+				Slot            int64  `json:"slot,string"`
+				Index           int64  `json:"index,string"`
+				BeaconBlockRoot string `json:"beacon_block_root"`
+				Source          struct {
+					Epoch int64  `json:"epoch,string"`
+					Root  string `json:"root"`
+				} `json:"source"`
+				Target struct {
+					Epoch int64  `json:"epoch,string"`
+					Root  string `json:"root"`
+				} `json:"target"`
+			} `json:"data"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get attestations for slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, false, nil
+	}
+
+	attestations := make([]types.Attestation, 0, len(result.Data))
+	for _, a := range result.Data {
+		attestations = append(attestations, types.Attestation{
+			AggregationBits: a.AggregationBits,
+			CommitteeBits:   a.CommitteeBits,
+			Signature:       a.Signature,
+			Data: types.AttestationData{
+				Slot:            int(a.Data.Slot),
+				Index:           int(a.Data.Index),
+				BeaconBlockRoot: a.Data.BeaconBlockRoot,
+				Source:          types.Checkpoint{Epoch: int(a.Data.Source.Epoch), Root: a.Data.Source.Root},
+				Target:          types.Checkpoint{Epoch: int(a.Data.Target.Epoch), Root: a.Data.Target.Root},
+			},
+		})
+	}
+
+	return attestations, true, nil
+}
+
+// GetCommittees retrieves the size of every beacon committee in an epoch
+func (c *BeaconClientImpl) GetCommittees(ctx context.Context, epoch int) ([]types.Committee, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/%d/committees?epoch=%d", c.baseURL, epoch*types.SlotsPerEpoch, epoch)
+
+	var result struct {
+		Data []struct {
+			Index      int64    `json:"index,string"`
+			Slot       int64    `json:"slot,string"`
+			Validators []string `json:"validators"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get committees for epoch %d: %w", epoch, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("committees for epoch %d: %w", epoch, types.ErrStateUnavailable)
+	}
+
+	committees := make([]types.Committee, 0, len(result.Data))
+	for _, committee := range result.Data {
+		committees = append(committees, types.Committee{
+			Slot:  int(committee.Slot),
+			Index: int(committee.Index),
+			Size:  len(committee.Validators),
+		})
+	}
+
+	return committees, nil
+}
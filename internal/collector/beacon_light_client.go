@@ -0,0 +1,150 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// lightClientHeader is the light client form of a header; only the beacon part is verified
+type lightClientHeader struct {
+	Beacon types.BeaconBlockHeader `json:"beacon"`
+}
+
+// lightClientUpdateData is the JSON form of a light client update, finality update included
+type lightClientUpdateData struct {
+	AttestedHeader          lightClientHeader    `json:"attested_header"`
+	NextSyncCommittee       *types.SyncCommittee `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string             `json:"next_sync_committee_branch"`
+	FinalizedHeader         *lightClientHeader   `json:"finalized_header"`
+	FinalityBranch          []string             `json:"finality_branch"`
+	SyncAggregate           types.SyncAggregate  `json:"sync_aggregate"`
+	SignatureSlot           int64                `json:"signature_slot,string"`
+}
+
+// GetLightClientBootstrap retrieves the sync committee in force at a block, with its proof
+func (c *BeaconClientImpl) GetLightClientBootstrap(ctx context.Context, blockRoot string) (*types.LightClientBootstrap, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/light_client/bootstrap/%s", c.baseURL, blockRoot)
+
+	var result struct {
+		Data struct {
+			Header                     lightClientHeader   `json:"header"`
+			CurrentSyncCommittee       types.SyncCommittee `json:"current_sync_committee"`
+			CurrentSyncCommitteeBranch []string            `json:"current_sync_committee_branch"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get light client bootstrap for %s: %w", blockRoot, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &types.LightClientBootstrap{
+		Header:                     result.Data.Header.Beacon,
+		CurrentSyncCommittee:       result.Data.CurrentSyncCommittee,
+		CurrentSyncCommitteeBranch: result.Data.CurrentSyncCommitteeBranch,
+	}, nil
+}
+
+// GetLightClientUpdates retrieves the best update of each sync committee period from startPeriod
+func (c *BeaconClientImpl) GetLightClientUpdates(ctx context.Context, startPeriod, count int64) ([]types.LightClientUpdate, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/light_client/updates?start_period=%d&count=%d", c.baseURL, startPeriod, count)
+
+	var result []struct {
+		Data lightClientUpdateData `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get light client updates from period %d: %w", startPeriod, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	updates := make([]types.LightClientUpdate, 0, len(result))
+	for _, u := range result {
+		updates = append(updates, lightClientUpdate(u.Data))
+	}
+
+	return updates, nil
+}
+
+// GetLightClientFinalityUpdate retrieves the latest finalized header attested by the sync committee
+func (c *BeaconClientImpl) GetLightClientFinalityUpdate(ctx context.Context) (*types.LightClientUpdate, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/light_client/finality_update", c.baseURL)
+
+	var result struct {
+		Data lightClientUpdateData `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get light client finality update: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	update := lightClientUpdate(result.Data)
+	return &update, nil
+}
+
+// GetBlockHeader retrieves the header of the block with a root, or nil if the node does not know it
+func (c *BeaconClientImpl) GetBlockHeader(ctx context.Context, blockRoot string) (*types.BeaconBlockHeader, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/headers/%s", c.baseURL, blockRoot)
+
+	var result struct {
+		Data struct {
+			Header struct {
+				Message types.BeaconBlockHeader `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block header %s: %w", blockRoot, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &result.Data.Header.Message, nil
+}
+
+// lightClientUpdate converts a decoded update. A next sync committee or finalized header whose
+// branch is all zeros is the protocol's way of leaving it out.
+func lightClientUpdate(d lightClientUpdateData) types.LightClientUpdate {
+	update := types.LightClientUpdate{
+		AttestedHeader: d.AttestedHeader.Beacon,
+		SyncAggregate:  d.SyncAggregate,
+		SignatureSlot:  d.SignatureSlot,
+	}
+	if d.NextSyncCommittee != nil && !zeroBranch(d.NextSyncCommitteeBranch) {
+		update.NextSyncCommittee = d.NextSyncCommittee
+		update.NextSyncCommitteeBranch = d.NextSyncCommitteeBranch
+	}
+	if d.FinalizedHeader != nil && !zeroBranch(d.FinalityBranch) {
+		finalized := d.FinalizedHeader.Beacon
+		update.FinalizedHeader = &finalized
+		update.FinalityBranch = d.FinalityBranch
+	}
+	return update
+}
+
+// zeroBranch reports whether a Merkle branch is empty or all zero hashes
+func zeroBranch(branch []string) bool {
+	for _, node := range branch {
+		if strings.Trim(strings.TrimPrefix(node, "0x"), "0") != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,138 @@
+package collector
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// GetProposerDuties retrieves the proposer of every slot in an epoch
+func (c *BeaconClientImpl) GetProposerDuties(ctx context.Context, epoch int) ([]types.ProposerDuty, error) {
+	url := fmt.Sprintf("%s/eth/v1/validator/duties/proposer/%d", c.baseURL, epoch)
+
+	var result struct {
+		Data []struct {
+			Pubkey         string `json:"pubkey"`
+			ValidatorIndex int64  `json:"validator_index,string"`
+			Slot           int64  `json:"slot,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proposer duties for epoch %d: %w", epoch, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("proposer duties for epoch %d: %w", epoch, types.ErrStateUnavailable)
+	}
+
+	duties := make([]types.ProposerDuty, 0, len(result.Data))
+	for _, d := range result.Data {
+		duties = append(duties, types.ProposerDuty{
+			ValidatorIndex: int(d.ValidatorIndex),
+			Pubkey:         d.Pubkey,
+			Slot:           int(d.Slot),
+		})
+	}
+
+	return duties, nil
+}
+
+// GetBlockHeaders retrieves the headers of all blocks the node knows at a slot, including
+// blocks that were reorged out
+func (c *BeaconClientImpl) GetBlockHeaders(ctx context.Context, slot int) ([]types.BlockHeader, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/headers?slot=%d", c.baseURL, slot)
+
+	var result struct {
+		Data []struct {
+			Root      string `json:"root"`
+			Canonical bool   `json:"canonical"`
+			Header    struct {
+				Message struct {
+					Slot          int64 `json:"slot,string"`
+					ProposerIndex int64 `json:"proposer_index,string"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block headers for slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	headers := make([]types.BlockHeader, 0, len(result.Data))
+	for _, h := range result.Data {
+		headers = append(headers, types.BlockHeader{
+			Root:          h.Root,
+			Slot:          int(h.Header.Message.Slot),
+			ProposerIndex: int(h.Header.Message.ProposerIndex),
+			Canonical:     h.Canonical,
+		})
+	}
+
+	return headers, nil
+}
+
+// SubscribeToBlockEvents subscribes to the node's block events. Each event is timestamped when it
+// is read, so the arrival time of a block relative to its slot can be measured.
+// The channel is closed when the stream ends.
+func (c *BeaconClientImpl) SubscribeToBlockEvents(ctx context.Context) (<-chan types.BlockEvent, error) {
+	url := fmt.Sprintf("%s/eth/v1/events?topics=block", c.baseURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+
+	resp, err := c.doSingleRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to block events: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to subscribe to block events: unexpected status code %d", resp.StatusCode)
+	}
+
+	eventChan := make(chan types.BlockEvent, 100)
+
+	go func() {
+		defer close(eventChan)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+
+			var event struct {
+				Slot  int64  `json:"slot,string"`
+				Block string `json:"block"`
+			}
+			if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &event); err != nil {
+				continue
+			}
+
+			select {
+			case eventChan <- types.BlockEvent{Slot: int(event.Slot), Block: event.Block, Timestamp: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return eventChan, nil
+}
@@ -0,0 +1,331 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// validatorIDsPerRequest bounds the number of validator IDs sent in a single state query
+const validatorIDsPerRequest = 100
+
+// GetValidatorBalances retrieves balances at the first slot of an epoch
+func (c *BeaconClientImpl) GetValidatorBalances(ctx context.Context, epoch int, indices []int) ([]types.ValidatorEpochBalance, error) {
+	balances := make([]types.ValidatorEpochBalance, 0, len(indices))
+
+	for start := 0; start < len(indices); start += validatorIDsPerRequest {
+		end := start + validatorIDsPerRequest
+		if end > len(indices) {
+			end = len(indices)
+		}
+
+		url := fmt.Sprintf("%s/eth/v1/beacon/states/%d/validators?id=%s", c.baseURL, epoch*types.SlotsPerEpoch, joinIndices(indices[start:end]))
+
+		var result struct {
+			Data []struct {
+				Index     int64  `json:"index,string"`
+				Balance   int64  `json:"balance,string"`
+				Status    string `json:"status"`
+				Validator struct {
+					EffectiveBalance int64 `json:"effective_balance,string"`
+					Slashed          bool  `json:"slashed"`
+				} `json:"validator"`
+			} `json:"data"`
+		}
+
+		found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validator balances at epoch %d: %w", epoch, err)
+		}
+		if !found {
+			return nil, fmt.Errorf("validator balances at epoch %d: %w", epoch, types.ErrStateUnavailable)
+		}
+
+		for _, v := range result.Data {
+			balances = append(balances, types.ValidatorEpochBalance{
+				Index:            int(v.Index),
+				Balance:          v.Balance,
+				EffectiveBalance: v.Validator.EffectiveBalance,
+				Slashed:          v.Validator.Slashed,
+				Status:           v.Status,
+			})
+		}
+	}
+
+	return balances, nil
+}
+
+// GetAttestationRewards retrieves attestation rewards for an epoch
+func (c *BeaconClientImpl) GetAttestationRewards(ctx context.Context, epoch int, indices []int) (*types.AttestationRewards, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/rewards/attestations/%d", c.baseURL, epoch)
+
+	var result struct {
+		Data struct {
+			IdealRewards []struct {
+				EffectiveBalance int64 `json:"effective_balance,string"`
+				Head             int64 `json:"head,string"`
+				Target           int64 `json:"target,string"`
+				Source           int64 `json:"source,string"`
+				InclusionDelay   int64 `json:"inclusion_delay,string"`
+				Inactivity       int64 `json:"inactivity,string"`
+			} `json:"ideal_rewards"`
+			TotalRewards []struct {
+				ValidatorIndex int64 `json:"validator_index,string"`
+				Head           int64 `json:"head,string"`
+				Target         int64 `json:"target,string"`
+				Source         int64 `json:"source,string"`
+				InclusionDelay int64 `json:"inclusion_delay,string"`
+				Inactivity     int64 `json:"inactivity,string"`
+			} `json:"total_rewards"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodPost, url, indexStrings(indices), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attestation rewards for epoch %d: %w", epoch, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("attestation rewards for epoch %d: %w", epoch, types.ErrStateUnavailable)
+	}
+
+	rewards := &types.AttestationRewards{}
+	for _, r := range result.Data.IdealRewards {
+		rewards.IdealRewards = append(rewards.IdealRewards, types.IdealAttestationReward{
+			EffectiveBalance: r.EffectiveBalance,
+			Head:             r.Head,
+			Target:           r.Target,
+			Source:           r.Source,
+			InclusionDelay:   r.InclusionDelay,
+			Inactivity:       r.Inactivity,
+		})
+	}
+	for _, r := range result.Data.TotalRewards {
+		rewards.TotalRewards = append(rewards.TotalRewards, types.ValidatorAttestationReward{
+			ValidatorIndex: int(r.ValidatorIndex),
+			Head:           r.Head,
+			Target:         r.Target,
+			Source:         r.Source,
+			InclusionDelay: r.InclusionDelay,
+			Inactivity:     r.Inactivity,
+		})
+	}
+
+	return rewards, nil
+}
+
+// GetBlockRewards retrieves the proposer reward for the block at a slot
+func (c *BeaconClientImpl) GetBlockRewards(ctx context.Context, slot int) (*types.BlockReward, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/rewards/blocks/%d", c.baseURL, slot)
+
+	var result struct {
+		Data struct {
+			ProposerIndex int64 `json:"proposer_index,string"`
+			Total         int64 `json:"total,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block rewards for slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &types.BlockReward{
+		Slot:          slot,
+		ProposerIndex: int(result.Data.ProposerIndex),
+		Total:         result.Data.Total,
+	}, nil
+}
+
+// GetSyncCommitteeRewards retrieves sync committee rewards for the block at a slot
+func (c *BeaconClientImpl) GetSyncCommitteeRewards(ctx context.Context, slot int, indices []int) ([]types.SyncCommitteeReward, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/rewards/sync_committee/%d", c.baseURL, slot)
+
+	var result struct {
+		Data []struct {
+			ValidatorIndex int64 `json:"validator_index,string"`
+			Reward         int64 `json:"reward,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodPost, url, indexStrings(indices), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync committee rewards for slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	rewards := make([]types.SyncCommitteeReward, 0, len(result.Data))
+	for _, r := range result.Data {
+		rewards = append(rewards, types.SyncCommitteeReward{
+			ValidatorIndex: int(r.ValidatorIndex),
+			Reward:         r.Reward,
+		})
+	}
+
+	return rewards, nil
+}
+
+// GetPendingDeposits retrieves the deposit queue in the state at a slot
+func (c *BeaconClientImpl) GetPendingDeposits(ctx context.Context, slot int) ([]types.PendingDeposit, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/%d/pending_deposits", c.baseURL, slot)
+
+	var result struct {
+		Data []struct {
+			Pubkey                string `json:"pubkey"`
+			WithdrawalCredentials string `json:"withdrawal_credentials"`
+			Amount                int64  `json:"amount,string"`
+			Signature             string `json:"signature"`
+			Slot                  int64  `json:"slot,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending deposits at slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("pending deposits at slot %d: %w", slot, types.ErrStateUnavailable)
+	}
+
+	deposits := make([]types.PendingDeposit, len(result.Data))
+	for i, d := range result.Data {
+		deposits[i] = types.PendingDeposit{
+			Pubkey:                d.Pubkey,
+			WithdrawalCredentials: d.WithdrawalCredentials,
+			Amount:                d.Amount,
+			Signature:             d.Signature,
+			Slot:                  d.Slot,
+		}
+	}
+
+	return deposits, nil
+}
+
+// GetPendingPartialWithdrawals retrieves the partial withdrawal queue in the state at a slot
+func (c *BeaconClientImpl) GetPendingPartialWithdrawals(ctx context.Context, slot int) ([]types.PendingPartialWithdrawal, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/%d/pending_partial_withdrawals", c.baseURL, slot)
+
+	var result struct {
+		Data []struct {
+			ValidatorIndex    int64 `json:"validator_index,string"`
+			Amount            int64 `json:"amount,string"`
+			WithdrawableEpoch int64 `json:"withdrawable_epoch,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending partial withdrawals at slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("pending partial withdrawals at slot %d: %w", slot, types.ErrStateUnavailable)
+	}
+
+	withdrawals := make([]types.PendingPartialWithdrawal, len(result.Data))
+	for i, w := range result.Data {
+		withdrawals[i] = types.PendingPartialWithdrawal{
+			ValidatorIndex:    w.ValidatorIndex,
+			Amount:            w.Amount,
+			WithdrawableEpoch: w.WithdrawableEpoch,
+		}
+	}
+
+	return withdrawals, nil
+}
+
+// GetPendingConsolidations retrieves the consolidation queue in the state at a slot
+func (c *BeaconClientImpl) GetPendingConsolidations(ctx context.Context, slot int) ([]types.PendingConsolidation, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/%d/pending_consolidations", c.baseURL, slot)
+
+	var result struct {
+		Data []struct {
+			SourceIndex int64 `json:"source_index,string"`
+			TargetIndex int64 `json:"target_index,string"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending consolidations at slot %d: %w", slot, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("pending consolidations at slot %d: %w", slot, types.ErrStateUnavailable)
+	}
+
+	consolidations := make([]types.PendingConsolidation, len(result.Data))
+	for i, p := range result.Data {
+		consolidations[i] = types.PendingConsolidation{
+			SourceIndex: p.SourceIndex,
+			TargetIndex: p.TargetIndex,
+		}
+	}
+
+	return consolidations, nil
+}
+
+// fetchJSON sends a request with an optional JSON body and decodes the "data" envelope into out.
+// It reports false, with no error, when the node responds 404.
+func (c *BeaconClientImpl) fetchJSON(ctx context.Context, method, url string, body interface{}, out interface{}) (bool, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return false, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return true, nil
+}
+
+// indexStrings converts validator indices to the string form the beacon API expects in request bodies
+func indexStrings(indices []int) []string {
+	ids := make([]string, len(indices))
+	for i, index := range indices {
+		ids[i] = strconv.Itoa(index)
+	}
+	return ids
+}
+
+// joinIndices formats validator indices as a comma-separated query parameter
+func joinIndices(indices []int) string {
+	return strings.Join(indexStrings(indices), ",")
+}
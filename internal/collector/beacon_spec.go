@@ -0,0 +1,86 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// specForks are the forks read from the chain spec, in activation order
+var specForks = []string{
+	types.ForkAltair,
+	types.ForkBellatrix,
+	types.ForkCapella,
+	types.ForkDeneb,
+	types.ForkElectra,
+	types.ForkFulu,
+}
+
+// GetForkSchedule retrieves the scheduled forks from the node's chain spec. Forks the spec does
+// not know, or schedules at the far future epoch, are left out.
+func (c *BeaconClientImpl) GetForkSchedule(ctx context.Context) ([]types.Fork, error) {
+	url := fmt.Sprintf("%s/eth/v1/config/spec", c.baseURL)
+
+	var result struct {
+		Data map[string]string `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain spec: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("beacon node returned no chain spec")
+	}
+
+	var forks []types.Fork
+	for _, name := range specForks {
+		prefix := strings.ToUpper(name)
+		version, ok := result.Data[prefix+"_FORK_VERSION"]
+		if !ok {
+			continue
+		}
+		epoch, err := strconv.ParseUint(result.Data[prefix+"_FORK_EPOCH"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s_FORK_EPOCH in chain spec: %w", prefix, err)
+		}
+		if epoch >= math.MaxInt64 {
+			continue // FAR_FUTURE_EPOCH: not scheduled
+		}
+		forks = append(forks, types.Fork{Name: name, Version: version, Epoch: int64(epoch)})
+	}
+
+	return forks, nil
+}
+
+// GetGenesis retrieves the genesis time, validators root and fork version of the node's chain
+func (c *BeaconClientImpl) GetGenesis(ctx context.Context) (*types.Genesis, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/genesis", c.baseURL)
+
+	var result struct {
+		Data struct {
+			GenesisTime           int64  `json:"genesis_time,string"`
+			GenesisValidatorsRoot string `json:"genesis_validators_root"`
+			GenesisForkVersion    string `json:"genesis_fork_version"`
+		} `json:"data"`
+	}
+
+	found, err := c.fetchJSON(ctx, http.MethodGet, url, nil, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genesis: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("beacon node returned no genesis")
+	}
+
+	return &types.Genesis{
+		Time:           result.Data.GenesisTime,
+		ValidatorsRoot: result.Data.GenesisValidatorsRoot,
+		ForkVersion:    result.Data.GenesisForkVersion,
+	}, nil
+}
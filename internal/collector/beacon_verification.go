@@ -0,0 +1,402 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// maxLightClientUpdates is the number of sync committee periods requested at once
+const maxLightClientUpdates = 128
+
+var (
+	beaconVerified = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "beacon_light_client_verified",
+			Help: "Whether the beacon node's data matched the chain verified from the trusted checkpoint on the last check (1 = verified, 0 = failed)",
+		},
+	)
+
+	beaconVerifiedSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "beacon_light_client_finalized_slot",
+			Help: "Latest finalized slot verified against sync committee signatures",
+		},
+	)
+
+	beaconVerifications = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "beacon_light_client_checks_total",
+			Help: "Total light client checks of the beacon node by result (verified, failed, error)",
+		},
+		[]string{"result"},
+	)
+)
+
+// BeaconVerificationConfig contains configuration for the beacon verification job
+type BeaconVerificationConfig struct {
+	TrustedCheckpoint     string // Root of a finalized block the chain is verified from
+	Forks                 []types.Fork
+	GenesisValidatorsRoot string
+	AncestorDepth         int // Blocks before each newly verified finalized block checked by parent root
+}
+
+// DefaultBeaconVerificationConfig returns default beacon verification configuration
+func DefaultBeaconVerificationConfig() *BeaconVerificationConfig {
+	return &BeaconVerificationConfig{
+		Forks:                 types.MainnetForks,
+		GenesisValidatorsRoot: types.MainnetGenesisValidatorsRoot,
+		AncestorDepth:         2 * types.SlotsPerEpoch,
+	}
+}
+
+// BeaconTrust records whether the beacon node's data passed light client verification. A beacon
+// client sharing it in reject mode refuses to return data while verification is failing.
+type BeaconTrust struct {
+	reject bool
+
+	mu      sync.RWMutex
+	failure error // Nil while the node's data verifies
+}
+
+// NewBeaconTrust creates a trust record; reject makes failed verification block beacon requests
+func NewBeaconTrust(reject bool) *BeaconTrust {
+	return &BeaconTrust{reject: reject}
+}
+
+// Allow returns ErrBeaconUntrusted in reject mode if the node's data failed its last verification
+func (t *BeaconTrust) Allow() error {
+	if !t.reject {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.failure != nil {
+		return fmt.Errorf("%w: %v", types.ErrBeaconUntrusted, t.failure)
+	}
+	return nil
+}
+
+// Failure returns the reason the node's data failed its last verification, or nil
+func (t *BeaconTrust) Failure() error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.failure
+}
+
+// record stores the outcome of a verification
+func (t *BeaconTrust) record(failure error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failure = failure
+}
+
+// BeaconVerification summarises the last check for the health monitor
+type BeaconVerification struct {
+	Status        string // "healthy", "unhealthy" when verification failed, "degraded" when it could not run, "unknown" before the first check
+	Message       string
+	FinalizedSlot int64 // Latest verified finalized slot
+	CheckedAt     time.Time
+}
+
+// BeaconVerificationJob verifies the beacon node against the light client sync protocol. From a
+// trusted checkpoint it follows the sync committees, verifying each finalized header against their
+// signatures. It then checks the block roots and headers the node reports, which the collectors
+// rely on, at the verified finalized block and its ancestors, found by following parent roots. Data
+// that fails verification raises a critical alert and marks the node untrusted, which makes
+// clients sharing the BeaconTrust in reject mode refuse it until verification passes again.
+type BeaconVerificationJob struct {
+	client    types.LightClient
+	trust     *BeaconTrust
+	alertRepo *repository.AlertRepository
+	config    *BeaconVerificationConfig
+
+	store  *LightClientStore // Nil until the checkpoint is bootstrapped
+	open   *int32            // Open alert ID
+	loaded bool              // Whether the open alert has been loaded
+
+	mu     sync.RWMutex
+	status BeaconVerification
+}
+
+// NewBeaconVerificationJob creates a new beacon verification job
+func NewBeaconVerificationJob(client types.LightClient, trust *BeaconTrust, pool *pgxpool.Pool, config *BeaconVerificationConfig) *BeaconVerificationJob {
+	return &BeaconVerificationJob{
+		client:    client,
+		trust:     trust,
+		alertRepo: repository.NewAlertRepository(pool),
+		config:    config,
+		status:    BeaconVerification{Status: "unknown"},
+	}
+}
+
+// Status returns the summary of the last check
+func (j *BeaconVerificationJob) Status() BeaconVerification {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.status
+}
+
+// RunOnce verifies the node's latest finalized header and records the outcome. Data that fails
+// verification is returned as an error wrapping ErrVerificationFailed after the node is flagged;
+// other errors leave the previous outcome in place.
+func (j *BeaconVerificationJob) RunOnce(ctx context.Context) error {
+	finalized, err := j.verify(ctx)
+	now := time.Now()
+
+	switch {
+	case err == nil:
+		beaconVerifications.WithLabelValues("verified").Inc()
+		beaconVerified.Set(1)
+		beaconVerifiedSlot.Set(float64(finalized.Slot))
+		j.trust.record(nil)
+		j.setStatus(BeaconVerification{
+			Status:        "healthy",
+			Message:       fmt.Sprintf("Finalized slot %d verified", finalized.Slot),
+			FinalizedSlot: finalized.Slot,
+			CheckedAt:     now,
+		})
+	case errors.Is(err, types.ErrVerificationFailed):
+		beaconVerifications.WithLabelValues("failed").Inc()
+		beaconVerified.Set(0)
+		j.trust.record(err)
+		j.setStatus(BeaconVerification{
+			Status:        "unhealthy",
+			Message:       err.Error(),
+			FinalizedSlot: j.Status().FinalizedSlot,
+			CheckedAt:     now,
+		})
+	default:
+		beaconVerifications.WithLabelValues("error").Inc()
+		status := j.Status()
+		if status.Status != "unhealthy" {
+			status.Status = "degraded"
+			status.Message = err.Error()
+		}
+		status.CheckedAt = now
+		j.setStatus(status)
+		return err
+	}
+
+	j.syncAlert(ctx, err)
+	return err
+}
+
+// verify brings the light client store up to the node's latest finality update and checks the
+// verified finalized block and its ancestors against the node's canonical chain
+func (j *BeaconVerificationJob) verify(ctx context.Context) (types.BeaconBlockHeader, error) {
+	if j.store == nil {
+		bootstrap, err := j.client.GetLightClientBootstrap(ctx, j.config.TrustedCheckpoint)
+		if err != nil {
+			return types.BeaconBlockHeader{}, err
+		}
+		if bootstrap == nil {
+			return types.BeaconBlockHeader{}, fmt.Errorf("beacon node serves no light client bootstrap for checkpoint %s", j.config.TrustedCheckpoint)
+		}
+		store, err := NewLightClientStore(j.config.TrustedCheckpoint, bootstrap, j.config.Forks, j.config.GenesisValidatorsRoot)
+		if err != nil {
+			return types.BeaconBlockHeader{}, err
+		}
+		j.store = store
+	}
+
+	previous := j.store.Finalized()
+
+	update, err := j.client.GetLightClientFinalityUpdate(ctx)
+	if err != nil {
+		return types.BeaconBlockHeader{}, err
+	}
+	if update == nil {
+		return types.BeaconBlockHeader{}, fmt.Errorf("beacon node serves no light client finality update")
+	}
+
+	// Catch up on the sync committees up to the one that signed the finality update
+	target := syncCommitteePeriod(update.SignatureSlot)
+	if period := j.store.Period(); period < target || !j.store.NextSyncCommitteeKnown() {
+		updates, err := j.client.GetLightClientUpdates(ctx, period, min(target-period+1, maxLightClientUpdates))
+		if err != nil {
+			return types.BeaconBlockHeader{}, err
+		}
+		for i := range updates {
+			if err := j.store.Apply(&updates[i]); err != nil {
+				return types.BeaconBlockHeader{}, fmt.Errorf("failed to apply light client update for period %d: %w", syncCommitteePeriod(updates[i].AttestedHeader.Slot), err)
+			}
+		}
+	}
+	if err := j.store.Apply(update); err != nil {
+		return types.BeaconBlockHeader{}, fmt.Errorf("failed to apply light client finality update: %w", err)
+	}
+
+	finalized := j.store.Finalized()
+	if err := j.checkCanonical(ctx, finalized); err != nil {
+		return types.BeaconBlockHeader{}, err
+	}
+	if err := j.checkAncestors(ctx, finalized, previous); err != nil {
+		return types.BeaconBlockHeader{}, err
+	}
+
+	return finalized, nil
+}
+
+// checkCanonical checks that the canonical block root and header the node reports at a verified
+// block's slot are those of the verified block
+func (j *BeaconVerificationJob) checkCanonical(ctx context.Context, header types.BeaconBlockHeader) error {
+	want, err := headerRoot(header)
+	if err != nil {
+		return err
+	}
+	verified := encodeRoot(want)
+
+	root, found, err := j.client.GetBlockRoot(ctx, int(header.Slot))
+	if err != nil {
+		return err
+	}
+	if !found || !strings.EqualFold(root, verified) {
+		return verificationError("canonical block at slot %d is %q, verified %s", header.Slot, root, verified)
+	}
+
+	headers, err := j.client.GetBlockHeaders(ctx, int(header.Slot))
+	if err != nil {
+		return err
+	}
+	for _, h := range headers {
+		if !h.Canonical {
+			continue
+		}
+		if !strings.EqualFold(h.Root, verified) || int64(h.ProposerIndex) != header.ProposerIndex {
+			return verificationError("canonical header at slot %d is %s by proposer %d, verified %s by proposer %d",
+				header.Slot, h.Root, h.ProposerIndex, verified, header.ProposerIndex)
+		}
+		return nil
+	}
+	return verificationError("no canonical header at slot %d, verified %s", header.Slot, verified)
+}
+
+// checkAncestors follows parent roots back from a verified block, up to AncestorDepth blocks or to
+// the previously verified finalized block, checking each ancestor against the node's canonical
+// chain. An ancestor's header is authenticated by hashing it to the root its child names, which
+// makes its own parent root trustworthy in turn. A chain that reaches the previously verified slot
+// without reaching its block has forked from it.
+func (j *BeaconVerificationJob) checkAncestors(ctx context.Context, from, previous types.BeaconBlockHeader) error {
+	want, err := headerRoot(previous)
+	if err != nil {
+		return err
+	}
+
+	header := from
+	for depth := 0; depth < j.config.AncestorDepth && header.Slot > previous.Slot; depth++ {
+		root := header.ParentRoot
+		parent, err := j.client.GetBlockHeader(ctx, root)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return fmt.Errorf("beacon node serves no header for block %s, the parent of slot %d", root, header.Slot)
+		}
+
+		got, err := headerRoot(*parent)
+		if err != nil || !strings.EqualFold(encodeRoot(got), root) {
+			return verificationError("header served for block %s, the parent of slot %d, does not hash to its root", root, header.Slot)
+		}
+		if parent.Slot >= header.Slot {
+			return verificationError("parent of slot %d is at slot %d", header.Slot, parent.Slot)
+		}
+		if parent.Slot <= previous.Slot {
+			if got != want {
+				return verificationError("chain verified at slot %d does not descend from the block verified at slot %d", from.Slot, previous.Slot)
+			}
+			return nil
+		}
+
+		if err := j.checkCanonical(ctx, *parent); err != nil {
+			return err
+		}
+		header = *parent
+	}
+
+	return nil
+}
+
+// setStatus replaces the summary of the last check
+func (j *BeaconVerificationJob) setStatus(status BeaconVerification) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status = status
+}
+
+// syncAlert raises the alert for a failed verification unless one is open, and resolves the open
+// one once verification passes. The open alert is loaded from the database on the first call, so
+// that a restart neither duplicates nor orphans it. Failures are logged and retried on the next run.
+func (j *BeaconVerificationJob) syncAlert(ctx context.Context, failure error) {
+	if !j.loaded {
+		alertType := string(types.AlertTypeBeaconUnverified)
+		status := models.AlertStatusNew
+		alerts, err := j.alertRepo.ListAlerts(ctx, &models.AlertFilter{
+			AlertType: &alertType,
+			Status:    &status,
+		})
+		if err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Msg("Failed to load beacon verification alert")
+			return
+		}
+		if len(alerts) > 0 {
+			j.open = &alerts[0].ID
+		}
+		j.loaded = true
+	}
+
+	switch {
+	case failure != nil && j.open == nil:
+		alert := beaconVerificationAlert(j.config.TrustedCheckpoint, j.trust.reject, failure)
+		if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Msg("Failed to create beacon verification alert")
+			return
+		}
+		j.open = &alert.ID
+	case failure == nil && j.open != nil:
+		if err := j.alertRepo.ResolveAlert(ctx, *j.open); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Int32("alert_id", *j.open).
+				Msg("Failed to resolve beacon verification alert")
+			return
+		}
+		j.open = nil
+	}
+}
+
+// beaconVerificationAlert builds the critical alert for beacon data that failed verification
+func beaconVerificationAlert(checkpoint string, reject bool, failure error) *models.Alert {
+	action := "Its data is still used for reporting"
+	if reject {
+		action = "Its data is rejected until verification passes"
+	}
+	return &models.Alert{
+		AlertType: string(types.AlertTypeBeaconUnverified),
+		Severity:  models.SeverityCritical,
+		Title:     "Beacon node data failed light client verification",
+		Message:   fmt.Sprintf("%s. %s.", failure, action),
+		Source:    "beacon_verification",
+		Details: models.JSONB{
+			"trusted_checkpoint": checkpoint,
+			"rejected":           reject,
+			"reason":             failure.Error(),
+		},
+		Status: models.AlertStatusNew,
+	}
+}
@@ -0,0 +1,449 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The light client fixtures follow a chain signed by sync committees of BLS test keys from a
+// checkpoint in period 1450, through the committee rotation, to a finalized block in period 1451
+const (
+	fixtureCheckpoint    = "0x503fd40765420290ae70fb16a24e1ea9d423cfa8ba8c73d3ae2d0ab0df98f7ca"
+	fixtureFinalizedSlot = 11886784
+)
+
+// lightClientFixtures maps the beacon API paths the stand-in node serves to fixture files. The
+// bootstrap is served for any block root.
+var lightClientFixtures = map[string]string{
+	"/eth/v1/beacon/light_client/bootstrap":       "bootstrap.json",
+	"/eth/v1/beacon/light_client/updates":         "updates.json",
+	"/eth/v1/beacon/light_client/finality_update": "finality_update.json",
+	"/eth/v1/beacon/blocks/11886784/root":         "block_root.json",
+	"/eth/v1/beacon/headers":                      "headers.json",
+}
+
+// newStandInLightClientNode serves the light client fixtures, passing each decoded response
+// through tamper first when it is set. Requested paths are appended to requests.
+func newStandInLightClientNode(t *testing.T, tamper func(path string, doc interface{}), requests *[]string) *httptest.Server {
+	t.Helper()
+
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			*requests = append(*requests, r.URL.RequestURI())
+		}
+		path := r.URL.Path
+		if strings.HasPrefix(path, "/eth/v1/beacon/light_client/bootstrap/") {
+			path = "/eth/v1/beacon/light_client/bootstrap"
+		}
+		name, ok := lightClientFixtures[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		raw, err := os.ReadFile(filepath.Join("testdata", "light_client", name))
+		require.NoError(t, err)
+		if tamper != nil {
+			var doc interface{}
+			require.NoError(t, json.Unmarshal(raw, &doc))
+			tamper(r.URL.Path, doc)
+			raw, err = json.Marshal(doc)
+			require.NoError(t, err)
+		}
+		w.Write(raw)
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+// newFixtureVerificationJob creates a verification job against a stand-in node, without alerts.
+// The fixtures hold no ancestors of the finalized blocks, so none are checked.
+func newFixtureVerificationJob(url, checkpoint string, trust *BeaconTrust) *BeaconVerificationJob {
+	config := DefaultBeaconVerificationConfig()
+	config.TrustedCheckpoint = checkpoint
+	config.AncestorDepth = 0
+	return &BeaconVerificationJob{
+		client: NewBeaconClientWithoutRetry(url, 5*time.Second),
+		trust:  trust,
+		config: config,
+	}
+}
+
+// field walks a decoded JSON document along a path of object keys and array indices
+func field(doc interface{}, path ...interface{}) map[string]interface{} {
+	for _, step := range path {
+		switch key := step.(type) {
+		case string:
+			doc = doc.(map[string]interface{})[key]
+		case int:
+			doc = doc.([]interface{})[key]
+		}
+	}
+	return doc.(map[string]interface{})
+}
+
+func TestBeaconVerification_VerifiesFixtureChain(t *testing.T) {
+	var requests []string
+	node := newStandInLightClientNode(t, nil, &requests)
+	job := newFixtureVerificationJob(node.URL, fixtureCheckpoint, NewBeaconTrust(true))
+
+	finalized, err := job.verify(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(fixtureFinalizedSlot), finalized.Slot)
+	assert.Equal(t, int64(1451), job.store.Period(), "the store follows the committee rotation")
+	assert.True(t, job.store.NextSyncCommitteeKnown())
+	assert.Contains(t, requests, "/eth/v1/beacon/light_client/updates?start_period=1450&count=2")
+
+	requests = nil
+	_, err = job.verify(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/eth/v1/beacon/light_client/finality_update",
+		"/eth/v1/beacon/blocks/11886784/root",
+		"/eth/v1/beacon/headers?slot=11886784",
+	}, requests, "a synced store only needs the latest finality update")
+}
+
+func TestBeaconVerification_FlagsDataThatFailsVerification(t *testing.T) {
+	tests := []struct {
+		name       string
+		checkpoint string
+		tamper     func(path string, doc interface{})
+		reason     string
+	}{
+		{
+			name:       "bootstrap for another checkpoint",
+			checkpoint: "0x" + strings.Repeat("ab", 32),
+			reason:     "does not match trusted checkpoint",
+		},
+		{
+			name: "next sync committee swapped",
+			tamper: func(path string, doc interface{}) {
+				if strings.HasSuffix(path, "/updates") {
+					committee := field(doc, 0, "data", "next_sync_committee")
+					pubkeys := committee["pubkeys"].([]interface{})
+					pubkeys[0], pubkeys[1] = pubkeys[1], pubkeys[0]
+				}
+			},
+			reason: "next sync committee proof is invalid",
+		},
+		{
+			name: "finalized header forged",
+			tamper: func(path string, doc interface{}) {
+				if strings.HasSuffix(path, "/finality_update") {
+					field(doc, "data", "finalized_header", "beacon")["body_root"] = "0x" + strings.Repeat("00", 32)
+				}
+			},
+			reason: "finality proof is invalid",
+		},
+		{
+			name: "attested header not signed by the committee",
+			tamper: func(path string, doc interface{}) {
+				if strings.HasSuffix(path, "/finality_update") {
+					field(doc, "data", "attested_header", "beacon")["proposer_index"] = "1"
+				}
+			},
+			reason: "sync committee signature over slot 11886856 is invalid",
+		},
+		{
+			name: "canonical chain diverges from the verified one",
+			tamper: func(path string, doc interface{}) {
+				if strings.HasSuffix(path, "/root") {
+					field(doc, "data")["root"] = "0x" + strings.Repeat("cd", 32)
+				}
+			},
+			reason: "canonical block at slot 11886784",
+		},
+		{
+			name: "canonical header names another proposer",
+			tamper: func(path string, doc interface{}) {
+				if path == "/eth/v1/beacon/headers" {
+					field(doc, "data", 0, "header", "message")["proposer_index"] = "785"
+				}
+			},
+			reason: "canonical header at slot 11886784",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkpoint := fixtureCheckpoint
+			if tt.checkpoint != "" {
+				checkpoint = tt.checkpoint
+			}
+			node := newStandInLightClientNode(t, tt.tamper, nil)
+			job := newFixtureVerificationJob(node.URL, checkpoint, NewBeaconTrust(true))
+
+			_, err := job.verify(context.Background())
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, types.ErrVerificationFailed), "got %v", err)
+			assert.Contains(t, err.Error(), tt.reason)
+		})
+	}
+}
+
+func TestLightClientStore_AppliesUpdates(t *testing.T) {
+	node := newStandInLightClientNode(t, nil, nil)
+	client := NewBeaconClientWithoutRetry(node.URL, 5*time.Second)
+	ctx := context.Background()
+
+	bootstrap, err := client.GetLightClientBootstrap(ctx, fixtureCheckpoint)
+	require.NoError(t, err)
+	store, err := NewLightClientStore(fixtureCheckpoint, bootstrap, types.MainnetForks, types.MainnetGenesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11878464), store.Finalized().Slot)
+	assert.False(t, store.NextSyncCommitteeKnown())
+
+	updates, err := client.GetLightClientUpdates(ctx, 1450, 2)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.NotNil(t, updates[0].NextSyncCommittee)
+	require.NotNil(t, updates[0].FinalizedHeader)
+
+	// The period 1451 update is signed by a committee the store only learns from the first
+	err = store.Apply(&updates[1])
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, types.ErrVerificationFailed), "an update the store cannot check yet is not a failure")
+
+	require.NoError(t, store.Apply(&updates[0]))
+	assert.Equal(t, int64(11886336), store.Finalized().Slot)
+	assert.True(t, store.NextSyncCommitteeKnown())
+
+	require.NoError(t, store.Apply(&updates[1]))
+	assert.Equal(t, int64(11886720), store.Finalized().Slot)
+	assert.Equal(t, int64(1451), store.Period())
+
+	update, err := client.GetLightClientFinalityUpdate(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.Nil(t, update.NextSyncCommittee, "finality updates carry no sync committee")
+
+	forged := *update
+	forged.SyncAggregate.SyncCommitteeBits = "0x01" + strings.Repeat("00", 63)
+	assert.ErrorIs(t, store.Apply(&forged), types.ErrVerificationFailed, "the signature does not match the claimed signers")
+	assert.Equal(t, int64(11886720), store.Finalized().Slot, "a failed update leaves the store unchanged")
+
+	raw, err := os.ReadFile(filepath.Join("testdata", "light_client", "finality_update_minority.json"))
+	require.NoError(t, err)
+	var minority struct {
+		Data lightClientUpdateData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &minority))
+	minorityUpdate := lightClientUpdate(minority.Data)
+	require.NoError(t, store.Apply(&minorityUpdate), "300 of 512 members signed validly")
+	assert.Equal(t, int64(11886720), store.Finalized().Slot, "a minority of the committee cannot finalize")
+
+	require.NoError(t, store.Apply(update))
+	assert.Equal(t, int64(fixtureFinalizedSlot), store.Finalized().Slot)
+}
+
+func TestBeaconTrust_RejectsRequestsWhileUnverified(t *testing.T) {
+	node := newStandInLightClientNode(t, nil, nil)
+	failure := errors.New("finality proof is invalid")
+
+	flagOnly := NewBeaconTrust(false)
+	flagOnly.record(failure)
+	assert.NoError(t, flagOnly.Allow(), "without reject mode a failure is only flagged")
+	assert.Equal(t, failure, flagOnly.Failure())
+
+	trust := NewBeaconTrust(true)
+	client := NewBeaconClientWithConfig(BeaconClientConfig{BaseURL: node.URL, Timeout: 5 * time.Second, Trust: trust})
+
+	_, found, err := client.GetBlockRoot(context.Background(), fixtureFinalizedSlot)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	trust.record(failure)
+	_, _, err = client.GetBlockRoot(context.Background(), fixtureFinalizedSlot)
+	assert.ErrorIs(t, err, types.ErrBeaconUntrusted)
+
+	trust.record(nil)
+	_, _, err = client.GetBlockRoot(context.Background(), fixtureFinalizedSlot)
+	assert.NoError(t, err)
+}
+
+// ancestorChain is a stand-in node's chain: headers by root, the canonical root by slot, and
+// proposers its headers endpoint misreports by slot
+type ancestorChain struct {
+	byRoot    map[string]types.BeaconBlockHeader
+	canonical map[int64]string
+	proposers map[int64]int64
+}
+
+// newAncestorChain links headers at the given slots by parent root, returning the chain and its
+// headers oldest first
+func newAncestorChain(t *testing.T, slots ...int64) (*ancestorChain, []types.BeaconBlockHeader) {
+	t.Helper()
+
+	chain := &ancestorChain{
+		byRoot:    map[string]types.BeaconBlockHeader{},
+		canonical: map[int64]string{},
+		proposers: map[int64]int64{},
+	}
+	var headers []types.BeaconBlockHeader
+	parent := "0x" + strings.Repeat("00", 32)
+	for _, slot := range slots {
+		header := types.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: slot * 3,
+			ParentRoot:    parent,
+			StateRoot:     "0x" + strings.Repeat("aa", 32),
+			BodyRoot:      "0x" + strings.Repeat("bb", 32),
+		}
+		root, err := headerRoot(header)
+		require.NoError(t, err)
+		parent = encodeRoot(root)
+		chain.byRoot[parent] = header
+		chain.canonical[slot] = parent
+		headers = append(headers, header)
+	}
+	return chain, headers
+}
+
+// serve answers the header and block root requests of the ancestor check from the chain
+func (c *ancestorChain) serve(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	entry := func(root string, h types.BeaconBlockHeader) map[string]interface{} {
+		return map[string]interface{}{
+			"root":      root,
+			"canonical": c.canonical[h.Slot] == root,
+			"header": map[string]interface{}{"message": map[string]string{
+				"slot":           strconv.FormatInt(h.Slot, 10),
+				"proposer_index": strconv.FormatInt(h.ProposerIndex, 10),
+				"parent_root":    h.ParentRoot,
+				"state_root":     h.StateRoot,
+				"body_root":      h.BodyRoot,
+			}},
+		}
+	}
+
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch {
+		case r.URL.Path == "/eth/v1/beacon/headers":
+			slot, _ := strconv.ParseInt(r.URL.Query().Get("slot"), 10, 64)
+			entries := []interface{}{}
+			for root, h := range c.byRoot {
+				if h.Slot == slot {
+					if proposer, ok := c.proposers[slot]; ok {
+						h.ProposerIndex = proposer
+					}
+					entries = append(entries, entry(root, h))
+				}
+			}
+			data = entries
+		case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/headers/"):
+			root := strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/headers/")
+			h, ok := c.byRoot[root]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			data = entry(root, h)
+		case strings.HasSuffix(r.URL.Path, "/root"):
+			slot, _ := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/blocks/"), "/root"), 10, 64)
+			root, ok := c.canonical[slot]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			data = map[string]string{"root": root}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func TestBeaconVerification_ChecksAncestorsByParentRoot(t *testing.T) {
+	newJob := func(chain *ancestorChain, depth int) *BeaconVerificationJob {
+		config := DefaultBeaconVerificationConfig()
+		config.AncestorDepth = depth
+		return &BeaconVerificationJob{client: NewBeaconClientWithoutRetry(chain.serve(t).URL, 5*time.Second), config: config}
+	}
+	ctx := context.Background()
+
+	chain, headers := newAncestorChain(t, 100, 101, 103, 104)
+	finalized, previous := headers[3], headers[0]
+	assert.NoError(t, newJob(chain, 64).checkAncestors(ctx, finalized, previous))
+	assert.NoError(t, newJob(chain, 64).checkAncestors(ctx, previous, previous), "nothing newly verified")
+
+	// A node missing a block at the end of the depth goes unnoticed, one further back does not
+	delete(chain.byRoot, chain.canonical[101])
+	assert.NoError(t, newJob(chain, 1).checkAncestors(ctx, finalized, previous))
+	err := newJob(chain, 2).checkAncestors(ctx, finalized, previous)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, types.ErrVerificationFailed), "a header the node cannot serve is not a failure")
+
+	tests := []struct {
+		name     string
+		tamper   func(chain *ancestorChain)
+		previous types.BeaconBlockHeader
+		reason   string
+	}{
+		{
+			name: "ancestor header forged",
+			tamper: func(chain *ancestorChain) {
+				root := chain.canonical[103]
+				forged := chain.byRoot[root]
+				forged.ProposerIndex = 1
+				chain.byRoot[root] = forged
+			},
+			reason: "does not hash to its root",
+		},
+		{
+			name: "another canonical block at an ancestor's slot",
+			tamper: func(chain *ancestorChain) {
+				chain.canonical[101] = "0x" + strings.Repeat("cd", 32)
+			},
+			reason: "canonical block at slot 101",
+		},
+		{
+			name: "ancestor's canonical header names another proposer",
+			tamper: func(chain *ancestorChain) {
+				chain.proposers[101] = 7
+			},
+			reason: "canonical header at slot 101",
+		},
+		{
+			name:     "chain forked from the previously verified block",
+			previous: types.BeaconBlockHeader{Slot: 100, ParentRoot: previous.ParentRoot, StateRoot: previous.StateRoot, BodyRoot: previous.BodyRoot},
+			reason:   "does not descend from the block verified at slot 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain, headers := newAncestorChain(t, 100, 101, 103, 104)
+			if tt.tamper != nil {
+				tt.tamper(chain)
+			}
+			previous := headers[0]
+			if tt.previous.Slot != 0 {
+				previous = tt.previous
+			}
+
+			err := newJob(chain, 64).checkAncestors(ctx, headers[3], previous)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, types.ErrVerificationFailed), "got %v", err)
+			assert.Contains(t, err.Error(), tt.reason)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package collector
+
+import (
+	"context"
+	"sync"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// blockStreamEpochs is the number of most recent epochs whose blocks the stream tallies by client
+// and publishes only once
+const blockStreamEpochs = 3
+
+var blockStreamDropped = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "validator_block_stream_dropped_total",
+		Help: "Total blocks not delivered to a block stream subscriber whose buffer was full",
+	},
+)
+
+// blockStream shares the blocks a beacon client fetches. The jobs that scan the chain all fetch
+// through the same client, so every block they see is published to subscribers once, and tallied
+// by the consensus client its graffiti identifies. Blocks older than the tallied epochs are
+// published each time they are fetched.
+type blockStream struct {
+	mu     sync.Mutex
+	seen   map[int]bool                            // Slots published in the tallied epochs
+	counts map[int64]map[types.ConsensusClient]int // Blocks per client in the tallied epochs
+	newest int64                                   // Newest epoch tallied
+
+	subMu       sync.RWMutex
+	subscribers map[*blockSubscriber]struct{}
+}
+
+// SubscribeToBlocks streams the blocks the client fetches for any job, each canonical block once,
+// until ctx is done. The channel is closed when ctx is done.
+func (c *BeaconClientImpl) SubscribeToBlocks(ctx context.Context) <-chan *types.Block {
+	return c.blocks.subscribe(ctx)
+}
+
+// blockSubscriber is a subscription to a block stream
+type blockSubscriber struct {
+	ch chan *types.Block
+}
+
+// newBlockStream creates an empty block stream
+func newBlockStream() *blockStream {
+	return &blockStream{
+		seen:        make(map[int]bool),
+		counts:      make(map[int64]map[types.ConsensusClient]int),
+		subscribers: make(map[*blockSubscriber]struct{}),
+	}
+}
+
+// subscribe streams published blocks until ctx is done, then closes the channel
+func (s *blockStream) subscribe(ctx context.Context) <-chan *types.Block {
+	sub := &blockSubscriber{ch: make(chan *types.Block, blockStreamEpochs*types.SlotsPerEpoch)}
+
+	s.subMu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.subMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.subMu.Lock()
+		delete(s.subscribers, sub)
+		close(sub.ch)
+		s.subMu.Unlock()
+	}()
+
+	return sub.ch
+}
+
+// publish tallies a fetched block and hands it to every subscriber, unless it was already
+// published. Delivery never waits, so a slow subscriber cannot hold up the job fetching the block;
+// a subscriber whose buffer is full misses it.
+func (s *blockStream) publish(block *types.Block) {
+	if !s.record(block) {
+		return
+	}
+
+	s.subMu.RLock()
+	defer s.subMu.RUnlock()
+	for sub := range s.subscribers {
+		select {
+		case sub.ch <- block:
+		default:
+			blockStreamDropped.Inc()
+		}
+	}
+}
+
+// record tallies a block, reporting false for one already published in the tallied epochs
+func (s *blockStream) record(block *types.Block) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	epoch := int64(block.Slot / types.SlotsPerEpoch)
+	if epoch <= s.newest-blockStreamEpochs {
+		return true // Too old to tell whether it was published
+	}
+	if s.seen[block.Slot] {
+		return false
+	}
+
+	if epoch > s.newest {
+		s.newest = epoch
+		for e := range s.counts {
+			if e <= s.newest-blockStreamEpochs {
+				delete(s.counts, e)
+			}
+		}
+		for slot := range s.seen {
+			if int64(slot/types.SlotsPerEpoch) <= s.newest-blockStreamEpochs {
+				delete(s.seen, slot)
+			}
+		}
+	}
+
+	s.seen[block.Slot] = true
+	if s.counts[epoch] == nil {
+		s.counts[epoch] = make(map[types.ConsensusClient]int)
+	}
+	s.counts[epoch][types.FingerprintGraffiti(block.Graffiti).Client]++
+	return true
+}
+
+// diversity returns the client distribution of the newest tallied epoch before the given one, or
+// nil when no block of such an epoch was fetched
+func (s *blockStream) diversity(before int64) *types.ClientDiversity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest := int64(-1)
+	for epoch := range s.counts {
+		if epoch < before && epoch > latest {
+			latest = epoch
+		}
+	}
+	if latest < 0 {
+		return nil
+	}
+	return types.NewClientDiversity(int(latest), s.counts[latest])
+}
@@ -0,0 +1,390 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Circuit breaker components. Beacon endpoints are grouped so that a failing
+// endpoint (e.g. historical states on a pruned node) does not trip the others.
+const (
+	ComponentBeaconValidators = "beacon.validators"
+	ComponentBeaconBlocks     = "beacon.blocks"
+	ComponentBeaconEvents     = "beacon.events"
+	ComponentBeaconNode       = "beacon.node"
+	ComponentDatabase         = "database.snapshots"
+	ComponentCache            = "cache.redis"
+)
+
+// ErrCircuitOpen is returned when a call is rejected because the component's circuit is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+var (
+	circuitBreakerState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "collector_circuit_breaker_state",
+			Help: "Circuit breaker state by component (0 = closed, 1 = half-open, 2 = open)",
+		},
+		[]string{"component"},
+	)
+
+	circuitBreakerTransitions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "collector_circuit_breaker_transitions_total",
+			Help: "Total circuit breaker state transitions by component and target state",
+		},
+		[]string{"component", "state"},
+	)
+
+	circuitBreakerRejections = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "collector_circuit_breaker_rejections_total",
+			Help: "Total calls rejected by an open circuit breaker by component",
+		},
+		[]string{"component"},
+	)
+)
+
+// CircuitState represents the state of a circuit breaker
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitHalfOpen
+	CircuitOpen
+)
+
+// String returns the state name
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig contains circuit breaker thresholds for a component
+type BreakerConfig struct {
+	ErrorThreshold      int           // Consecutive failures within ErrorWindow that open the circuit
+	ErrorWindow         time.Duration // Window in which failures are counted
+	OpenTimeout         time.Duration // Time the circuit stays open before allowing probes
+	HalfOpenMaxRequests int           // Concurrent probe requests allowed while half-open
+}
+
+// DefaultBreakerConfig returns default circuit breaker thresholds
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		ErrorThreshold:      10,
+		ErrorWindow:         5 * time.Minute,
+		OpenTimeout:         30 * time.Second,
+		HalfOpenMaxRequests: 1,
+	}
+}
+
+// circuitBreaker tracks state for a single component
+type circuitBreaker struct {
+	config           BreakerConfig
+	state            CircuitState
+	failures         int
+	windowStart      time.Time
+	openedAt         time.Time
+	halfOpenInFlight int
+}
+
+// ErrorRecovery handles error recovery for the collector. It maintains a circuit
+// breaker per component and provides retries with exponential backoff.
+type ErrorRecovery struct {
+	maxRetries   int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+
+	defaults  BreakerConfig
+	overrides map[string]BreakerConfig
+	breakers  map[string]*circuitBreaker
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+// NewErrorRecovery creates a new error recovery manager with default thresholds
+func NewErrorRecovery() *ErrorRecovery {
+	return NewErrorRecoveryWithConfig(DefaultBreakerConfig(), nil)
+}
+
+// NewErrorRecoveryWithConfig creates an error recovery manager with per-component thresholds.
+// Overrides are keyed by component ("beacon.blocks") or component group ("beacon").
+func NewErrorRecoveryWithConfig(defaults BreakerConfig, overrides map[string]BreakerConfig) *ErrorRecovery {
+	if overrides == nil {
+		overrides = make(map[string]BreakerConfig)
+	}
+
+	return &ErrorRecovery{
+		maxRetries:   3,
+		retryBackoff: 1 * time.Second,
+		maxBackoff:   30 * time.Second,
+		defaults:     defaults,
+		overrides:    overrides,
+		breakers:     make(map[string]*circuitBreaker),
+		now:          time.Now,
+	}
+}
+
+// breaker returns the circuit breaker for a component, creating it if needed. Caller must hold mu.
+func (er *ErrorRecovery) breaker(component string) *circuitBreaker {
+	if cb, exists := er.breakers[component]; exists {
+		return cb
+	}
+
+	config := er.defaults
+	if override, ok := er.overrides[component]; ok {
+		config = override
+	} else if group, _, found := strings.Cut(component, "."); found {
+		if override, ok := er.overrides[group]; ok {
+			config = override
+		}
+	}
+
+	cb := &circuitBreaker{config: config, state: CircuitClosed}
+	er.breakers[component] = cb
+	circuitBreakerState.WithLabelValues(component).Set(float64(CircuitClosed))
+	return cb
+}
+
+// transition moves a breaker to a new state, logging and recording the change. Caller must hold mu.
+func (er *ErrorRecovery) transition(component string, cb *circuitBreaker, to CircuitState) {
+	from := cb.state
+	if from == to {
+		return
+	}
+
+	cb.state = to
+	cb.halfOpenInFlight = 0
+	if to == CircuitOpen {
+		cb.openedAt = er.now()
+	}
+	if to == CircuitClosed {
+		cb.failures = 0
+	}
+
+	circuitBreakerState.WithLabelValues(component).Set(float64(to))
+	circuitBreakerTransitions.WithLabelValues(component, to.String()).Inc()
+
+	event := logger.Logger.Info()
+	if to == CircuitOpen {
+		event = logger.Logger.Warn()
+	}
+	event.
+		Str("component", component).
+		Str("from", from.String()).
+		Str("to", to.String()).
+		Int("failures", cb.failures).
+		Dur("open_timeout", cb.config.OpenTimeout).
+		Msg("Circuit breaker state changed")
+}
+
+// Allow reports whether a call to the component may proceed. Every allowed call must be
+// followed by Record so half-open probes are accounted for.
+func (er *ErrorRecovery) Allow(component string) error {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	cb := er.breaker(component)
+
+	if cb.state == CircuitOpen {
+		if er.now().Sub(cb.openedAt) < cb.config.OpenTimeout {
+			circuitBreakerRejections.WithLabelValues(component).Inc()
+			return fmt.Errorf("%w for component %s", ErrCircuitOpen, component)
+		}
+		er.transition(component, cb, CircuitHalfOpen)
+	}
+
+	if cb.state == CircuitHalfOpen {
+		if cb.halfOpenInFlight >= cb.config.HalfOpenMaxRequests {
+			circuitBreakerRejections.WithLabelValues(component).Inc()
+			return fmt.Errorf("%w for component %s", ErrCircuitOpen, component)
+		}
+		cb.halfOpenInFlight++
+	}
+
+	return nil
+}
+
+// Record reports the outcome of an allowed call. Context cancellation is not
+// treated as a failure of the dependency.
+func (er *ErrorRecovery) Record(component string, err error) {
+	if err == nil {
+		er.RecordSuccess(component)
+		return
+	}
+
+	if errors.Is(err, context.Canceled) {
+		er.mu.Lock()
+		defer er.mu.Unlock()
+
+		cb := er.breaker(component)
+		if cb.state == CircuitHalfOpen && cb.halfOpenInFlight > 0 {
+			cb.halfOpenInFlight--
+		}
+		return
+	}
+
+	er.RecordError(component)
+}
+
+// RecordSuccess records a successful call, closing a half-open circuit
+func (er *ErrorRecovery) RecordSuccess(component string) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	cb := er.breaker(component)
+	switch cb.state {
+	case CircuitHalfOpen:
+		er.transition(component, cb, CircuitClosed)
+	case CircuitClosed:
+		cb.failures = 0
+	}
+}
+
+// RecordError records an error for a specific component, opening the circuit once
+// the threshold is reached or immediately if a half-open probe fails
+func (er *ErrorRecovery) RecordError(component string) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	now := er.now()
+	cb := er.breaker(component)
+
+	switch cb.state {
+	case CircuitHalfOpen:
+		er.transition(component, cb, CircuitOpen)
+	case CircuitClosed:
+		// Reset counter if outside error window
+		if cb.failures == 0 || now.Sub(cb.windowStart) > cb.config.ErrorWindow {
+			cb.failures = 0
+			cb.windowStart = now
+		}
+
+		cb.failures++
+		if cb.failures >= cb.config.ErrorThreshold {
+			er.transition(component, cb, CircuitOpen)
+		}
+	}
+}
+
+// ShouldCircuitBreak returns true if calls to the component are currently being rejected
+func (er *ErrorRecovery) ShouldCircuitBreak(component string) bool {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	cb, exists := er.breakers[component]
+	if !exists {
+		return false
+	}
+
+	return cb.state == CircuitOpen && er.now().Sub(cb.openedAt) < cb.config.OpenTimeout
+}
+
+// State returns the current circuit state for a component
+func (er *ErrorRecovery) State(component string) CircuitState {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	cb, exists := er.breakers[component]
+	if !exists {
+		return CircuitClosed
+	}
+	return cb.state
+}
+
+// States returns the circuit state of every component seen so far
+func (er *ErrorRecovery) States() map[string]CircuitState {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	states := make(map[string]CircuitState, len(er.breakers))
+	for component, cb := range er.breakers {
+		states[component] = cb.state
+	}
+	return states
+}
+
+// ResetErrors resets error counts for a component and closes its circuit
+func (er *ErrorRecovery) ResetErrors(component string) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	cb := er.breaker(component)
+	er.transition(component, cb, CircuitClosed)
+	cb.failures = 0
+}
+
+// Execute runs an operation through the component's circuit breaker
+func (er *ErrorRecovery) Execute(component string, operation func() error) error {
+	if err := er.Allow(component); err != nil {
+		return err
+	}
+
+	err := operation()
+	er.Record(component, err)
+	return err
+}
+
+// GetBackoff calculates exponential backoff duration
+func (er *ErrorRecovery) GetBackoff(attempt int) time.Duration {
+	backoff := er.retryBackoff * time.Duration(1<<uint(attempt))
+	if backoff > er.maxBackoff {
+		backoff = er.maxBackoff
+	}
+	return backoff
+}
+
+// RetryWithBackoff retries an operation with exponential backoff
+func (er *ErrorRecovery) RetryWithBackoff(ctx context.Context, component string, operation func() error) error {
+	var lastErr error
+
+	for attempt := 0; attempt < er.maxRetries; attempt++ {
+		// Attempt operation through the circuit breaker
+		err := er.Execute(component, operation)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, ErrCircuitOpen) {
+			return err
+		}
+
+		lastErr = err
+
+		// Don't sleep on last attempt
+		if attempt < er.maxRetries-1 {
+			backoff := er.GetBackoff(attempt)
+			logger.FromContext(ctx).Warn().
+				Err(err).
+				Str("component", component).
+				Int("attempt", attempt+1).
+				Int("max_retries", er.maxRetries).
+				Dur("backoff", backoff).
+				Msg("Retry attempt failed, backing off")
+
+			select {
+			case <-time.After(backoff):
+				// Continue to next attempt
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return fmt.Errorf("operation failed after %d attempts: %w", er.maxRetries, lastErr)
+}
@@ -0,0 +1,148 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRecovery(now *time.Time, overrides map[string]BreakerConfig) *ErrorRecovery {
+	er := NewErrorRecoveryWithConfig(BreakerConfig{
+		ErrorThreshold:      3,
+		ErrorWindow:         time.Minute,
+		OpenTimeout:         10 * time.Second,
+		HalfOpenMaxRequests: 1,
+	}, overrides)
+	er.now = func() time.Time { return *now }
+	return er
+}
+
+func TestErrorRecovery_OpensAfterThreshold(t *testing.T) {
+	now := time.Now()
+	er := newTestRecovery(&now, nil)
+	failing := errors.New("connection refused")
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, er.Allow(ComponentCache))
+		er.Record(ComponentCache, failing)
+	}
+
+	assert.Equal(t, CircuitOpen, er.State(ComponentCache))
+	assert.True(t, er.ShouldCircuitBreak(ComponentCache))
+	assert.ErrorIs(t, er.Allow(ComponentCache), ErrCircuitOpen)
+
+	// Other components are unaffected
+	assert.NoError(t, er.Allow(ComponentDatabase))
+}
+
+func TestErrorRecovery_HalfOpenProbe(t *testing.T) {
+	now := time.Now()
+	er := newTestRecovery(&now, nil)
+
+	for i := 0; i < 3; i++ {
+		er.RecordError(ComponentDatabase)
+	}
+	require.Equal(t, CircuitOpen, er.State(ComponentDatabase))
+
+	// After the open timeout a single probe is allowed
+	now = now.Add(11 * time.Second)
+	require.NoError(t, er.Allow(ComponentDatabase))
+	assert.Equal(t, CircuitHalfOpen, er.State(ComponentDatabase))
+	assert.ErrorIs(t, er.Allow(ComponentDatabase), ErrCircuitOpen)
+
+	// A failed probe re-opens the circuit
+	er.Record(ComponentDatabase, errors.New("still down"))
+	assert.Equal(t, CircuitOpen, er.State(ComponentDatabase))
+
+	// A successful probe closes it
+	now = now.Add(11 * time.Second)
+	require.NoError(t, er.Allow(ComponentDatabase))
+	er.Record(ComponentDatabase, nil)
+	assert.Equal(t, CircuitClosed, er.State(ComponentDatabase))
+}
+
+func TestErrorRecovery_SuccessResetsFailures(t *testing.T) {
+	now := time.Now()
+	er := newTestRecovery(&now, nil)
+
+	er.RecordError(ComponentCache)
+	er.RecordError(ComponentCache)
+	er.RecordSuccess(ComponentCache)
+	er.RecordError(ComponentCache)
+	er.RecordError(ComponentCache)
+
+	assert.Equal(t, CircuitClosed, er.State(ComponentCache))
+}
+
+func TestErrorRecovery_ContextCanceledIsNotAFailure(t *testing.T) {
+	now := time.Now()
+	er := newTestRecovery(&now, nil)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, er.Allow(ComponentBeaconNode))
+		er.Record(ComponentBeaconNode, context.Canceled)
+	}
+
+	assert.Equal(t, CircuitClosed, er.State(ComponentBeaconNode))
+}
+
+func TestErrorRecovery_GroupOverride(t *testing.T) {
+	now := time.Now()
+	er := newTestRecovery(&now, map[string]BreakerConfig{
+		"beacon": {ErrorThreshold: 1, ErrorWindow: time.Minute, OpenTimeout: time.Second, HalfOpenMaxRequests: 1},
+	})
+
+	er.RecordError(ComponentBeaconBlocks)
+	assert.Equal(t, CircuitOpen, er.State(ComponentBeaconBlocks))
+
+	er.RecordError(ComponentCache)
+	assert.Equal(t, CircuitClosed, er.State(ComponentCache))
+}
+
+func TestBeaconComponent(t *testing.T) {
+	assert.Equal(t, ComponentBeaconValidators, beaconComponent("/eth/v1/beacon/states/head/validators/1"))
+	assert.Equal(t, ComponentBeaconBlocks, beaconComponent("/eth/v2/beacon/blocks/100"))
+	assert.Equal(t, ComponentBeaconBlocks, beaconComponent("/eth/v1/beacon/blocks/100/attestations"))
+	assert.Equal(t, ComponentBeaconEvents, beaconComponent("/eth/v1/events"))
+	assert.Equal(t, ComponentBeaconNode, beaconComponent("/eth/v1/beacon/headers/head"))
+}
+
+func TestValidatorCollector_SkipsRoundsWhileBeaconCircuitOpen(t *testing.T) {
+	var requests atomic.Int32
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer node.Close()
+
+	now := time.Now()
+	recovery := newTestRecovery(&now, nil)
+	client := NewBeaconClientWithConfig(BeaconClientConfig{BaseURL: node.URL, Timeout: 5 * time.Second, CircuitBreaker: recovery})
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetValidator(context.Background(), 1)
+		require.Error(t, err)
+	}
+	assert.Equal(t, CircuitOpen, recovery.State(ComponentBeaconValidators))
+
+	_, err := client.GetValidator(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(3), requests.Load(), "an open circuit keeps requests off the node")
+
+	// The collector shares the breaker, so it skips the round without submitting any work
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := &ValidatorCollector{beaconClient: client, recovery: recovery, validators: []int64{1, 2}, ctx: ctx, cancel: cancel}
+	c.collectAllValidators()
+	assert.Equal(t, uint64(0), c.collectionsCount)
+
+	now = now.Add(11 * time.Second)
+	assert.False(t, recovery.ShouldCircuitBreak(ComponentBeaconValidators), "a probe is allowed after the open timeout")
+}
@@ -0,0 +1,229 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	clientBlocks = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_client_blocks_total",
+			Help: "Total canonical blocks by the consensus client their graffiti identifies",
+		},
+		[]string{"client"},
+	)
+
+	fleetClientShare = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_fleet_client_share",
+			Help: "Fraction of identified monitored validators running each consensus client",
+		},
+		[]string{"client"},
+	)
+)
+
+// ClientDiversityConfig contains configuration for the client diversity job
+type ClientDiversityConfig struct {
+	LookbackEpochs         int64   // Window of network shares
+	SupermajorityThreshold float64 // Network share from which a client is a supermajority client
+	FleetThreshold         float64 // Fleet share on a supermajority client that raises an alert
+	GenesisTime            time.Time
+}
+
+// DefaultClientDiversityConfig returns default client diversity configuration
+func DefaultClientDiversityConfig() *ClientDiversityConfig {
+	return &ClientDiversityConfig{
+		LookbackEpochs:         225, // ~1 day
+		SupermajorityThreshold: 0.66,
+		FleetThreshold:         0.5,
+		GenesisTime:            time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// ClientDiversityJob fingerprints the consensus client of every canonical block from its graffiti.
+// Blocks come from the beacon client's block stream, which publishes the blocks the other jobs
+// fetch as they scan the chain, so the job fetches none itself and needs one of them to run.
+// Monitored validators are attributed to the client of their latest identified proposal, and an
+// alert is raised while the fleet's share on a client that holds a supermajority of the network is
+// at or above the configured threshold: a bug in that client could finalize an invalid chain, and
+// validators on it would be slashed or leak for leaving it.
+type ClientDiversityJob struct {
+	blocks     types.BlockStream
+	clientRepo *repository.ClientRepository
+	alertRepo  *repository.AlertRepository
+	config     *ClientDiversityConfig
+
+	mu      sync.Mutex
+	pending []*models.BlockClient // Streamed blocks not yet recorded
+
+	alerted *models.Alert // Open concentration alert, if any
+	loaded  bool          // Whether alerted was loaded from the database
+}
+
+// NewClientDiversityJob creates a new client diversity job
+func NewClientDiversityJob(blocks types.BlockStream, pool *pgxpool.Pool, config *ClientDiversityConfig) *ClientDiversityJob {
+	return &ClientDiversityJob{
+		blocks:     blocks,
+		clientRepo: repository.NewClientRepository(pool),
+		alertRepo:  repository.NewAlertRepository(pool),
+		config:     config,
+	}
+}
+
+// CollectBlocks fingerprints streamed blocks until ctx is done, holding them for the next run
+func (j *ClientDiversityJob) CollectBlocks(ctx context.Context) {
+	for block := range j.blocks.SubscribeToBlocks(ctx) {
+		b := newBlockClient(j.config.GenesisTime, block)
+		j.mu.Lock()
+		j.pending = append(j.pending, b)
+		j.mu.Unlock()
+	}
+}
+
+// RunOnce records the blocks streamed since the last run, then checks the fleet's client
+// concentration
+func (j *ClientDiversityJob) RunOnce(ctx context.Context) error {
+	j.mu.Lock()
+	blocks := j.pending
+	j.pending = nil
+	j.mu.Unlock()
+
+	recorded, err := j.clientRepo.RecordBlocks(ctx, blocks)
+	if err != nil {
+		// Keep the blocks for the next run
+		j.mu.Lock()
+		j.pending = append(blocks, j.pending...)
+		j.mu.Unlock()
+		return err
+	}
+	for _, b := range recorded {
+		clientBlocks.WithLabelValues(string(b.Client)).Inc()
+	}
+
+	return j.checkConcentration(ctx)
+}
+
+// checkConcentration compares the fleet's client distribution with the network's over the
+// lookback window, raising a concentration alert or resolving the open one
+func (j *ClientDiversityJob) checkConcentration(ctx context.Context) error {
+	if !j.loaded {
+		status := models.AlertStatusNew
+		alertType := string(types.AlertTypeClientConcentration)
+		open, err := j.alertRepo.ListAlerts(ctx, &models.AlertFilter{
+			AlertType: &alertType,
+			Status:    &status,
+			Limit:     1,
+		})
+		if err != nil {
+			return err
+		}
+		if len(open) > 0 {
+			j.alerted = open[0]
+		}
+		j.loaded = true
+	}
+
+	network, err := j.clientRepo.NetworkDistribution(ctx, int(j.config.LookbackEpochs))
+	if err != nil {
+		return err
+	}
+	validators, err := j.clientRepo.ValidatorClients(ctx, nil)
+	if err != nil {
+		return err
+	}
+	fleet := fleetDistribution(validators)
+
+	fleetClientShare.Reset()
+	for _, s := range fleet.Shares {
+		fleetClientShare.WithLabelValues(string(s.Client)).Set(s.Share)
+	}
+
+	alert := concentrationAlert(network, fleet, j.config.SupermajorityThreshold, j.config.FleetThreshold)
+	switch {
+	case alert != nil && j.alerted == nil:
+		if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Msg("Failed to create client concentration alert")
+			return nil
+		}
+		j.alerted = alert
+	case alert == nil && j.alerted != nil:
+		if err := j.alertRepo.ResolveAlert(ctx, j.alerted.ID); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Int32("alert_id", j.alerted.ID).
+				Msg("Failed to resolve client concentration alert")
+			return nil
+		}
+		j.alerted = nil
+	}
+	return nil
+}
+
+// newBlockClient fingerprints the client that produced a block
+func newBlockClient(genesis time.Time, block *types.Block) *models.BlockClient {
+	slot := int64(block.Slot)
+	fingerprint := types.FingerprintGraffiti(block.Graffiti)
+	return &models.BlockClient{
+		Slot:            slot,
+		Epoch:           slot / types.SlotsPerEpoch,
+		ProposerIndex:   int64(block.ProposerIndex),
+		Time:            types.SlotStartTime(genesis, slot),
+		Graffiti:        block.Graffiti,
+		Client:          fingerprint.Client,
+		Version:         fingerprint.Version,
+		ExecutionClient: fingerprint.ExecutionClient,
+	}
+}
+
+// fleetDistribution counts the validators attributed to each client
+func fleetDistribution(validators []*models.BlockClient) *models.ClientDistribution {
+	counts := make(map[types.ConsensusClient]int64)
+	for _, v := range validators {
+		counts[v.Client]++
+	}
+	return models.NewClientDistribution(counts)
+}
+
+// concentrationAlert builds the alert for a fleet whose share on a supermajority client is at or
+// above the threshold, or returns nil when there is nothing to alert on
+func concentrationAlert(network, fleet *models.ClientDistribution, supermajority, threshold float64) *models.Alert {
+	for _, s := range network.Shares {
+		if s.Share < supermajority {
+			break // Shares are sorted largest first
+		}
+
+		share := fleet.ShareOf(s.Client)
+		if share < threshold {
+			continue
+		}
+		return &models.Alert{
+			AlertType: string(types.AlertTypeClientConcentration),
+			Severity:  models.SeverityWarning,
+			Title:     "Fleet concentrated on a supermajority client",
+			Message: fmt.Sprintf("%.1f%% of identified validators run %s, which produces %.1f%% of network blocks",
+				share*100, s.Client, s.Share*100),
+			Source: "client_diversity",
+			Details: models.JSONB{
+				"client":                string(s.Client),
+				"fleet_share":           share,
+				"network_share":         s.Share,
+				"identified_validators": fleet.Identified,
+			},
+			Status: models.AlertStatusNew,
+		}
+	}
+	return nil
+}
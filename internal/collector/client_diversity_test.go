@@ -0,0 +1,142 @@
+package collector
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprintGraffiti(t *testing.T) {
+	tests := []struct {
+		graffiti string
+		want     types.ClientFingerprint
+	}{
+		{"Lighthouse/v5.1.3-441fc16", types.ClientFingerprint{Client: types.ClientLighthouse, Version: "5.1.3"}},
+		{"teku/v24.4.0", types.ClientFingerprint{Client: types.ClientTeku, Version: "24.4.0"}},
+		{"prysmatic labs", types.ClientFingerprint{Client: types.ClientPrysm}},
+		{"Stakefish GE1a2bLH3c4d", types.ClientFingerprint{Client: types.ClientLighthouse, Version: "3c4d", ExecutionClient: "geth"}},
+		{"NMNB", types.ClientFingerprint{Client: types.ClientNimbus, ExecutionClient: "nethermind"}},
+		{"my pool RHb0c3TKffff", types.ClientFingerprint{Client: types.ClientTeku, Version: "ffff", ExecutionClient: "reth"}},
+		{"XXABCD", types.ClientFingerprint{Client: types.ClientUnknown}},
+		{"", types.ClientFingerprint{Client: types.ClientUnknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.graffiti, func(t *testing.T) {
+			assert.Equal(t, tt.want, types.FingerprintGraffiti(tt.graffiti))
+		})
+	}
+}
+
+func TestDecodeGraffiti(t *testing.T) {
+	assert.Equal(t, "Lighthouse", types.DecodeGraffiti("0x4c69676874686f7573650000000000000000000000000000000000000000000000"))
+	assert.Equal(t, "", types.DecodeGraffiti("0x0000000000000000000000000000000000000000000000000000000000000000"))
+	assert.Equal(t, "a�b", types.DecodeGraffiti("0x61ff62"))
+	assert.Equal(t, "", types.DecodeGraffiti("not hex"))
+}
+
+func TestNewBlockClient(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	b := newBlockClient(genesis, &types.Block{Slot: 3201, ProposerIndex: 7, Graffiti: "GEabcdPM0123"})
+
+	assert.Equal(t, int64(100), b.Epoch)
+	assert.Equal(t, int64(7), b.ProposerIndex)
+	assert.Equal(t, types.SlotStartTime(genesis, 3201), b.Time)
+	assert.Equal(t, types.ClientPrysm, b.Client)
+	assert.Equal(t, "0123", b.Version)
+	assert.Equal(t, "geth", b.ExecutionClient)
+}
+
+func TestConcentrationAlert(t *testing.T) {
+	network := models.NewClientDistribution(map[types.ConsensusClient]int64{
+		types.ClientLighthouse: 70,
+		types.ClientPrysm:      20,
+		types.ClientTeku:       10,
+	})
+	var validators []*models.BlockClient
+	for _, c := range []types.ConsensusClient{types.ClientLighthouse, types.ClientLighthouse, types.ClientTeku, types.ClientUnknown} {
+		validators = append(validators, &models.BlockClient{Client: c})
+	}
+	fleet := fleetDistribution(validators)
+	require.Equal(t, int64(3), fleet.Identified, "unidentified validators are not counted")
+
+	alert := concentrationAlert(network, fleet, 0.66, 0.5)
+	require.NotNil(t, alert)
+	assert.Equal(t, string(types.AlertTypeClientConcentration), alert.AlertType)
+	assert.Nil(t, alert.ValidatorIndex)
+	assert.Equal(t, "lighthouse", alert.Details["client"])
+	assert.InDelta(t, 2.0/3, alert.Details["fleet_share"], 1e-9)
+
+	assert.Nil(t, concentrationAlert(network, fleet, 0.66, 0.7), "fleet share below the threshold")
+	assert.Nil(t, concentrationAlert(network, fleet, 0.75, 0.5), "no supermajority client")
+}
+
+func TestBeaconClient_BlockStream(t *testing.T) {
+	graffiti := map[string]string{"3199": "teku/v24.4.0", "3200": "Lighthouse/v5.1.3", "3201": "GE1a2bPM3c4d", "3202": "my pool"}
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slot := strings.TrimPrefix(r.URL.Path, "/eth/v2/beacon/blocks/")
+		text, ok := graffiti[slot]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"message":{"proposer_index":"%s","body":{"graffiti":"0x%s"}}}}`, slot, hex.EncodeToString([]byte(text)))
+	}))
+	defer node.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewBeaconClientWithoutRetry(node.URL, 5*time.Second)
+	blocks := client.SubscribeToBlocks(ctx)
+
+	// Two jobs scanning the same slots share one stream of blocks
+	for _, slot := range []int{3199, 3200, 3201, 3200, 3202, 3203, 3201} {
+		_, err := client.GetBlock(ctx, slot)
+		require.NoError(t, err)
+	}
+
+	var streamed []int
+	for range 4 {
+		block := <-blocks
+		streamed = append(streamed, block.Slot)
+	}
+	assert.Equal(t, []int{3199, 3200, 3201, 3202}, streamed, "each block once, missed slots not at all")
+
+	diversity := client.blocks.diversity(101)
+	require.NotNil(t, diversity)
+	assert.Equal(t, 100, diversity.Epoch)
+	assert.Equal(t, 3, diversity.Blocks)
+	assert.Equal(t, 2, diversity.Identified)
+	assert.Equal(t, 0.5, diversity.Shares[types.ClientLighthouse])
+	assert.Equal(t, 0.5, diversity.Shares[types.ClientPrysm])
+	assert.Equal(t, 99, client.blocks.diversity(100).Epoch)
+	assert.Nil(t, client.blocks.diversity(99))
+
+	cancel()
+	_, ok := <-blocks
+	assert.False(t, ok, "the channel closes once the subscription ends")
+}
+
+func TestBlockStream_PublishDoesNotWait(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := newBlockStream()
+	blocks := stream.subscribe(ctx)
+
+	// Nothing reads the subscription, so the blocks beyond its buffer are dropped
+	for slot := range blockStreamEpochs*types.SlotsPerEpoch + 1 {
+		stream.publish(&types.Block{Slot: slot})
+	}
+	assert.Equal(t, cap(blocks), len(blocks))
+	assert.Equal(t, 0, (<-blocks).Slot)
+}
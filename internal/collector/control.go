@@ -0,0 +1,149 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// MaxRecollectEpochs bounds a single re-collection request (~7 days of epochs)
+const MaxRecollectEpochs = 1575
+
+var (
+	// ErrInvalidEpochRange is returned when a re-collection range is empty, reversed or too large
+	ErrInvalidEpochRange = errors.New("invalid epoch range")
+
+	// ErrValidatorNotFound is returned when re-collection targets an unknown validator
+	ErrValidatorNotFound = errors.New("validator not found")
+
+	// ErrRecollectUnsupported is returned when the collector has no client for historical state
+	ErrRecollectUnsupported = errors.New("re-collection needs a historical state client")
+)
+
+var collectorPaused = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "collector_paused",
+		Help: "Whether scheduled collection is paused by an operator (1 = paused)",
+	},
+)
+
+// RecollectResult summarises a forced re-collection
+type RecollectResult struct {
+	ValidatorIndex    int64   `json:"validator_index"`
+	FromEpoch         int64   `json:"from_epoch"`
+	ToEpoch           int64   `json:"to_epoch"`
+	Recollected       int     `json:"recollected"`
+	Replaced          int64   `json:"replaced"`
+	UnavailableEpochs []int64 `json:"unavailable_epochs"`
+}
+
+// Pause stops scheduled collection rounds. In-flight tasks still complete and are stored.
+// It reports whether the collector was running before the call.
+func (c *ValidatorCollector) Pause() bool {
+	if !c.paused.CompareAndSwap(false, true) {
+		return false
+	}
+
+	collectorPaused.Set(1)
+	logger.FromContext(c.ctx).Warn().Msg("Validator collection paused")
+	return true
+}
+
+// Resume restarts scheduled collection rounds.
+// It reports whether the collector was paused before the call.
+func (c *ValidatorCollector) Resume() bool {
+	if !c.paused.CompareAndSwap(true, false) {
+		return false
+	}
+
+	collectorPaused.Set(0)
+	logger.FromContext(c.ctx).Info().Msg("Validator collection resumed")
+	return true
+}
+
+// IsPaused reports whether scheduled collection is paused
+func (c *ValidatorCollector) IsPaused() bool {
+	return c.paused.Load()
+}
+
+// DrainPool pauses collection and waits until every queued task has been processed
+// and stored. The collector stays paused afterwards until Resume is called.
+func (c *ValidatorCollector) DrainPool(ctx context.Context) error {
+	c.Pause()
+
+	if err := c.workerPool.Drain(ctx); err != nil {
+		return err
+	}
+
+	logger.FromContext(c.ctx).Info().Msg("Worker pool drained")
+	return nil
+}
+
+// Recollect rebuilds a validator's snapshots for [fromEpoch, toEpoch] from historical beacon
+// state, replacing whatever was stored for those epochs. Epochs whose state the node no longer
+// has are reported as unavailable; any other error stops the run and returns partial results.
+func (c *ValidatorCollector) Recollect(ctx context.Context, validatorIndex, fromEpoch, toEpoch int64) (*RecollectResult, error) {
+	if fromEpoch < 0 || toEpoch < fromEpoch {
+		return nil, fmt.Errorf("%w: from %d to %d", ErrInvalidEpochRange, fromEpoch, toEpoch)
+	}
+	if toEpoch-fromEpoch+1 > MaxRecollectEpochs {
+		return nil, fmt.Errorf("%w: at most %d epochs per request", ErrInvalidEpochRange, MaxRecollectEpochs)
+	}
+	if c.history == nil {
+		return nil, ErrRecollectUnsupported
+	}
+
+	validator, err := c.validatorRepo.GetValidatorByIndex(ctx, validatorIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator: %w", err)
+	}
+	if validator == nil {
+		return nil, fmt.Errorf("%w: %d", ErrValidatorNotFound, validatorIndex)
+	}
+
+	result := &RecollectResult{
+		ValidatorIndex:    validatorIndex,
+		FromEpoch:         fromEpoch,
+		ToEpoch:           toEpoch,
+		UnavailableEpochs: []int64{},
+	}
+
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		snapshot, err := historicalSnapshot(ctx, c.history, validator, epoch, c.genesisTime)
+		if errors.Is(err, types.ErrStateUnavailable) {
+			result.UnavailableEpochs = append(result.UnavailableEpochs, epoch)
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to collect epoch %d: %w", epoch, err)
+		}
+
+		start := types.EpochStartTime(c.genesisTime, epoch)
+		replaced, err := c.snapshotRepo.ReplaceSnapshots(ctx, snapshot, start, start.Add(types.EpochDuration))
+		if err != nil {
+			return result, fmt.Errorf("failed to store epoch %d: %w", epoch, err)
+		}
+
+		result.Recollected++
+		result.Replaced += replaced
+	}
+
+	logger.FromContext(ctx).Info().
+		Int64("validator_index", validatorIndex).
+		Int64("from_epoch", fromEpoch).
+		Int64("to_epoch", toEpoch).
+		Int("recollected", result.Recollected).
+		Int("unavailable", len(result.UnavailableEpochs)).
+		Msg("Validator snapshots re-collected")
+
+	return result, nil
+}
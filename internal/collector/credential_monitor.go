@@ -0,0 +1,468 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var credentialOperations = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_credential_operations_total",
+		Help: "Total credential changes and execution layer withdrawal requests for monitored validators by type",
+	},
+	[]string{"type"},
+)
+
+// AllowlistAllValidators is the allowlist group that applies to every monitored validator
+const AllowlistAllValidators = "*"
+
+// CredentialMonitorConfig contains configuration for the credential monitor job
+type CredentialMonitorConfig struct {
+	LookbackEpochs int64               // Epochs scanned when the job starts
+	MaxSlotsPerRun int                 // Upper bound on blocks fetched per run
+	Allowlist      map[string][]string // Allowed withdrawal addresses by validator tag, or "*" for all
+	GenesisTime    time.Time
+}
+
+// DefaultCredentialMonitorConfig returns default credential monitor configuration
+func DefaultCredentialMonitorConfig() *CredentialMonitorConfig {
+	return &CredentialMonitorConfig{
+		LookbackEpochs: 225, // ~1 day
+		MaxSlotsPerRun: 64,
+		GenesisTime:    time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// CredentialMonitorJob scans every block for BLS to execution changes and execution layer
+// withdrawal requests (EIP-7002) naming monitored validators. It records them and raises a
+// critical alert when credentials change to an address that is not allowlisted for any of the
+// validator's groups, or when an exit is requested. Once an epoch it also stores the credentials
+// of the head state, which follow consolidations to compounding (0x02) credentials, and records
+// the monitored validators' entries in the partial withdrawal and consolidation queues.
+type CredentialMonitorJob struct {
+	client         types.RewardsClient
+	states         types.DiscoveryClient
+	validatorRepo  *repository.ValidatorRepository
+	credentialRepo *repository.CredentialRepository
+	alertRepo      *repository.AlertRepository
+	config         *CredentialMonitorConfig
+
+	nextSlot     int64 // First slot not yet scanned; zero before the first run
+	refreshEpoch int64 // First epoch whose head state credentials are not read yet
+	queueEpoch   int64 // First epoch whose head state request queues are not read yet
+}
+
+// NewCredentialMonitorJob creates a new credential monitor job
+func NewCredentialMonitorJob(client types.RewardsClient, states types.DiscoveryClient, pool *pgxpool.Pool, config *CredentialMonitorConfig) *CredentialMonitorJob {
+	return &CredentialMonitorJob{
+		client:         client,
+		states:         states,
+		validatorRepo:  repository.NewValidatorRepository(pool),
+		credentialRepo: repository.NewCredentialRepository(pool),
+		alertRepo:      repository.NewAlertRepository(pool),
+		config:         config,
+	}
+}
+
+// RunOnce scans the blocks since the last run, up to MaxSlotsPerRun, for operations naming
+// monitored validators. The first run starts LookbackEpochs before the head.
+func (j *CredentialMonitorJob) RunOnce(ctx context.Context) error {
+	head := types.SlotAtTime(j.config.GenesisTime, time.Now()) - 1
+	if j.nextSlot == 0 {
+		j.nextSlot = max(head-j.config.LookbackEpochs*types.SlotsPerEpoch+1, 1)
+	}
+	if j.nextSlot > head {
+		return nil
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+
+	// Stale credentials only affect what is displayed, so a failed read does not hold up the scan
+	if err := j.refreshCredentials(ctx, validators); err != nil && ctx.Err() == nil {
+		logger.FromContext(ctx).Warn().
+			Err(err).
+			Msg("Failed to refresh withdrawal credentials")
+	}
+	if err := j.pollQueues(ctx, validators, head); err != nil && ctx.Err() == nil {
+		logger.FromContext(ctx).Warn().
+			Err(err).
+			Msg("Failed to read the withdrawal and consolidation queues")
+	}
+
+	last := min(head, j.nextSlot+int64(j.config.MaxSlotsPerRun)-1)
+	for slot := j.nextSlot; slot <= last; slot++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		block, err := j.client.GetBlock(ctx, int(slot))
+		if err != nil {
+			return err
+		}
+		if block != nil {
+			changes, requests := blockOperations(j.config.GenesisTime, validators, j.config.Allowlist, block)
+			if err := j.record(ctx, changes, requests); err != nil {
+				return err
+			}
+		}
+		j.nextSlot = slot + 1
+	}
+
+	return nil
+}
+
+// refreshCredentials stores the withdrawal credentials of the head state that differ from those
+// on record, once per epoch. This fills in validators added without credentials and follows
+// changes no block operation names, such as a consolidation to compounding credentials.
+func (j *CredentialMonitorJob) refreshCredentials(ctx context.Context, validators []*models.Validator) error {
+	epoch := types.EpochAtTime(j.config.GenesisTime, time.Now())
+	if j.states == nil || epoch < j.refreshEpoch || len(validators) == 0 {
+		return nil
+	}
+
+	byIndex := make(map[int64]*models.Validator, len(validators))
+	ids := make([]string, 0, len(validators))
+	for _, v := range validators {
+		byIndex[v.ValidatorIndex] = v
+		ids = append(ids, strconv.FormatInt(v.ValidatorIndex, 10))
+	}
+
+	var identities []types.ValidatorIdentity
+	err := j.states.ScanValidators(ctx, ids, func(identity types.ValidatorIdentity) error {
+		identities = append(identities, identity)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read withdrawal credentials: %w", err)
+	}
+
+	for _, identity := range identities {
+		v, ok := byIndex[identity.Index]
+		if !ok || !credentialsChanged(v, identity.WithdrawalCredentials) {
+			continue
+		}
+		if err := j.credentialRepo.UpdateCredentials(ctx, v.ValidatorIndex, identity.WithdrawalCredentials); err != nil {
+			return err
+		}
+		credentials := identity.WithdrawalCredentials
+		v.WithdrawalCredentials = &credentials
+
+		logger.FromContext(ctx).Info().
+			Int64("validator_index", v.ValidatorIndex).
+			Str("withdrawal_credentials", credentials).
+			Msg("Withdrawal credentials updated from the beacon state")
+	}
+
+	j.refreshEpoch = epoch + 1
+	return nil
+}
+
+// pollQueues records the monitored validators' entries in the partial withdrawal and
+// consolidation queues of the state at head, once per epoch. This catches requests in blocks the
+// scan did not cover, such as those before the lookback or before a validator was added, so a
+// queued withdrawal only alerts when no request for it was recorded from a block. A queue the node
+// does not serve, as for a state before Electra, is skipped.
+func (j *CredentialMonitorJob) pollQueues(ctx context.Context, validators []*models.Validator, head int64) error {
+	epoch := head / types.SlotsPerEpoch
+	if epoch < j.queueEpoch || len(validators) == 0 {
+		return nil
+	}
+
+	withdrawals, err := j.client.GetPendingPartialWithdrawals(ctx, int(head))
+	if err != nil && !errors.Is(err, types.ErrStateUnavailable) {
+		return fmt.Errorf("failed to read partial withdrawal queue: %w", err)
+	}
+	consolidations, err := j.client.GetPendingConsolidations(ctx, int(head))
+	if err != nil && !errors.Is(err, types.ErrStateUnavailable) {
+		return fmt.Errorf("failed to read consolidation queue: %w", err)
+	}
+
+	monitored := make(map[int64]bool, len(validators))
+	for _, v := range validators {
+		monitored[v.ValidatorIndex] = true
+	}
+	queuedWithdrawals, queuedConsolidations := queuedRequests(monitored, withdrawals, consolidations)
+
+	for _, w := range queuedWithdrawals {
+		inserted, err := j.credentialRepo.RecordQueuedWithdrawal(ctx, w)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			continue
+		}
+
+		credentialOperations.WithLabelValues("queued_withdrawal").Inc()
+		requested, err := j.credentialRepo.HasWithdrawalRequest(ctx, w.ValidatorIndex, w.Amount)
+		if err != nil {
+			return err
+		}
+		if !requested {
+			j.alert(ctx, queuedWithdrawalAlert(w))
+		}
+	}
+
+	for _, c := range queuedConsolidations {
+		inserted, err := j.credentialRepo.RecordQueuedConsolidation(ctx, c)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			continue
+		}
+
+		credentialOperations.WithLabelValues("queued_consolidation").Inc()
+		if !monitored[c.TargetIndex] {
+			j.alert(ctx, consolidationAlert(c))
+		}
+	}
+
+	j.queueEpoch = epoch + 1
+	return nil
+}
+
+// queuedRequests returns the queue entries of monitored validators: partial withdrawals from them
+// and consolidations with one of them as the source
+func queuedRequests(monitored map[int64]bool, withdrawals []types.PendingPartialWithdrawal, consolidations []types.PendingConsolidation) ([]*models.QueuedWithdrawal, []*models.QueuedConsolidation) {
+	var queuedWithdrawals []*models.QueuedWithdrawal
+	for _, w := range withdrawals {
+		if !monitored[w.ValidatorIndex] {
+			continue
+		}
+		queuedWithdrawals = append(queuedWithdrawals, &models.QueuedWithdrawal{
+			ValidatorIndex:    w.ValidatorIndex,
+			WithdrawableEpoch: w.WithdrawableEpoch,
+			Amount:            w.Amount,
+		})
+	}
+
+	var queuedConsolidations []*models.QueuedConsolidation
+	for _, c := range consolidations {
+		if !monitored[c.SourceIndex] {
+			continue
+		}
+		queuedConsolidations = append(queuedConsolidations, &models.QueuedConsolidation{
+			SourceIndex: c.SourceIndex,
+			TargetIndex: c.TargetIndex,
+		})
+	}
+
+	return queuedWithdrawals, queuedConsolidations
+}
+
+// credentialsChanged reports whether credentials read from the beacon state differ from those on
+// record. Empty credentials, from a node that omits them, leave the recorded ones in place.
+func credentialsChanged(v *models.Validator, credentials string) bool {
+	if credentials == "" {
+		return false
+	}
+	return v.WithdrawalCredentials == nil || !strings.EqualFold(*v.WithdrawalCredentials, credentials)
+}
+
+// record stores operations and alerts on those not seen before
+func (j *CredentialMonitorJob) record(ctx context.Context, changes []*models.CredentialChange, requests []*models.WithdrawalRequest) error {
+	for _, c := range changes {
+		inserted, err := j.credentialRepo.RecordChange(ctx, c)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			continue
+		}
+
+		credentialOperations.WithLabelValues("credential_change").Inc()
+		if !c.Allowlisted {
+			j.alert(ctx, credentialChangeAlert(c))
+		}
+	}
+
+	for _, w := range requests {
+		inserted, err := j.credentialRepo.RecordWithdrawalRequest(ctx, w)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			continue
+		}
+
+		if w.IsFullExit() {
+			credentialOperations.WithLabelValues("exit_request").Inc()
+		} else {
+			credentialOperations.WithLabelValues("withdrawal_request").Inc()
+		}
+		j.alert(ctx, withdrawalRequestAlert(w))
+	}
+
+	return nil
+}
+
+// alert creates an alert, logging rather than returning a failure so the operation stays recorded
+func (j *CredentialMonitorJob) alert(ctx context.Context, alert *models.Alert) {
+	if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+		logger.FromContext(ctx).Error().
+			Err(err).
+			Int64("validator_index", *alert.ValidatorIndex).
+			Msg("Failed to create credential alert")
+	}
+}
+
+// blockOperations returns the credential changes and withdrawal requests in a block that name one
+// of the validators. Each change is checked against the allowlists of the validator's tags.
+func blockOperations(genesis time.Time, validators []*models.Validator, allowlist map[string][]string, block *types.Block) ([]*models.CredentialChange, []*models.WithdrawalRequest) {
+	byIndex := make(map[int64]*models.Validator, len(validators))
+	byPubkey := make(map[string]*models.Validator, len(validators))
+	for _, v := range validators {
+		byIndex[v.ValidatorIndex] = v
+		byPubkey[strings.ToLower(v.Pubkey)] = v
+	}
+
+	slot := int64(block.Slot)
+	slotTime := types.SlotStartTime(genesis, slot)
+
+	var changes []*models.CredentialChange
+	for _, c := range block.CredentialChanges {
+		v, ok := byIndex[int64(c.ValidatorIndex)]
+		if !ok {
+			continue
+		}
+		changes = append(changes, &models.CredentialChange{
+			ValidatorIndex:     v.ValidatorIndex,
+			Slot:               slot,
+			Time:               slotTime,
+			FromBLSPubkey:      c.FromBLSPubkey,
+			ToExecutionAddress: c.ToExecutionAddress,
+			Allowlisted:        allowlisted(allowlist, v.Tags, c.ToExecutionAddress),
+		})
+	}
+
+	var requests []*models.WithdrawalRequest
+	for _, w := range block.WithdrawalRequests {
+		v, ok := byPubkey[strings.ToLower(w.ValidatorPubkey)]
+		if !ok {
+			continue
+		}
+		requests = append(requests, &models.WithdrawalRequest{
+			ValidatorIndex: v.ValidatorIndex,
+			Slot:           slot,
+			Time:           slotTime,
+			SourceAddress:  w.SourceAddress,
+			Amount:         w.Amount,
+		})
+	}
+
+	return changes, requests
+}
+
+// allowlisted reports whether address is allowed for every validator or for one of the tags
+func allowlisted(allowlist map[string][]string, tags models.Tags, address string) bool {
+	groups := append([]string{AllowlistAllValidators}, tags...)
+	for _, group := range groups {
+		for _, allowed := range allowlist[group] {
+			if strings.EqualFold(allowed, address) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// credentialChangeAlert builds the critical alert for a change to an address not on the allowlist
+func credentialChangeAlert(c *models.CredentialChange) *models.Alert {
+	index := c.ValidatorIndex
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeCredentialChange),
+		Severity:       models.SeverityCritical,
+		Title:          "Withdrawal credentials changed to an unexpected address",
+		Message:        c.Summary(),
+		Source:         "credential_monitor",
+		Details: models.JSONB{
+			"slot":                 c.Slot,
+			"from_bls_pubkey":      c.FromBLSPubkey,
+			"to_execution_address": c.ToExecutionAddress,
+		},
+		Status: models.AlertStatusNew,
+	}
+}
+
+// withdrawalRequestAlert builds the alert for an execution layer withdrawal request; exits are
+// critical since they cannot be undone
+func withdrawalRequestAlert(w *models.WithdrawalRequest) *models.Alert {
+	index := w.ValidatorIndex
+	alert := &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeWithdrawalRequest),
+		Severity:       models.SeverityWarning,
+		Title:          "Partial withdrawal requested",
+		Message:        w.Summary(),
+		Source:         "credential_monitor",
+		Details: models.JSONB{
+			"slot":           w.Slot,
+			"source_address": w.SourceAddress,
+			"amount":         w.Amount,
+			"full_exit":      w.IsFullExit(),
+		},
+		Status: models.AlertStatusNew,
+	}
+	if w.IsFullExit() {
+		alert.Severity = models.SeverityCritical
+		alert.Title = "Exit requested from the execution layer"
+	}
+	return alert
+}
+
+// queuedWithdrawalAlert builds the alert for a queued partial withdrawal with no request recorded
+// from a block
+func queuedWithdrawalAlert(w *models.QueuedWithdrawal) *models.Alert {
+	index := w.ValidatorIndex
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeWithdrawalRequest),
+		Severity:       models.SeverityWarning,
+		Title:          "Partial withdrawal queued",
+		Message: fmt.Sprintf("Partial withdrawal of %.4f ETH queued, withdrawable at epoch %d",
+			float64(w.Amount)/1e9, w.WithdrawableEpoch),
+		Source: "credential_monitor",
+		Details: models.JSONB{
+			"amount":             w.Amount,
+			"withdrawable_epoch": w.WithdrawableEpoch,
+		},
+		Status: models.AlertStatusNew,
+	}
+}
+
+// consolidationAlert builds the critical alert for a consolidation that moves a monitored
+// validator's balance to a validator that is not monitored
+func consolidationAlert(c *models.QueuedConsolidation) *models.Alert {
+	index := c.SourceIndex
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeConsolidationRequest),
+		Severity:       models.SeverityCritical,
+		Title:          "Consolidation into an unmonitored validator queued",
+		Message:        fmt.Sprintf("Balance is queued to consolidate into validator %d, which is not monitored", c.TargetIndex),
+		Source:         "credential_monitor",
+		Details: models.JSONB{
+			"target_index": c.TargetIndex,
+		},
+		Status: models.AlertStatusNew,
+	}
+}
@@ -0,0 +1,108 @@
+package collector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockOperations(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	validators := []*models.Validator{
+		{ValidatorIndex: 1, Pubkey: "0xAA", Tags: models.Tags{"treasury"}},
+		{ValidatorIndex: 2, Pubkey: "0xbb"},
+	}
+	allowlist := map[string][]string{
+		"treasury":             {"0xTreasury"},
+		AllowlistAllValidators: {"0xshared"},
+	}
+	block := &types.Block{
+		Slot: 3201,
+		CredentialChanges: []types.CredentialChange{
+			{ValidatorIndex: 1, FromBLSPubkey: "0x11", ToExecutionAddress: "0xtreasury"},
+			{ValidatorIndex: 2, FromBLSPubkey: "0x22", ToExecutionAddress: "0xtreasury"},
+			{ValidatorIndex: 2, FromBLSPubkey: "0x22", ToExecutionAddress: "0xshared"},
+			{ValidatorIndex: 99, FromBLSPubkey: "0x99", ToExecutionAddress: "0xattacker"}, // Not monitored
+		},
+		WithdrawalRequests: []types.WithdrawalRequest{
+			{SourceAddress: "0xtreasury", ValidatorPubkey: "0xaa", Amount: 0},
+			{SourceAddress: "0xelse", ValidatorPubkey: "0xcc", Amount: 0}, // Not monitored
+		},
+	}
+
+	changes, requests := blockOperations(genesis, validators, allowlist, block)
+	require.Len(t, changes, 3)
+	assert.Equal(t, types.SlotStartTime(genesis, 3201), changes[0].Time)
+	assert.True(t, changes[0].Allowlisted, "addresses match case-insensitively")
+	assert.False(t, changes[1].Allowlisted, "tag allowlists only cover tagged validators")
+	assert.True(t, changes[2].Allowlisted, "the * allowlist covers every validator")
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, int64(1), requests[0].ValidatorIndex, "pubkeys match case-insensitively")
+	assert.True(t, requests[0].IsFullExit())
+}
+
+func TestCredentialAlerts(t *testing.T) {
+	change := credentialChangeAlert(&models.CredentialChange{ValidatorIndex: 4, Slot: 3201, ToExecutionAddress: "0xbeef"})
+	assert.Equal(t, int64(4), *change.ValidatorIndex)
+	assert.Equal(t, string(types.AlertTypeCredentialChange), change.AlertType)
+	assert.Equal(t, models.SeverityCritical, change.Severity)
+	assert.Equal(t, "Withdrawal credentials changed to 0xbeef, which is not on the allowlist", change.Message)
+
+	exit := withdrawalRequestAlert(&models.WithdrawalRequest{ValidatorIndex: 4, SourceAddress: "0xbeef"})
+	assert.Equal(t, models.SeverityCritical, exit.Severity)
+	assert.Equal(t, true, exit.Details["full_exit"])
+
+	partial := withdrawalRequestAlert(&models.WithdrawalRequest{ValidatorIndex: 4, SourceAddress: "0xbeef", Amount: 1_000_000_000})
+	assert.Equal(t, models.SeverityWarning, partial.Severity)
+	assert.Equal(t, "Partial withdrawal requested", partial.Title)
+}
+
+func TestCredentialsChanged(t *testing.T) {
+	bls := "0x00" + strings.Repeat("ab", 31)
+	compounding := "0x02" + strings.Repeat("00", 11) + strings.Repeat("cd", 20)
+
+	v := &models.Validator{ValidatorIndex: 7}
+	assert.True(t, credentialsChanged(v, bls), "credentials are filled in for validators added without them")
+
+	v.WithdrawalCredentials = &bls
+	assert.True(t, credentialsChanged(v, compounding), "a consolidation to compounding credentials is followed")
+
+	v.WithdrawalCredentials = &compounding
+	assert.False(t, credentialsChanged(v, strings.ToUpper(compounding)), "credentials compare case-insensitively")
+	assert.False(t, credentialsChanged(v, ""), "a node that omits credentials leaves them as recorded")
+}
+
+func TestQueuedRequests(t *testing.T) {
+	monitored := map[int64]bool{1: true, 2: true}
+	withdrawals := []types.PendingPartialWithdrawal{
+		{ValidatorIndex: 1, Amount: 1_000_000_000, WithdrawableEpoch: 400},
+		{ValidatorIndex: 99, Amount: 1_000_000_000, WithdrawableEpoch: 400}, // Not monitored
+	}
+	consolidations := []types.PendingConsolidation{
+		{SourceIndex: 2, TargetIndex: 1},
+		{SourceIndex: 1, TargetIndex: 99},
+		{SourceIndex: 99, TargetIndex: 2}, // Only the target is monitored
+	}
+
+	queuedWithdrawals, queuedConsolidations := queuedRequests(monitored, withdrawals, consolidations)
+	require.Len(t, queuedWithdrawals, 1)
+	assert.Equal(t, models.QueuedWithdrawal{ValidatorIndex: 1, WithdrawableEpoch: 400, Amount: 1_000_000_000}, *queuedWithdrawals[0])
+
+	require.Len(t, queuedConsolidations, 2, "only consolidations from monitored validators are recorded")
+	assert.Equal(t, int64(2), queuedConsolidations[0].SourceIndex)
+	assert.Equal(t, int64(99), queuedConsolidations[1].TargetIndex)
+
+	withdrawal := queuedWithdrawalAlert(queuedWithdrawals[0])
+	assert.Equal(t, string(types.AlertTypeWithdrawalRequest), withdrawal.AlertType)
+	assert.Equal(t, "Partial withdrawal of 1.0000 ETH queued, withdrawable at epoch 400", withdrawal.Message)
+
+	consolidation := consolidationAlert(queuedConsolidations[1])
+	assert.Equal(t, int64(1), *consolidation.ValidatorIndex)
+	assert.Equal(t, models.SeverityCritical, consolidation.Severity)
+}
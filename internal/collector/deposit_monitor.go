@@ -0,0 +1,314 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var depositsObserved = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_deposits_total",
+		Help: "Total deposits to monitored validators by whether they were expected (expected, unexpected)",
+	},
+	[]string{"result"},
+)
+
+// DepositMonitorConfig contains configuration for the deposit monitor job
+type DepositMonitorConfig struct {
+	LookbackEpochs int64 // Epochs scanned when the job starts
+	MaxSlotsPerRun int   // Upper bound on blocks fetched per run
+	GenesisTime    time.Time
+}
+
+// DefaultDepositMonitorConfig returns default deposit monitor configuration
+func DefaultDepositMonitorConfig() *DepositMonitorConfig {
+	return &DepositMonitorConfig{
+		LookbackEpochs: 225, // ~1 day
+		MaxSlotsPerRun: 64,
+		GenesisTime:    time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// DepositMonitorJob follows deposits to monitored validators from the block that includes them,
+// through the beacon state's deposit queue, until they are credited. Each run scans the blocks
+// since the last run and, once it has caught up with the head, reads the queue to update each
+// pending deposit's place in it and mark those that have left it credited. Deposits that are
+// unexpected for the validator raise a warning.
+type DepositMonitorJob struct {
+	client        types.RewardsClient
+	validatorRepo *repository.ValidatorRepository
+	depositRepo   *repository.DepositRepository
+	alertRepo     *repository.AlertRepository
+	config        *DepositMonitorConfig
+
+	nextSlot int64 // First slot not yet scanned; zero before the first run
+}
+
+// NewDepositMonitorJob creates a new deposit monitor job
+func NewDepositMonitorJob(client types.RewardsClient, pool *pgxpool.Pool, config *DepositMonitorConfig) *DepositMonitorJob {
+	return &DepositMonitorJob{
+		client:        client,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		depositRepo:   repository.NewDepositRepository(pool),
+		alertRepo:     repository.NewAlertRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce scans the blocks since the last run, up to MaxSlotsPerRun, for deposits to monitored
+// validators, then reconciles the pending deposits with the queue at the head. The first run
+// starts LookbackEpochs before the head.
+func (j *DepositMonitorJob) RunOnce(ctx context.Context) error {
+	head := types.SlotAtTime(j.config.GenesisTime, time.Now()) - 1
+	if j.nextSlot == 0 {
+		j.nextSlot = max(head-j.config.LookbackEpochs*types.SlotsPerEpoch+1, 1)
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	last := min(head, j.nextSlot+int64(j.config.MaxSlotsPerRun)-1)
+	for slot := j.nextSlot; slot <= last; slot++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		block, err := j.client.GetBlock(ctx, int(slot))
+		if err != nil {
+			return err
+		}
+		if block != nil {
+			for _, d := range blockDeposits(j.config.GenesisTime, validators, block) {
+				if err := j.record(ctx, d); err != nil {
+					return err
+				}
+			}
+		}
+		j.nextSlot = slot + 1
+	}
+
+	// The queue only accounts for every recorded deposit once the scan has reached it
+	if j.nextSlot <= head {
+		return nil
+	}
+	return j.reconcileQueue(ctx, validators, head)
+}
+
+// reconcileQueue reads the deposit queue at a slot, records queued deposits not seen in a block,
+// and updates the pending deposits on record
+func (j *DepositMonitorJob) reconcileQueue(ctx context.Context, validators []*models.Validator, slot int64) error {
+	queue, err := j.client.GetPendingDeposits(ctx, int(slot))
+	if err != nil {
+		return fmt.Errorf("failed to read deposit queue: %w", err)
+	}
+	pending, err := j.depositRepo.GetPendingDeposits(ctx)
+	if err != nil {
+		return err
+	}
+
+	epoch := slot / types.SlotsPerEpoch
+	queued, added, credited := planDepositQueue(j.config.GenesisTime, validators, queue, pending, epoch, time.Now())
+	for _, d := range added {
+		if err := j.record(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	return j.depositRepo.UpdateQueue(ctx, queued, credited)
+}
+
+// record stores a deposit and alerts if it is unexpected and was not seen before
+func (j *DepositMonitorJob) record(ctx context.Context, d *models.ValidatorDeposit) error {
+	inserted, err := j.depositRepo.RecordDeposit(ctx, d)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return nil
+	}
+
+	if d.UnexpectedReason == nil {
+		depositsObserved.WithLabelValues("expected").Inc()
+		return nil
+	}
+
+	depositsObserved.WithLabelValues("unexpected").Inc()
+	if err := j.alertRepo.CreateAlert(ctx, depositAlert(d)); err != nil {
+		logger.FromContext(ctx).Error().
+			Err(err).
+			Int64("validator_index", d.ValidatorIndex).
+			Msg("Failed to create deposit alert")
+	}
+	return nil
+}
+
+// blockDeposits returns the deposit operations and deposit requests in a block that name one of
+// the validators
+func blockDeposits(genesis time.Time, validators []*models.Validator, block *types.Block) []*models.ValidatorDeposit {
+	byPubkey := validatorsByPubkey(validators)
+
+	slot := int64(block.Slot)
+	var deposits []*models.ValidatorDeposit
+	for _, d := range block.Deposits {
+		v, ok := byPubkey[strings.ToLower(d.Pubkey)]
+		if !ok {
+			continue
+		}
+		deposits = append(deposits, newValidatorDeposit(v, d.WithdrawalCredentials, d.Signature, d.Amount, slot,
+			types.SlotStartTime(genesis, slot)))
+	}
+
+	return deposits
+}
+
+// planDepositQueue matches the validators' entries in the deposit queue, read at epoch, with the
+// pending deposits on record. It returns the recorded deposits still queued with their new place
+// in the queue, the queued deposits not on record, and the recorded deposits that have left the
+// queue, credited at epoch. Entries that joined the queue without a deposit request have no slot,
+// so they are matched to a recorded deposit with the same signature and amount once deposits
+// matching by slot have been taken.
+func planDepositQueue(genesis time.Time, validators []*models.Validator, queue []types.PendingDeposit, pending []*models.ValidatorDeposit, epoch int64, now time.Time) (queued, added, credited []*models.ValidatorDeposit) {
+	byPubkey := validatorsByPubkey(validators)
+	byIndex := make(map[int64]bool, len(validators))
+	for _, v := range validators {
+		byIndex[v.ValidatorIndex] = true
+	}
+
+	type entry struct {
+		deposit   types.PendingDeposit
+		validator *models.Validator
+		position  int64
+		ahead     int64
+	}
+	var entries []entry
+	var ahead int64
+	for i, d := range queue {
+		if v, ok := byPubkey[strings.ToLower(d.Pubkey)]; ok && !d.IsBalanceTransfer() {
+			entries = append(entries, entry{deposit: d, validator: v, position: int64(i), ahead: ahead})
+		}
+		ahead += d.Amount
+	}
+
+	matched := make(map[*models.ValidatorDeposit]bool, len(pending))
+	match := func(e entry, anySlot bool) *models.ValidatorDeposit {
+		for _, p := range pending {
+			if matched[p] || p.ValidatorIndex != e.validator.ValidatorIndex || p.Amount != e.deposit.Amount ||
+				!strings.EqualFold(p.Signature, e.deposit.Signature) || !anySlot && p.Slot != e.deposit.Slot {
+				continue
+			}
+			matched[p] = true
+			return p
+		}
+		return nil
+	}
+
+	place := func(d *models.ValidatorDeposit, e entry) {
+		position, amountAhead := e.position, e.ahead
+		d.QueuePosition, d.QueueAmountAhead = &position, &amountAhead
+	}
+
+	var unmatched []entry
+	for _, e := range entries {
+		if p := match(e, false); p != nil {
+			place(p, e)
+			queued = append(queued, p)
+		} else {
+			unmatched = append(unmatched, e)
+		}
+	}
+	for _, e := range unmatched {
+		if e.deposit.Slot == 0 {
+			if p := match(e, true); p != nil {
+				place(p, e)
+				queued = append(queued, p)
+				continue
+			}
+		}
+
+		observed := now
+		if e.deposit.Slot > 0 {
+			observed = types.SlotStartTime(genesis, e.deposit.Slot)
+		}
+		d := newValidatorDeposit(e.validator, e.deposit.WithdrawalCredentials, e.deposit.Signature, e.deposit.Amount,
+			e.deposit.Slot, observed)
+		place(d, e)
+		added = append(added, d)
+	}
+
+	for _, p := range pending {
+		// Deposits to validators no longer monitored are left as last seen
+		if _, ok := byIndex[p.ValidatorIndex]; ok && !matched[p] {
+			creditedEpoch := epoch
+			p.Status = models.DepositStatusCredited
+			p.CreditedEpoch = &creditedEpoch
+			p.QueuePosition, p.QueueAmountAhead = nil, nil
+			credited = append(credited, p)
+		}
+	}
+
+	return queued, added, credited
+}
+
+// newValidatorDeposit builds a pending deposit to v, noting why it is unexpected if it is
+func newValidatorDeposit(v *models.Validator, credentials, signature string, amount, slot int64, at time.Time) *models.ValidatorDeposit {
+	d := &models.ValidatorDeposit{
+		ValidatorIndex:        v.ValidatorIndex,
+		Slot:                  slot,
+		Time:                  at,
+		WithdrawalCredentials: credentials,
+		Signature:             signature,
+		Amount:                amount,
+		Status:                models.DepositStatusPending,
+	}
+	if reason := v.DepositConcern(credentials); reason != "" {
+		d.UnexpectedReason = &reason
+	}
+	return d
+}
+
+// validatorsByPubkey indexes validators by lowercase public key
+func validatorsByPubkey(validators []*models.Validator) map[string]*models.Validator {
+	byPubkey := make(map[string]*models.Validator, len(validators))
+	for _, v := range validators {
+		byPubkey[strings.ToLower(v.Pubkey)] = v
+	}
+	return byPubkey
+}
+
+// depositAlert builds the warning for an unexpected deposit to a monitored validator
+func depositAlert(d *models.ValidatorDeposit) *models.Alert {
+	index := d.ValidatorIndex
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeUnexpectedDeposit),
+		Severity:       models.SeverityWarning,
+		Title:          "Unexpected deposit to validator",
+		Message:        d.Summary(),
+		Source:         "deposit_monitor",
+		Details: models.JSONB{
+			"slot":                   d.Slot,
+			"amount":                 d.Amount,
+			"withdrawal_credentials": d.WithdrawalCredentials,
+			"reason":                 *d.UnexpectedReason,
+		},
+		Status: models.AlertStatusNew,
+	}
+}
@@ -0,0 +1,102 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentials01 = "0x010000000000000000000000" + "00000000000000000000000000000000000000aa"
+
+func TestBlockDeposits(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	credentials := testCredentials01
+	exiting := models.LifecycleActiveExiting
+	validators := []*models.Validator{
+		{ValidatorIndex: 1, Pubkey: "0xAA", WithdrawalCredentials: &credentials},
+		{ValidatorIndex: 2, Pubkey: "0xbb", WithdrawalCredentials: &credentials, Status: &exiting},
+	}
+	block := &types.Block{
+		Slot: 3201,
+		Deposits: []types.Deposit{
+			{Pubkey: "0xaa", WithdrawalCredentials: credentials, Amount: 1_000_000_000, Signature: "0x5e"},
+			{Pubkey: "0xbb", WithdrawalCredentials: credentials, Amount: 2_000_000_000, Signature: "0x5f"},
+			{Pubkey: "0xee", WithdrawalCredentials: credentials, Amount: 32_000_000_000}, // External
+		},
+	}
+
+	deposits := blockDeposits(genesis, validators, block)
+	require.Len(t, deposits, 2)
+
+	assert.Equal(t, int64(1), deposits[0].ValidatorIndex, "pubkeys match case-insensitively")
+	assert.Equal(t, int64(3201), deposits[0].Slot)
+	assert.Equal(t, types.SlotStartTime(genesis, 3201), deposits[0].Time)
+	assert.Equal(t, models.DepositStatusPending, deposits[0].Status)
+	assert.Nil(t, deposits[0].UnexpectedReason)
+
+	require.NotNil(t, deposits[1].UnexpectedReason)
+	assert.Equal(t, "validator is active_exiting", *deposits[1].UnexpectedReason)
+
+	alert := depositAlert(deposits[1])
+	assert.Equal(t, string(types.AlertTypeUnexpectedDeposit), alert.AlertType)
+	assert.Equal(t, models.SeverityWarning, alert.Severity)
+	assert.Equal(t, int64(2), *alert.ValidatorIndex)
+	assert.Equal(t, "Deposit of 2.0000 ETH: validator is active_exiting", alert.Message)
+}
+
+func TestPlanDepositQueue(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	credentials := testCredentials01
+	validators := []*models.Validator{
+		{ValidatorIndex: 1, Pubkey: "0xaa", WithdrawalCredentials: &credentials},
+		{ValidatorIndex: 2, Pubkey: "0xbb", WithdrawalCredentials: &credentials},
+	}
+
+	request := &models.ValidatorDeposit{ID: 10, ValidatorIndex: 1, Slot: 3201, Signature: "0x5e", Amount: 1_000_000_000}
+	legacy := &models.ValidatorDeposit{ID: 11, ValidatorIndex: 1, Slot: 3150, Signature: "0x60", Amount: 1_000_000_000}
+	done := &models.ValidatorDeposit{ID: 12, ValidatorIndex: 2, Slot: 3100, Signature: "0x61", Amount: 4_000_000_000}
+	unmonitored := &models.ValidatorDeposit{ID: 13, ValidatorIndex: 9, Slot: 3000, Signature: "0x62", Amount: 1_000_000_000}
+
+	queue := []types.PendingDeposit{
+		{Pubkey: "0xee", Signature: "0x01", Amount: 32_000_000_000, Slot: 3000},
+		{Pubkey: "0xaa", Signature: "0x60", Amount: 1_000_000_000}, // Legacy deposit, no slot
+		{Pubkey: "0xbb", Signature: types.G2PointAtInfinity, Amount: 500_000_000},
+		{Pubkey: "0xAA", Signature: "0x5e", Amount: 1_000_000_000, Slot: 3201},
+		{Pubkey: "0xbb", WithdrawalCredentials: "0x01" + "00", Signature: "0x63", Amount: 3_000_000_000, Slot: 3210},
+	}
+
+	queued, added, credited := planDepositQueue(genesis, validators, queue,
+		[]*models.ValidatorDeposit{legacy, request, done, unmonitored}, 110, now)
+
+	require.Len(t, queued, 2)
+	assert.Equal(t, request, queued[0], "deposits with a slot are matched first")
+	assert.Equal(t, int64(3), *request.QueuePosition)
+	assert.Equal(t, int64(33_500_000_000), *request.QueueAmountAhead, "balance moved by the chain still counts ahead")
+	assert.Equal(t, legacy, queued[1])
+	assert.Equal(t, int64(1), *legacy.QueuePosition)
+	assert.Equal(t, int64(32_000_000_000), *legacy.QueueAmountAhead)
+
+	require.Len(t, added, 1)
+	assert.Equal(t, int64(2), added[0].ValidatorIndex)
+	assert.Equal(t, int64(3210), added[0].Slot)
+	assert.Equal(t, types.SlotStartTime(genesis, 3210), added[0].Time)
+	assert.Equal(t, int64(4), *added[0].QueuePosition)
+	require.NotNil(t, added[0].UnexpectedReason, "credentials differ from the validator's")
+
+	require.Len(t, credited, 1)
+	assert.Equal(t, done, credited[0])
+	assert.Equal(t, models.DepositStatusCredited, done.Status)
+	assert.Equal(t, int64(110), *done.CreditedEpoch)
+	assert.Nil(t, done.QueuePosition)
+	assert.Equal(t, models.DepositStatus(""), unmonitored.Status, "deposits to unmonitored validators are left alone")
+
+	_, added, _ = planDepositQueue(genesis, validators,
+		[]types.PendingDeposit{{Pubkey: "0xaa", WithdrawalCredentials: credentials, Signature: "0x70", Amount: 1}}, nil, 110, now)
+	require.Len(t, added, 1)
+	assert.Equal(t, now, added[0].Time, "queued deposits without a slot are dated when first seen")
+}
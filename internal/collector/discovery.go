@@ -0,0 +1,342 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var discoveryEnrolled = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_discovery_enrolled_total",
+		Help: "Total validators enrolled for monitoring by discovery rules by rule kind",
+	},
+	[]string{"kind"},
+)
+
+// DiscoveryConfig contains configuration for the validator discovery job
+type DiscoveryConfig struct {
+	LookbackEpochs    int64 // Epochs of blocks searched for fee recipients when the job starts
+	MaxSlotsPerRun    int   // Upper bound on blocks fetched per run for fee recipient rules
+	DepositStartBlock int64 // Execution block depositor rules start searching from
+	MaxBlocksPerRun   int64 // Upper bound on execution blocks searched per depositor rule per run
+	LogChunkBlocks    int64 // Execution blocks per eth_getLogs request
+	GenesisTime       time.Time
+}
+
+// DefaultDiscoveryConfig returns default discovery configuration
+func DefaultDiscoveryConfig() *DiscoveryConfig {
+	return &DiscoveryConfig{
+		LookbackEpochs:    225, // ~1 day
+		MaxSlotsPerRun:    600,
+		DepositStartBlock: MainnetDepositContractBlock,
+		MaxBlocksPerRun:   1_000_000,
+		LogChunkBlocks:    10_000,
+		GenesisTime:       time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// DiscoveryJob enrolls validators matching discovery rules as monitored validators under each
+// rule's tag. Withdrawal rules are matched against the credentials of every validator in the head
+// state, fee recipient rules against the blocks proposed since the last run, and depositor rules
+// against the senders of deposit contract events read from the execution client. Every run picks
+// up validators that appeared since the previous one.
+type DiscoveryJob struct {
+	client        types.DiscoveryClient
+	deposits      types.DepositSource // Nil when no execution client is configured
+	validatorRepo *repository.ValidatorRepository
+	discoveryRepo *repository.DiscoveryRepository
+	config        *DiscoveryConfig
+
+	nextSlot int64 // First slot not yet searched for fee recipients; zero before the first search
+}
+
+// NewDiscoveryJob creates a new discovery job. deposits may be nil, in which case depositor rules
+// are skipped.
+func NewDiscoveryJob(client types.DiscoveryClient, deposits types.DepositSource, pool *pgxpool.Pool, config *DiscoveryConfig) *DiscoveryJob {
+	return &DiscoveryJob{
+		client:        client,
+		deposits:      deposits,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		discoveryRepo: repository.NewDiscoveryRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce evaluates every discovery rule and enrolls the validators it matches
+func (j *DiscoveryJob) RunOnce(ctx context.Context) error {
+	rules, err := j.discoveryRepo.ListRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	byKind := make(map[models.DiscoveryKind][]*models.DiscoveryRule)
+	for _, rule := range rules {
+		byKind[rule.Kind] = append(byKind[rule.Kind], rule)
+	}
+
+	if withdrawal := byKind[models.DiscoveryKindWithdrawal]; len(withdrawal) > 0 {
+		if err := j.discoverByWithdrawal(ctx, withdrawal); err != nil {
+			return err
+		}
+	}
+
+	if feeRecipient := byKind[models.DiscoveryKindFeeRecipient]; len(feeRecipient) > 0 {
+		if err := j.discoverByFeeRecipient(ctx, feeRecipient); err != nil {
+			return err
+		}
+	}
+
+	if depositor := byKind[models.DiscoveryKindDepositor]; len(depositor) > 0 {
+		if j.deposits == nil {
+			logger.FromContext(ctx).Warn().
+				Int("rules", len(depositor)).
+				Msg("Skipping depositor discovery rules: no execution client configured")
+			return nil
+		}
+		for _, rule := range depositor {
+			if err := j.discoverByDepositor(ctx, rule); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// discoverByWithdrawal scans the whole head state once for validators withdrawing to the rules'
+// addresses or credentials
+func (j *DiscoveryJob) discoverByWithdrawal(ctx context.Context, rules []*models.DiscoveryRule) error {
+	matches := make(map[int64][]*models.Validator, len(rules))
+	err := j.client.ScanValidators(ctx, nil, func(identity types.ValidatorIdentity) error {
+		for _, rule := range matchWithdrawalRules(rules, identity) {
+			matches[rule.ID] = append(matches[rule.ID], identityValidator(identity))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to scan validators: %w", err)
+	}
+
+	for _, rule := range rules {
+		if err := j.enroll(ctx, rule, matches[rule.ID], rule.NextBlock); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discoverByFeeRecipient searches the blocks since the last run, up to MaxSlotsPerRun, for
+// proposals paying the rules' fee recipients. The first search starts LookbackEpochs before the
+// head.
+func (j *DiscoveryJob) discoverByFeeRecipient(ctx context.Context, rules []*models.DiscoveryRule) error {
+	head := types.SlotAtTime(j.config.GenesisTime, time.Now()) - 1
+	if j.nextSlot == 0 {
+		j.nextSlot = max(head-j.config.LookbackEpochs*types.SlotsPerEpoch+1, 1)
+	}
+
+	proposers := make(map[int64]map[int64]bool, len(rules))
+	last := min(head, j.nextSlot+int64(j.config.MaxSlotsPerRun)-1)
+	for slot := j.nextSlot; slot <= last; slot++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		block, err := j.client.GetBlock(ctx, int(slot))
+		if err != nil {
+			return err
+		}
+		if block != nil {
+			for _, rule := range matchFeeRecipientRules(rules, block) {
+				if proposers[rule.ID] == nil {
+					proposers[rule.ID] = make(map[int64]bool)
+				}
+				proposers[rule.ID][int64(block.ProposerIndex)] = true
+			}
+		}
+	}
+
+	for _, rule := range rules {
+		ids := make([]string, 0, len(proposers[rule.ID]))
+		for index := range proposers[rule.ID] {
+			ids = append(ids, strconv.FormatInt(index, 10))
+		}
+
+		validators, err := j.identities(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if err := j.enroll(ctx, rule, validators, rule.NextBlock); err != nil {
+			return err
+		}
+	}
+	j.nextSlot = max(j.nextSlot, last+1)
+
+	return nil
+}
+
+// discoverByDepositor searches deposit contract events from the rule's next block, up to
+// MaxBlocksPerRun, for deposits sent by the rule's address. Deposited keys are enrolled once the
+// beacon chain has assigned them an index, which can take many hours after the deposit.
+func (j *DiscoveryJob) discoverByDepositor(ctx context.Context, rule *models.DiscoveryRule) error {
+	head, err := j.deposits.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	from := rule.NextBlock
+	if from == 0 {
+		from = j.config.DepositStartBlock
+	}
+	last := min(head, from+j.config.MaxBlocksPerRun-1)
+
+	senders := make(map[string]string)
+	for start := from; start <= last; start += j.config.LogChunkBlocks {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		end := min(last, start+j.config.LogChunkBlocks-1)
+		logs, err := j.deposits.GetDepositLogs(ctx, start, end)
+		if err != nil {
+			return err
+		}
+		for _, l := range logs {
+			if _, ok := senders[l.TxHash]; ok {
+				continue
+			}
+			sender, err := j.deposits.GetTransactionSender(ctx, l.TxHash)
+			if err != nil {
+				return err
+			}
+			senders[l.TxHash] = sender
+		}
+
+		if err := j.discoveryRepo.RecordDeposits(ctx, rule.ID, depositorDeposits(rule, logs, senders)); err != nil {
+			return err
+		}
+	}
+
+	pending, err := j.discoveryRepo.PendingDeposits(ctx, rule.ID)
+	if err != nil {
+		return err
+	}
+	validators, err := j.identities(ctx, pending)
+	if err != nil {
+		return err
+	}
+	for _, v := range validators {
+		if err := j.discoveryRepo.ResolveDeposit(ctx, rule.ID, strings.ToLower(v.Pubkey), v.ValidatorIndex); err != nil {
+			return err
+		}
+	}
+
+	return j.enroll(ctx, rule, validators, max(from, last+1))
+}
+
+// identities resolves validator indices or public keys to validators in the head state; keys the
+// beacon chain has not yet seen are omitted
+func (j *DiscoveryJob) identities(ctx context.Context, ids []string) ([]*models.Validator, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var validators []*models.Validator
+	err := j.client.ScanValidators(ctx, ids, func(identity types.ValidatorIdentity) error {
+		validators = append(validators, identityValidator(identity))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up validators: %w", err)
+	}
+
+	return validators, nil
+}
+
+// enroll adds a rule's matches to monitoring and records the run
+func (j *DiscoveryJob) enroll(ctx context.Context, rule *models.DiscoveryRule, validators []*models.Validator, nextBlock int64) error {
+	enrolled, err := j.validatorRepo.EnrollValidators(ctx, rule.Tag, validators)
+	if err != nil {
+		return err
+	}
+
+	if enrolled > 0 {
+		discoveryEnrolled.WithLabelValues(string(rule.Kind)).Add(float64(enrolled))
+		logger.FromContext(ctx).Info().
+			Int64("rule_id", rule.ID).
+			Str("kind", string(rule.Kind)).
+			Str("tag", rule.Tag).
+			Int("enrolled", enrolled).
+			Msg("Enrolled discovered validators")
+	}
+
+	return j.discoveryRepo.CompleteRun(ctx, rule.ID, nextBlock, enrolled)
+}
+
+// matchWithdrawalRules returns the withdrawal rules matching a validator's credentials
+func matchWithdrawalRules(rules []*models.DiscoveryRule, identity types.ValidatorIdentity) []*models.DiscoveryRule {
+	var matched []*models.DiscoveryRule
+	for _, rule := range rules {
+		if rule.MatchesCredentials(identity.WithdrawalCredentials) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// matchFeeRecipientRules returns the fee recipient rules naming a block's fee recipient
+func matchFeeRecipientRules(rules []*models.DiscoveryRule, block *types.Block) []*models.DiscoveryRule {
+	if block.FeeRecipient == "" {
+		return nil
+	}
+
+	var matched []*models.DiscoveryRule
+	for _, rule := range rules {
+		if rule.Kind == models.DiscoveryKindFeeRecipient && strings.EqualFold(rule.Value, block.FeeRecipient) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// depositorDeposits returns the deposits among logs sent by a depositor rule's address, given the
+// sender of each transaction
+func depositorDeposits(rule *models.DiscoveryRule, logs []types.DepositLog, senders map[string]string) []*models.DiscoveredDeposit {
+	var deposits []*models.DiscoveredDeposit
+	for _, l := range logs {
+		if !strings.EqualFold(senders[l.TxHash], rule.Value) {
+			continue
+		}
+		deposits = append(deposits, &models.DiscoveredDeposit{
+			RuleID:      rule.ID,
+			Pubkey:      strings.ToLower(l.Pubkey),
+			BlockNumber: l.BlockNumber,
+			TxHash:      l.TxHash,
+		})
+	}
+	return deposits
+}
+
+// identityValidator converts a beacon state identity into a validator to enroll
+func identityValidator(identity types.ValidatorIdentity) *models.Validator {
+	v := &models.Validator{
+		ValidatorIndex: identity.Index,
+		Pubkey:         identity.Pubkey,
+	}
+	if identity.WithdrawalCredentials != "" {
+		credentials := identity.WithdrawalCredentials
+		v.WithdrawalCredentials = &credentials
+	}
+	return v
+}
@@ -0,0 +1,196 @@
+package collector
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress     = "0x1111111111111111111111111111111111111111"
+	testCredentials = "0x010000000000000000000000" + "1111111111111111111111111111111111111111"
+)
+
+func TestDiscoveryRuleMatching(t *testing.T) {
+	byAddress := &models.DiscoveryRule{ID: 1, Kind: models.DiscoveryKindWithdrawal, Value: testAddress}
+	byCredentials := &models.DiscoveryRule{ID: 2, Kind: models.DiscoveryKindWithdrawal, Value: "0x00" + strings.Repeat("ab", 31)}
+	rules := []*models.DiscoveryRule{byAddress, byCredentials}
+
+	matched := matchWithdrawalRules(rules, types.ValidatorIdentity{Index: 7, WithdrawalCredentials: strings.ToUpper(testCredentials)})
+	assert.Equal(t, []*models.DiscoveryRule{byAddress}, matched)
+
+	matched = matchWithdrawalRules(rules, types.ValidatorIdentity{Index: 8, WithdrawalCredentials: byCredentials.Value})
+	assert.Equal(t, []*models.DiscoveryRule{byCredentials}, matched)
+
+	feeRecipient := &models.DiscoveryRule{ID: 3, Kind: models.DiscoveryKindFeeRecipient, Value: testAddress}
+	block := &types.Block{Slot: 100, ProposerIndex: 7, FeeRecipient: "0x" + strings.ToUpper(testAddress[2:])}
+	assert.Equal(t, []*models.DiscoveryRule{feeRecipient}, matchFeeRecipientRules([]*models.DiscoveryRule{byAddress, feeRecipient}, block))
+	assert.Empty(t, matchFeeRecipientRules([]*models.DiscoveryRule{feeRecipient}, &types.Block{Slot: 101}))
+}
+
+func TestDepositorDeposits(t *testing.T) {
+	rule := &models.DiscoveryRule{ID: 4, Kind: models.DiscoveryKindDepositor, Value: testAddress}
+	logs := []types.DepositLog{
+		{BlockNumber: 10, TxHash: "0xa1", Pubkey: "0xAA"},
+		{BlockNumber: 10, TxHash: "0xa1", Pubkey: "0xbb"}, // Same batch deposit
+		{BlockNumber: 11, TxHash: "0xa2", Pubkey: "0xcc"}, // Someone else
+		{BlockNumber: 12, TxHash: "0xa3", Pubkey: "0xdd"}, // Sender unknown
+	}
+	senders := map[string]string{"0xa1": strings.ToUpper(testAddress), "0xa2": "0x2222222222222222222222222222222222222222"}
+
+	deposits := depositorDeposits(rule, logs, senders)
+	assert.Equal(t, []*models.DiscoveredDeposit{
+		{RuleID: 4, Pubkey: "0xaa", BlockNumber: 10, TxHash: "0xa1"},
+		{RuleID: 4, Pubkey: "0xbb", BlockNumber: 10, TxHash: "0xa1"},
+	}, deposits)
+}
+
+// encodeDepositEvent ABI-encodes the five byte string fields of a DepositEvent
+func encodeDepositEvent(fields ...[]byte) string {
+	var head, tail []byte
+	for _, f := range fields {
+		head = append(head, word(int64(len(fields)*32+len(tail)))...)
+		tail = append(tail, word(int64(len(f)))...)
+		padded := make([]byte, (len(f)+31)/32*32)
+		copy(padded, f)
+		tail = append(tail, padded...)
+	}
+	return "0x" + hex.EncodeToString(append(head, tail...))
+}
+
+func word(n int64) []byte {
+	return new(big.Int).SetInt64(n).FillBytes(make([]byte, 32))
+}
+
+func TestDecodeDepositEvent(t *testing.T) {
+	pubkey := make([]byte, 48)
+	pubkey[0] = 0xaa
+	credentials, _ := hex.DecodeString(testCredentials[2:])
+	amount := binary.LittleEndian.AppendUint64(nil, 32_000_000_000)
+
+	deposit, err := decodeDepositEvent(encodeDepositEvent(pubkey, credentials, amount, make([]byte, 96), make([]byte, 8)))
+	require.NoError(t, err)
+	assert.Equal(t, "0xaa"+strings.Repeat("00", 47), deposit.Pubkey)
+	assert.Equal(t, testCredentials, deposit.WithdrawalCredentials)
+	assert.Equal(t, int64(32_000_000_000), deposit.Amount)
+
+	_, err = decodeDepositEvent(encodeDepositEvent(pubkey, credentials, amount[:4], nil, nil))
+	assert.Error(t, err)
+	_, err = decodeDepositEvent("0x1234")
+	assert.Error(t, err)
+}
+
+func TestExecutionClient(t *testing.T) {
+	data := encodeDepositEvent([]byte{0xbb}, []byte{0x01}, binary.LittleEndian.AppendUint64(nil, 1_000_000_000), nil, nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64             `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result string
+		switch req.Method {
+		case "eth_blockNumber":
+			result = `"0x1a"`
+		case "eth_getLogs":
+			var filter map[string]interface{}
+			require.NoError(t, json.Unmarshal(req.Params[0], &filter))
+			assert.Equal(t, MainnetDepositContract, filter["address"])
+			assert.Equal(t, "0xa", filter["fromBlock"])
+			assert.Equal(t, "0x14", filter["toBlock"])
+			result = `[{"blockNumber":"0x10","transactionHash":"0xa1","data":"` + data + `"},
+				{"blockNumber":"0x11","transactionHash":"0xa2","data":"` + data + `","removed":true}]`
+		case "eth_getTransactionByHash":
+			if string(req.Params[0]) == `"0xa1"` {
+				result = `{"hash":"0xa1","from":"` + testAddress + `"}`
+			} else {
+				result = `null`
+			}
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+	}))
+	defer server.Close()
+
+	client := NewExecutionClient(server.URL, MainnetDepositContract, 5*time.Second)
+	ctx := context.Background()
+
+	head, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(26), head)
+
+	logs, err := client.GetDepositLogs(ctx, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, []types.DepositLog{{BlockNumber: 16, TxHash: "0xa1", Pubkey: "0xbb", WithdrawalCredentials: "0x01", Amount: 1_000_000_000}}, logs,
+		"removed logs are skipped")
+
+	sender, err := client.GetTransactionSender(ctx, "0xa1")
+	require.NoError(t, err)
+	assert.Equal(t, testAddress, sender)
+
+	_, err = client.GetTransactionSender(ctx, "0xa9")
+	assert.ErrorContains(t, err, "not found")
+
+	err = client.call(ctx, "eth_unknown", nil, new(string))
+	assert.ErrorContains(t, err, "method not found")
+}
+
+func TestBeaconClient_ScanValidators(t *testing.T) {
+	var requested [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/states/head/validators", r.URL.Path)
+		var body struct {
+			IDs []string `json:"ids"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requested = append(requested, body.IDs)
+		w.Write([]byte(`{"execution_optimistic":false,"finalized":false,"data":[
+			{"index":"7","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0xaa","withdrawal_credentials":"` + testCredentials + `",
+				"slashed":false,"activation_eligibility_epoch":"100","activation_epoch":"105","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}},
+			{"index":"8","balance":"0","status":"pending_queued","validator":{"pubkey":"0xbb","withdrawal_credentials":"0x00ab"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewBeaconClientWithoutRetry(server.URL, 5*time.Second)
+
+	var scanned []types.ValidatorIdentity
+	err := client.ScanValidators(context.Background(), nil, func(v types.ValidatorIdentity) error {
+		scanned = append(scanned, v)
+		return nil
+	})
+	require.NoError(t, err)
+	eligibility, activation := int64(100), int64(105)
+	assert.Equal(t, []types.ValidatorIdentity{
+		{Index: 7, Pubkey: "0xaa", WithdrawalCredentials: testCredentials, Status: "active_ongoing",
+			ActivationEligibilityEpoch: &eligibility, ActivationEpoch: &activation},
+		{Index: 8, Pubkey: "0xbb", WithdrawalCredentials: "0x00ab", Status: "pending_queued"},
+	}, scanned)
+	assert.Nil(t, requested[0], "an empty scan asks for every validator")
+
+	ids := make([]string, validatorIDsPerRequest+1)
+	for i := range ids {
+		ids[i] = "1"
+	}
+	err = client.ScanValidators(context.Background(), ids, func(types.ValidatorIdentity) error {
+		return assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError, "the callback's error stops the scan")
+	assert.Len(t, requested, 2)
+	assert.Len(t, requested[1], validatorIDsPerRequest)
+}
@@ -0,0 +1,114 @@
+package collector
+
+import (
+	"context"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var effectiveBalanceSteps = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_effective_balance_steps_total",
+		Help: "Total effective balance steps of monitored validators by direction (up, down)",
+	},
+	[]string{"direction"},
+)
+
+// EffectiveBalanceConfig contains configuration for the effective balance job
+type EffectiveBalanceConfig struct {
+	LookbackEpochs int64 // Ledger epochs searched for steps on each run
+	GenesisTime    time.Time
+}
+
+// DefaultEffectiveBalanceConfig returns default effective balance configuration
+func DefaultEffectiveBalanceConfig() *EffectiveBalanceConfig {
+	return &EffectiveBalanceConfig{
+		LookbackEpochs: 225, // ~1 day
+		GenesisTime:    time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// EffectiveBalanceJob records the effective balance steps of monitored validators from the rewards
+// ledger, and raises an alert when penalties push a validator below its downward hysteresis
+// threshold, since the lower effective balance earns less on every duty until it recovers.
+type EffectiveBalanceJob struct {
+	balanceRepo *repository.EffectiveBalanceRepository
+	alertRepo   *repository.AlertRepository
+	config      *EffectiveBalanceConfig
+}
+
+// NewEffectiveBalanceJob creates a new effective balance job
+func NewEffectiveBalanceJob(pool *pgxpool.Pool, config *EffectiveBalanceConfig) *EffectiveBalanceJob {
+	return &EffectiveBalanceJob{
+		balanceRepo: repository.NewEffectiveBalanceRepository(pool),
+		alertRepo:   repository.NewAlertRepository(pool),
+		config:      config,
+	}
+}
+
+// RunOnce records the steps found in the ledger over the lookback and alerts on new steps down
+// caused by penalties
+func (j *EffectiveBalanceJob) RunOnce(ctx context.Context) error {
+	fromEpoch := types.EpochAtTime(j.config.GenesisTime, time.Now()) - j.config.LookbackEpochs
+
+	steps, err := j.balanceRepo.DetectSteps(ctx, fromEpoch)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		inserted, err := j.balanceRepo.Record(ctx, step)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			continue
+		}
+
+		direction := "up"
+		if step.Down() {
+			direction = "down"
+		}
+		effectiveBalanceSteps.WithLabelValues(direction).Inc()
+
+		if !step.CausedByPenalties() {
+			continue
+		}
+		if err := j.alertRepo.CreateAlert(ctx, stepAlert(step)); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Int64("validator_index", step.ValidatorIndex).
+				Msg("Failed to create effective balance alert")
+		}
+	}
+
+	return nil
+}
+
+// stepAlert builds the balance decrease alert for a step down caused by penalties
+func stepAlert(step *models.EffectiveBalanceStep) *models.Alert {
+	index := step.ValidatorIndex
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeBalanceDecrease),
+		Severity:       models.SeverityWarning,
+		Title:          "Effective balance stepped down",
+		Message:        step.Summary(),
+		Source:         "effective_balance",
+		Details: models.JSONB{
+			"epoch":                      step.Epoch,
+			"previous_effective_balance": step.PreviousEffectiveBalance,
+			"effective_balance":          step.EffectiveBalance,
+			"balance":                    step.Balance,
+			"penalties":                  step.Penalties,
+		},
+		Status: models.AlertStatusNew,
+	}
+}
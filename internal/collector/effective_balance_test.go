@@ -0,0 +1,30 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepAlert(t *testing.T) {
+	step := &models.EffectiveBalanceStep{
+		ValidatorIndex:           42,
+		Epoch:                    300_000,
+		PreviousEffectiveBalance: 32_000_000_000,
+		EffectiveBalance:         31_000_000_000,
+		Balance:                  31_749_000_000,
+		Penalties:                2_250_000,
+	}
+
+	alert := stepAlert(step)
+	require.NotNil(t, alert.ValidatorIndex)
+	assert.Equal(t, int64(42), *alert.ValidatorIndex)
+	assert.Equal(t, string(types.AlertTypeBalanceDecrease), alert.AlertType)
+	assert.Equal(t, models.SeverityWarning, alert.Severity)
+	assert.Equal(t, step.Summary(), alert.Message)
+	assert.Equal(t, int64(31_000_000_000), alert.Details["effective_balance"])
+	assert.Equal(t, int64(2_250_000), alert.Details["penalties"])
+}
@@ -0,0 +1,217 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// MainnetDepositContract is the address of the beacon chain deposit contract on mainnet
+const MainnetDepositContract = "0x00000000219ab540356cBB839Cbe05303d7705Fa"
+
+// MainnetDepositContractBlock is the execution block in which the mainnet deposit contract was deployed
+const MainnetDepositContractBlock int64 = 11052984
+
+// depositEventTopic is the keccak256 hash of DepositEvent(bytes,bytes,bytes,bytes,bytes)
+const depositEventTopic = "0x649bbc62d0e31342afea4e5cd82d4049e7e1ee912fc0889aa790803be39038c5"
+
+// ExecutionClient reads deposit contract events through an execution client's JSON-RPC API
+type ExecutionClient struct {
+	url             string
+	depositContract string
+	httpClient      *http.Client
+	nextID          atomic.Int64
+}
+
+// NewExecutionClient creates a client for the execution node at url, reading events of the
+// deposit contract at depositContract
+func NewExecutionClient(url, depositContract string, timeout time.Duration) *ExecutionClient {
+	return &ExecutionClient{
+		url:             url,
+		depositContract: depositContract,
+		httpClient:      &http.Client{Timeout: timeout},
+	}
+}
+
+// BlockNumber retrieves the number of the latest execution block
+func (c *ExecutionClient) BlockNumber(ctx context.Context) (int64, error) {
+	var result string
+	if err := c.call(ctx, "eth_blockNumber", nil, &result); err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+	return parseQuantity(result)
+}
+
+// GetDepositLogs retrieves the deposit events emitted in [fromBlock, toBlock]
+func (c *ExecutionClient) GetDepositLogs(ctx context.Context, fromBlock, toBlock int64) ([]types.DepositLog, error) {
+	filter := map[string]interface{}{
+		"address":   c.depositContract,
+		"topics":    []string{depositEventTopic},
+		"fromBlock": "0x" + strconv.FormatInt(fromBlock, 16),
+		"toBlock":   "0x" + strconv.FormatInt(toBlock, 16),
+	}
+
+	var logs []struct {
+		BlockNumber     string `json:"blockNumber"`
+		TransactionHash string `json:"transactionHash"`
+		Data            string `json:"data"`
+		Removed         bool   `json:"removed"`
+	}
+	if err := c.call(ctx, "eth_getLogs", []interface{}{filter}, &logs); err != nil {
+		return nil, fmt.Errorf("failed to get deposit logs for blocks %d-%d: %w", fromBlock, toBlock, err)
+	}
+
+	deposits := make([]types.DepositLog, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		block, err := parseQuantity(l.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		deposit, err := decodeDepositEvent(l.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode deposit in %s: %w", l.TransactionHash, err)
+		}
+		deposit.BlockNumber = block
+		deposit.TxHash = l.TransactionHash
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits, nil
+}
+
+// GetTransactionSender retrieves the address that sent a transaction
+func (c *ExecutionClient) GetTransactionSender(ctx context.Context, txHash string) (string, error) {
+	var tx *struct {
+		From string `json:"from"`
+	}
+	if err := c.call(ctx, "eth_getTransactionByHash", []interface{}{txHash}, &tx); err != nil {
+		return "", fmt.Errorf("failed to get transaction %s: %w", txHash, err)
+	}
+	if tx == nil {
+		return "", fmt.Errorf("transaction %s not found", txHash)
+	}
+	return tx.From, nil
+}
+
+// call sends a JSON-RPC request and decodes its result into out
+func (c *ExecutionClient) call(ctx context.Context, method string, params []interface{}, out interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      c.nextID.Add(1),
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if envelope.Error != nil {
+		return fmt.Errorf("rpc error %d: %s", envelope.Error.Code, envelope.Error.Message)
+	}
+
+	if err := json.Unmarshal(envelope.Result, out); err != nil {
+		return fmt.Errorf("failed to decode result: %w", err)
+	}
+	return nil
+}
+
+// parseQuantity parses a hex-encoded JSON-RPC quantity
+func parseQuantity(s string) (int64, error) {
+	n, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	if !ok || !n.IsInt64() {
+		return 0, fmt.Errorf("invalid quantity %q", s)
+	}
+	return n.Int64(), nil
+}
+
+// decodeDepositEvent decodes the ABI-encoded data of a DepositEvent, whose five fields are dynamic
+// byte strings: pubkey, withdrawal_credentials, amount (little-endian Gwei), signature and index
+func decodeDepositEvent(data string) (types.DepositLog, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return types.DepositLog{}, fmt.Errorf("invalid hex: %w", err)
+	}
+
+	field := func(i int) ([]byte, error) {
+		if len(raw) < (i+1)*32 {
+			return nil, fmt.Errorf("data too short")
+		}
+		offset := new(big.Int).SetBytes(raw[i*32 : (i+1)*32])
+		if !offset.IsInt64() || offset.Int64()+32 > int64(len(raw)) {
+			return nil, fmt.Errorf("field %d offset out of range", i)
+		}
+		start := int(offset.Int64())
+		length := new(big.Int).SetBytes(raw[start : start+32])
+		if !length.IsInt64() || int64(start+32)+length.Int64() > int64(len(raw)) {
+			return nil, fmt.Errorf("field %d length out of range", i)
+		}
+		return raw[start+32 : start+32+int(length.Int64())], nil
+	}
+
+	pubkey, err := field(0)
+	if err != nil {
+		return types.DepositLog{}, err
+	}
+	credentials, err := field(1)
+	if err != nil {
+		return types.DepositLog{}, err
+	}
+	amount, err := field(2)
+	if err != nil {
+		return types.DepositLog{}, err
+	}
+	if len(amount) != 8 {
+		return types.DepositLog{}, fmt.Errorf("amount is %d bytes, want 8", len(amount))
+	}
+
+	return types.DepositLog{
+		Pubkey:                "0x" + hex.EncodeToString(pubkey),
+		WithdrawalCredentials: "0x" + hex.EncodeToString(credentials),
+		Amount:                int64(binary.LittleEndian.Uint64(amount)),
+	}, nil
+}
@@ -0,0 +1,266 @@
+package collector
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var feeRecipientChecks = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_fee_recipient_checks_total",
+		Help: "Total blocks proposed by monitored validators checked for their fee recipient by outcome",
+	},
+	[]string{"status"},
+)
+
+// FeeRecipientConfig contains configuration for the fee recipient job
+type FeeRecipientConfig struct {
+	LookbackEpochs int64               // Epochs checked when the job starts
+	MaxSlotsPerRun int                 // Upper bound on slots whose proposals are checked per run
+	Expected       map[string][]string // Expected fee recipients by validator tag, or "*" for all
+	GenesisTime    time.Time
+}
+
+// DefaultFeeRecipientConfig returns default fee recipient configuration
+func DefaultFeeRecipientConfig() *FeeRecipientConfig {
+	return &FeeRecipientConfig{
+		LookbackEpochs: 225, // ~1 day
+		MaxSlotsPerRun: 7200,
+		GenesisTime:    time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// FeeRecipientJob checks where the execution rewards of every block proposed by a monitored
+// validator went. A locally built block must name an expected address as its fee recipient; a
+// MEV-boost block names the builder, which must pay an expected address in the block's final
+// transaction. Every check is recorded, and a mismatch raises a critical alert. Validators with
+// no expected address are not checked.
+type FeeRecipientJob struct {
+	client           types.FeeRecipientClient
+	validatorRepo    *repository.ValidatorRepository
+	feeRecipientRepo *repository.FeeRecipientRepository
+	alertRepo        *repository.AlertRepository
+	config           *FeeRecipientConfig
+
+	nextSlot int64 // First slot not yet checked; zero before the first run
+}
+
+// NewFeeRecipientJob creates a new fee recipient job
+func NewFeeRecipientJob(client types.FeeRecipientClient, pool *pgxpool.Pool, config *FeeRecipientConfig) *FeeRecipientJob {
+	return &FeeRecipientJob{
+		client:           client,
+		validatorRepo:    repository.NewValidatorRepository(pool),
+		feeRecipientRepo: repository.NewFeeRecipientRepository(pool),
+		alertRepo:        repository.NewAlertRepository(pool),
+		config:           config,
+	}
+}
+
+// RunOnce checks the blocks proposed by monitored validators since the last run, up to
+// MaxSlotsPerRun slots. The first run starts LookbackEpochs before the head.
+func (j *FeeRecipientJob) RunOnce(ctx context.Context) error {
+	head := types.SlotAtTime(j.config.GenesisTime, time.Now()) - 1
+	if j.nextSlot == 0 {
+		j.nextSlot = max(head-j.config.LookbackEpochs*types.SlotsPerEpoch+1, 1)
+	}
+	if j.nextSlot > head {
+		return nil
+	}
+
+	expected, err := j.expected(ctx)
+	if err != nil {
+		return err
+	}
+
+	last := min(head, j.nextSlot+int64(j.config.MaxSlotsPerRun)-1)
+	if len(expected) > 0 {
+		duties, err := scheduledProposals(ctx, j.client, j.nextSlot, last, func(index int64) bool {
+			_, ok := expected[index]
+			return ok
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, duty := range duties {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			block, err := j.client.GetBlock(ctx, duty.Slot)
+			if err != nil {
+				return err
+			}
+			if block == nil || block.ProposerIndex != duty.ValidatorIndex {
+				continue // Missed proposals are handled by proposal analysis
+			}
+
+			check := checkFeeRecipient(j.config.GenesisTime, int64(duty.ValidatorIndex), expected[int64(duty.ValidatorIndex)], block)
+			if err := j.record(ctx, check); err != nil {
+				return err
+			}
+		}
+	}
+	j.nextSlot = last + 1
+
+	return nil
+}
+
+// expected returns the expected fee recipients of each monitored validator that has any
+func (j *FeeRecipientJob) expected(ctx context.Context) (map[int64][]string, error) {
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := j.feeRecipientRepo.GetExpected(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := make(map[int64][]string, len(validators))
+	for _, v := range validators {
+		if addresses := expectedFeeRecipients(j.config.Expected, overrides, v); len(addresses) > 0 {
+			expected[v.ValidatorIndex] = addresses
+		}
+	}
+
+	return expected, nil
+}
+
+// scheduledProposals returns the proposer duties in [from, to] of the validators include accepts,
+// in slot order
+func scheduledProposals(ctx context.Context, client types.FeeRecipientClient, from, to int64, include func(index int64) bool) ([]types.ProposerDuty, error) {
+	var proposals []types.ProposerDuty
+	for epoch := from / types.SlotsPerEpoch; epoch <= to/types.SlotsPerEpoch; epoch++ {
+		duties, err := client.GetProposerDuties(ctx, int(epoch))
+		if err != nil {
+			return nil, err
+		}
+		for _, duty := range duties {
+			slot := int64(duty.Slot)
+			if slot >= from && slot <= to && include(int64(duty.ValidatorIndex)) {
+				proposals = append(proposals, duty)
+			}
+		}
+	}
+
+	sort.Slice(proposals, func(a, b int) bool { return proposals[a].Slot < proposals[b].Slot })
+	return proposals, nil
+}
+
+// record stores a check and alerts on a mismatch not seen before
+func (j *FeeRecipientJob) record(ctx context.Context, check *models.FeeRecipientCheck) error {
+	inserted, err := j.feeRecipientRepo.RecordCheck(ctx, check)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return nil
+	}
+
+	feeRecipientChecks.WithLabelValues(string(check.Status)).Inc()
+	if check.Status.Compliant() {
+		return nil
+	}
+
+	if err := j.alertRepo.CreateAlert(ctx, feeRecipientAlert(check)); err != nil {
+		logger.FromContext(ctx).Error().
+			Err(err).
+			Int64("validator_index", check.ValidatorIndex).
+			Msg("Failed to create fee recipient alert")
+	}
+	return nil
+}
+
+// expectedFeeRecipients returns the lowercase addresses a validator's execution rewards may go to:
+// its own override if set, otherwise the addresses configured for every validator and for any of
+// its tags
+func expectedFeeRecipients(byTag map[string][]string, overrides map[int64]string, v *models.Validator) []string {
+	if address, ok := overrides[v.ValidatorIndex]; ok {
+		return []string{strings.ToLower(address)}
+	}
+
+	var addresses []string
+	for _, group := range append([]string{AllowlistAllValidators}, v.Tags...) {
+		for _, address := range byTag[group] {
+			if address = strings.ToLower(address); !slices.Contains(addresses, address) {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	return addresses
+}
+
+// checkFeeRecipient compares where a proposed block's execution rewards went with the expected
+// lowercase addresses. A block whose fee recipient is not expected is taken to be built by a
+// builder, which must pay an expected address a positive value in the final transaction.
+func checkFeeRecipient(genesis time.Time, validatorIndex int64, expected []string, block *types.Block) *models.FeeRecipientCheck {
+	slot := int64(block.Slot)
+	check := &models.FeeRecipientCheck{
+		ValidatorIndex: validatorIndex,
+		Slot:           slot,
+		Time:           types.SlotStartTime(genesis, slot),
+		BlockHash:      block.BlockHash,
+		FeeRecipient:   strings.ToLower(block.FeeRecipient),
+		Expected:       expected,
+		Status:         models.FeeRecipientMismatch,
+	}
+
+	if slices.Contains(expected, check.FeeRecipient) {
+		check.Status = models.FeeRecipientCompliant
+		return check
+	}
+
+	if tx := block.FinalTransaction; tx != nil {
+		to := strings.ToLower(tx.To)
+		gwei := weiToGwei(tx.Value)
+		check.PaymentTo = &to
+		check.PaymentGwei = &gwei
+		if slices.Contains(expected, to) && tx.Value.Sign() > 0 {
+			check.Status = models.FeeRecipientBuilderPaid
+		}
+	}
+
+	return check
+}
+
+// feeRecipientAlert builds the critical alert for a block whose rewards missed the expected address
+func feeRecipientAlert(c *models.FeeRecipientCheck) *models.Alert {
+	index := c.ValidatorIndex
+	details := models.JSONB{
+		"slot":          c.Slot,
+		"block_hash":    c.BlockHash,
+		"fee_recipient": c.FeeRecipient,
+		"expected":      c.Expected,
+	}
+	if c.PaymentTo != nil {
+		details["payment_to"] = *c.PaymentTo
+		details["payment_gwei"] = *c.PaymentGwei
+	}
+
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeFeeRecipientMismatch),
+		Severity:       models.SeverityCritical,
+		Title:          "Execution rewards sent to an unexpected address",
+		Message:        c.Summary(),
+		Source:         "fee_recipient_monitor",
+		Details:        details,
+		Status:         models.AlertStatusNew,
+	}
+}
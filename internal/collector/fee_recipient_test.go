@@ -0,0 +1,99 @@
+package collector
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	poolAddress    = "0x1111111111111111111111111111111111111111"
+	builderAddress = "0x2222222222222222222222222222222222222222"
+)
+
+func TestExpectedFeeRecipients(t *testing.T) {
+	byTag := map[string][]string{
+		AllowlistAllValidators: {"0xAAAA"},
+		"pool-a":               {poolAddress, "0xaaaa"},
+	}
+
+	v := &models.Validator{ValidatorIndex: 1, Tags: models.Tags{"pool-a"}}
+	assert.Equal(t, []string{"0xaaaa", poolAddress}, expectedFeeRecipients(byTag, nil, v), "addresses are lowercased and deduplicated")
+
+	assert.Equal(t, []string{builderAddress}, expectedFeeRecipients(byTag, map[int64]string{1: builderAddress}, v), "a validator override replaces its tags")
+	assert.Empty(t, expectedFeeRecipients(nil, nil, v))
+}
+
+func TestCheckFeeRecipient(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	expected := []string{poolAddress}
+
+	local := checkFeeRecipient(genesis, 1, expected, &types.Block{Slot: 3201, FeeRecipient: "0x1111111111111111111111111111111111111111", BlockHash: "0xb1"})
+	assert.Equal(t, models.FeeRecipientCompliant, local.Status)
+	assert.Equal(t, types.SlotStartTime(genesis, 3201), local.Time)
+	assert.Nil(t, local.PaymentTo)
+
+	builder := &types.Block{
+		Slot:             3202,
+		FeeRecipient:     builderAddress,
+		FinalTransaction: &types.PayloadTransaction{To: "0x1111111111111111111111111111111111111111", Value: big.NewInt(50_000_000_000_000_000)},
+	}
+	paid := checkFeeRecipient(genesis, 1, expected, builder)
+	assert.Equal(t, models.FeeRecipientBuilderPaid, paid.Status)
+	require.NotNil(t, paid.PaymentGwei)
+	assert.Equal(t, int64(50_000_000), *paid.PaymentGwei)
+	assert.Contains(t, paid.Summary(), "0.0500 ETH")
+
+	builder.FinalTransaction.Value = big.NewInt(0)
+	assert.Equal(t, models.FeeRecipientMismatch, checkFeeRecipient(genesis, 1, expected, builder).Status, "a zero payment is not a builder payment")
+
+	builder.FinalTransaction = &types.PayloadTransaction{To: "0x3333333333333333333333333333333333333333", Value: big.NewInt(1)}
+	mismatch := checkFeeRecipient(genesis, 1, expected, builder)
+	assert.Equal(t, models.FeeRecipientMismatch, mismatch.Status)
+	assert.False(t, mismatch.Status.Compliant())
+
+	alert := feeRecipientAlert(mismatch)
+	assert.Equal(t, models.SeverityCritical, alert.Severity)
+	assert.Equal(t, string(types.AlertTypeFeeRecipientMismatch), alert.AlertType)
+	assert.Equal(t, "0x3333333333333333333333333333333333333333", alert.Details["payment_to"])
+
+	builder.FinalTransaction = nil
+	assert.Equal(t, models.FeeRecipientMismatch, checkFeeRecipient(genesis, 1, expected, builder).Status)
+}
+
+func TestDecodePayloadTransaction(t *testing.T) {
+	to, _ := hex.DecodeString(poolAddress[2:])
+	value := big.NewInt(1_000_000_000_000_000_000)
+
+	legacy, err := rlp.EncodeToBytes([]interface{}{uint64(7), big.NewInt(1), uint64(21000), to, value, []byte{}, uint64(27), big.NewInt(1), big.NewInt(2)})
+	require.NoError(t, err)
+	tx, err := decodePayloadTransaction("0x" + hex.EncodeToString(legacy))
+	require.NoError(t, err)
+	assert.Equal(t, poolAddress, tx.To)
+	assert.Equal(t, value, tx.Value)
+
+	dynamicFee, err := rlp.EncodeToBytes([]interface{}{uint64(1), uint64(7), big.NewInt(1), big.NewInt(2), uint64(21000), to, value, []byte{}, []interface{}{}, uint64(0), big.NewInt(1), big.NewInt(2)})
+	require.NoError(t, err)
+	tx, err = decodePayloadTransaction("0x02" + hex.EncodeToString(dynamicFee))
+	require.NoError(t, err)
+	assert.Equal(t, poolAddress, tx.To)
+	assert.Equal(t, value, tx.Value)
+
+	creation, err := rlp.EncodeToBytes([]interface{}{uint64(1), uint64(7), big.NewInt(1), uint64(21000), []byte{}, big.NewInt(0), []byte{0x60}})
+	require.NoError(t, err)
+	tx, err = decodePayloadTransaction("0x01" + hex.EncodeToString(creation))
+	require.NoError(t, err)
+	assert.Empty(t, tx.To)
+
+	_, err = decodePayloadTransaction("0x7f00")
+	assert.Error(t, err)
+	_, err = decodePayloadTransaction("0x")
+	assert.Error(t, err)
+}
@@ -0,0 +1,265 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	snapshotGaps = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_snapshot_gaps",
+			Help: "Snapshot gaps within the repair window by validator and status (missing, repaired, irreparable)",
+		},
+		[]string{"validator_index", "status"},
+	)
+
+	snapshotGapRepairs = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_snapshot_gap_repairs_total",
+			Help: "Total snapshot gap repair attempts by result (repaired, irreparable, failed)",
+		},
+		[]string{"result"},
+	)
+)
+
+// GapRepairConfig contains configuration for the snapshot gap repair job
+type GapRepairConfig struct {
+	WindowEpochs     int64
+	MaxAttempts      int32
+	MaxRepairsPerRun int
+	GenesisTime      time.Time
+}
+
+// DefaultGapRepairConfig returns default gap repair configuration
+func DefaultGapRepairConfig() *GapRepairConfig {
+	return &GapRepairConfig{
+		WindowEpochs:     225, // ~1 day
+		MaxAttempts:      5,
+		MaxRepairsPerRun: 500,
+		GenesisTime:      time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// GapRepairJob scans for epochs with no snapshot and backfills them from beacon state
+type GapRepairJob struct {
+	rewardsClient types.RewardsClient
+	validatorRepo *repository.ValidatorRepository
+	snapshotRepo  *repository.SnapshotRepository
+	gapRepo       *repository.SnapshotGapRepository
+	config        *GapRepairConfig
+}
+
+// NewGapRepairJob creates a new snapshot gap repair job
+func NewGapRepairJob(rewardsClient types.RewardsClient, pool *pgxpool.Pool, config *GapRepairConfig) *GapRepairJob {
+	return &GapRepairJob{
+		rewardsClient: rewardsClient,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		snapshotRepo:  repository.NewSnapshotRepository(pool),
+		gapRepo:       repository.NewSnapshotGapRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce scans the sliding window for every monitored validator and repairs missing epochs
+func (j *GapRepairJob) RunOnce(ctx context.Context) error {
+	// Skip the in-progress epoch and the one before it, which the collector may still be filling
+	toEpoch := types.EpochAtTime(j.config.GenesisTime, time.Now()) - 2
+	fromEpoch := toEpoch - j.config.WindowEpochs + 1
+	if fromEpoch < 0 {
+		fromEpoch = 0
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+
+	budget := j.config.MaxRepairsPerRun
+	totalMissing := 0
+
+	for _, validator := range validators {
+		start, end := j.scanRange(validator, fromEpoch, toEpoch)
+
+		missing, err := j.gapRepo.FindMissingEpochs(ctx, validator.ValidatorIndex, start, end, j.config.GenesisTime)
+		if err != nil {
+			return err
+		}
+		totalMissing += len(missing)
+
+		// Record every gap up front so those not attempted this run still count as missing
+		if err := j.gapRepo.RecordGaps(ctx, validator.ValidatorIndex, missing); err != nil {
+			return err
+		}
+
+		for _, epoch := range missing {
+			if budget <= 0 {
+				break
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			j.repairGap(ctx, validator, epoch)
+			budget--
+		}
+
+		j.recordGapMetrics(ctx, validator.ValidatorIndex, fromEpoch)
+	}
+
+	logger.FromContext(ctx).Info().
+		Int("validator_count", len(validators)).
+		Int("missing_epochs", totalMissing).
+		Int("repair_attempts", j.config.MaxRepairsPerRun-budget).
+		Int64("from_epoch", fromEpoch).
+		Int64("to_epoch", toEpoch).
+		Msg("Snapshot gap scan completed")
+
+	return nil
+}
+
+// scanRange narrows the window to epochs in which the validator was active and monitored
+func (j *GapRepairJob) scanRange(validator *models.Validator, fromEpoch, toEpoch int64) (int64, int64) {
+	start, end := fromEpoch, toEpoch
+
+	// The epoch monitoring started in is only partially covered, so begin with the next one
+	if monitoredFrom := types.EpochAtTime(j.config.GenesisTime, validator.CreatedAt) + 1; monitoredFrom > start {
+		start = monitoredFrom
+	}
+	if validator.ActivationEpoch != nil && *validator.ActivationEpoch > start {
+		start = *validator.ActivationEpoch
+	}
+	if validator.ExitEpoch != nil && *validator.ExitEpoch <= end {
+		end = *validator.ExitEpoch - 1
+	}
+
+	return start, end
+}
+
+// repairGap backfills a single epoch from historical beacon state, or records why it could not.
+// It returns the resulting status of the gap.
+func (j *GapRepairJob) repairGap(ctx context.Context, validator *models.Validator, epoch int64) models.GapStatus {
+	log := logger.FromContext(ctx)
+	index := validator.ValidatorIndex
+
+	snapshot, err := historicalSnapshot(ctx, j.rewardsClient, validator, epoch, j.config.GenesisTime)
+	if err != nil {
+		return j.recordRepairFailure(ctx, index, epoch, err)
+	}
+
+	if err := j.snapshotRepo.InsertSnapshot(ctx, snapshot); err != nil {
+		return j.recordRepairFailure(ctx, index, epoch, err)
+	}
+
+	if err := j.gapRepo.MarkGapRepaired(ctx, index, epoch); err != nil {
+		log.Warn().Err(err).Int64("validator_index", index).Int64("epoch", epoch).Msg("Failed to record repaired gap")
+	}
+
+	snapshotGapRepairs.WithLabelValues(string(models.GapStatusRepaired)).Inc()
+	log.Debug().
+		Int64("validator_index", index).
+		Int64("epoch", epoch).
+		Msg("Repaired snapshot gap")
+
+	return models.GapStatusRepaired
+}
+
+// historicalSnapshot builds a snapshot for an epoch from historical beacon state. Balance,
+// effective balance and slashing come from the state at the epoch start; liveness comes from the
+// epoch's attestation rewards, since only a timely source vote earns a source reward.
+// A wrapped types.ErrStateUnavailable is returned when the node no longer has the state.
+func historicalSnapshot(ctx context.Context, client types.RewardsClient, validator *models.Validator, epoch int64, genesis time.Time) (*models.ValidatorSnapshot, error) {
+	index := int(validator.ValidatorIndex)
+
+	balances, err := client.GetValidatorBalances(ctx, int(epoch), []int{index})
+	if err != nil {
+		return nil, err
+	}
+	if len(balances) == 0 {
+		return nil, fmt.Errorf("validator %d not in state at epoch %d: %w", index, epoch, types.ErrStateUnavailable)
+	}
+	state := balances[0]
+
+	rewards, err := client.GetAttestationRewards(ctx, int(epoch), []int{index})
+	if err != nil {
+		return nil, err
+	}
+	isOnline := false
+	for _, reward := range rewards.TotalRewards {
+		if reward.ValidatorIndex == index {
+			isOnline = reward.Source > 0
+		}
+	}
+
+	return &models.ValidatorSnapshot{
+		Time:             types.EpochStartTime(genesis, epoch),
+		ValidatorIndex:   validator.ValidatorIndex,
+		Balance:          state.Balance,
+		EffectiveBalance: state.EffectiveBalance,
+		Slashed:          state.Slashed,
+		IsOnline:         isOnline,
+	}, nil
+}
+
+// recordRepairFailure records a failed attempt, marking the gap irreparable once the state is
+// known to be unavailable or the attempt limit is reached
+func (j *GapRepairJob) recordRepairFailure(ctx context.Context, validatorIndex, epoch int64, cause error) models.GapStatus {
+	log := logger.FromContext(ctx)
+
+	irreparable := errors.Is(cause, types.ErrStateUnavailable)
+	if !irreparable {
+		attempts, err := j.gapRepo.RecordGapAttempt(ctx, validatorIndex, epoch, cause.Error())
+		if err != nil {
+			log.Warn().Err(err).Int64("validator_index", validatorIndex).Int64("epoch", epoch).Msg("Failed to record gap repair attempt")
+			return models.GapStatusMissing
+		}
+		irreparable = attempts >= j.config.MaxAttempts
+	}
+
+	if !irreparable {
+		snapshotGapRepairs.WithLabelValues("failed").Inc()
+		return models.GapStatusMissing
+	}
+
+	if err := j.gapRepo.MarkGapIrreparable(ctx, validatorIndex, epoch, cause.Error()); err != nil {
+		log.Warn().Err(err).Int64("validator_index", validatorIndex).Int64("epoch", epoch).Msg("Failed to mark gap irreparable")
+		return models.GapStatusMissing
+	}
+
+	snapshotGapRepairs.WithLabelValues(string(models.GapStatusIrreparable)).Inc()
+	log.Warn().
+		Err(cause).
+		Int64("validator_index", validatorIndex).
+		Int64("epoch", epoch).
+		Msg("Snapshot gap marked irreparable")
+
+	return models.GapStatusIrreparable
+}
+
+// recordGapMetrics publishes per-status gap counts for the validator
+func (j *GapRepairJob) recordGapMetrics(ctx context.Context, validatorIndex, fromEpoch int64) {
+	counts, err := j.gapRepo.GetGapCounts(ctx, validatorIndex, fromEpoch)
+	if err != nil {
+		logger.FromContext(ctx).Warn().Err(err).Int64("validator_index", validatorIndex).Msg("Failed to get gap counts")
+		return
+	}
+
+	label := strconv.FormatInt(validatorIndex, 10)
+	snapshotGaps.WithLabelValues(label, string(models.GapStatusMissing)).Set(float64(counts.Missing))
+	snapshotGaps.WithLabelValues(label, string(models.GapStatusRepaired)).Set(float64(counts.Repaired))
+	snapshotGaps.WithLabelValues(label, string(models.GapStatusIrreparable)).Set(float64(counts.Irreparable))
+}
@@ -0,0 +1,80 @@
+package collector
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalSnapshot(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+
+	tests := []struct {
+		name         string
+		validatorDoc string
+		rewards      string
+		wantOnline   bool
+		wantSlashed  bool
+	}{
+		{
+			name:         "timely source vote",
+			validatorDoc: `{"index":"7","balance":"31990000000","status":"active_ongoing","validator":{"effective_balance":"31000000000","slashed":false}}`,
+			rewards:      `{"validator_index":"7","head":"2800","target":"5400","source":"2800","inclusion_delay":"0","inactivity":"0"}`,
+			wantOnline:   true,
+		},
+		{
+			name:         "missed attestation despite a balance increase",
+			validatorDoc: `{"index":"7","balance":"32990000000","status":"active_ongoing","validator":{"effective_balance":"32000000000","slashed":false}}`,
+			rewards:      `{"validator_index":"7","head":"0","target":"-5400","source":"-2800","inclusion_delay":"0","inactivity":"0"}`,
+		},
+		{
+			name:         "slashed in the historical state",
+			validatorDoc: `{"index":"7","balance":"31000000000","status":"active_slashed","validator":{"effective_balance":"31000000000","slashed":true}}`,
+			rewards:      `{"validator_index":"7","head":"0","target":"-5400","source":"-2800","inclusion_delay":"0","inactivity":"0"}`,
+			wantSlashed:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/eth/v1/beacon/states/3200/validators":
+					w.Write([]byte(`{"data":[` + tt.validatorDoc + `]}`))
+				case "/eth/v1/beacon/rewards/attestations/100":
+					w.Write([]byte(`{"data":{"ideal_rewards":[],"total_rewards":[` + tt.rewards + `]}}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer node.Close()
+
+			// The stored validator reflects today's state, which must not leak into history
+			validator := &models.Validator{ValidatorIndex: 7, EffectiveBalance: 2_048_000_000_000, Slashed: !tt.wantSlashed}
+			client := NewBeaconClientWithoutRetry(node.URL, 5*time.Second)
+
+			snapshot, err := historicalSnapshot(context.Background(), client, validator, 100, genesis)
+			require.NoError(t, err)
+			assert.Equal(t, types.EpochStartTime(genesis, 100), snapshot.Time)
+			assert.Equal(t, tt.wantOnline, snapshot.IsOnline)
+			assert.Equal(t, tt.wantSlashed, snapshot.Slashed)
+			assert.NotEqual(t, validator.EffectiveBalance, snapshot.EffectiveBalance)
+		})
+	}
+
+	t.Run("pruned state", func(t *testing.T) {
+		node := httptest.NewServer(http.NotFoundHandler())
+		defer node.Close()
+
+		client := NewBeaconClientWithoutRetry(node.URL, 5*time.Second)
+		_, err := historicalSnapshot(context.Background(), client, &models.Validator{ValidatorIndex: 7}, 100, genesis)
+		assert.ErrorIs(t, err, types.ErrStateUnavailable)
+	})
+}
@@ -0,0 +1,192 @@
+package collector
+
+import (
+	"context"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var incidentsUnresolved = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "validator_incidents_unresolved",
+		Help: "Incidents grouping correlated alerts that are open or acknowledged",
+	},
+)
+
+// IncidentConfig contains configuration for the incident job
+type IncidentConfig struct {
+	Window    time.Duration // Largest gap between consecutive alerts of one incident
+	MinAlerts int           // Alerts needed to open an incident
+	Lookback  time.Duration // Age beyond which alerts are no longer grouped
+}
+
+// DefaultIncidentConfig returns default incident configuration
+func DefaultIncidentConfig() *IncidentConfig {
+	return &IncidentConfig{
+		Window:    10 * time.Minute,
+		MinAlerts: 3,
+		Lookback:  time.Hour,
+	}
+}
+
+// IncidentJob groups correlated alerts into incidents. Alerts of the same type whose validators
+// share a validator client, or failing that a tag, are clustered while each follows the previous
+// one within the window. A cluster of at least MinAlerts alerts opens an incident, and later alerts
+// of the same cluster join it. Incidents resolve themselves once all their member alerts are
+// settled.
+type IncidentJob struct {
+	incidentRepo *repository.IncidentRepository
+	config       *IncidentConfig
+}
+
+// NewIncidentJob creates a new incident job
+func NewIncidentJob(pool *pgxpool.Pool, config *IncidentConfig) *IncidentJob {
+	return &IncidentJob{
+		incidentRepo: repository.NewIncidentRepository(pool),
+		config:       config,
+	}
+}
+
+// RunOnce groups the ungrouped alerts into new or unresolved incidents and resolves the incidents
+// whose alerts are all settled
+func (j *IncidentJob) RunOnce(ctx context.Context) error {
+	unresolved, err := j.incidentRepo.ListUnresolved(ctx)
+	if err != nil {
+		return err
+	}
+	candidates, err := j.incidentRepo.ListCandidates(ctx, time.Now().Add(-j.config.Lookback))
+	if err != nil {
+		return err
+	}
+
+	attach, create := planIncidents(unresolved, candidates, j.config.Window, j.config.MinAlerts)
+	for _, b := range attach {
+		if err := j.incidentRepo.AttachAlerts(ctx, b.incident.ID, b.alertIDs, b.first, b.last); err != nil {
+			return err
+		}
+	}
+	for _, b := range create {
+		if err := j.incidentRepo.CreateIncident(ctx, b.incident, b.alertIDs); err != nil {
+			return err
+		}
+		logger.FromContext(ctx).Warn().
+			Int64("incident_id", b.incident.ID).
+			Str("failure_type", b.incident.FailureType).
+			Str("scope", b.incident.Scope()).
+			Int("alerts", len(b.alertIDs)).
+			Msg("Incident opened")
+	}
+
+	resolved, err := j.incidentRepo.ResolveSettled(ctx)
+	if err != nil {
+		return err
+	}
+	incidentsUnresolved.Set(float64(len(unresolved) + len(create) - int(resolved)))
+
+	return nil
+}
+
+// incidentBatch is a set of alerts to add to an incident, created between first and last
+type incidentBatch struct {
+	incident *models.Incident
+	alertIDs []int32
+	first    time.Time
+	last     time.Time
+}
+
+// add appends a candidate to the batch, widening its span
+func (b *incidentBatch) add(c *models.IncidentCandidate) {
+	if len(b.alertIDs) == 0 || c.CreatedAt.Before(b.first) {
+		b.first = c.CreatedAt
+	}
+	if c.CreatedAt.After(b.last) {
+		b.last = c.CreatedAt
+	}
+	b.alertIDs = append(b.alertIDs, c.AlertID)
+}
+
+// incidentKey identifies the cluster an alert belongs to
+type incidentKey struct {
+	failureType string
+	kind        models.IncidentGroupKind
+	value       string
+}
+
+// planIncidents clusters candidates, ordered oldest first, by failure type and group. A candidate
+// within window of the span of its cluster's most recent unresolved incident joins that incident;
+// the others are split wherever consecutive alerts are more than window apart, and each run of at
+// least minAlerts alerts opens a new incident. Smaller runs stay ungrouped and may still join a
+// later run.
+func planIncidents(unresolved []*models.Incident, candidates []*models.IncidentCandidate, window time.Duration, minAlerts int) (attach, create []*incidentBatch) {
+	latest := make(map[incidentKey]*models.Incident)
+	for _, i := range unresolved {
+		key := incidentKey{i.FailureType, i.GroupKind, i.GroupValue}
+		if prev, ok := latest[key]; !ok || i.LastAlertAt.After(prev.LastAlertAt) {
+			latest[key] = i
+		}
+	}
+
+	var keys []incidentKey
+	clusters := make(map[incidentKey][]*models.IncidentCandidate)
+	for _, c := range candidates {
+		kind, value := c.Group()
+		key := incidentKey{c.AlertType, kind, value}
+		if _, ok := clusters[key]; !ok {
+			keys = append(keys, key)
+		}
+		clusters[key] = append(clusters[key], c)
+	}
+
+	for _, key := range keys {
+		var joined *incidentBatch
+		var start, end time.Time
+		if i := latest[key]; i != nil {
+			joined = &incidentBatch{incident: i}
+			start, end = i.StartedAt.Add(-window), i.LastAlertAt.Add(window)
+		}
+
+		var run *incidentBatch
+		flush := func() {
+			if run != nil && len(run.alertIDs) >= minAlerts {
+				run.incident = &models.Incident{
+					FailureType: key.failureType,
+					GroupKind:   key.kind,
+					GroupValue:  key.value,
+					StartedAt:   run.first,
+					LastAlertAt: run.last,
+				}
+				create = append(create, run)
+			}
+			run = nil
+		}
+
+		for _, c := range clusters[key] {
+			switch {
+			case joined != nil && !c.CreatedAt.Before(start) && !c.CreatedAt.After(end):
+				joined.add(c)
+				if c.CreatedAt.Add(window).After(end) {
+					end = c.CreatedAt.Add(window)
+				}
+			case run != nil && c.CreatedAt.Sub(run.last) <= window:
+				run.add(c)
+			default:
+				flush()
+				run = &incidentBatch{}
+				run.add(c)
+			}
+		}
+		flush()
+
+		if joined != nil && len(joined.alertIDs) > 0 {
+			attach = append(attach, joined)
+		}
+	}
+
+	return attach, create
+}
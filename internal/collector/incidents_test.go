@@ -0,0 +1,65 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanIncidents(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	window := 10 * time.Minute
+	node := "vc-1"
+
+	var candidates []*models.IncidentCandidate
+	candidate := func(id int32, alertType string, at time.Duration, node *string, tags ...string) {
+		candidates = append(candidates, &models.IncidentCandidate{
+			AlertID: id, AlertType: alertType, CreatedAt: start.Add(at), Node: node, Tags: tags,
+		})
+	}
+	// A beacon node behind vc-1 dies: its validators go offline within a minute
+	candidate(1, "offline", 0, &node, "lido")
+	candidate(2, "offline", 20*time.Second, &node)
+	candidate(3, "offline", 40*time.Second, &node)
+	candidate(4, "offline", 5*time.Minute, &node)
+	// Two unrelated validators of the lido tag, too few for an incident
+	candidate(5, "offline", time.Minute, nil, "lido")
+	candidate(6, "offline", 2*time.Minute, nil, "lido")
+	// A different failure on vc-1 does not join the offline incident
+	candidate(7, "missed_proposal", time.Minute, &node)
+	// vc-1 fails again an hour later, well past the window
+	candidate(8, "offline", time.Hour, &node)
+	candidate(9, "offline", time.Hour+time.Minute, &node)
+	candidate(10, "offline", time.Hour+2*time.Minute, &node)
+
+	attach, create := planIncidents(nil, candidates, window, 3)
+	assert.Empty(t, attach)
+	require.Len(t, create, 2)
+	assert.Equal(t, []int32{1, 2, 3, 4}, create[0].alertIDs)
+	assert.Equal(t, models.IncidentGroupNode, create[0].incident.GroupKind)
+	assert.Equal(t, "vc-1", create[0].incident.GroupValue)
+	assert.Equal(t, start, create[0].incident.StartedAt)
+	assert.Equal(t, start.Add(5*time.Minute), create[0].incident.LastAlertAt)
+	assert.Equal(t, []int32{8, 9, 10}, create[1].alertIDs, "alerts more than a window apart open separate incidents")
+
+	// Once the first incident exists, a straggler joins it instead of waiting for company
+	open := &models.Incident{
+		ID: 42, FailureType: "offline", GroupKind: models.IncidentGroupNode, GroupValue: "vc-1",
+		StartedAt: start, LastAlertAt: start.Add(5 * time.Minute),
+	}
+	candidates = nil
+	candidate(11, "offline", 12*time.Minute, &node)
+	candidate(12, "offline", 21*time.Minute, &node)
+	candidate(13, "offline", 40*time.Minute, &node)
+
+	attach, create = planIncidents([]*models.Incident{open}, candidates, window, 3)
+	assert.Empty(t, create)
+	require.Len(t, attach, 1)
+	assert.Same(t, open, attach[0].incident)
+	assert.Equal(t, []int32{11, 12}, attach[0].alertIDs, "each joining alert extends the window")
+	assert.Equal(t, start.Add(12*time.Minute), attach[0].first)
+	assert.Equal(t, start.Add(21*time.Minute), attach[0].last)
+}
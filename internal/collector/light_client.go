@@ -0,0 +1,413 @@
+package collector
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	blsu "github.com/protolambda/bls12-381-util"
+)
+
+// domainSyncCommittee is the signature domain type of sync committee messages
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// lightClientGindices are the generalized indices of the light client proofs in the beacon state
+type lightClientGindices struct {
+	currentSyncCommittee uint64
+	nextSyncCommittee    uint64
+	finalizedRoot        uint64
+}
+
+var (
+	altairGindices  = lightClientGindices{currentSyncCommittee: 54, nextSyncCommittee: 55, finalizedRoot: 105}
+	electraGindices = lightClientGindices{currentSyncCommittee: 86, nextSyncCommittee: 87, finalizedRoot: 169} // The state grew past 32 fields
+)
+
+// verifiedSyncCommittee is a sync committee whose root has been proven, with its keys decoded
+type verifiedSyncCommittee struct {
+	root    [32]byte
+	pubkeys []*blsu.Pubkey
+}
+
+// LightClientStore is the chain verified from a trusted checkpoint: the latest finalized header and
+// the sync committees able to sign the headers that follow it. It follows the light client sync
+// protocol, except that only finalized headers are tracked.
+type LightClientStore struct {
+	forks                 []types.Fork
+	genesisValidatorsRoot [32]byte
+
+	finalized types.BeaconBlockHeader
+	current   *verifiedSyncCommittee
+	next      *verifiedSyncCommittee // Nil until an update proves it
+}
+
+// NewLightClientStore verifies a bootstrap against the root of a trusted block and starts a store
+// from it
+func NewLightClientStore(checkpoint string, bootstrap *types.LightClientBootstrap, forks []types.Fork, genesisValidatorsRoot string) (*LightClientStore, error) {
+	gvr, err := decodeRoot(genesisValidatorsRoot)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis validators root: %w", err)
+	}
+	trusted, err := decodeRoot(checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted checkpoint: %w", err)
+	}
+
+	s := &LightClientStore{forks: forks, genesisValidatorsRoot: gvr}
+
+	root, err := headerRoot(bootstrap.Header)
+	if err != nil {
+		return nil, verificationError("bootstrap header is malformed: %v", err)
+	}
+	if root != trusted {
+		return nil, verificationError("bootstrap header root %s does not match trusted checkpoint %s", encodeRoot(root), checkpoint)
+	}
+
+	committee, err := decodeSyncCommittee(&bootstrap.CurrentSyncCommittee)
+	if err != nil {
+		return nil, err
+	}
+	gindices, err := s.gindices(bootstrap.Header.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyBranch(committee.root, bootstrap.CurrentSyncCommitteeBranch, gindices.currentSyncCommittee, bootstrap.Header.StateRoot); err != nil {
+		return nil, verificationError("current sync committee proof is invalid: %v", err)
+	}
+
+	s.finalized = bootstrap.Header
+	s.current = committee
+	return s, nil
+}
+
+// Finalized returns the latest verified finalized header
+func (s *LightClientStore) Finalized() types.BeaconBlockHeader {
+	return s.finalized
+}
+
+// Period returns the sync committee period of the latest verified finalized header
+func (s *LightClientStore) Period() int64 {
+	return syncCommitteePeriod(s.finalized.Slot)
+}
+
+// NextSyncCommitteeKnown reports whether the sync committee of the next period has been verified
+func (s *LightClientStore) NextSyncCommitteeKnown() bool {
+	return s.next != nil
+}
+
+// Apply verifies an update against the known sync committees and, when a supermajority signed it,
+// advances the finalized header and sync committees. Updates that prove nothing new are verified
+// and ignored; the store is left unchanged by any update that fails verification.
+func (s *LightClientStore) Apply(u *types.LightClientUpdate) error {
+	attested := u.AttestedHeader
+	if u.SignatureSlot <= attested.Slot {
+		return verificationError("signature slot %d does not follow attested slot %d", u.SignatureSlot, attested.Slot)
+	}
+	if u.FinalizedHeader != nil && u.FinalizedHeader.Slot > attested.Slot {
+		return verificationError("finalized slot %d is after attested slot %d", u.FinalizedHeader.Slot, attested.Slot)
+	}
+
+	storePeriod := s.Period()
+	signaturePeriod := syncCommitteePeriod(u.SignatureSlot)
+	var committee *verifiedSyncCommittee
+	switch {
+	case signaturePeriod == storePeriod:
+		committee = s.current
+	case signaturePeriod == storePeriod+1 && s.next != nil:
+		committee = s.next
+	default:
+		return fmt.Errorf("no verified sync committee for period %d (finalized period %d)", signaturePeriod, storePeriod)
+	}
+
+	gindices, err := s.gindices(attested.Slot)
+	if err != nil {
+		return err
+	}
+	if u.FinalizedHeader != nil {
+		root, err := headerRoot(*u.FinalizedHeader)
+		if err != nil {
+			return verificationError("finalized header is malformed: %v", err)
+		}
+		if err := verifyBranch(root, u.FinalityBranch, gindices.finalizedRoot, attested.StateRoot); err != nil {
+			return verificationError("finality proof is invalid: %v", err)
+		}
+	}
+
+	attestedPeriod := syncCommitteePeriod(attested.Slot)
+	var next *verifiedSyncCommittee
+	if u.NextSyncCommittee != nil {
+		next, err = decodeSyncCommittee(u.NextSyncCommittee)
+		if err != nil {
+			return err
+		}
+		if err := verifyBranch(next.root, u.NextSyncCommitteeBranch, gindices.nextSyncCommittee, attested.StateRoot); err != nil {
+			return verificationError("next sync committee proof is invalid: %v", err)
+		}
+		if attestedPeriod == storePeriod && s.next != nil && next.root != s.next.root {
+			return verificationError("next sync committee %s conflicts with the verified one %s", encodeRoot(next.root), encodeRoot(s.next.root))
+		}
+	}
+
+	participants, err := s.verifySignature(committee, u)
+	if err != nil {
+		return err
+	}
+
+	// Only a supermajority of the committee is trusted to finalize
+	if u.FinalizedHeader == nil || 3*participants < 2*types.SyncCommitteeSize {
+		return nil
+	}
+
+	finalizedPeriod := syncCommitteePeriod(u.FinalizedHeader.Slot)
+	switch {
+	case finalizedPeriod == storePeriod:
+	case finalizedPeriod == storePeriod+1 && s.next != nil:
+		s.current, s.next = s.next, nil
+	default:
+		return nil
+	}
+	if s.next == nil && next != nil && attestedPeriod == finalizedPeriod {
+		s.next = next
+	}
+	if u.FinalizedHeader.Slot > s.finalized.Slot {
+		s.finalized = *u.FinalizedHeader
+	}
+
+	return nil
+}
+
+// verifySignature checks the sync aggregate of an update against the committee that signed it,
+// returning the number of members that signed
+func (s *LightClientStore) verifySignature(committee *verifiedSyncCommittee, u *types.LightClientUpdate) (int, error) {
+	bitfield, err := decodeHex(u.SyncAggregate.SyncCommitteeBits, types.SyncCommitteeSize/8)
+	if err != nil {
+		return 0, verificationError("sync committee bits are malformed: %v", err)
+	}
+	var pubkeys []*blsu.Pubkey
+	for i, pubkey := range committee.pubkeys {
+		if bitfield[i/8]&(1<<(i%8)) != 0 {
+			pubkeys = append(pubkeys, pubkey)
+		}
+	}
+	if len(pubkeys) == 0 {
+		return 0, fmt.Errorf("update at slot %d has no sync committee participants", u.SignatureSlot)
+	}
+
+	raw, err := decodeHex(u.SyncAggregate.SyncCommitteeSignature, 96)
+	if err != nil {
+		return 0, verificationError("sync committee signature is malformed: %v", err)
+	}
+	var signature blsu.Signature
+	if err := signature.Deserialize((*[96]byte)(raw)); err != nil {
+		return 0, verificationError("sync committee signature is malformed: %v", err)
+	}
+
+	// The committee signs the block of the slot before the signature slot, in that slot's fork
+	fork, err := s.forkAt(max(u.SignatureSlot, 1) - 1)
+	if err != nil {
+		return 0, err
+	}
+	version, err := decodeHex(fork.Version, 4)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version of fork %s: %w", fork.Name, err)
+	}
+	root, err := headerRoot(u.AttestedHeader)
+	if err != nil {
+		return 0, verificationError("attested header is malformed: %v", err)
+	}
+	domain := computeDomain(domainSyncCommittee, [4]byte(version), s.genesisValidatorsRoot)
+	signingRoot := hashPair(root, domain)
+
+	if !blsu.FastAggregateVerify(pubkeys, signingRoot[:], &signature) {
+		return 0, verificationError("sync committee signature over slot %d is invalid", u.AttestedHeader.Slot)
+	}
+
+	return len(pubkeys), nil
+}
+
+// forkAt returns the fork in force at a slot
+func (s *LightClientStore) forkAt(slot int64) (types.Fork, error) {
+	epoch := slot / types.SlotsPerEpoch
+	for i := len(s.forks) - 1; i >= 0; i-- {
+		if s.forks[i].Epoch <= epoch {
+			return s.forks[i], nil
+		}
+	}
+	return types.Fork{}, fmt.Errorf("slot %d precedes sync committees", slot)
+}
+
+// gindices returns the proof indices for the state of the block at a slot
+func (s *LightClientStore) gindices(slot int64) (lightClientGindices, error) {
+	fork, err := s.forkAt(slot)
+	if err != nil {
+		return lightClientGindices{}, err
+	}
+	switch fork.Name {
+	case types.ForkAltair, types.ForkBellatrix, types.ForkCapella, types.ForkDeneb:
+		return altairGindices, nil
+	default:
+		return electraGindices, nil
+	}
+}
+
+// verificationError builds an error marking beacon data that failed verification
+func verificationError(format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), types.ErrVerificationFailed)
+}
+
+// syncCommitteePeriod returns the sync committee period of a slot
+func syncCommitteePeriod(slot int64) int64 {
+	return slot / (types.SlotsPerEpoch * types.EpochsPerSyncCommitteePeriod)
+}
+
+// decodeSyncCommittee decodes a sync committee's keys and computes its root
+func decodeSyncCommittee(c *types.SyncCommittee) (*verifiedSyncCommittee, error) {
+	if len(c.Pubkeys) != types.SyncCommitteeSize {
+		return nil, verificationError("sync committee has %d members, want %d", len(c.Pubkeys), types.SyncCommitteeSize)
+	}
+
+	committee := &verifiedSyncCommittee{pubkeys: make([]*blsu.Pubkey, 0, len(c.Pubkeys))}
+	decoded := make(map[string]*blsu.Pubkey) // Members may hold several seats
+	leaves := make([][32]byte, 0, len(c.Pubkeys))
+	for _, hexKey := range c.Pubkeys {
+		raw, err := decodeHex(hexKey, 48)
+		if err != nil {
+			return nil, verificationError("sync committee pubkey is malformed: %v", err)
+		}
+		pubkey, ok := decoded[string(raw)]
+		if !ok {
+			pubkey = new(blsu.Pubkey)
+			if err := pubkey.Deserialize((*[48]byte)(raw)); err != nil {
+				return nil, verificationError("sync committee pubkey %s is invalid: %v", hexKey, err)
+			}
+			decoded[string(raw)] = pubkey
+		}
+		committee.pubkeys = append(committee.pubkeys, pubkey)
+		leaves = append(leaves, pubkeyRoot(raw))
+	}
+
+	aggregate, err := decodeHex(c.AggregatePubkey, 48)
+	if err != nil {
+		return nil, verificationError("sync committee aggregate pubkey is malformed: %v", err)
+	}
+	committee.root = hashPair(merkleize(leaves), pubkeyRoot(aggregate))
+
+	return committee, nil
+}
+
+// headerRoot computes the SSZ hash tree root of a block header, which is the block root
+func headerRoot(h types.BeaconBlockHeader) ([32]byte, error) {
+	leaves := make([][32]byte, 5)
+	binary.LittleEndian.PutUint64(leaves[0][:], uint64(h.Slot))
+	binary.LittleEndian.PutUint64(leaves[1][:], uint64(h.ProposerIndex))
+	for i, root := range []string{h.ParentRoot, h.StateRoot, h.BodyRoot} {
+		decoded, err := decodeRoot(root)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		leaves[2+i] = decoded
+	}
+	return merkleize(leaves), nil
+}
+
+// pubkeyRoot computes the SSZ hash tree root of a 48-byte BLS public key
+func pubkeyRoot(pubkey []byte) [32]byte {
+	var lo, hi [32]byte
+	copy(lo[:], pubkey[:32])
+	copy(hi[:], pubkey[32:])
+	return hashPair(lo, hi)
+}
+
+// computeDomain mixes a domain type with the fork data root of a fork version
+func computeDomain(domainType [4]byte, version [4]byte, genesisValidatorsRoot [32]byte) [32]byte {
+	var versionChunk [32]byte
+	copy(versionChunk[:], version[:])
+	forkDataRoot := hashPair(versionChunk, genesisValidatorsRoot)
+
+	var domain [32]byte
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain
+}
+
+// verifyBranch checks that a Merkle branch proves a leaf at a generalized index under a root
+func verifyBranch(leaf [32]byte, branch []string, gindex uint64, root string) error {
+	depth := bits.Len64(gindex) - 1
+	if len(branch) != depth {
+		return fmt.Errorf("branch has %d nodes, want %d", len(branch), depth)
+	}
+	want, err := decodeRoot(root)
+	if err != nil {
+		return err
+	}
+
+	node := leaf
+	for i, sibling := range branch {
+		decoded, err := decodeRoot(sibling)
+		if err != nil {
+			return err
+		}
+		if gindex>>i&1 == 1 {
+			node = hashPair(decoded, node)
+		} else {
+			node = hashPair(node, decoded)
+		}
+	}
+	if node != want {
+		return fmt.Errorf("branch leads to %s, not %s", encodeRoot(node), root)
+	}
+
+	return nil
+}
+
+// merkleize computes the root of chunks padded with zero chunks to a power of two
+func merkleize(chunks [][32]byte) [32]byte {
+	width := 1
+	for width < len(chunks) {
+		width *= 2
+	}
+	layer := make([][32]byte, width)
+	copy(layer, chunks)
+	for len(layer) > 1 {
+		for i := 0; i < len(layer)/2; i++ {
+			layer[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = layer[:len(layer)/2]
+	}
+	return layer[0]
+}
+
+// hashPair hashes the concatenation of two chunks
+func hashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// decodeRoot decodes a 32-byte hex root
+func decodeRoot(s string) ([32]byte, error) {
+	raw, err := decodeHex(s, 32)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return [32]byte(raw), nil
+}
+
+// encodeRoot formats a root as 0x-prefixed hex
+func encodeRoot(root [32]byte) string {
+	return "0x" + hex.EncodeToString(root[:])
+}
+
+// decodeHex decodes 0x-prefixed hex of an exact byte length
+func decodeHex(s string, length int) ([]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex %q: %w", s, err)
+	}
+	if len(raw) != length {
+		return nil, fmt.Errorf("%q is %d bytes, want %d", s, len(raw), length)
+	}
+	return raw, nil
+}
@@ -0,0 +1,437 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var networkRankEpochs = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_network_rank_epochs_total",
+		Help: "Total epochs processed by the network rank job by result (ranked, unavailable)",
+	},
+	[]string{"result"},
+)
+
+// maxNetworkSize bounds the search for the size of the validator set
+const maxNetworkSize = 1 << 30
+
+// NetworkRankConfig contains configuration for the network rank job
+type NetworkRankConfig struct {
+	SampleSize  int           // Random network validators scored each epoch
+	SizeRefresh time.Duration // How often the size of the validator set is re-measured
+	GenesisTime time.Time
+}
+
+// DefaultNetworkRankConfig returns default network rank configuration
+func DefaultNetworkRankConfig() *NetworkRankConfig {
+	return &NetworkRankConfig{
+		SampleSize:  1000,
+		SizeRefresh: 24 * time.Hour,
+		GenesisTime: time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// NetworkRankJob scores a random sample of the network's active validators on attestation
+// rewards each epoch and ranks monitored validators against it
+type NetworkRankJob struct {
+	client        types.RewardsClient
+	validatorRepo *repository.ValidatorRepository
+	rankRepo      *repository.NetworkRankRepository
+	config        *NetworkRankConfig
+	rand          *rand.Rand
+
+	// Cached size of the validator set
+	networkSize int
+	sizedAt     time.Time
+
+	// Most recent ranks, served to snapshots
+	latest      map[int64]*models.ValidatorNetworkRank
+	latestStats *models.NetworkEpochStats
+	latestMu    sync.RWMutex
+	loaded      bool // Whether the stored ranks were restored
+}
+
+// NewNetworkRankJob creates a new network rank job
+func NewNetworkRankJob(client types.RewardsClient, pool *pgxpool.Pool, config *NetworkRankConfig) *NetworkRankJob {
+	return &NetworkRankJob{
+		client:        client,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		rankRepo:      repository.NewNetworkRankRepository(pool),
+		config:        config,
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// SnapshotNetworkRank returns a validator's latest network percentile and the network average
+// score for the same epoch. ok is false until the validator has been ranked.
+func (j *NetworkRankJob) SnapshotNetworkRank(validatorIndex int64) (percentile, average float64, ok bool) {
+	j.latestMu.RLock()
+	defer j.latestMu.RUnlock()
+
+	rank, ok := j.latest[validatorIndex]
+	if !ok || j.latestStats == nil {
+		return 0, 0, false
+	}
+	return rank.Percentile, j.latestStats.AverageScore, true
+}
+
+// loadLatest restores the most recently stored epoch so snapshots carry ranks after a restart
+func (j *NetworkRankJob) loadLatest(ctx context.Context) error {
+	epoch, ok, err := j.rankRepo.LatestEpoch(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	indices, err := j.monitoredIndices(ctx)
+	if err != nil {
+		return err
+	}
+
+	stats, err := j.rankRepo.GetEpochStats(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	ranks, err := j.rankRepo.GetRanks(ctx, epoch, indices)
+	if err != nil {
+		return err
+	}
+
+	j.latestMu.Lock()
+	j.latest, j.latestStats = ranks, stats
+	j.latestMu.Unlock()
+	return nil
+}
+
+// RunOnce ranks monitored validators in the most recent finished epoch, if not already ranked.
+// Earlier epochs are not backfilled. The first run restores the stored ranks.
+func (j *NetworkRankJob) RunOnce(ctx context.Context) error {
+	if !j.loaded {
+		if err := j.loadLatest(ctx); err != nil && ctx.Err() == nil {
+			logger.FromContext(ctx).Warn().
+				Err(err).
+				Msg("Failed to load stored network ranks")
+		}
+		j.loaded = true
+	}
+
+	// Attestation rewards for an epoch are final once the following epoch has been processed
+	epoch := types.EpochAtTime(j.config.GenesisTime, time.Now()) - 2
+	if epoch < 0 {
+		return nil
+	}
+
+	latest, ok, err := j.rankRepo.LatestEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if ok && latest >= epoch {
+		return nil
+	}
+
+	indices, err := j.monitoredIndices(ctx)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	if j.networkSize == 0 || time.Since(j.sizedAt) >= j.config.SizeRefresh {
+		size, err := j.measureNetworkSize(ctx, int(epoch))
+		if errors.Is(err, types.ErrStateUnavailable) {
+			networkRankEpochs.WithLabelValues("unavailable").Inc()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		j.networkSize, j.sizedAt = size, time.Now()
+	}
+
+	sample := sampleIndices(j.rand, j.networkSize, j.config.SampleSize)
+
+	stats, ranks, err := j.rankEpoch(ctx, epoch, sample, indices)
+	if errors.Is(err, types.ErrStateUnavailable) {
+		networkRankEpochs.WithLabelValues("unavailable").Inc()
+		logger.FromContext(ctx).Debug().Err(err).Int64("epoch", epoch).Msg("Skipping network rank for epoch with unavailable state")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to rank epoch %d: %w", epoch, err)
+	}
+
+	if err := j.rankRepo.SaveEpoch(ctx, stats, ranks); err != nil {
+		return err
+	}
+
+	byIndex := make(map[int64]*models.ValidatorNetworkRank, len(ranks))
+	for _, rank := range ranks {
+		byIndex[rank.ValidatorIndex] = rank
+	}
+	j.latestMu.Lock()
+	j.latest, j.latestStats = byIndex, stats
+	j.latestMu.Unlock()
+
+	networkRankEpochs.WithLabelValues("ranked").Inc()
+	logger.FromContext(ctx).Info().
+		Int64("epoch", epoch).
+		Int32("sample_size", stats.SampleSize).
+		Float64("network_average", stats.AverageScore).
+		Int("validators_ranked", len(ranks)).
+		Msg("Network ranks updated")
+
+	return nil
+}
+
+// rankEpoch scores the sample and the monitored validators for an epoch. Inactive sample
+// members are dropped, so the effective sample may be smaller than requested.
+func (j *NetworkRankJob) rankEpoch(ctx context.Context, epoch int64, sample []int, monitored []int64) (*models.NetworkEpochStats, []*models.ValidatorNetworkRank, error) {
+	inSample := make(map[int]bool, len(sample))
+	query := make([]int, 0, len(sample)+len(monitored))
+	for _, index := range sample {
+		inSample[index] = true
+		query = append(query, index)
+	}
+	for _, index := range monitored {
+		if !inSample[int(index)] {
+			query = append(query, int(index))
+		}
+	}
+
+	balances, err := j.client.GetValidatorBalances(ctx, int(epoch), query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	active := make([]int, 0, len(balances))
+	for _, b := range balances {
+		if b.IsActive() {
+			active = append(active, b.Index)
+		}
+	}
+	if len(active) == 0 {
+		return nil, nil, fmt.Errorf("no active validators among %d queried", len(query))
+	}
+
+	rewards, err := j.client.GetAttestationRewards(ctx, int(epoch), active)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scores := attestationScores(balancesByIndex(balances), rewards)
+
+	sampleScores := make([]float64, 0, len(sample))
+	for _, index := range sample {
+		if score, ok := scores[index]; ok {
+			sampleScores = append(sampleScores, score)
+		}
+	}
+	if len(sampleScores) == 0 {
+		return nil, nil, fmt.Errorf("no active validators in the network sample")
+	}
+	sort.Float64s(sampleScores)
+
+	epochTime := types.EpochStartTime(j.config.GenesisTime, epoch)
+	activeValidators, totalActiveBalance := estimateActiveBalance(balances, inSample, len(sample), j.networkSize)
+	stats := &models.NetworkEpochStats{
+		Epoch:              epoch,
+		Time:               epochTime,
+		SampleSize:         int32(len(sampleScores)),
+		AverageScore:       meanScore(sampleScores),
+		MedianScore:        medianScore(sampleScores),
+		ActiveValidators:   activeValidators,
+		TotalActiveBalance: totalActiveBalance,
+	}
+
+	ranks := make([]*models.ValidatorNetworkRank, 0, len(monitored))
+	for _, index := range monitored {
+		score, ok := scores[int(index)]
+		if !ok {
+			continue // Not active in this epoch
+		}
+		ranks = append(ranks, &models.ValidatorNetworkRank{
+			ValidatorIndex: index,
+			Epoch:          epoch,
+			Time:           epochTime,
+			Score:          score,
+			Percentile:     percentileRank(sampleScores, score),
+		})
+	}
+
+	return stats, ranks, nil
+}
+
+// estimateActiveBalance extrapolates the number of active validators and their total effective
+// balance from the share of a uniform sample of the validator set that is active. Both are zero
+// if the size of the validator set is unknown or the sample has no active members.
+func estimateActiveBalance(balances []types.ValidatorEpochBalance, inSample map[int]bool, sampleSize, networkSize int) (int64, int64) {
+	var active, effectiveBalance int64
+	for _, b := range balances {
+		if inSample[b.Index] && b.IsActive() {
+			active++
+			effectiveBalance += b.EffectiveBalance
+		}
+	}
+	if active == 0 || sampleSize == 0 || networkSize == 0 {
+		return 0, 0
+	}
+
+	activeValidators := int64(math.Round(float64(networkSize) * float64(active) / float64(sampleSize)))
+	return activeValidators, activeValidators * (effectiveBalance / active)
+}
+
+// measureNetworkSize finds the number of validators in the state at an epoch by searching for
+// the highest index that exists
+func (j *NetworkRankJob) measureNetworkSize(ctx context.Context, epoch int) (int, error) {
+	exists := func(index int) (bool, error) {
+		balances, err := j.client.GetValidatorBalances(ctx, epoch, []int{index})
+		if err != nil {
+			return false, err
+		}
+		return len(balances) > 0, nil
+	}
+
+	found, err := exists(0)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	// Double until past the end, then bisect between the last index found and the first missing
+	lo, hi := 0, 1
+	for {
+		found, err := exists(hi)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			break
+		}
+		if hi >= maxNetworkSize {
+			return 0, fmt.Errorf("validator set larger than %d", maxNetworkSize)
+		}
+		lo, hi = hi, hi*2
+	}
+
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		found, err := exists(mid)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return hi, nil
+}
+
+// monitoredIndices lists the indices of monitored validators
+func (j *NetworkRankJob) monitoredIndices(ctx context.Context) ([]int64, error) {
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+
+	indices := make([]int64, len(validators))
+	for i, v := range validators {
+		indices[i] = v.ValidatorIndex
+	}
+	return indices, nil
+}
+
+// sampleIndices draws up to n distinct validator indices below size, in ascending order
+func sampleIndices(rng *rand.Rand, size, n int) []int {
+	if n > size {
+		n = size
+	}
+
+	chosen := make(map[int]struct{}, n)
+	for len(chosen) < n {
+		chosen[rng.Intn(size)] = struct{}{}
+	}
+
+	sample := make([]int, 0, n)
+	for index := range chosen {
+		sample = append(sample, index)
+	}
+	sort.Ints(sample)
+	return sample
+}
+
+// attestationScores scores each validator's attestation rewards as a percentage of the ideal
+// for its effective balance. Validators without a balance or an ideal reward are omitted.
+func attestationScores(balances map[int]types.ValidatorEpochBalance, rewards *types.AttestationRewards) map[int]float64 {
+	scores := make(map[int]float64, len(rewards.TotalRewards))
+	for _, r := range rewards.TotalRewards {
+		balance, ok := balances[r.ValidatorIndex]
+		if !ok {
+			continue
+		}
+		idealTotal := rewards.IdealTotal(balance.EffectiveBalance)
+		if idealTotal <= 0 {
+			continue
+		}
+
+		var earned int64
+		for _, component := range r.Components() {
+			earned += component
+		}
+		scores[r.ValidatorIndex] = float64(earned) / float64(idealTotal) * 100
+	}
+
+	return scores
+}
+
+// meanScore returns the mean of scores
+func meanScore(scores []float64) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, s := range scores {
+		sum += s
+	}
+	return sum / float64(len(scores))
+}
+
+// medianScore returns the median of sorted scores
+func medianScore(sorted []float64) float64 {
+	n := len(sorted)
+	if n == 0 {
+		return 0
+	}
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// percentileRank returns the percentage of sorted scores at or below score
+func percentileRank(sorted []float64, score float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	atOrBelow := sort.Search(len(sorted), func(i int) bool { return sorted[i] > score })
+	return float64(atOrBelow) / float64(len(sorted)) * 100
+}
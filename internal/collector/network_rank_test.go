@@ -0,0 +1,194 @@
+package collector
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNetwork is a validator set of the given size in which validator i earns
+// (i % 100) percent of the ideal attestation reward
+type fakeNetwork struct {
+	size     int
+	inactive map[int]bool
+	queries  int
+}
+
+const fakeIdealHead = 10_000
+
+func (f *fakeNetwork) GetValidatorBalances(ctx context.Context, epoch int, indices []int) ([]types.ValidatorEpochBalance, error) {
+	f.queries++
+	var balances []types.ValidatorEpochBalance
+	for _, index := range indices {
+		if index >= f.size {
+			continue
+		}
+		status := "active_ongoing"
+		if f.inactive[index] {
+			status = "pending_queued"
+		}
+		balances = append(balances, types.ValidatorEpochBalance{Index: index, Balance: 32_000_000_000, EffectiveBalance: 32_000_000_000, Status: status})
+	}
+	return balances, nil
+}
+
+func (f *fakeNetwork) GetAttestationRewards(ctx context.Context, epoch int, indices []int) (*types.AttestationRewards, error) {
+	rewards := &types.AttestationRewards{
+		IdealRewards: []types.IdealAttestationReward{{EffectiveBalance: 32_000_000_000, Head: fakeIdealHead}},
+	}
+	for _, index := range indices {
+		rewards.TotalRewards = append(rewards.TotalRewards, types.ValidatorAttestationReward{
+			ValidatorIndex: index,
+			Head:           int64(index%100) * fakeIdealHead / 100,
+		})
+	}
+	return rewards, nil
+}
+
+func (f *fakeNetwork) GetBlockRewards(ctx context.Context, slot int) (*types.BlockReward, error) {
+	return nil, nil
+}
+
+func (f *fakeNetwork) GetSyncCommitteeRewards(ctx context.Context, slot int, indices []int) ([]types.SyncCommitteeReward, error) {
+	return nil, nil
+}
+
+func (f *fakeNetwork) GetBlock(ctx context.Context, slot int) (*types.Block, error) {
+	return nil, nil
+}
+
+func (f *fakeNetwork) GetPendingDeposits(ctx context.Context, slot int) ([]types.PendingDeposit, error) {
+	return nil, nil
+}
+
+func (f *fakeNetwork) GetPendingPartialWithdrawals(ctx context.Context, slot int) ([]types.PendingPartialWithdrawal, error) {
+	return nil, nil
+}
+
+func (f *fakeNetwork) GetPendingConsolidations(ctx context.Context, slot int) ([]types.PendingConsolidation, error) {
+	return nil, nil
+}
+
+func TestAttestationScores(t *testing.T) {
+	balances := balancesByIndex([]types.ValidatorEpochBalance{
+		{Index: 1, EffectiveBalance: 32_000_000_000},
+		{Index: 2, EffectiveBalance: 32_000_000_000},
+		{Index: 3, EffectiveBalance: 64_000_000_000}, // Compounding; no ideal reward reported for this balance
+		{Index: 5, EffectiveBalance: 0},
+	})
+	rewards := &types.AttestationRewards{
+		IdealRewards: []types.IdealAttestationReward{
+			{EffectiveBalance: 32_000_000_000, Head: 3_000, Target: 5_000, Source: 2_000},
+		},
+		TotalRewards: []types.ValidatorAttestationReward{
+			{ValidatorIndex: 1, Head: 3_000, Target: 5_000, Source: 2_000},
+			{ValidatorIndex: 2, Head: 0, Target: -5_000, Source: 0},
+			{ValidatorIndex: 3, Head: 6_000, Target: 10_000, Source: 4_000},
+			{ValidatorIndex: 4, Head: 3_000}, // No balance
+			{ValidatorIndex: 5, Head: 3_000}, // No ideal reward
+		},
+	}
+
+	scores := attestationScores(balances, rewards)
+	assert.Equal(t, map[int]float64{1: 100, 2: -50, 3: 100}, scores, "ideal rewards scale with effective balance")
+}
+
+func TestScoreStatistics(t *testing.T) {
+	sorted := []float64{50, 90, 95, 100}
+	assert.Equal(t, 83.75, meanScore(sorted))
+	assert.Equal(t, 92.5, medianScore(sorted))
+	assert.Equal(t, 95.0, medianScore(sorted[1:]))
+	assert.Equal(t, 0.0, medianScore(nil))
+
+	assert.Equal(t, 75.0, percentileRank(sorted, 95), "ties count as at or below")
+	assert.Equal(t, 50.0, percentileRank(sorted, 92))
+	assert.Equal(t, 0.0, percentileRank(sorted, 10))
+	assert.Equal(t, 100.0, percentileRank(sorted, 100))
+	assert.Equal(t, 0.0, percentileRank(nil, 100))
+}
+
+func TestSampleIndices(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	sample := sampleIndices(rng, 1000, 100)
+	require.Len(t, sample, 100)
+	for i, index := range sample {
+		assert.True(t, index >= 0 && index < 1000)
+		if i > 0 {
+			assert.Less(t, sample[i-1], index, "indices are distinct and ascending")
+		}
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, sampleIndices(rng, 3, 10), "small sets are sampled in full")
+}
+
+func TestNetworkRankJob_MeasureNetworkSize(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 5, 1024, 1025, 987_654} {
+		client := &fakeNetwork{size: size}
+		j := &NetworkRankJob{client: client}
+
+		got, err := j.measureNetworkSize(context.Background(), 100)
+		require.NoError(t, err)
+		assert.Equal(t, size, got)
+		assert.LessOrEqual(t, client.queries, 45, "search is logarithmic")
+	}
+}
+
+func TestNetworkRankJob_RankEpoch(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	client := &fakeNetwork{size: 1000, inactive: map[int]bool{10: true, 77: true}}
+	j := &NetworkRankJob{client: client, config: &NetworkRankConfig{GenesisTime: genesis}, networkSize: 1000}
+
+	// Sample scores are 0..99 except the inactive 10 and 77
+	sample := make([]int, 100)
+	for i := range sample {
+		sample[i] = i
+	}
+
+	stats, ranks, err := j.rankEpoch(context.Background(), 100, sample, []int64{250, 399, 77})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(100), stats.Epoch)
+	assert.Equal(t, types.EpochStartTime(genesis, 100), stats.Time)
+	assert.Equal(t, int32(98), stats.SampleSize)
+	assert.InDelta(t, (4950.0-10-77)/98, stats.AverageScore, 1e-9)
+	assert.Equal(t, 49.5, stats.MedianScore)
+	assert.Equal(t, int64(980), stats.ActiveValidators, "98% of the sample is active")
+	assert.Equal(t, int64(980*32_000_000_000), stats.TotalActiveBalance)
+
+	require.Len(t, ranks, 2, "inactive validators are not ranked")
+	assert.Equal(t, int64(250), ranks[0].ValidatorIndex)
+	assert.Equal(t, 50.0, ranks[0].Score)
+	assert.InDelta(t, 50.0/98*100, ranks[0].Percentile, 1e-9)
+	assert.Equal(t, 99.0, ranks[1].Score)
+	assert.Equal(t, 100.0, ranks[1].Percentile)
+}
+
+type fixedNetworkRank map[int64][2]float64
+
+func (f fixedNetworkRank) SnapshotNetworkRank(validatorIndex int64) (float64, float64, bool) {
+	v, ok := f[validatorIndex]
+	return v[0], v[1], ok
+}
+
+func TestValidatorCollector_SnapshotNetworkRank(t *testing.T) {
+	c := &ValidatorCollector{}
+	c.SetNetworkRankSource(fixedNetworkRank{1: {87.5, 98.2}})
+
+	result := Result{ValidatorIndex: 1, Data: map[string]interface{}{"balance": int64(32_000_000_000)}, CollectedAt: time.Now()}
+	snapshot, err := c.resultToSnapshot(result)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot.NetworkPercentile)
+	assert.Equal(t, 87.5, *snapshot.NetworkPercentile)
+	assert.Equal(t, 98.2, *snapshot.NetworkAverage)
+
+	result.ValidatorIndex = 2
+	snapshot, err = c.resultToSnapshot(result)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot.NetworkPercentile, "no rank until the validator has been ranked")
+}
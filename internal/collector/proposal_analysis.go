@@ -0,0 +1,350 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var proposalMisses = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_proposal_misses_total",
+		Help: "Total missed block proposals by verdict",
+	},
+	[]string{"reason"},
+)
+
+// blockEventRetryDelay is the pause before resubscribing to block events after the stream ends
+const blockEventRetryDelay = 5 * time.Second
+
+// ProposalAnalysisConfig contains configuration for the proposal analysis job
+type ProposalAnalysisConfig struct {
+	LookbackEpochs int64
+	LateThreshold  time.Duration // Blocks arriving later than this after the slot start are late
+	GenesisTime    time.Time
+}
+
+// DefaultProposalAnalysisConfig returns default proposal analysis configuration
+func DefaultProposalAnalysisConfig() *ProposalAnalysisConfig {
+	return &ProposalAnalysisConfig{
+		LookbackEpochs: 225,             // ~1 day
+		LateThreshold:  4 * time.Second, // Attestation deadline
+		GenesisTime:    time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// ProposalAnalysisJob checks the proposal duties of monitored validators against the canonical
+// chain, records a verdict for every proposal that did not become canonical and raises an alert.
+// It also watches block events so the arrival time of blocks that are later orphaned is known.
+type ProposalAnalysisJob struct {
+	client        types.ProposalClient
+	relays        types.RelaySource // Nil when no relays are configured
+	validatorRepo *repository.ValidatorRepository
+	missRepo      *repository.ProposalMissRepository
+	alertRepo     *repository.AlertRepository
+	config        *ProposalAnalysisConfig
+
+	blocksMu sync.Mutex
+	blocks   map[int][]types.BlockEvent // Block events by slot
+}
+
+// NewProposalAnalysisJob creates a new proposal analysis job. relays may be nil.
+func NewProposalAnalysisJob(client types.ProposalClient, relays types.RelaySource, pool *pgxpool.Pool, config *ProposalAnalysisConfig) *ProposalAnalysisJob {
+	return &ProposalAnalysisJob{
+		client:        client,
+		relays:        relays,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		missRepo:      repository.NewProposalMissRepository(pool),
+		alertRepo:     repository.NewAlertRepository(pool),
+		config:        config,
+		blocks:        make(map[int][]types.BlockEvent),
+	}
+}
+
+// WatchBlocks records block events until ctx is done, resubscribing when the stream ends
+func (j *ProposalAnalysisJob) WatchBlocks(ctx context.Context) {
+	for {
+		events, err := j.client.SubscribeToBlockEvents(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.FromContext(ctx).Warn().
+					Err(err).
+					Msg("Failed to subscribe to block events")
+			}
+		} else {
+			for event := range events {
+				j.recordBlock(event)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(blockEventRetryDelay):
+		}
+	}
+}
+
+// recordBlock stores a block event, dropping events older than the lookback window
+func (j *ProposalAnalysisJob) recordBlock(event types.BlockEvent) {
+	j.blocksMu.Lock()
+	defer j.blocksMu.Unlock()
+
+	j.blocks[event.Slot] = append(j.blocks[event.Slot], event)
+
+	if event.Slot%types.SlotsPerEpoch == 0 {
+		oldest := event.Slot - int(j.config.LookbackEpochs+2)*types.SlotsPerEpoch
+		for slot := range j.blocks {
+			if slot < oldest {
+				delete(j.blocks, slot)
+			}
+		}
+	}
+}
+
+// observedBlocks returns the block events received for a slot
+func (j *ProposalAnalysisJob) observedBlocks(slot int) []types.BlockEvent {
+	j.blocksMu.Lock()
+	defer j.blocksMu.Unlock()
+
+	return append([]types.BlockEvent(nil), j.blocks[slot]...)
+}
+
+// RunOnce analyses every epoch in the lookback window not analysed yet, newest first
+func (j *ProposalAnalysisJob) RunOnce(ctx context.Context) error {
+	// Leave time for short reorgs to settle before judging a slot
+	toEpoch := types.EpochAtTime(j.config.GenesisTime, time.Now()) - 2
+	fromEpoch := toEpoch - j.config.LookbackEpochs + 1
+	if fromEpoch < 1 {
+		fromEpoch = 1
+	}
+	if toEpoch < fromEpoch {
+		return nil
+	}
+
+	monitoredOnly := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitoredOnly,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	monitored := make(map[int]bool, len(validators))
+	for _, v := range validators {
+		monitored[int(v.ValidatorIndex)] = true
+	}
+
+	analyzed, err := j.missRepo.AnalyzedEpochs(ctx, fromEpoch, toEpoch)
+	if err != nil {
+		return err
+	}
+
+	processed, missed := 0, 0
+	for epoch := toEpoch; epoch >= fromEpoch; epoch-- {
+		if analyzed[epoch] {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		duties, misses, err := j.analyzeEpoch(ctx, epoch, monitored)
+		if errors.Is(err, types.ErrStateUnavailable) {
+			logger.FromContext(ctx).Debug().Err(err).Int64("epoch", epoch).Msg("Skipping proposal analysis for epoch with unavailable state")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to analyse epoch %d: %w", epoch, err)
+		}
+
+		if err := j.missRepo.SaveEpoch(ctx, epoch, duties, misses); err != nil {
+			return err
+		}
+		for _, m := range misses {
+			proposalMisses.WithLabelValues(string(m.Reason)).Inc()
+			j.raiseAlert(ctx, m)
+		}
+		processed++
+		missed += len(misses)
+	}
+
+	logger.FromContext(ctx).Info().
+		Int("validator_count", len(validators)).
+		Int("epochs_analyzed", processed).
+		Int("proposals_missed", missed).
+		Int64("to_epoch", toEpoch).
+		Msg("Proposal analysis updated")
+
+	return nil
+}
+
+// analyzeEpoch checks the proposal duties of monitored validators in an epoch. It returns the
+// number of duties checked and a verdict for each proposal that did not become canonical.
+func (j *ProposalAnalysisJob) analyzeEpoch(ctx context.Context, epoch int64, monitored map[int]bool) (int, []*models.ProposalMiss, error) {
+	duties, err := j.client.GetProposerDuties(ctx, int(epoch))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	checked := 0
+	var misses []*models.ProposalMiss
+	for _, duty := range duties {
+		if !monitored[duty.ValidatorIndex] {
+			continue
+		}
+		checked++
+
+		_, found, err := j.client.GetBlockRoot(ctx, duty.Slot)
+		if err != nil {
+			return 0, nil, err
+		}
+		if found {
+			continue
+		}
+
+		headers, err := j.client.GetBlockHeaders(ctx, duty.Slot)
+		if err != nil {
+			return 0, nil, err
+		}
+		observed := j.observedBlocks(duty.Slot)
+
+		// Relays only matter when no block was seen at all
+		var payloads []types.RelayPayload
+		if j.relays != nil && len(observed) == 0 && !hasOrphanedHeader(duty, headers) {
+			var failed []string
+			payloads, failed, err = j.relays.GetDeliveredPayloads(ctx, duty.Slot)
+			if err != nil || len(failed) > 0 {
+				logger.FromContext(ctx).Warn().
+					Err(err).
+					Strs("relays", failed).
+					Int("slot", duty.Slot).
+					Msg("Failed to query relays for missed proposal")
+			}
+		}
+
+		slotStart := types.SlotStartTime(j.config.GenesisTime, int64(duty.Slot))
+		misses = append(misses, classifyMissedProposal(duty, slotStart, headers, observed, payloads, j.config.LateThreshold))
+	}
+
+	return checked, misses, nil
+}
+
+// raiseAlert records a missed proposal alert carrying the verdict. Failures are logged since the
+// verdict itself has already been stored.
+func (j *ProposalAnalysisJob) raiseAlert(ctx context.Context, m *models.ProposalMiss) {
+	index := m.ValidatorIndex
+	details := models.JSONB{
+		"slot":   m.Slot,
+		"epoch":  m.Epoch,
+		"reason": string(m.Reason),
+	}
+	if m.BlockRoot != nil {
+		details["block_root"] = *m.BlockRoot
+	}
+	if m.BlockDelayMs != nil {
+		details["block_delay_ms"] = *m.BlockDelayMs
+	}
+	if m.Relay != nil {
+		details["relay"] = *m.Relay
+	}
+
+	alert := &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeMissedProposal),
+		Severity:       models.SeverityError,
+		Title:          "Missed block proposal",
+		Message:        m.Summary(),
+		Source:         "proposal_analysis",
+		Details:        details,
+		Status:         models.AlertStatusNew,
+	}
+	if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+		logger.FromContext(ctx).Error().
+			Err(err).
+			Int64("validator_index", m.ValidatorIndex).
+			Int64("slot", m.Slot).
+			Msg("Failed to create missed proposal alert")
+	}
+}
+
+// hasOrphanedHeader reports whether the node knows a non-canonical block by the duty's proposer
+func hasOrphanedHeader(duty types.ProposerDuty, headers []types.BlockHeader) bool {
+	_, ok := orphanedRoot(duty, headers)
+	return ok
+}
+
+// orphanedRoot returns the root of a non-canonical block by the duty's proposer at its slot
+func orphanedRoot(duty types.ProposerDuty, headers []types.BlockHeader) (string, bool) {
+	for _, h := range headers {
+		if !h.Canonical && h.Slot == duty.Slot && h.ProposerIndex == duty.ValidatorIndex {
+			return h.Root, true
+		}
+	}
+	return "", false
+}
+
+// classifyMissedProposal decides why a scheduled proposal did not become canonical. A block seen
+// at the slot (as a non-canonical header or a block event) was orphaned, or late if it arrived
+// after lateThreshold. Without a block, a payload delivered by a relay to the proposer points at
+// the relay or builder; otherwise no block was published.
+func classifyMissedProposal(duty types.ProposerDuty, slotStart time.Time, headers []types.BlockHeader, observed []types.BlockEvent, payloads []types.RelayPayload, lateThreshold time.Duration) *models.ProposalMiss {
+	miss := &models.ProposalMiss{
+		ValidatorIndex: int64(duty.ValidatorIndex),
+		Slot:           int64(duty.Slot),
+		Epoch:          int64(duty.Slot / types.SlotsPerEpoch),
+		Time:           slotStart,
+		Reason:         models.ProposalMissNoBlock,
+	}
+
+	root, seen := orphanedRoot(duty, headers)
+	var arrival *time.Time
+	// Only the scheduled proposer can produce a valid block at the slot
+	for _, event := range observed {
+		if event.Slot == duty.Slot && (!seen || strings.EqualFold(event.Block, root)) {
+			root, seen = event.Block, true
+			received := event.Timestamp
+			arrival = &received
+			break
+		}
+	}
+
+	if seen {
+		miss.BlockRoot = &root
+		miss.Reason = models.ProposalMissOrphaned
+		if arrival != nil {
+			delay := arrival.Sub(slotStart)
+			delayMs := int32(delay.Milliseconds())
+			miss.BlockDelayMs = &delayMs
+			if delay > lateThreshold {
+				miss.Reason = models.ProposalMissLate
+			}
+		}
+		return miss
+	}
+
+	for _, p := range payloads {
+		if p.Slot == duty.Slot && strings.EqualFold(p.ProposerPubkey, duty.Pubkey) {
+			relay := p.Relay
+			miss.Relay = &relay
+			miss.Reason = models.ProposalMissRelayFailure
+			break
+		}
+	}
+
+	return miss
+}
@@ -0,0 +1,159 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyMissedProposal(t *testing.T) {
+	duty := types.ProposerDuty{ValidatorIndex: 42, Pubkey: "0xabc", Slot: 3205}
+	slotStart := time.Unix(types.MainnetGenesisTime, 0).Add(3205 * 12 * time.Second)
+	orphan := []types.BlockHeader{
+		{Root: "0xcanonical", Slot: 3205, ProposerIndex: 7, Canonical: true},
+		{Root: "0xorphan", Slot: 3205, ProposerIndex: 42, Canonical: false},
+	}
+	arrivedAfter := func(d time.Duration) []types.BlockEvent {
+		return []types.BlockEvent{{Slot: 3205, Block: "0xorphan", Timestamp: slotStart.Add(d)}}
+	}
+	delivered := []types.RelayPayload{
+		{Relay: "relay.example", Slot: 3205, ProposerPubkey: "0xABC", Value: big.NewInt(1)},
+	}
+
+	tests := []struct {
+		name      string
+		headers   []types.BlockHeader
+		observed  []types.BlockEvent
+		payloads  []types.RelayPayload
+		reason    models.ProposalMissReason
+		delayMs   *int32
+		wantRoot  bool
+		wantRelay bool
+	}{
+		{name: "nothing seen", reason: models.ProposalMissNoBlock},
+		{name: "orphaned header without timing", headers: orphan, reason: models.ProposalMissOrphaned, wantRoot: true},
+		{name: "orphaned on time", headers: orphan, observed: arrivedAfter(1500 * time.Millisecond), reason: models.ProposalMissOrphaned, delayMs: int32Ptr(1500), wantRoot: true},
+		{name: "late block", headers: orphan, observed: arrivedAfter(6 * time.Second), reason: models.ProposalMissLate, delayMs: int32Ptr(6000), wantRoot: true},
+		{name: "block event only", observed: arrivedAfter(5 * time.Second), reason: models.ProposalMissLate, delayMs: int32Ptr(5000), wantRoot: true},
+		{name: "relay delivered payload", payloads: delivered, reason: models.ProposalMissRelayFailure, wantRelay: true},
+		{name: "payload for another proposer", payloads: []types.RelayPayload{{Relay: "relay.example", Slot: 3205, ProposerPubkey: "0xdef"}}, reason: models.ProposalMissNoBlock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			miss := classifyMissedProposal(duty, slotStart, tt.headers, tt.observed, tt.payloads, 4*time.Second)
+
+			assert.Equal(t, int64(42), miss.ValidatorIndex)
+			assert.Equal(t, int64(3205), miss.Slot)
+			assert.Equal(t, int64(100), miss.Epoch)
+			assert.Equal(t, slotStart, miss.Time)
+			assert.Equal(t, tt.reason, miss.Reason)
+			assert.Equal(t, tt.delayMs, miss.BlockDelayMs)
+			if tt.wantRoot {
+				require.NotNil(t, miss.BlockRoot)
+				assert.Equal(t, "0xorphan", *miss.BlockRoot)
+			} else {
+				assert.Nil(t, miss.BlockRoot)
+			}
+			if tt.wantRelay {
+				require.NotNil(t, miss.Relay)
+				assert.Equal(t, "relay.example", *miss.Relay)
+			} else {
+				assert.Nil(t, miss.Relay)
+			}
+		})
+	}
+}
+
+func TestBeaconClient_ProposalEndpoints(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/100", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"dependent_root":"0x1","data":[{"pubkey":"0xaa","validator_index":"42","slot":"3205"}]}`))
+	})
+	mux.HandleFunc("/eth/v1/beacon/headers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "3205", r.URL.Query().Get("slot"))
+		w.Write([]byte(`{"data":[{"root":"0xorphan","canonical":false,"header":{"message":{"slot":"3205","proposer_index":"42"}}}]}`))
+	})
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "block", r.URL.Query().Get("topics"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: block\ndata: {\"slot\":\"3205\",\"block\":\"0xorphan\",\"execution_optimistic\":false}\n\n")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewBeaconClientWithoutRetry(server.URL, 5*time.Second)
+	ctx := context.Background()
+
+	duties, err := client.GetProposerDuties(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []types.ProposerDuty{{ValidatorIndex: 42, Pubkey: "0xaa", Slot: 3205}}, duties)
+
+	_, err = client.GetProposerDuties(ctx, 101)
+	assert.ErrorIs(t, err, types.ErrStateUnavailable)
+
+	headers, err := client.GetBlockHeaders(ctx, 3205)
+	require.NoError(t, err)
+	assert.Equal(t, []types.BlockHeader{{Root: "0xorphan", Slot: 3205, ProposerIndex: 42, Canonical: false}}, headers)
+
+	events, err := client.SubscribeToBlockEvents(ctx)
+	require.NoError(t, err)
+	event, ok := <-events
+	require.True(t, ok)
+	assert.Equal(t, 3205, event.Slot)
+	assert.Equal(t, "0xorphan", event.Block)
+	assert.False(t, event.Timestamp.IsZero())
+	_, ok = <-events
+	assert.False(t, ok, "channel closes when the stream ends")
+}
+
+func TestRelayClient_GetDeliveredPayloads(t *testing.T) {
+	relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/relay/v1/data/bidtraces/proposer_payload_delivered", r.URL.Path)
+		if r.URL.Query().Get("slot") != "3205" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"slot":"3205","parent_hash":"0x1","block_hash":"0xblock","builder_pubkey":"0xbuilder",
+			"proposer_pubkey":"0xaa","proposer_fee_recipient":"0xfee","gas_limit":"30000000","gas_used":"1","value":"51234567890123456"}]`))
+	}))
+	defer relay.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	client := NewRelayClient([]string{relay.URL + "/", down.URL, " "}, 5*time.Second)
+	assert.Len(t, client.Relays(), 2)
+
+	ctx := context.Background()
+	payloads, failed, err := client.GetDeliveredPayloads(ctx, 3205)
+	require.NoError(t, err, "an unreachable relay is reported rather than failing the query")
+	assert.Equal(t, []string{down.Listener.Addr().String()}, failed)
+	require.Len(t, payloads, 1)
+	assert.Equal(t, relay.Listener.Addr().String(), payloads[0].Relay)
+	assert.Equal(t, "0xaa", payloads[0].ProposerPubkey)
+	assert.Equal(t, "0xbuilder", payloads[0].BuilderPubkey)
+	assert.Equal(t, "0xblock", payloads[0].BlockHash)
+	assert.Equal(t, "51234567890123456", payloads[0].Value.String())
+
+	payloads, _, err = client.GetDeliveredPayloads(ctx, 3206)
+	require.NoError(t, err)
+	assert.Empty(t, payloads)
+
+	_, _, err = NewRelayClient([]string{down.URL}, 5*time.Second).GetDeliveredPayloads(ctx, 3205)
+	assert.Error(t, err, "no relay could be queried")
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,190 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// RelayClient queries the data API of a set of MEV-boost relays
+type RelayClient struct {
+	relays     []string
+	httpClient *http.Client
+}
+
+// NewRelayClient creates a client for the relays at the given base URLs
+func NewRelayClient(relays []string, timeout time.Duration) *RelayClient {
+	trimmed := make([]string, 0, len(relays))
+	for _, relay := range relays {
+		if relay = strings.TrimRight(strings.TrimSpace(relay), "/"); relay != "" {
+			trimmed = append(trimmed, relay)
+		}
+	}
+
+	return &RelayClient{
+		relays:     trimmed,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Relays returns the base URLs of the configured relays
+func (c *RelayClient) Relays() []string {
+	return c.relays
+}
+
+// GetDeliveredPayloads retrieves the payloads every relay delivered at a slot. Relays that cannot be
+// reached are logged and returned as failed; an error is returned only if none could be queried.
+func (c *RelayClient) GetDeliveredPayloads(ctx context.Context, slot int) ([]types.RelayPayload, []string, error) {
+	var payloads []types.RelayPayload
+	var failed []string
+	var lastErr error
+
+	for _, relay := range c.relays {
+		delivered, err := c.deliveredPayloads(ctx, relay, slot)
+		if err != nil {
+			lastErr = err
+			failed = append(failed, relayName(relay))
+			logger.FromContext(ctx).Warn().
+				Err(err).
+				Str("relay", relay).
+				Int("slot", slot).
+				Msg("Failed to query relay")
+			continue
+		}
+		payloads = append(payloads, delivered...)
+	}
+
+	if len(failed) == len(c.relays) && lastErr != nil {
+		return nil, failed, fmt.Errorf("failed to query any relay: %w", lastErr)
+	}
+
+	return payloads, failed, nil
+}
+
+// deliveredPayloads queries one relay's proposer_payload_delivered endpoint for a slot
+func (c *RelayClient) deliveredPayloads(ctx context.Context, relay string, slot int) ([]types.RelayPayload, error) {
+	endpoint := fmt.Sprintf("%s/relay/v1/data/bidtraces/proposer_payload_delivered?slot=%d", relay, slot)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	var traces []struct {
+		Slot                 int64  `json:"slot,string"`
+		ProposerPubkey       string `json:"proposer_pubkey"`
+		BuilderPubkey        string `json:"builder_pubkey"`
+		BlockHash            string `json:"block_hash"`
+		ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+		Value                string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&traces); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	payloads := make([]types.RelayPayload, 0, len(traces))
+	for _, t := range traces {
+		value, ok := new(big.Int).SetString(t.Value, 10)
+		if !ok {
+			value = big.NewInt(0)
+		}
+		payloads = append(payloads, types.RelayPayload{
+			Relay:                relayName(relay),
+			Slot:                 int(t.Slot),
+			ProposerPubkey:       t.ProposerPubkey,
+			BuilderPubkey:        t.BuilderPubkey,
+			BlockHash:            t.BlockHash,
+			ProposerFeeRecipient: t.ProposerFeeRecipient,
+			Value:                value,
+		})
+	}
+
+	return payloads, nil
+}
+
+// GetRegisteredRelays returns the names of the relays a validator has registered with. Relays that
+// cannot be reached are logged and returned as failed; an error is returned only if none could be
+// queried.
+func (c *RelayClient) GetRegisteredRelays(ctx context.Context, pubkey string) ([]string, []string, error) {
+	var registered []string
+	var failed []string
+	var lastErr error
+
+	for _, relay := range c.relays {
+		ok, err := c.isRegistered(ctx, relay, pubkey)
+		if err != nil {
+			lastErr = err
+			failed = append(failed, relayName(relay))
+			logger.FromContext(ctx).Warn().
+				Err(err).
+				Str("relay", relay).
+				Str("pubkey", pubkey).
+				Msg("Failed to query relay")
+			continue
+		}
+		if ok {
+			registered = append(registered, relayName(relay))
+		}
+	}
+
+	if len(failed) == len(c.relays) && lastErr != nil {
+		return nil, failed, fmt.Errorf("failed to query any relay: %w", lastErr)
+	}
+
+	return registered, failed, nil
+}
+
+// isRegistered queries one relay's validator_registration endpoint. Relays answer 400 or 404 for
+// validators that have not registered.
+func (c *RelayClient) isRegistered(ctx context.Context, relay, pubkey string) (bool, error) {
+	endpoint := fmt.Sprintf("%s/relay/v1/data/validator_registration?pubkey=%s", relay, url.QueryEscape(pubkey))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusBadRequest, http.StatusNotFound:
+		return false, nil
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+}
+
+// relayName returns the host of a relay URL, dropping any credentials embedded in it
+func relayName(relay string) string {
+	if u, err := url.Parse(relay); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return relay
+}
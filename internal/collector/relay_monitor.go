@@ -0,0 +1,347 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	relayProposals = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_relay_proposals_total",
+			Help: "Total blocks proposed by monitored validators by how they were built",
+		},
+		[]string{"source"},
+	)
+
+	relayShortfallGwei = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "validator_relay_shortfall_gwei_total",
+			Help: "Total Gwei relay bids promised monitored validators but builders did not pay",
+		},
+	)
+)
+
+// RelayMonitorConfig contains configuration for the relay monitor job
+type RelayMonitorConfig struct {
+	LookbackEpochs     int64   // Epochs checked when the job starts
+	MaxSlotsPerRun     int     // Upper bound on slots whose proposals are checked per run
+	ShortfallTolerance float64 // Fraction of the promised value a payment may fall short by without an alert
+	MaxRetries         int     // Runs that retry a slot while relays are unreachable before it is recorded unverified
+	GenesisTime        time.Time
+}
+
+// DefaultRelayMonitorConfig returns default relay monitor configuration
+func DefaultRelayMonitorConfig() *RelayMonitorConfig {
+	return &RelayMonitorConfig{
+		LookbackEpochs:     225, // ~1 day
+		MaxSlotsPerRun:     7200,
+		ShortfallTolerance: 0.01,
+		MaxRetries:         3,
+		GenesisTime:        time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// RelayMonitorJob matches every block proposed by a monitored validator against the payloads the
+// configured MEV-boost relays report delivering. Relay blocks record the relays, builder and the
+// value the bid promised, which is compared with the builder's payment in the block's final
+// transaction. A locally built block by a validator registered with a relay is a fallback, which
+// usually means the relays or builders failed to deliver in time. A block whose source stays
+// undecided because relays are unreachable is recorded as unverified after MaxRetries runs.
+type RelayMonitorJob struct {
+	client        types.FeeRecipientClient
+	relays        types.RelayMonitorSource
+	validatorRepo *repository.ValidatorRepository
+	relayRepo     *repository.RelayRepository
+	alertRepo     *repository.AlertRepository
+	config        *RelayMonitorConfig
+
+	nextSlot  int64 // First slot not yet checked; zero before the first run
+	retrySlot int64 // Slot left undecided by unreachable relays
+	retries   int   // Runs that have retried retrySlot
+}
+
+// NewRelayMonitorJob creates a new relay monitor job
+func NewRelayMonitorJob(client types.FeeRecipientClient, relays types.RelayMonitorSource, pool *pgxpool.Pool, config *RelayMonitorConfig) *RelayMonitorJob {
+	return &RelayMonitorJob{
+		client:        client,
+		relays:        relays,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		relayRepo:     repository.NewRelayRepository(pool),
+		alertRepo:     repository.NewAlertRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce checks the blocks proposed by monitored validators since the last run, up to
+// MaxSlotsPerRun slots. The first run starts LookbackEpochs before the head. A run stops at a
+// block whose source cannot be told while some relays are unreachable, until the block has been
+// retried MaxRetries times.
+func (j *RelayMonitorJob) RunOnce(ctx context.Context) error {
+	head := types.SlotAtTime(j.config.GenesisTime, time.Now()) - 1
+	if j.nextSlot == 0 {
+		j.nextSlot = max(head-j.config.LookbackEpochs*types.SlotsPerEpoch+1, 1)
+	}
+	if j.nextSlot > head {
+		return nil
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return err
+	}
+	byIndex := make(map[int64]*models.Validator, len(validators))
+	for _, v := range validators {
+		byIndex[v.ValidatorIndex] = v
+	}
+
+	last := min(head, j.nextSlot+int64(j.config.MaxSlotsPerRun)-1)
+	if len(byIndex) > 0 {
+		duties, err := scheduledProposals(ctx, j.client, j.nextSlot, last, func(index int64) bool {
+			_, ok := byIndex[index]
+			return ok
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, duty := range duties {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			block, err := j.client.GetBlock(ctx, duty.Slot)
+			if err != nil {
+				return err
+			}
+			if block == nil || block.ProposerIndex != duty.ValidatorIndex {
+				continue // Missed proposals are handled by proposal analysis
+			}
+
+			proposal, failed, err := j.classify(ctx, byIndex[int64(duty.ValidatorIndex)], block)
+			if err != nil {
+				return err
+			}
+			if len(failed) > 0 && j.retry(int64(duty.Slot)) {
+				// Check the slot again next run rather than record a verdict the missing relays could change
+				j.nextSlot = int64(duty.Slot)
+				return fmt.Errorf("failed to query relays %s for slot %d", strings.Join(failed, ", "), duty.Slot)
+			}
+
+			if err := j.record(ctx, proposal, failed); err != nil {
+				return err
+			}
+		}
+	}
+	j.nextSlot = last + 1
+
+	return nil
+}
+
+// retry reports whether a slot left undecided by unreachable relays should be checked again, counting
+// the retries of the slot
+func (j *RelayMonitorJob) retry(slot int64) bool {
+	if j.retrySlot != slot {
+		j.retrySlot, j.retries = slot, 0
+	}
+	if j.retries >= j.config.MaxRetries {
+		return false
+	}
+	j.retries++
+	return true
+}
+
+// classify matches a block proposed by a monitored validator against the payloads relays delivered
+// at its slot. A block no relay delivered is a fallback if the validator is registered with a relay,
+// and local otherwise. When relays that could not be queried leave the source undecided, the
+// proposal is unverified and they are returned with it.
+func (j *RelayMonitorJob) classify(ctx context.Context, v *models.Validator, block *types.Block) (*models.RelayProposal, []string, error) {
+	payloads, failed, err := j.relays.GetDeliveredPayloads(ctx, block.Slot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proposal := matchRelayProposal(j.config.GenesisTime, v.ValidatorIndex, block, payloads)
+	if proposal.Source != models.BlockSourceLocal {
+		return proposal, nil, nil
+	}
+	// A relay that could not be asked may have delivered the payload
+	if len(failed) > 0 {
+		proposal.Source = models.BlockSourceUnverified
+		return proposal, failed, nil
+	}
+
+	registered, failed, err := j.relays.GetRegisteredRelays(ctx, v.Pubkey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(registered) > 0 {
+		proposal.Source = models.BlockSourceFallback
+	} else if len(failed) > 0 {
+		proposal.Source = models.BlockSourceUnverified
+		return proposal, failed, nil
+	}
+
+	return proposal, nil, nil
+}
+
+// record stores a proposal and alerts on a fallback, underpayment or unverified proposal not seen
+// before. unreachable lists the relays that left an unverified proposal undecided.
+func (j *RelayMonitorJob) record(ctx context.Context, proposal *models.RelayProposal, unreachable []string) error {
+	inserted, err := j.relayRepo.RecordProposal(ctx, proposal)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return nil
+	}
+
+	relayProposals.WithLabelValues(string(proposal.Source)).Inc()
+	if shortfall, ok := proposal.Shortfall(); ok && shortfall > 0 {
+		relayShortfallGwei.Add(float64(shortfall))
+	}
+
+	alert := relayAlert(proposal, j.config.ShortfallTolerance)
+	if proposal.Source == models.BlockSourceUnverified {
+		alert = relayUnreachableAlert(proposal, unreachable)
+	}
+	if alert != nil {
+		if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Int64("validator_index", proposal.ValidatorIndex).
+				Msg("Failed to create relay alert")
+		}
+	}
+	return nil
+}
+
+// matchRelayProposal matches a proposed block against the payloads relays delivered at its slot.
+// A block no relay delivered was built locally. For a relay block the proposer received the
+// builder's final transaction if it paid the fee recipient the relay promised; a block naming that
+// address as fee recipient pays the proposer directly, which the beacon block does not show.
+func matchRelayProposal(genesis time.Time, validatorIndex int64, block *types.Block, payloads []types.RelayPayload) *models.RelayProposal {
+	slot := int64(block.Slot)
+	proposal := &models.RelayProposal{
+		ValidatorIndex: validatorIndex,
+		Slot:           slot,
+		Time:           types.SlotStartTime(genesis, slot),
+		BlockHash:      block.BlockHash,
+		Source:         models.BlockSourceLocal,
+	}
+
+	var winner *types.RelayPayload
+	for i := range payloads {
+		p := &payloads[i]
+		if p.Slot != block.Slot || block.BlockHash == "" || !strings.EqualFold(p.BlockHash, block.BlockHash) {
+			continue
+		}
+		if !slices.Contains(proposal.Relays, p.Relay) {
+			proposal.Relays = append(proposal.Relays, p.Relay)
+		}
+		if winner == nil || p.Value.Cmp(winner.Value) > 0 {
+			winner = p
+		}
+	}
+	if winner == nil {
+		return proposal
+	}
+
+	slices.Sort(proposal.Relays)
+	proposal.Source = models.BlockSourceRelay
+	builder := winner.BuilderPubkey
+	promised := weiToGwei(winner.Value)
+	proposal.BuilderPubkey = &builder
+	proposal.PromisedGwei = &promised
+
+	feeRecipient := winner.ProposerFeeRecipient
+	switch tx := block.FinalTransaction; {
+	case tx != nil && strings.EqualFold(tx.To, feeRecipient):
+		received := weiToGwei(tx.Value)
+		proposal.ReceivedGwei = &received
+	case strings.EqualFold(block.FeeRecipient, feeRecipient):
+		// Paid through the block's fees, which the beacon block does not show
+	default:
+		received := int64(0)
+		proposal.ReceivedGwei = &received
+	}
+
+	return proposal
+}
+
+// relayAlert builds the alert for a proposal that fell back to local building or whose builder
+// paid less than promised, or returns nil when there is nothing to alert on
+func relayAlert(p *models.RelayProposal, tolerance float64) *models.Alert {
+	index := p.ValidatorIndex
+	details := models.JSONB{
+		"slot":       p.Slot,
+		"block_hash": p.BlockHash,
+		"source":     string(p.Source),
+	}
+
+	switch {
+	case p.Source == models.BlockSourceFallback:
+		return &models.Alert{
+			ValidatorIndex: &index,
+			AlertType:      string(types.AlertTypeRelayFallback),
+			Severity:       models.SeverityWarning,
+			Title:          "Proposal fell back to local block building",
+			Message:        p.Summary(),
+			Source:         "relay_monitor",
+			Details:        details,
+			Status:         models.AlertStatusNew,
+		}
+	case p.Underpaid(tolerance):
+		shortfall, _ := p.Shortfall()
+		details["relays"] = p.Relays
+		details["builder_pubkey"] = *p.BuilderPubkey
+		details["promised_gwei"] = *p.PromisedGwei
+		details["received_gwei"] = *p.ReceivedGwei
+		details["shortfall_gwei"] = shortfall
+		return &models.Alert{
+			ValidatorIndex: &index,
+			AlertType:      string(types.AlertTypeRelayUnderpaid),
+			Severity:       models.SeverityCritical,
+			Title:          "Builder paid less than the relay bid promised",
+			Message:        p.Summary(),
+			Source:         "relay_monitor",
+			Details:        details,
+			Status:         models.AlertStatusNew,
+		}
+	}
+	return nil
+}
+
+// relayUnreachableAlert builds the alert for a proposal left unverified because relays stayed
+// unreachable
+func relayUnreachableAlert(p *models.RelayProposal, unreachable []string) *models.Alert {
+	index := p.ValidatorIndex
+	return &models.Alert{
+		ValidatorIndex: &index,
+		AlertType:      string(types.AlertTypeRelayUnreachable),
+		Severity:       models.SeverityWarning,
+		Title:          "Relays unreachable; proposal left unverified",
+		Message:        fmt.Sprintf("%s: %s", p.Summary(), strings.Join(unreachable, ", ")),
+		Source:         "relay_monitor",
+		Details: models.JSONB{
+			"slot":               p.Slot,
+			"block_hash":         p.BlockHash,
+			"unreachable_relays": unreachable,
+		},
+		Status: models.AlertStatusNew,
+	}
+}
@@ -0,0 +1,211 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	proposerPubkey = "0xaa"
+	relayBuilder   = "0xbuilder"
+)
+
+// bidTrace is a delivered payload as the relay data API reports it
+type bidTrace struct {
+	Slot                 string `json:"slot"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerPubkey       string `json:"proposer_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	Value                string `json:"value"`
+}
+
+// newStandInRelay serves the relay data API from the given delivered payloads and registered
+// validator pubkeys
+func newStandInRelay(t *testing.T, delivered []bidTrace, registered ...string) *httptest.Server {
+	t.Helper()
+
+	relay := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/relay/v1/data/bidtraces/proposer_payload_delivered":
+			traces := []bidTrace{}
+			for _, trace := range delivered {
+				if trace.Slot == r.URL.Query().Get("slot") {
+					traces = append(traces, trace)
+				}
+			}
+			json.NewEncoder(w).Encode(traces)
+		case "/relay/v1/data/validator_registration":
+			for _, pubkey := range registered {
+				if pubkey == r.URL.Query().Get("pubkey") {
+					w.Write([]byte(`{"message":{"fee_recipient":"0x1111111111111111111111111111111111111111","pubkey":"` + pubkey + `"}}`))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"no registration found for validator"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(relay.Close)
+	return relay
+}
+
+func TestRelayClient_GetRegisteredRelays(t *testing.T) {
+	registeredAt := newStandInRelay(t, nil, proposerPubkey)
+	unregistered := newStandInRelay(t, nil)
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	ctx := context.Background()
+	client := NewRelayClient([]string{registeredAt.URL, unregistered.URL, down.URL}, 5*time.Second)
+
+	relays, failed, err := client.GetRegisteredRelays(ctx, proposerPubkey)
+	require.NoError(t, err, "an unreachable relay is reported rather than failing the query")
+	assert.Equal(t, []string{registeredAt.Listener.Addr().String()}, relays)
+	assert.Equal(t, []string{down.Listener.Addr().String()}, failed)
+
+	relays, _, err = client.GetRegisteredRelays(ctx, "0xbb")
+	require.NoError(t, err)
+	assert.Empty(t, relays)
+
+	_, _, err = NewRelayClient([]string{down.URL}, 5*time.Second).GetRegisteredRelays(ctx, proposerPubkey)
+	assert.Error(t, err, "no relay could be queried")
+}
+
+func TestMatchRelayProposal(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	promised := big.NewInt(60_000_000_000_000_000)
+
+	trace := bidTrace{Slot: "3201", BlockHash: "0xB1", BuilderPubkey: relayBuilder, ProposerPubkey: proposerPubkey,
+		ProposerFeeRecipient: poolAddress, Value: promised.String()}
+	first := newStandInRelay(t, []bidTrace{trace})
+	trace.Value = "59000000000000000" // A lower bid for the same payload
+	second := newStandInRelay(t, []bidTrace{trace})
+
+	client := NewRelayClient([]string{second.URL, first.URL}, 5*time.Second)
+	payloads, failed, err := client.GetDeliveredPayloads(context.Background(), 3201)
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+	require.Len(t, payloads, 2)
+
+	block := &types.Block{
+		Slot:             3201,
+		ProposerIndex:    1,
+		BlockHash:        "0xb1",
+		FeeRecipient:     builderAddress,
+		FinalTransaction: &types.PayloadTransaction{To: poolAddress, Value: big.NewInt(50_000_000_000_000_000)},
+	}
+	proposal := matchRelayProposal(genesis, 1, block, payloads)
+	assert.Equal(t, models.BlockSourceRelay, proposal.Source)
+	assert.Equal(t, types.SlotStartTime(genesis, 3201), proposal.Time)
+	assert.Len(t, proposal.Relays, 2)
+	assert.Equal(t, relayBuilder, *proposal.BuilderPubkey)
+	assert.Equal(t, int64(60_000_000), *proposal.PromisedGwei, "the highest bid is promised")
+	assert.Equal(t, int64(50_000_000), *proposal.ReceivedGwei)
+	assert.True(t, proposal.Underpaid(0.01))
+	assert.False(t, proposal.Underpaid(0.2))
+
+	alert := relayAlert(proposal, 0.01)
+	require.NotNil(t, alert)
+	assert.Equal(t, string(types.AlertTypeRelayUnderpaid), alert.AlertType)
+	assert.Equal(t, models.SeverityCritical, alert.Severity)
+	assert.Equal(t, int64(10_000_000), alert.Details["shortfall_gwei"])
+
+	// A builder naming the proposer's address as fee recipient pays through the block's fees
+	block.FeeRecipient = poolAddress
+	block.FinalTransaction = &types.PayloadTransaction{To: builderAddress, Value: big.NewInt(1)}
+	proposal = matchRelayProposal(genesis, 1, block, payloads)
+	assert.Nil(t, proposal.ReceivedGwei)
+	assert.Nil(t, relayAlert(proposal, 0.01))
+
+	// Otherwise a payment elsewhere leaves the proposer with nothing
+	block.FeeRecipient = builderAddress
+	proposal = matchRelayProposal(genesis, 1, block, payloads)
+	require.NotNil(t, proposal.ReceivedGwei)
+	assert.Zero(t, *proposal.ReceivedGwei)
+
+	block.BlockHash = "0xlocal"
+	proposal = matchRelayProposal(genesis, 1, block, payloads)
+	assert.Equal(t, models.BlockSourceLocal, proposal.Source)
+	assert.Empty(t, proposal.Relays)
+	assert.Nil(t, relayAlert(proposal, 0.01), "validators not registered with a relay build locally")
+
+	proposal.Source = models.BlockSourceFallback
+	alert = relayAlert(proposal, 0.01)
+	require.NotNil(t, alert)
+	assert.Equal(t, string(types.AlertTypeRelayFallback), alert.AlertType)
+	assert.Equal(t, int64(3201), alert.Details["slot"])
+}
+
+func TestRelayMonitor_ClassifyLeavesUnreachableRelaysUndecided(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	trace := bidTrace{Slot: "3201", BlockHash: "0xB1", BuilderPubkey: relayBuilder, ProposerPubkey: proposerPubkey,
+		ProposerFeeRecipient: poolAddress, Value: "60000000000000000"}
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	newJob := func(relays ...string) *RelayMonitorJob {
+		config := DefaultRelayMonitorConfig()
+		config.GenesisTime = genesis
+		return &RelayMonitorJob{relays: NewRelayClient(relays, 5*time.Second), config: config}
+	}
+	validator := &models.Validator{ValidatorIndex: 1, Pubkey: proposerPubkey}
+	block := &types.Block{Slot: 3201, ProposerIndex: 1, BlockHash: "0xb1", FeeRecipient: poolAddress}
+	ctx := context.Background()
+
+	// A payload delivered by a reachable relay decides the source on its own
+	proposal, failed, err := newJob(newStandInRelay(t, []bidTrace{trace}).URL, down.URL).classify(ctx, validator, block)
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+	assert.Equal(t, models.BlockSourceRelay, proposal.Source)
+
+	// The unreachable relay may have delivered a block no other relay did
+	block.BlockHash = "0xlocal"
+	proposal, failed, err = newJob(newStandInRelay(t, nil, proposerPubkey).URL, down.URL).classify(ctx, validator, block)
+	require.NoError(t, err)
+	assert.Equal(t, models.BlockSourceUnverified, proposal.Source)
+	assert.Equal(t, []string{down.Listener.Addr().String()}, failed)
+
+	// Without it, the registrations the reachable relay knows decide between local and fallback
+	proposal, failed, err = newJob(newStandInRelay(t, nil, proposerPubkey).URL).classify(ctx, validator, block)
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+	assert.Equal(t, models.BlockSourceFallback, proposal.Source)
+
+	proposal, _, err = newJob(newStandInRelay(t, nil).URL).classify(ctx, validator, block)
+	require.NoError(t, err)
+	assert.Equal(t, models.BlockSourceLocal, proposal.Source)
+}
+
+func TestRelayMonitor_RetriesAreCapped(t *testing.T) {
+	config := DefaultRelayMonitorConfig()
+	config.MaxRetries = 2
+	job := &RelayMonitorJob{config: config}
+
+	assert.True(t, job.retry(3201))
+	assert.True(t, job.retry(3201))
+	assert.False(t, job.retry(3201), "the slot is recorded unverified once its retries are used up")
+	assert.True(t, job.retry(3233), "each slot gets its own retries")
+
+	proposal := &models.RelayProposal{ValidatorIndex: 1, Slot: 3201, BlockHash: "0xb1", Source: models.BlockSourceUnverified}
+	alert := relayUnreachableAlert(proposal, []string{"relay.example"})
+	assert.Equal(t, string(types.AlertTypeRelayUnreachable), alert.AlertType)
+	assert.Equal(t, "Block at slot 3201 could not be matched against the relays, some of which were unreachable: relay.example", alert.Message)
+	assert.Equal(t, []string{"relay.example"}, alert.Details["unreachable_relays"])
+}
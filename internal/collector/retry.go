@@ -51,6 +51,13 @@ func (r *RetryableHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	for attempt := 0; attempt <= r.config.MaxRetries; attempt++ {
 		// Clone the request for retry attempts (body may have been consumed)
 		reqClone := req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			reqClone.Body = body
+		}
 
 		resp, err := r.client.Do(reqClone)
 
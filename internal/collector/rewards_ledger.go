@@ -0,0 +1,487 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var rewardsLedgerEpochs = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_rewards_ledger_epochs_total",
+		Help: "Total epochs processed by the rewards ledger job by result (accounted, unavailable)",
+	},
+	[]string{"result"},
+)
+
+// RewardsLedgerConfig contains configuration for the rewards ledger job
+type RewardsLedgerConfig struct {
+	LookbackEpochs  int64
+	MaxEpochsPerRun int
+	GenesisTime     time.Time
+	IncomeWindow    models.IncomeWindow // Window for the daily income and APR served to snapshots
+}
+
+// DefaultRewardsLedgerConfig returns default rewards ledger configuration
+func DefaultRewardsLedgerConfig() *RewardsLedgerConfig {
+	return &RewardsLedgerConfig{
+		LookbackEpochs:  225, // ~1 day
+		MaxEpochsPerRun: 32,
+		GenesisTime:     time.Unix(types.MainnetGenesisTime, 0),
+		IncomeWindow:    models.IncomeWindowWeek,
+	}
+}
+
+// RewardsLedgerJob builds the per-epoch rewards and penalties ledger for monitored validators
+type RewardsLedgerJob struct {
+	client            types.RewardsClient
+	validatorRepo     *repository.ValidatorRepository
+	ledgerRepo        *repository.RewardsLedgerRepository
+	consolidationRepo *repository.ConsolidationRepository
+	config            *RewardsLedgerConfig
+
+	// Trailing income per validator over config.IncomeWindow, refreshed after each run
+	income   map[int64]*models.IncomeSummary
+	incomeMu sync.RWMutex
+
+	// Deposit queue in the last state fetched, reused as the start of the next epoch accounted
+	queue     []types.PendingDeposit
+	queueSlot int
+}
+
+// NewRewardsLedgerJob creates a new rewards ledger job
+func NewRewardsLedgerJob(client types.RewardsClient, pool *pgxpool.Pool, config *RewardsLedgerConfig) *RewardsLedgerJob {
+	return &RewardsLedgerJob{
+		client:            client,
+		validatorRepo:     repository.NewValidatorRepository(pool),
+		ledgerRepo:        repository.NewRewardsLedgerRepository(pool),
+		consolidationRepo: repository.NewConsolidationRepository(pool),
+		config:            config,
+	}
+}
+
+// SnapshotIncome returns a validator's daily income (Gwei) and APR over the configured income
+// window. ok is false until the ledger has entries for the validator.
+func (j *RewardsLedgerJob) SnapshotIncome(validatorIndex int64) (dailyIncome int64, apr float64, ok bool) {
+	j.incomeMu.RLock()
+	defer j.incomeMu.RUnlock()
+
+	s, ok := j.income[validatorIndex]
+	if !ok || s.Epochs == 0 {
+		return 0, 0, false
+	}
+	return s.DailyIncome(), s.APR(), true
+}
+
+// refreshIncome recomputes trailing income for monitored validators from the ledger
+func (j *RewardsLedgerJob) refreshIncome(ctx context.Context) error {
+	if j.config.IncomeWindow == "" {
+		return nil
+	}
+	window, err := j.config.IncomeWindow.Duration()
+	if err != nil {
+		return err
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+
+	indices := make([]int64, len(validators))
+	for i, v := range validators {
+		indices[i] = v.ValidatorIndex
+	}
+
+	now := time.Now()
+	income, err := j.ledgerRepo.IncomeByValidator(ctx, indices, now.Add(-window), now)
+	if err != nil {
+		return err
+	}
+
+	j.incomeMu.Lock()
+	j.income = income
+	j.incomeMu.Unlock()
+	return nil
+}
+
+// RunOnce accounts the epochs not yet in the ledger, then refreshes the trailing income served to
+// snapshots
+func (j *RewardsLedgerJob) RunOnce(ctx context.Context) error {
+	err := j.account(ctx)
+	if refreshErr := j.refreshIncome(ctx); refreshErr != nil && ctx.Err() == nil {
+		logger.FromContext(ctx).Warn().
+			Err(refreshErr).
+			Msg("Failed to refresh snapshot income")
+	}
+	return err
+}
+
+// account accounts every epoch not yet in the ledger for each monitored validator, oldest first,
+// up to MaxEpochsPerRun epochs
+func (j *RewardsLedgerJob) account(ctx context.Context) error {
+	// Accounting epoch e needs the state at the start of e+1, and the attestation rewards for
+	// e-1 which are only final once e has been processed
+	toEpoch := types.EpochAtTime(j.config.GenesisTime, time.Now()) - 2
+	fromEpoch := toEpoch - j.config.LookbackEpochs + 1
+	if fromEpoch < 1 {
+		fromEpoch = 1
+	}
+
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	indices := make([]int64, len(validators))
+	for i, v := range validators {
+		indices[i] = v.ValidatorIndex
+	}
+
+	latest, err := j.ledgerRepo.LatestEpochs(ctx, indices)
+	if err != nil {
+		return err
+	}
+
+	// Per-validator range still to be accounted
+	starts := make(map[int64]int64, len(validators))
+	ends := make(map[int64]int64, len(validators))
+	first := toEpoch + 1
+	for _, v := range validators {
+		start, end := fromEpoch, toEpoch
+		if last, ok := latest[v.ValidatorIndex]; ok && last+1 > start {
+			start = last + 1
+		}
+		if v.ActivationEpoch != nil && *v.ActivationEpoch > start {
+			start = *v.ActivationEpoch
+		}
+		if v.ExitEpoch != nil && *v.ExitEpoch <= end {
+			end = *v.ExitEpoch - 1
+		}
+
+		starts[v.ValidatorIndex], ends[v.ValidatorIndex] = start, end
+		if start <= end && start < first {
+			first = start
+		}
+	}
+
+	accounted := 0
+	for epoch := first; epoch <= toEpoch && accounted < j.config.MaxEpochsPerRun; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var due []*models.Validator
+		for _, v := range validators {
+			if starts[v.ValidatorIndex] <= epoch && epoch <= ends[v.ValidatorIndex] {
+				due = append(due, v)
+			}
+		}
+		if len(due) == 0 {
+			continue
+		}
+
+		entries, consolidations, err := j.accountEpoch(ctx, epoch, due)
+		if errors.Is(err, types.ErrStateUnavailable) {
+			rewardsLedgerEpochs.WithLabelValues("unavailable").Inc()
+			logger.FromContext(ctx).Debug().Err(err).Int64("epoch", epoch).Msg("Skipping ledger epoch with unavailable state")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to account epoch %d: %w", epoch, err)
+		}
+
+		if err := j.ledgerRepo.UpsertEntries(ctx, entries); err != nil {
+			return err
+		}
+		if err := j.consolidationRepo.SaveRequests(ctx, consolidations); err != nil {
+			return err
+		}
+
+		rewardsLedgerEpochs.WithLabelValues("accounted").Inc()
+		accounted++
+	}
+
+	logger.FromContext(ctx).Info().
+		Int("validator_count", len(validators)).
+		Int("epochs_accounted", accounted).
+		Int64("to_epoch", toEpoch).
+		Msg("Rewards ledger updated")
+
+	return nil
+}
+
+// epochActivity holds everything fetched from the beacon node to account one epoch
+type epochActivity struct {
+	startBalances map[int]types.ValidatorEpochBalance
+	endBalances   map[int]types.ValidatorEpochBalance
+	attestations  *types.AttestationRewards
+	blocks        []types.BlockReward
+	syncRewards   []types.SyncCommitteeReward
+	proposed      []types.Block
+	credits       map[string]int64 // Deposits credited by the transition into the next epoch, by lowercase public key
+}
+
+// accountEpoch fetches beacon data for an epoch and builds ledger entries for the given validators,
+// along with any consolidation requests naming them
+func (j *RewardsLedgerJob) accountEpoch(ctx context.Context, epoch int64, validators []*models.Validator) ([]*models.RewardLedgerEntry, []*models.ConsolidationRequest, error) {
+	indices := make([]int, len(validators))
+	for i, v := range validators {
+		indices[i] = int(v.ValidatorIndex)
+	}
+
+	activity := &epochActivity{}
+
+	startBalances, err := j.client.GetValidatorBalances(ctx, int(epoch), indices)
+	if err != nil {
+		return nil, nil, err
+	}
+	endBalances, err := j.client.GetValidatorBalances(ctx, int(epoch+1), indices)
+	if err != nil {
+		return nil, nil, err
+	}
+	activity.startBalances = balancesByIndex(startBalances)
+	activity.endBalances = balancesByIndex(endBalances)
+
+	// Deposits are credited from the front of the queue when the epoch ends
+	startQueue, err := j.pendingDeposits(ctx, int(epoch)*types.SlotsPerEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	endQueue, err := j.pendingDeposits(ctx, int(epoch+1)*types.SlotsPerEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	activity.credits = creditedDeposits(startQueue, endQueue)
+
+	// The transition into the next epoch pays for attestations made in the previous one
+	activity.attestations, err = j.client.GetAttestationRewards(ctx, int(epoch-1), indices)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Blocks after the epoch's first slot, up to and including the next epoch's first slot,
+	// change the balance between the two states
+	firstSlot := int(epoch)*types.SlotsPerEpoch + 1
+	for slot := firstSlot; slot < firstSlot+types.SlotsPerEpoch; slot++ {
+		block, err := j.client.GetBlockRewards(ctx, slot)
+		if err != nil {
+			return nil, nil, err
+		}
+		if block == nil {
+			continue // Missed slot
+		}
+		activity.blocks = append(activity.blocks, *block)
+
+		syncRewards, err := j.client.GetSyncCommitteeRewards(ctx, slot, indices)
+		if err != nil {
+			return nil, nil, err
+		}
+		activity.syncRewards = append(activity.syncRewards, syncRewards...)
+
+		proposal, err := j.client.GetBlock(ctx, slot)
+		if err != nil {
+			return nil, nil, err
+		}
+		if proposal != nil {
+			activity.proposed = append(activity.proposed, *proposal)
+		}
+	}
+
+	entries := buildLedgerEntries(epoch, j.config.GenesisTime, validators, activity)
+	return entries, consolidationRequests(j.config.GenesisTime, validators, activity.proposed), nil
+}
+
+// pendingDeposits returns the deposit queue in the state at a slot, reusing the last queue fetched
+// when it is for the same slot
+func (j *RewardsLedgerJob) pendingDeposits(ctx context.Context, slot int) ([]types.PendingDeposit, error) {
+	if j.queue != nil && j.queueSlot == slot {
+		return j.queue, nil
+	}
+
+	queue, err := j.client.GetPendingDeposits(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if queue == nil {
+		queue = []types.PendingDeposit{}
+	}
+
+	j.queue, j.queueSlot = queue, slot
+	return queue, nil
+}
+
+// creditedDeposits returns the amount credited to each public key between two states of the
+// deposit queue: the deposits in before that are no longer queued in after. Deposits postponed
+// for an exiting validator go back into the queue and so are not counted, nor is balance the
+// chain moves through the queue itself.
+func creditedDeposits(before, after []types.PendingDeposit) map[string]int64 {
+	type key struct {
+		pubkey, signature string
+		amount, slot      int64
+	}
+	keyOf := func(d types.PendingDeposit) key {
+		return key{strings.ToLower(d.Pubkey), strings.ToLower(d.Signature), d.Amount, d.Slot}
+	}
+
+	remaining := make(map[key]int, len(after))
+	for _, d := range after {
+		remaining[keyOf(d)]++
+	}
+
+	credits := make(map[string]int64)
+	for _, d := range before {
+		k := keyOf(d)
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		if !d.IsBalanceTransfer() {
+			credits[k.pubkey] += d.Amount
+		}
+	}
+
+	return credits
+}
+
+// buildLedgerEntries splits each validator's balance change into its components. Validators
+// missing from either balance snapshot are skipped.
+func buildLedgerEntries(epoch int64, genesis time.Time, validators []*models.Validator, activity *epochActivity) []*models.RewardLedgerEntry {
+	attestations := make(map[int]types.ValidatorAttestationReward)
+	if activity.attestations != nil {
+		for _, r := range activity.attestations.TotalRewards {
+			attestations[r.ValidatorIndex] = r
+		}
+	}
+
+	entries := make([]*models.RewardLedgerEntry, 0, len(validators))
+	for _, v := range validators {
+		index := int(v.ValidatorIndex)
+		start, okStart := activity.startBalances[index]
+		end, okEnd := activity.endBalances[index]
+		if !okStart || !okEnd {
+			continue
+		}
+
+		entry := &models.RewardLedgerEntry{
+			ValidatorIndex:   v.ValidatorIndex,
+			Epoch:            epoch,
+			Time:             types.EpochStartTime(genesis, epoch),
+			EffectiveBalance: start.EffectiveBalance,
+			BalanceStart:     start.Balance,
+			BalanceEnd:       end.Balance,
+		}
+		if activity.attestations != nil {
+			entry.IdealRewards = activity.attestations.IdealTotal(start.EffectiveBalance)
+		}
+
+		if reward, ok := attestations[index]; ok {
+			for _, component := range reward.Components() {
+				if component >= 0 {
+					entry.AttestationRewards += component
+				} else {
+					entry.Penalties -= component
+				}
+			}
+		}
+
+		for _, block := range activity.blocks {
+			if block.ProposerIndex == index {
+				entry.ProposalRewards += block.Total
+			}
+		}
+
+		for _, reward := range activity.syncRewards {
+			if reward.ValidatorIndex != index {
+				continue
+			}
+			if reward.Reward >= 0 {
+				entry.SyncRewards += reward.Reward
+			} else {
+				entry.Penalties -= reward.Reward
+				entry.SyncPenalties -= reward.Reward
+			}
+		}
+
+		for _, block := range activity.proposed {
+			for _, w := range block.Withdrawals {
+				if w.ValidatorIndex == index {
+					entry.Withdrawals += w.Amount
+				}
+			}
+		}
+		entry.Deposits = activity.credits[strings.ToLower(v.Pubkey)]
+
+		explained := entry.NetRewards() - entry.Withdrawals + entry.Deposits
+		entry.Other = entry.BalanceEnd - entry.BalanceStart - explained
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// consolidationRequests returns the consolidation requests in the given blocks whose source or
+// target is one of the validators. Requests between two external validators are ignored.
+func consolidationRequests(genesis time.Time, validators []*models.Validator, blocks []types.Block) []*models.ConsolidationRequest {
+	byPubkey := make(map[string]int64, len(validators))
+	for _, v := range validators {
+		byPubkey[strings.ToLower(v.Pubkey)] = v.ValidatorIndex
+	}
+	lookup := func(pubkey string) *int64 {
+		if index, ok := byPubkey[strings.ToLower(pubkey)]; ok {
+			return &index
+		}
+		return nil
+	}
+
+	var requests []*models.ConsolidationRequest
+	for _, block := range blocks {
+		for _, c := range block.Consolidations {
+			source, target := lookup(c.SourcePubkey), lookup(c.TargetPubkey)
+			if source == nil && target == nil {
+				continue
+			}
+			requests = append(requests, &models.ConsolidationRequest{
+				Slot:          int64(block.Slot),
+				Time:          types.SlotStartTime(genesis, int64(block.Slot)),
+				SourceAddress: c.SourceAddress,
+				SourcePubkey:  c.SourcePubkey,
+				TargetPubkey:  c.TargetPubkey,
+				SourceIndex:   source,
+				TargetIndex:   target,
+			})
+		}
+	}
+
+	return requests
+}
+
+// balancesByIndex indexes epoch balances by validator index
+func balancesByIndex(balances []types.ValidatorEpochBalance) map[int]types.ValidatorEpochBalance {
+	byIndex := make(map[int]types.ValidatorEpochBalance, len(balances))
+	for _, b := range balances {
+		byIndex[b.Index] = b
+	}
+	return byIndex
+}
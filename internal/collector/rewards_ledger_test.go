@@ -0,0 +1,250 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildLedgerEntries(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	validators := []*models.Validator{
+		{ValidatorIndex: 1, Pubkey: "0xAA"},
+		{ValidatorIndex: 2, Pubkey: "0xbb"},
+		{ValidatorIndex: 3, Pubkey: "0xcc"}, // No end balance
+	}
+
+	activity := &epochActivity{
+		startBalances: balancesByIndex([]types.ValidatorEpochBalance{
+			{Index: 1, Balance: 32_000_000_000, EffectiveBalance: 32_000_000_000},
+			{Index: 2, Balance: 32_500_000_000, EffectiveBalance: 32_000_000_000},
+			{Index: 3, Balance: 32_000_000_000, EffectiveBalance: 32_000_000_000},
+		}),
+		endBalances: balancesByIndex([]types.ValidatorEpochBalance{
+			// 12k attestation + 40M proposal + 20k sync + 1 ETH deposit
+			{Index: 1, Balance: 33_040_032_000, EffectiveBalance: 32_000_000_000},
+			// Penalties cancel out rewards; 0.5 ETH withdrawn and 100 Gwei unexplained
+			{Index: 2, Balance: 31_999_999_900, EffectiveBalance: 32_000_000_000},
+		}),
+		attestations: &types.AttestationRewards{
+			IdealRewards: []types.IdealAttestationReward{
+				{EffectiveBalance: 32_000_000_000, Head: 3_000, Target: 5_000, Source: 4_000},
+			},
+			TotalRewards: []types.ValidatorAttestationReward{
+				{ValidatorIndex: 1, Head: 3_000, Target: 5_000, Source: 4_000},
+				{ValidatorIndex: 2, Head: 0, Target: 5_000, Source: 3_000, Inactivity: -3_000},
+			},
+		},
+		blocks: []types.BlockReward{
+			{Slot: 3201, ProposerIndex: 1, Total: 40_000_000},
+			{Slot: 3202, ProposerIndex: 99, Total: 30_000_000},
+		},
+		syncRewards: []types.SyncCommitteeReward{
+			{ValidatorIndex: 1, Reward: 10_000},
+			{ValidatorIndex: 1, Reward: 10_000},
+			{ValidatorIndex: 2, Reward: -5_000},
+		},
+		proposed: []types.Block{
+			{
+				Slot:        3201,
+				Withdrawals: []types.Withdrawal{{ValidatorIndex: 2, Amount: 500_000_000}},
+				Deposits:    []types.Deposit{{Pubkey: "0xbb", Amount: 1_000_000_000}}, // Queued, not yet credited
+			},
+		},
+		credits: map[string]int64{"0xaa": 1_000_000_000},
+	}
+
+	entries := buildLedgerEntries(100, genesis, validators, activity)
+	require.Len(t, entries, 2)
+
+	first := entries[0]
+	assert.Equal(t, int64(100), first.Epoch)
+	assert.Equal(t, types.EpochStartTime(genesis, 100), first.Time)
+	assert.Equal(t, int64(12_000), first.AttestationRewards)
+	assert.Equal(t, int64(40_000_000), first.ProposalRewards)
+	assert.Equal(t, int64(20_000), first.SyncRewards)
+	assert.Equal(t, int64(0), first.Penalties)
+	assert.Equal(t, int64(0), first.SyncPenalties)
+	assert.Equal(t, int64(1_000_000_000), first.Deposits, "credits match pubkeys case-insensitively")
+	assert.Equal(t, int64(12_000), first.IdealRewards)
+	assert.Equal(t, int64(0), first.Other)
+
+	second := entries[1]
+	assert.Equal(t, int64(8_000), second.AttestationRewards)
+	assert.Equal(t, int64(8_000), second.Penalties, "attestation and sync penalties are combined")
+	assert.Equal(t, int64(5_000), second.SyncPenalties)
+	assert.Equal(t, int64(500_000_000), second.Withdrawals)
+	assert.Equal(t, int64(-100), second.Other)
+	assert.Equal(t, second.BalanceEnd-second.BalanceStart,
+		second.NetRewards()-second.Withdrawals+second.Deposits+second.Other)
+}
+
+func TestCreditedDeposits(t *testing.T) {
+	topUp := types.PendingDeposit{Pubkey: "0xAA", Signature: "0x01", Amount: 1_000_000_000, Slot: 3000}
+	postponed := types.PendingDeposit{Pubkey: "0xbb", Signature: "0x02", Amount: 2_000_000_000, Slot: 3001}
+	excess := types.PendingDeposit{Pubkey: "0xcc", Signature: types.G2PointAtInfinity, Amount: 500_000_000}
+	repeated := types.PendingDeposit{Pubkey: "0xdd", Signature: "0x03", Amount: 1_000_000_000, Slot: 3002}
+	late := types.PendingDeposit{Pubkey: "0xee", Signature: "0x04", Amount: 1_000_000_000, Slot: 3100}
+
+	before := []types.PendingDeposit{topUp, postponed, excess, repeated, repeated}
+	after := []types.PendingDeposit{repeated, postponed, late}
+
+	assert.Equal(t, map[string]int64{
+		"0xaa": 1_000_000_000,
+		"0xdd": 1_000_000_000,
+	}, creditedDeposits(before, after), "postponed deposits and balance moved by the chain are not credited")
+	assert.Empty(t, creditedDeposits(nil, after))
+}
+
+func TestConsolidationRequests(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	validators := []*models.Validator{
+		{ValidatorIndex: 1, Pubkey: "0xAA"},
+		{ValidatorIndex: 2, Pubkey: "0xbb"},
+	}
+	blocks := []types.Block{
+		{
+			Slot: 3201,
+			Consolidations: []types.ConsolidationRequest{
+				{SourceAddress: "0xdead", SourcePubkey: "0xaa", TargetPubkey: "0xbb"},
+				{SourceAddress: "0xdead", SourcePubkey: "0xee", TargetPubkey: "0xff"}, // External
+			},
+		},
+		{
+			Slot:           3202,
+			Consolidations: []types.ConsolidationRequest{{SourceAddress: "0xbeef", SourcePubkey: "0xcc", TargetPubkey: "0xBB"}},
+		},
+	}
+
+	requests := consolidationRequests(genesis, validators, blocks)
+	require.Len(t, requests, 2)
+
+	assert.Equal(t, int64(3201), requests[0].Slot)
+	assert.Equal(t, types.SlotStartTime(genesis, 3201), requests[0].Time)
+	require.NotNil(t, requests[0].SourceIndex)
+	assert.Equal(t, int64(1), *requests[0].SourceIndex, "pubkeys match case-insensitively")
+	assert.Equal(t, int64(2), *requests[0].TargetIndex)
+	assert.True(t, requests[0].Internal())
+
+	assert.Nil(t, requests[1].SourceIndex)
+	require.NotNil(t, requests[1].TargetIndex)
+	assert.Equal(t, int64(2), *requests[1].TargetIndex)
+}
+
+func TestBeaconClient_RewardsEndpoints(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/3200/validators", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1,2", r.URL.Query().Get("id"))
+		w.Write([]byte(`{"data":[{"index":"1","balance":"32000001000","status":"active_ongoing","validator":{"effective_balance":"32000000000"}}]}`))
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/3232/validators", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/3200/pending_deposits", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"electra","data":[{"pubkey":"0xaa","withdrawal_credentials":"0x01","amount":"1000000000","signature":"0x5e","slot":"3100"}]}`))
+	})
+	mux.HandleFunc("/eth/v1/beacon/rewards/attestations/99", func(w http.ResponseWriter, r *http.Request) {
+		var ids []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ids))
+		assert.Equal(t, []string{"1", "2"}, ids)
+		w.Write([]byte(`{"data":{"ideal_rewards":[{"effective_balance":"32000000000","head":"3000","target":"5000","source":"4000","inactivity":"0"}],
+			"total_rewards":[{"validator_index":"1","head":"3000","target":"5000","source":"-4000","inactivity":"0"}]}}`))
+	})
+	mux.HandleFunc("/eth/v1/beacon/rewards/blocks/3201", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"proposer_index":"1","total":"40000000","attestations":"39000000","sync_aggregate":"1000000"}}`))
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/3201", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"message":{"proposer_index":"1","body":{"graffiti":"0x4c69676874686f7573652f76352e312e33000000000000000000000000000000","deposits":[{"data":{"pubkey":"0xaa","amount":"1000000000"}}],
+			"execution_payload":{"fee_recipient":"0xfee","block_hash":"0xb1","withdrawals":[{"index":"7","validator_index":"2","address":"0xdead","amount":"12345"}]},
+			"bls_to_execution_changes":[{"message":{"validator_index":"2","from_bls_pubkey":"0xcc","to_execution_address":"0xbeef"},"signature":"0x00"}],
+			"execution_requests":{"deposits":[{"pubkey":"0xbb","withdrawal_credentials":"0x02","amount":"2000000000","signature":"0x5f","index":"9"}],
+			"withdrawals":[{"source_address":"0xbeef","validator_pubkey":"0xbb","amount":"0"}],
+			"consolidations":[{"source_address":"0xdead","source_pubkey":"0xaa","target_pubkey":"0xbb"}]}}}}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewBeaconClientWithoutRetry(server.URL, 5*time.Second)
+	ctx := context.Background()
+
+	balances, err := client.GetValidatorBalances(ctx, 100, []int{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ValidatorEpochBalance{{Index: 1, Balance: 32_000_001_000, EffectiveBalance: 32_000_000_000, Status: "active_ongoing"}}, balances)
+	assert.True(t, balances[0].IsActive())
+
+	_, err = client.GetValidatorBalances(ctx, 101, []int{1, 2})
+	assert.ErrorIs(t, err, types.ErrStateUnavailable)
+
+	rewards, err := client.GetAttestationRewards(ctx, 99, []int{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(12_000), rewards.IdealRewards[0].Total())
+	assert.Equal(t, int64(-4_000), rewards.TotalRewards[0].Source)
+
+	block, err := client.GetBlockRewards(ctx, 3201)
+	require.NoError(t, err)
+	assert.Equal(t, &types.BlockReward{Slot: 3201, ProposerIndex: 1, Total: 40_000_000}, block)
+
+	missed, err := client.GetBlockRewards(ctx, 3202)
+	require.NoError(t, err)
+	assert.Nil(t, missed)
+
+	decoded, err := client.GetBlock(ctx, 3201)
+	require.NoError(t, err)
+	assert.Equal(t, 1, decoded.ProposerIndex)
+	assert.Equal(t, "0xfee", decoded.FeeRecipient)
+	assert.Equal(t, "0xb1", decoded.BlockHash)
+	assert.Equal(t, "Lighthouse/v5.1.3", decoded.Graffiti)
+	require.Len(t, decoded.Withdrawals, 1)
+	assert.Equal(t, 2, decoded.Withdrawals[0].ValidatorIndex)
+	assert.Equal(t, int64(12_345), decoded.Withdrawals[0].Amount)
+	require.Len(t, decoded.Deposits, 2, "deposit operations and deposit requests")
+	assert.Equal(t, int64(1_000_000_000), decoded.Deposits[0].Amount)
+	assert.Equal(t, types.Deposit{Pubkey: "0xbb", WithdrawalCredentials: "0x02", Amount: 2_000_000_000, Signature: "0x5f"}, decoded.Deposits[1])
+	assert.Equal(t, []types.ConsolidationRequest{{SourceAddress: "0xdead", SourcePubkey: "0xaa", TargetPubkey: "0xbb"}}, decoded.Consolidations)
+	assert.Equal(t, []types.CredentialChange{{ValidatorIndex: 2, FromBLSPubkey: "0xcc", ToExecutionAddress: "0xbeef"}}, decoded.CredentialChanges)
+	assert.Equal(t, []types.WithdrawalRequest{{SourceAddress: "0xbeef", ValidatorPubkey: "0xbb", Amount: 0}}, decoded.WithdrawalRequests)
+
+	queue, err := client.GetPendingDeposits(ctx, 3200)
+	require.NoError(t, err)
+	assert.Equal(t, []types.PendingDeposit{{Pubkey: "0xaa", WithdrawalCredentials: "0x01", Amount: 1_000_000_000, Signature: "0x5e", Slot: 3100}}, queue)
+
+	_, err = client.GetPendingDeposits(ctx, 3232)
+	assert.ErrorIs(t, err, types.ErrStateUnavailable)
+}
+
+type fixedIncome map[int64][2]float64
+
+func (f fixedIncome) SnapshotIncome(validatorIndex int64) (int64, float64, bool) {
+	v, ok := f[validatorIndex]
+	return int64(v[0]), v[1], ok
+}
+
+func TestValidatorCollector_SnapshotIncome(t *testing.T) {
+	c := &ValidatorCollector{}
+	result := Result{ValidatorIndex: 1, Data: map[string]interface{}{"balance": int64(32_000_000_000)}, CollectedAt: time.Now()}
+
+	snapshot, err := c.resultToSnapshot(result)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot.DailyIncome)
+	assert.Nil(t, snapshot.APR)
+
+	c.SetIncomeSource(fixedIncome{1: {2_700_000, 3.08}})
+	snapshot, err = c.resultToSnapshot(result)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot.DailyIncome)
+	assert.Equal(t, int64(2_700_000), *snapshot.DailyIncome)
+	assert.Equal(t, 3.08, *snapshot.APR)
+
+	result.ValidatorIndex = 2
+	snapshot, err = c.resultToSnapshot(result)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot.DailyIncome, "no income until the ledger covers the validator")
+}
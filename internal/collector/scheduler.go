@@ -0,0 +1,69 @@
+package collector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+)
+
+// Scheduler runs the periodic jobs in the background until it is stopped
+type Scheduler struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a scheduler whose jobs run until ctx is done or it is stopped
+func NewScheduler(ctx context.Context) *Scheduler {
+	schedulerCtx, cancel := context.WithCancel(ctx)
+
+	return &Scheduler{
+		ctx:    schedulerCtx,
+		cancel: cancel,
+	}
+}
+
+// Every executes runOnce now and then on every tick of interval until the scheduler is stopped.
+// Failed runs are logged under the job name.
+func (s *Scheduler) Every(name string, interval time.Duration, runOnce func(ctx context.Context) error) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := runOnce(s.ctx); err != nil && s.ctx.Err() == nil {
+				logger.FromContext(s.ctx).Error().
+					Err(err).
+					Str("job", name).
+					Msg("Job run failed")
+			}
+
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Go runs fn in the background until the scheduler is stopped. fn must return once its context is
+// done.
+func (s *Scheduler) Go(fn func(ctx context.Context)) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		fn(s.ctx)
+	}()
+}
+
+// Stop stops every job and waits for the current runs to finish
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
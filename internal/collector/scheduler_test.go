@@ -0,0 +1,40 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_RunsJobsUntilStopped(t *testing.T) {
+	scheduler := NewScheduler(context.Background())
+
+	var runs atomic.Int32
+	scheduler.Every("test", 10*time.Millisecond, func(ctx context.Context) error {
+		runs.Add(1)
+		return errors.New("failed runs are retried on the next tick")
+	})
+
+	watched := make(chan struct{})
+	scheduler.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		close(watched)
+	})
+
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+
+	scheduler.Stop()
+	select {
+	case <-watched:
+	default:
+		t.Fatal("Stop returned before the background function did")
+	}
+
+	stopped := runs.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load(), "no run after Stop")
+}
@@ -131,20 +131,33 @@ func (sm *ShutdownManager) waitForInProgressWork(ctx context.Context) error {
 	}
 }
 
-// flushAllBuffers ensures all buffered data is written to storage
+// flushAllBuffers ensures all buffered data is written to storage.
+// In-memory batches were spooled when the collector stopped; make the spool durable.
 func (sm *ShutdownManager) flushAllBuffers(ctx context.Context) error {
-	// The storage layer's Close() method handles buffer flushing
-	// This is handled in closeDatabaseConnections
-	logger.FromContext(ctx).Debug().Msg("All buffers flushed via storage layer shutdown")
+	if err := sm.collector.FlushSpool(); err != nil {
+		return fmt.Errorf("error flushing snapshot spool: %w", err)
+	}
+
+	logger.FromContext(ctx).Debug().Msg("All buffers flushed to snapshot spool")
 	return nil
 }
 
 // closeDatabaseConnections closes database connections gracefully
 func (sm *ShutdownManager) closeDatabaseConnections(ctx context.Context) error {
-	// Database connections are closed via the storage layer
-	// This should be handled by the main application's cleanup
-	logger.FromContext(ctx).Debug().Msg("Database connections closed")
-	return nil
+	// pgxpool.Close waits for acquired connections to be released
+	done := make(chan struct{})
+	go func() {
+		sm.collector.ClosePool()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		logger.FromContext(ctx).Debug().Msg("Database connections closed")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("timeout closing database connections")
+	}
 }
 
 // closeCacheConnections closes Redis cache connections
@@ -166,143 +179,6 @@ func (sm *ShutdownManager) IsShuttingDown() bool {
 	return sm.shutdownStarted
 }
 
-// ErrorRecovery handles error recovery for the collector
-type ErrorRecovery struct {
-	maxRetries       int
-	retryBackoff     time.Duration
-	maxBackoff       time.Duration
-	errorThreshold   int
-	errorWindow      time.Duration
-	errorCounts      map[string]*errorCounter
-	mu               sync.RWMutex
-}
-
-// errorCounter tracks errors for a specific component
-type errorCounter struct {
-	count      int
-	firstError time.Time
-	lastError  time.Time
-}
-
-// NewErrorRecovery creates a new error recovery manager
-func NewErrorRecovery() *ErrorRecovery {
-	return &ErrorRecovery{
-		maxRetries:     3,
-		retryBackoff:   1 * time.Second,
-		maxBackoff:     30 * time.Second,
-		errorThreshold: 10,
-		errorWindow:    5 * time.Minute,
-		errorCounts:    make(map[string]*errorCounter),
-	}
-}
-
-// RecordError records an error for a specific component
-func (er *ErrorRecovery) RecordError(component string) {
-	er.mu.Lock()
-	defer er.mu.Unlock()
-
-	now := time.Now()
-	counter, exists := er.errorCounts[component]
-
-	if !exists {
-		er.errorCounts[component] = &errorCounter{
-			count:      1,
-			firstError: now,
-			lastError:  now,
-		}
-		return
-	}
-
-	// Reset counter if outside error window
-	if now.Sub(counter.firstError) > er.errorWindow {
-		counter.count = 1
-		counter.firstError = now
-		counter.lastError = now
-		return
-	}
-
-	counter.count++
-	counter.lastError = now
-}
-
-// ShouldCircuitBreak returns true if the error threshold has been exceeded
-func (er *ErrorRecovery) ShouldCircuitBreak(component string) bool {
-	er.mu.RLock()
-	defer er.mu.RUnlock()
-
-	counter, exists := er.errorCounts[component]
-	if !exists {
-		return false
-	}
-
-	// Check if within error window
-	if time.Since(counter.firstError) > er.errorWindow {
-		return false
-	}
-
-	return counter.count >= er.errorThreshold
-}
-
-// ResetErrors resets error counts for a component
-func (er *ErrorRecovery) ResetErrors(component string) {
-	er.mu.Lock()
-	defer er.mu.Unlock()
-	delete(er.errorCounts, component)
-}
-
-// GetBackoff calculates exponential backoff duration
-func (er *ErrorRecovery) GetBackoff(attempt int) time.Duration {
-	backoff := er.retryBackoff * time.Duration(1<<uint(attempt))
-	if backoff > er.maxBackoff {
-		backoff = er.maxBackoff
-	}
-	return backoff
-}
-
-// RetryWithBackoff retries an operation with exponential backoff
-func (er *ErrorRecovery) RetryWithBackoff(ctx context.Context, component string, operation func() error) error {
-	var lastErr error
-
-	for attempt := 0; attempt < er.maxRetries; attempt++ {
-		// Check circuit breaker
-		if er.ShouldCircuitBreak(component) {
-			return fmt.Errorf("circuit breaker open for component %s", component)
-		}
-
-		// Attempt operation
-		err := operation()
-		if err == nil {
-			// Success - reset error counter
-			er.ResetErrors(component)
-			return nil
-		}
-
-		lastErr = err
-		er.RecordError(component)
-
-		// Don't sleep on last attempt
-		if attempt < er.maxRetries-1 {
-			backoff := er.GetBackoff(attempt)
-			logger.FromContext(ctx).Warn().
-				Err(err).
-				Str("component", component).
-				Int("attempt", attempt+1).
-				Int("max_retries", er.maxRetries).
-				Dur("backoff", backoff).
-				Msg("Retry attempt failed, backing off")
-
-			select {
-			case <-time.After(backoff):
-				// Continue to next attempt
-			case <-ctx.Done():
-				return ctx.Err()
-			}
-		}
-	}
-
-	return fmt.Errorf("operation failed after %d attempts: %w", er.maxRetries, lastErr)
-}
-
 // HealthChecker provides health check functionality
 type HealthChecker struct {
 	collector *ValidatorCollector
@@ -351,6 +227,14 @@ func (hc *HealthChecker) CheckHealth() HealthStatus {
 		status.Issues = append(status.Issues, fmt.Sprintf("Queue congested: %d tasks pending", stats.PoolStats.QueueSize))
 	}
 
+	// Check circuit breakers
+	for component, state := range stats.CircuitStates {
+		if state != CircuitClosed {
+			status.Healthy = false
+			status.Issues = append(status.Issues, fmt.Sprintf("Circuit %s for %s", state, component))
+		}
+	}
+
 	return status
 }
 
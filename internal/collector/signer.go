@@ -0,0 +1,296 @@
+package collector
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+const (
+	signerHealthy   = "healthy"
+	signerDegraded  = "degraded"
+	signerUnhealthy = "unhealthy"
+)
+
+// Lighthouse validator client gauges read from its Prometheus endpoint when present
+const (
+	vcBeaconNodesAvailable = "vc_beacon_nodes_available_count"
+	vcBeaconNodesTotal     = "vc_beacon_nodes_total_count"
+	vcValidatorsEnabled    = "vc_validators_enabled_count"
+)
+
+// SignerClient scrapes validator client Prometheus endpoints, validator client keymanager APIs
+// and Web3Signer
+type SignerClient struct {
+	httpClient *http.Client
+}
+
+// NewSignerClient creates a signer client whose requests time out after the given duration
+func NewSignerClient(timeout time.Duration) *SignerClient {
+	return &SignerClient{
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Check scrapes an endpoint according to its kind
+func (c *SignerClient) Check(ctx context.Context, target types.SignerTarget) *types.SignerCheck {
+	var check *types.SignerCheck
+	var err error
+	switch target.Kind {
+	case types.SignerKindMetrics:
+		check, err = c.checkMetrics(ctx, target)
+	case types.SignerKindKeymanager:
+		check, err = c.checkKeymanager(ctx, target)
+	case types.SignerKindWeb3Signer:
+		check, err = c.checkWeb3Signer(ctx, target)
+	default:
+		err = fmt.Errorf("unknown endpoint kind %q", target.Kind)
+	}
+
+	if err != nil {
+		return &types.SignerCheck{Status: signerUnhealthy, Message: err.Error()}
+	}
+	return check
+}
+
+// checkMetrics scrapes a validator client's Prometheus endpoint. A reachable endpoint is healthy
+// unless the Lighthouse beacon node gauges show beacon nodes unavailable.
+func (c *SignerClient) checkMetrics(ctx context.Context, target types.SignerTarget) (*types.SignerCheck, error) {
+	resp, err := c.get(ctx, target.URL, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(resp)
+	}
+	samples, err := parseMetrics(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	check := &types.SignerCheck{Status: signerHealthy}
+	if enabled, ok := samples[vcValidatorsEnabled]; ok {
+		check.Message = fmt.Sprintf("%d validators enabled", int64(enabled))
+	}
+
+	available, ok := samples[vcBeaconNodesAvailable]
+	if !ok {
+		return check, nil
+	}
+	total := samples[vcBeaconNodesTotal]
+	switch {
+	case available == 0:
+		check.Status = signerUnhealthy
+		check.Message = "no beacon node available"
+	case available < total:
+		check.Status = signerDegraded
+		check.Message = fmt.Sprintf("%d of %d beacon nodes available", int64(available), int64(total))
+	}
+	return check, nil
+}
+
+// checkKeymanager lists the local and remote keys loaded in a validator client through the
+// standard keymanager API. Clients without remote key support answer 404 for remote keys.
+func (c *SignerClient) checkKeymanager(ctx context.Context, target types.SignerTarget) (*types.SignerCheck, error) {
+	var keystores struct {
+		Data []struct {
+			ValidatingPubkey string `json:"validating_pubkey"`
+		} `json:"data"`
+	}
+	if found, err := c.getJSON(ctx, target.URL+"/eth/v1/keystores", target.Token, &keystores); err != nil {
+		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("keymanager API not found")
+	}
+
+	var remotekeys struct {
+		Data []struct {
+			Pubkey string `json:"pubkey"`
+		} `json:"data"`
+	}
+	if _, err := c.getJSON(ctx, target.URL+"/eth/v1/remotekeys", target.Token, &remotekeys); err != nil {
+		return nil, err
+	}
+
+	keys := make([]types.LoadedKey, 0, len(keystores.Data)+len(remotekeys.Data))
+	for _, k := range keystores.Data {
+		keys = append(keys, types.LoadedKey{Pubkey: strings.ToLower(k.ValidatingPubkey)})
+	}
+	for _, k := range remotekeys.Data {
+		keys = append(keys, types.LoadedKey{Pubkey: strings.ToLower(k.Pubkey), Remote: true})
+	}
+
+	return &types.SignerCheck{
+		Status:  signerHealthy,
+		Message: fmt.Sprintf("%d keys loaded", len(keys)),
+		Keys:    keys,
+	}, nil
+}
+
+// checkWeb3Signer checks Web3Signer's upcheck and healthcheck endpoints and lists its keys. A
+// signer that is up but fails one of its own health checks is degraded.
+func (c *SignerClient) checkWeb3Signer(ctx context.Context, target types.SignerTarget) (*types.SignerCheck, error) {
+	resp, err := c.get(ctx, target.URL+"/upcheck", "")
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("upcheck returned status code %d", resp.StatusCode)
+	}
+
+	var pubkeys []string
+	if found, err := c.getJSON(ctx, target.URL+"/api/v1/eth2/publicKeys", "", &pubkeys); err != nil {
+		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("eth2 signing API not found")
+	}
+
+	check := &types.SignerCheck{
+		Status:  signerHealthy,
+		Message: fmt.Sprintf("%d keys loaded", len(pubkeys)),
+		Keys:    make([]types.LoadedKey, 0, len(pubkeys)),
+	}
+	for _, pubkey := range pubkeys {
+		check.Keys = append(check.Keys, types.LoadedKey{Pubkey: strings.ToLower(pubkey)})
+	}
+
+	// The healthcheck answers 503 with the same body when an internal check is down; releases
+	// without it answer 404
+	resp, err = c.get(ctx, target.URL+"/healthcheck", "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return check, nil
+	}
+
+	var health struct {
+		Outcome string `json:"outcome"`
+		Checks  []struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"checks"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		return nil, fmt.Errorf("failed to decode healthcheck: %w", err)
+	}
+	if health.Outcome != "UP" {
+		var failing []string
+		for _, hc := range health.Checks {
+			if hc.Status != "UP" {
+				failing = append(failing, hc.ID)
+			}
+		}
+		check.Status = signerDegraded
+		check.Message = fmt.Sprintf("healthcheck %s: %s", strings.ToLower(health.Outcome), strings.Join(failing, ", "))
+	}
+
+	return check, nil
+}
+
+// get sends a GET request, with a bearer token when one is given
+func (c *SignerClient) get(ctx context.Context, endpoint, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	return resp, nil
+}
+
+// getJSON decodes the JSON response of a GET request into v. found is false for a 404.
+func (c *SignerClient) getJSON(ctx context.Context, endpoint, token string, v any) (found bool, err error) {
+	resp, err := c.get(ctx, endpoint, token)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, unexpectedStatus(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return true, nil
+}
+
+// redactURL drops any credentials embedded in an endpoint URL
+func redactURL(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.User == nil {
+		return endpoint
+	}
+	u.User = nil
+	return u.String()
+}
+
+// unexpectedStatus builds the error for a response with an unexpected status code
+func unexpectedStatus(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// parseMetrics reads the Prometheus text exposition format, summing the samples of each metric
+// over its labels
+func parseMetrics(r io.Reader) (map[string]float64, error) {
+	samples := make(map[string]float64)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, rest := line, ""
+		if i := strings.IndexAny(line, "{ "); i >= 0 {
+			name, rest = line[:i], line[i:]
+		}
+		if strings.HasPrefix(rest, "{") {
+			end := strings.LastIndex(rest, "}")
+			if end < 0 {
+				continue
+			}
+			rest = rest[end+1:]
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+		samples[name] += value
+	}
+
+	return samples, scanner.Err()
+}
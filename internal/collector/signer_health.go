@@ -0,0 +1,323 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	signerEndpointUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_signer_endpoint_up",
+			Help: "Health of each validator client and remote signer endpoint (1 = healthy, 0.5 = degraded, 0 = unhealthy)",
+		},
+		[]string{"name", "kind"},
+	)
+
+	signerKeyProblems = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_signer_key_problems",
+			Help: "Monitored validator keys loaded in no validator client, in more than one, or missing from the remote signers",
+		},
+		[]string{"problem"},
+	)
+)
+
+// signerAlertTypes are the alert types the signer health job raises and resolves
+var signerAlertTypes = []types.AlertType{
+	types.AlertTypeSignerUnhealthy,
+	types.AlertTypeKeyNotLoaded,
+	types.AlertTypeKeyDuplicated,
+	types.AlertTypeRemoteKeyMissing,
+}
+
+// SignerHealthConfig contains configuration for the signer health job
+type SignerHealthConfig struct {
+	Targets []types.SignerTarget
+}
+
+// DefaultSignerHealthConfig returns default signer health configuration
+func DefaultSignerHealthConfig() *SignerHealthConfig {
+	return &SignerHealthConfig{}
+}
+
+// SignerHealthJob scrapes validator client and Web3Signer endpoints and maps the keys they have
+// loaded back to the monitored validators. Unhealthy endpoints, keys loaded in no validator client
+// or in more than one, and remotely signed keys no Web3Signer holds raise alerts that are resolved
+// once the problem clears. Key coverage is only checked when a keymanager API is scraped, and
+// remote keys only when a Web3Signer is.
+type SignerHealthJob struct {
+	source     types.SignerSource
+	signerRepo *repository.SignerRepository
+	alertRepo  *repository.AlertRepository
+	config     *SignerHealthConfig
+
+	open   map[string]int32 // Open alert IDs by alertKey; nil before the first run
+	mu     sync.RWMutex
+	health SignerHealth
+}
+
+// SignerHealth summarises the last run for the health monitor
+type SignerHealth struct {
+	Status    string // "healthy", "degraded", "unhealthy", or "unknown" before the first run
+	Message   string
+	CheckedAt time.Time
+}
+
+// NewSignerHealthJob creates a new signer health job
+func NewSignerHealthJob(source types.SignerSource, pool *pgxpool.Pool, config *SignerHealthConfig) *SignerHealthJob {
+	return &SignerHealthJob{
+		source:     source,
+		signerRepo: repository.NewSignerRepository(pool),
+		alertRepo:  repository.NewAlertRepository(pool),
+		config:     config,
+		health:     SignerHealth{Status: "unknown"},
+	}
+}
+
+// Health returns the summary of the last run
+func (j *SignerHealthJob) Health() SignerHealth {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.health
+}
+
+// RunOnce scrapes every endpoint, checks the coverage of monitored keys and syncs the alerts
+func (j *SignerHealthJob) RunOnce(ctx context.Context) error {
+	if err := j.loadOpenAlerts(ctx); err != nil {
+		return err
+	}
+	if err := j.signerRepo.PruneEndpoints(ctx, j.config.Targets); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	want := make(map[string]*models.Alert)
+	var unhealthy, degraded int
+	var keymanagers, signers bool
+	for _, target := range j.config.Targets {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		check := j.source.Check(ctx, target)
+		recorded := target
+		recorded.URL = redactURL(target.URL)
+		if err := j.signerRepo.RecordCheck(ctx, recorded, check, now); err != nil {
+			return err
+		}
+
+		up := 1.0
+		switch check.Status {
+		case signerUnhealthy:
+			up = 0
+			unhealthy++
+			alert := signerEndpointAlert(target, check)
+			want[alertKey(alert)] = alert
+		case signerDegraded:
+			up = 0.5
+			degraded++
+		}
+		signerEndpointUp.WithLabelValues(target.Name, string(target.Kind)).Set(up)
+
+		keymanagers = keymanagers || target.Kind == types.SignerKindKeymanager
+		signers = signers || target.Kind == types.SignerKindWeb3Signer
+	}
+
+	var problems map[types.AlertType]int
+	if keymanagers {
+		coverage, err := j.signerRepo.KeyCoverage(ctx)
+		if err != nil {
+			return err
+		}
+		problems = make(map[types.AlertType]int)
+		for _, c := range coverage {
+			for _, alert := range keyCoverageAlerts(c, signers) {
+				want[alertKey(alert)] = alert
+				problems[types.AlertType(alert.AlertType)]++
+			}
+		}
+		signerKeyProblems.WithLabelValues("missing").Set(float64(problems[types.AlertTypeKeyNotLoaded]))
+		signerKeyProblems.WithLabelValues("duplicated").Set(float64(problems[types.AlertTypeKeyDuplicated]))
+		signerKeyProblems.WithLabelValues("unsigned").Set(float64(problems[types.AlertTypeRemoteKeyMissing]))
+	}
+
+	j.syncAlerts(ctx, want)
+
+	j.mu.Lock()
+	j.health = summarizeSignerHealth(len(j.config.Targets), unhealthy, degraded, problems)
+	j.health.CheckedAt = now
+	j.mu.Unlock()
+
+	return nil
+}
+
+// loadOpenAlerts seeds the open alerts from the database on the first run, so that a restart
+// neither duplicates nor orphans them
+func (j *SignerHealthJob) loadOpenAlerts(ctx context.Context) error {
+	if j.open != nil {
+		return nil
+	}
+
+	open := make(map[string]int32)
+	status := models.AlertStatusNew
+	for _, t := range signerAlertTypes {
+		alertType := string(t)
+		alerts, err := j.alertRepo.ListAlerts(ctx, &models.AlertFilter{
+			AlertType: &alertType,
+			Status:    &status,
+		})
+		if err != nil {
+			return err
+		}
+		for _, a := range alerts {
+			open[alertKey(a)] = a.ID
+		}
+	}
+	j.open = open
+	return nil
+}
+
+// syncAlerts raises the wanted alerts that are not open and resolves the open ones no longer
+// wanted. Failures are logged and retried on the next run.
+func (j *SignerHealthJob) syncAlerts(ctx context.Context, want map[string]*models.Alert) {
+	for key, alert := range want {
+		if _, ok := j.open[key]; ok {
+			continue
+		}
+		if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Str("alert", key).
+				Msg("Failed to create signer alert")
+			continue
+		}
+		j.open[key] = alert.ID
+	}
+
+	for key, id := range j.open {
+		if _, ok := want[key]; ok {
+			continue
+		}
+		if err := j.alertRepo.ResolveAlert(ctx, id); err != nil {
+			logger.FromContext(ctx).Error().
+				Err(err).
+				Int32("alert_id", id).
+				Msg("Failed to resolve signer alert")
+			continue
+		}
+		delete(j.open, key)
+	}
+}
+
+// alertKey identifies the problem an alert reports: the validator for key alerts, the endpoint
+// otherwise
+func alertKey(a *models.Alert) string {
+	if a.ValidatorIndex != nil {
+		return fmt.Sprintf("%s/%d", a.AlertType, *a.ValidatorIndex)
+	}
+	return fmt.Sprintf("%s/%v/%v", a.AlertType, a.Details["name"], a.Details["kind"])
+}
+
+// signerEndpointAlert builds the alert for an unhealthy endpoint
+func signerEndpointAlert(target types.SignerTarget, check *types.SignerCheck) *models.Alert {
+	return &models.Alert{
+		AlertType: string(types.AlertTypeSignerUnhealthy),
+		Severity:  models.SeverityWarning,
+		Title:     fmt.Sprintf("Validator client %s is unhealthy", target.Name),
+		Message:   fmt.Sprintf("%s endpoint of %s: %s", target.Kind, target.Name, check.Message),
+		Source:    "signer_health",
+		Details: models.JSONB{
+			"name": target.Name,
+			"kind": string(target.Kind),
+			"url":  redactURL(target.URL),
+		},
+		Status: models.AlertStatusNew,
+	}
+}
+
+// keyCoverageAlerts builds the alerts for a monitored key that is loaded in no validator client,
+// in more than one, or signed for remotely without any Web3Signer holding it. Remote keys are only
+// checked when signers are scraped.
+func keyCoverageAlerts(c *models.KeyCoverage, signers bool) []*models.Alert {
+	index := c.ValidatorIndex
+	details := models.JSONB{
+		"pubkey":  c.Pubkey,
+		"clients": c.Clients,
+	}
+	alert := func(t types.AlertType, severity models.Severity, title, message string) *models.Alert {
+		return &models.Alert{
+			ValidatorIndex: &index,
+			AlertType:      string(t),
+			Severity:       severity,
+			Title:          title,
+			Message:        message,
+			Source:         "signer_health",
+			Details:        details,
+			Status:         models.AlertStatusNew,
+		}
+	}
+
+	var alerts []*models.Alert
+	switch {
+	case c.Missing():
+		alerts = append(alerts, alert(types.AlertTypeKeyNotLoaded, models.SeverityWarning,
+			"Validator key not loaded",
+			fmt.Sprintf("Validator %d is monitored but its key is loaded in no validator client", index)))
+	case c.Duplicated():
+		alerts = append(alerts, alert(types.AlertTypeKeyDuplicated, models.SeverityCritical,
+			"Validator key loaded in more than one validator client",
+			fmt.Sprintf("Validator %d is loaded in %s, which risks slashing", index, strings.Join(c.Clients, ", "))))
+	}
+	if signers && c.Unsigned() {
+		alerts = append(alerts, alert(types.AlertTypeRemoteKeyMissing, models.SeverityWarning,
+			"Remote signer does not hold validator key",
+			fmt.Sprintf("Validator %d is signed for remotely by %s but no Web3Signer holds its key", index,
+				strings.Join(c.RemoteClients, ", "))))
+	}
+	return alerts
+}
+
+// summarizeSignerHealth rolls the endpoint states and key problems up into one status. Unhealthy
+// endpoints and duplicated keys make it unhealthy; degraded endpoints and missing keys degraded.
+func summarizeSignerHealth(endpoints, unhealthy, degraded int, problems map[types.AlertType]int) SignerHealth {
+	var parts []string
+	if unhealthy > 0 {
+		parts = append(parts, fmt.Sprintf("%d of %d endpoints unhealthy", unhealthy, endpoints))
+	}
+	if degraded > 0 {
+		parts = append(parts, fmt.Sprintf("%d of %d endpoints degraded", degraded, endpoints))
+	}
+	if n := problems[types.AlertTypeKeyDuplicated]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d keys loaded more than once", n))
+	}
+	if n := problems[types.AlertTypeKeyNotLoaded]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d keys not loaded", n))
+	}
+	if n := problems[types.AlertTypeRemoteKeyMissing]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d remote keys missing from signers", n))
+	}
+
+	health := SignerHealth{Status: signerHealthy, Message: strings.Join(parts, "; ")}
+	switch {
+	case unhealthy > 0 || problems[types.AlertTypeKeyDuplicated] > 0:
+		health.Status = signerUnhealthy
+	case len(parts) > 0:
+		health.Status = signerDegraded
+	}
+	if health.Message == "" {
+		health.Message = fmt.Sprintf("%d endpoints healthy", endpoints)
+	}
+	return health
+}
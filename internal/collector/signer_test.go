@@ -0,0 +1,157 @@
+package collector
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const keymanagerToken = "api-token-0x1234"
+
+// newStandInValidatorClient serves a Lighthouse-style metrics endpoint and the keymanager API
+func newStandInValidatorClient(t *testing.T, beaconNodesAvailable string) *httptest.Server {
+	t.Helper()
+
+	vc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/eth/") && r.Header.Get("Authorization") != "Bearer "+keymanagerToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/metrics":
+			w.Write([]byte("# HELP vc_beacon_nodes_available_count Number of available beacon nodes\n" +
+				"# TYPE vc_beacon_nodes_available_count gauge\n" +
+				"vc_beacon_nodes_available_count " + beaconNodesAvailable + "\n" +
+				"vc_beacon_nodes_total_count 2\n" +
+				`vc_validators_enabled_count{kind="local"} 2` + "\n" +
+				`vc_validators_enabled_count{kind="remote"} 1` + "\n"))
+		case "/eth/v1/keystores":
+			w.Write([]byte(`{"data":[{"validating_pubkey":"0xAA","derivation_path":"m/12381/3600/0/0/0","readonly":false},` +
+				`{"validating_pubkey":"0xbb","derivation_path":"","readonly":false}]}`))
+		case "/eth/v1/remotekeys":
+			w.Write([]byte(`{"data":[{"pubkey":"0xcc","url":"http://signer:9000","readonly":false}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(vc.Close)
+	return vc
+}
+
+// newStandInWeb3Signer serves Web3Signer's upcheck, healthcheck and public keys endpoints
+func newStandInWeb3Signer(t *testing.T, outcome string) *httptest.Server {
+	t.Helper()
+
+	signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/upcheck":
+			w.Write([]byte("OK"))
+		case "/healthcheck":
+			if outcome != "UP" {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			w.Write([]byte(`{"status":"` + outcome + `","checks":[{"id":"default-keys-check","status":"UP"},` +
+				`{"id":"slashing-protection-db-health-check","status":"` + outcome + `"}],"outcome":"` + outcome + `"}`))
+		case "/api/v1/eth2/publicKeys":
+			w.Write([]byte(`["0xCC"]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(signer.Close)
+	return signer
+}
+
+func TestSignerClient_Check(t *testing.T) {
+	ctx := context.Background()
+	client := NewSignerClient(5 * time.Second)
+
+	vc := newStandInValidatorClient(t, "2")
+	check := client.Check(ctx, types.SignerTarget{Name: "vc-1", Kind: types.SignerKindMetrics, URL: vc.URL + "/metrics"})
+	assert.Equal(t, signerHealthy, check.Status)
+	assert.Equal(t, "3 validators enabled", check.Message, "samples are summed over labels")
+	assert.Nil(t, check.Keys)
+
+	check = client.Check(ctx, types.SignerTarget{Name: "vc-1", Kind: types.SignerKindKeymanager, URL: vc.URL, Token: keymanagerToken})
+	assert.Equal(t, signerHealthy, check.Status)
+	assert.Equal(t, []types.LoadedKey{{Pubkey: "0xaa"}, {Pubkey: "0xbb"}, {Pubkey: "0xcc", Remote: true}}, check.Keys)
+
+	check = client.Check(ctx, types.SignerTarget{Name: "vc-1", Kind: types.SignerKindKeymanager, URL: vc.URL})
+	assert.Equal(t, signerUnhealthy, check.Status, "the keymanager API needs its token")
+	assert.Contains(t, check.Message, "401")
+
+	degraded := newStandInValidatorClient(t, "1")
+	check = client.Check(ctx, types.SignerTarget{Name: "vc-2", Kind: types.SignerKindMetrics, URL: degraded.URL + "/metrics"})
+	assert.Equal(t, signerDegraded, check.Status)
+	assert.Equal(t, "1 of 2 beacon nodes available", check.Message)
+
+	isolated := newStandInValidatorClient(t, "0")
+	check = client.Check(ctx, types.SignerTarget{Name: "vc-3", Kind: types.SignerKindMetrics, URL: isolated.URL + "/metrics"})
+	assert.Equal(t, signerUnhealthy, check.Status)
+
+	signer := newStandInWeb3Signer(t, "UP")
+	check = client.Check(ctx, types.SignerTarget{Name: "signer", Kind: types.SignerKindWeb3Signer, URL: signer.URL})
+	assert.Equal(t, signerHealthy, check.Status)
+	assert.Equal(t, []types.LoadedKey{{Pubkey: "0xcc"}}, check.Keys)
+
+	signer = newStandInWeb3Signer(t, "DOWN")
+	check = client.Check(ctx, types.SignerTarget{Name: "signer", Kind: types.SignerKindWeb3Signer, URL: signer.URL})
+	assert.Equal(t, signerDegraded, check.Status)
+	assert.Equal(t, "healthcheck down: slashing-protection-db-health-check", check.Message)
+	assert.Len(t, check.Keys, 1)
+
+	signer.Close()
+	check = client.Check(ctx, types.SignerTarget{Name: "signer", Kind: types.SignerKindWeb3Signer, URL: signer.URL})
+	assert.Equal(t, signerUnhealthy, check.Status, "an unreachable endpoint is unhealthy")
+	assert.Nil(t, check.Keys)
+}
+
+func TestKeyCoverageAlerts(t *testing.T) {
+	missing := &models.KeyCoverage{ValidatorIndex: 1, Pubkey: "0xaa", Clients: []string{}}
+	alerts := keyCoverageAlerts(missing, true)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, string(types.AlertTypeKeyNotLoaded), alerts[0].AlertType)
+	assert.Equal(t, "key_not_loaded/1", alertKey(alerts[0]))
+
+	duplicated := &models.KeyCoverage{ValidatorIndex: 2, Pubkey: "0xbb", Clients: []string{"vc-1", "vc-2"},
+		RemoteClients: []string{"vc-2"}}
+	alerts = keyCoverageAlerts(duplicated, true)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, string(types.AlertTypeKeyDuplicated), alerts[0].AlertType)
+	assert.Equal(t, models.SeverityCritical, alerts[0].Severity)
+	assert.Contains(t, alerts[0].Message, "vc-1, vc-2")
+	assert.Equal(t, string(types.AlertTypeRemoteKeyMissing), alerts[1].AlertType)
+	assert.Len(t, keyCoverageAlerts(duplicated, false), 1, "remote keys are only checked when signers are scraped")
+
+	covered := &models.KeyCoverage{ValidatorIndex: 3, Pubkey: "0xcc", Clients: []string{"vc-1"},
+		RemoteClients: []string{"vc-1"}, Signers: []string{"signer"}}
+	assert.Empty(t, keyCoverageAlerts(covered, true))
+
+	alert := signerEndpointAlert(types.SignerTarget{Name: "vc-1", Kind: types.SignerKindMetrics, URL: "http://user:pass@vc"},
+		&types.SignerCheck{Status: signerUnhealthy, Message: "no beacon node available"})
+	assert.Nil(t, alert.ValidatorIndex)
+	assert.Equal(t, "signer_unhealthy/vc-1/metrics", alertKey(alert))
+	assert.Equal(t, "http://vc", alert.Details["url"], "credentials are not stored")
+}
+
+func TestSummarizeSignerHealth(t *testing.T) {
+	health := summarizeSignerHealth(3, 0, 0, nil)
+	assert.Equal(t, signerHealthy, health.Status)
+	assert.Equal(t, "3 endpoints healthy", health.Message)
+
+	health = summarizeSignerHealth(3, 0, 1, map[types.AlertType]int{types.AlertTypeKeyNotLoaded: 2})
+	assert.Equal(t, signerDegraded, health.Status)
+	assert.Equal(t, "1 of 3 endpoints degraded; 2 keys not loaded", health.Message)
+
+	health = summarizeSignerHealth(3, 0, 0, map[types.AlertType]int{types.AlertTypeKeyDuplicated: 1})
+	assert.Equal(t, signerUnhealthy, health.Status, "a key loaded twice risks slashing")
+}
@@ -0,0 +1,461 @@
+package collector
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	spoolSegmentPrefix = "segment-"
+	spoolSegmentSuffix = ".wal"
+	spoolCheckpoint    = "checkpoint"
+
+	// spoolRecordHeaderSize is the length (uint32) and CRC32 (uint32) preceding each record
+	spoolRecordHeaderSize = 8
+
+	// DefaultSpoolSegmentBytes is the size at which the active segment is rotated
+	DefaultSpoolSegmentBytes int64 = 16 << 20
+)
+
+var (
+	spoolPendingBytes = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "collector_spool_pending_bytes",
+			Help: "Bytes of snapshot data spooled to disk awaiting replay into the database",
+		},
+	)
+
+	spoolSnapshots = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "collector_spool_snapshots_total",
+			Help: "Total snapshots written to or replayed from the on-disk spool by operation",
+		},
+		[]string{"op"},
+	)
+)
+
+// SnapshotSpool is an append-only, segmented write-ahead log for snapshot batches
+// that could not be written to the database. Each record is a length-prefixed,
+// CRC-checked JSON batch; replay progress is checkpointed so records are applied
+// exactly once and in order.
+type SnapshotSpool struct {
+	dir             string
+	maxSegmentBytes int64
+
+	mu          sync.Mutex
+	active      *os.File
+	activeSeq   uint64
+	activeBytes int64
+	closed      bool
+
+	// replayMu serialises replays so checkpoint updates never interleave
+	replayMu sync.Mutex
+}
+
+// spoolCheckpointState records how far replay has progressed
+type spoolCheckpointState struct {
+	Segment uint64
+	Offset  int64
+}
+
+// OpenSnapshotSpool opens (or creates) a spool in dir. New appends always go to a fresh
+// segment so a torn tail left by a crash is never appended to.
+func OpenSnapshotSpool(dir string, maxSegmentBytes int64) (*SnapshotSpool, error) {
+	if maxSegmentBytes <= 0 {
+		maxSegmentBytes = DefaultSpoolSegmentBytes
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	s := &SnapshotSpool{
+		dir:             dir,
+		maxSegmentBytes: maxSegmentBytes,
+	}
+
+	segments, err := s.segments()
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) > 0 {
+		s.activeSeq = segments[len(segments)-1]
+	}
+
+	s.updatePendingGauge()
+	return s, nil
+}
+
+// Append durably writes a batch of snapshots to the spool
+func (s *SnapshotSpool) Append(snapshots []*models.ValidatorSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(snapshots)
+	if err != nil {
+		return fmt.Errorf("failed to encode spool record: %w", err)
+	}
+
+	record := make([]byte, spoolRecordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+	copy(record[spoolRecordHeaderSize:], payload)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("spool is closed")
+	}
+
+	if s.active == nil || s.activeBytes+int64(len(record)) > s.maxSegmentBytes {
+		if err := s.rotateLocked(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.active.Write(record); err != nil {
+		return fmt.Errorf("failed to write spool record: %w", err)
+	}
+	if err := s.active.Sync(); err != nil {
+		return fmt.Errorf("failed to sync spool segment: %w", err)
+	}
+
+	s.activeBytes += int64(len(record))
+	spoolSnapshots.WithLabelValues("spooled").Add(float64(len(snapshots)))
+	spoolPendingBytes.Add(float64(len(record)))
+	return nil
+}
+
+// rotateLocked seals the active segment and opens the next one. Caller must hold mu.
+func (s *SnapshotSpool) rotateLocked() error {
+	if s.active != nil {
+		if err := s.active.Close(); err != nil {
+			return fmt.Errorf("failed to close spool segment: %w", err)
+		}
+		s.active = nil
+	}
+
+	s.activeSeq++
+	file, err := os.OpenFile(s.segmentPath(s.activeSeq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open spool segment: %w", err)
+	}
+
+	s.active = file
+	s.activeBytes = 0
+	return nil
+}
+
+// Pending reports whether the spool holds records that have not been replayed
+func (s *SnapshotSpool) Pending() bool {
+	return s.pendingBytes() > 0
+}
+
+// Replay applies spooled batches in order, oldest first. Replay stops at the first
+// apply error; already-applied records are checkpointed and will not be re-applied.
+// It returns the number of snapshots replayed.
+func (s *SnapshotSpool) Replay(apply func([]*models.ValidatorSnapshot) error) (int, error) {
+	s.replayMu.Lock()
+	defer s.replayMu.Unlock()
+
+	// Seal the active segment so concurrent appends go to a new one
+	s.mu.Lock()
+	if s.active != nil {
+		err := s.active.Close()
+		s.active = nil
+		if err != nil {
+			s.mu.Unlock()
+			return 0, fmt.Errorf("failed to close spool segment: %w", err)
+		}
+	}
+	sealedUpTo := s.activeSeq
+	s.mu.Unlock()
+
+	checkpoint, err := s.readCheckpoint()
+	if err != nil {
+		return 0, err
+	}
+
+	segments, err := s.segments()
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	for _, seq := range segments {
+		if seq > sealedUpTo {
+			break
+		}
+
+		offset := int64(0)
+		if seq == checkpoint.Segment {
+			offset = checkpoint.Offset
+		} else if seq < checkpoint.Segment {
+			// Fully replayed previously but not yet removed
+			s.removeSegment(seq)
+			continue
+		}
+
+		n, err := s.replaySegment(seq, offset, apply)
+		replayed += n
+		if err != nil {
+			s.updatePendingGauge()
+			return replayed, err
+		}
+
+		s.removeSegment(seq)
+	}
+
+	if err := s.writeCheckpoint(spoolCheckpointState{Segment: sealedUpTo + 1}); err != nil {
+		return replayed, err
+	}
+
+	s.updatePendingGauge()
+	return replayed, nil
+}
+
+// replaySegment applies records from a single sealed segment starting at offset
+func (s *SnapshotSpool) replaySegment(seq uint64, offset int64, apply func([]*models.ValidatorSnapshot) error) (int, error) {
+	file, err := os.Open(s.segmentPath(seq))
+	if err != nil {
+		return 0, fmt.Errorf("failed to open spool segment: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek spool segment: %w", err)
+	}
+
+	reader := bufio.NewReader(file)
+	replayed := 0
+
+	for {
+		batch, size, err := readSpoolRecord(reader)
+		if errors.Is(err, io.EOF) {
+			return replayed, nil
+		}
+		if err != nil {
+			// A torn or corrupt tail can only come from a crash mid-write; nothing after it is valid
+			logger.Logger.Warn().
+				Err(err).
+				Uint64("segment", seq).
+				Int64("offset", offset).
+				Msg("Discarding corrupt spool segment tail")
+			return replayed, nil
+		}
+
+		if err := apply(batch); err != nil {
+			return replayed, err
+		}
+
+		offset += size
+		replayed += len(batch)
+		spoolSnapshots.WithLabelValues("replayed").Add(float64(len(batch)))
+
+		if err := s.writeCheckpoint(spoolCheckpointState{Segment: seq, Offset: offset}); err != nil {
+			return replayed, err
+		}
+	}
+}
+
+// readSpoolRecord reads one record, returning the batch and the number of bytes consumed
+func readSpoolRecord(r io.Reader) ([]*models.ValidatorSnapshot, int64, error) {
+	header := make([]byte, spoolRecordHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, 0, fmt.Errorf("truncated spool record header: %w", err)
+		}
+		return nil, 0, err
+	}
+
+	length := binary.BigEndian.Uint32(header[0:4])
+	checksum := binary.BigEndian.Uint32(header[4:8])
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, fmt.Errorf("truncated spool record: %w", err)
+	}
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, 0, fmt.Errorf("spool record checksum mismatch")
+	}
+
+	var batch []*models.ValidatorSnapshot
+	if err := json.Unmarshal(payload, &batch); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode spool record: %w", err)
+	}
+
+	return batch, int64(spoolRecordHeaderSize) + int64(length), nil
+}
+
+// Sync flushes the active segment to stable storage
+func (s *SnapshotSpool) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active == nil {
+		return nil
+	}
+	return s.active.Sync()
+}
+
+// Close syncs and closes the active segment. It is safe to call more than once.
+func (s *SnapshotSpool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if s.active == nil {
+		return nil
+	}
+
+	err := s.active.Sync()
+	if closeErr := s.active.Close(); err == nil {
+		err = closeErr
+	}
+	s.active = nil
+	return err
+}
+
+// segments returns the sequence numbers of all segment files in ascending order
+func (s *SnapshotSpool) segments() ([]uint64, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	var seqs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, spoolSegmentPrefix) || !strings.HasSuffix(name, spoolSegmentSuffix) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, spoolSegmentPrefix), spoolSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+// segmentPath returns the file path for a segment sequence number
+func (s *SnapshotSpool) segmentPath(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%s%020d%s", spoolSegmentPrefix, seq, spoolSegmentSuffix))
+}
+
+// removeSegment deletes a fully replayed segment
+func (s *SnapshotSpool) removeSegment(seq uint64) {
+	if err := os.Remove(s.segmentPath(seq)); err != nil && !os.IsNotExist(err) {
+		logger.Logger.Warn().Err(err).Uint64("segment", seq).Msg("Failed to remove replayed spool segment")
+	}
+}
+
+// readCheckpoint loads replay progress; a missing checkpoint means nothing has been replayed
+func (s *SnapshotSpool) readCheckpoint() (spoolCheckpointState, error) {
+	var state spoolCheckpointState
+
+	data, err := os.ReadFile(filepath.Join(s.dir, spoolCheckpoint))
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("failed to read spool checkpoint: %w", err)
+	}
+
+	if _, err := fmt.Sscanf(string(data), "%d %d", &state.Segment, &state.Offset); err != nil {
+		return state, fmt.Errorf("failed to parse spool checkpoint: %w", err)
+	}
+	return state, nil
+}
+
+// writeCheckpoint atomically persists replay progress
+func (s *SnapshotSpool) writeCheckpoint(state spoolCheckpointState) error {
+	path := filepath.Join(s.dir, spoolCheckpoint)
+	tmp := path + ".tmp"
+
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to write spool checkpoint: %w", err)
+	}
+	if _, err := fmt.Fprintf(file, "%d %d", state.Segment, state.Offset); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write spool checkpoint: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to sync spool checkpoint: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close spool checkpoint: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to commit spool checkpoint: %w", err)
+	}
+	return nil
+}
+
+// pendingBytes returns the number of spooled bytes not yet replayed
+func (s *SnapshotSpool) pendingBytes() int64 {
+	checkpoint, err := s.readCheckpoint()
+	if err != nil {
+		return 0
+	}
+
+	segments, err := s.segments()
+	if err != nil {
+		return 0
+	}
+
+	var total int64
+	for _, seq := range segments {
+		if seq < checkpoint.Segment {
+			continue
+		}
+
+		info, err := os.Stat(s.segmentPath(seq))
+		if err != nil {
+			continue
+		}
+
+		size := info.Size()
+		if seq == checkpoint.Segment {
+			size -= checkpoint.Offset
+		}
+		if size > 0 {
+			total += size
+		}
+	}
+
+	return total
+}
+
+// updatePendingGauge recomputes the pending bytes gauge from disk
+func (s *SnapshotSpool) updatePendingGauge() {
+	spoolPendingBytes.Set(float64(s.pendingBytes()))
+}
@@ -0,0 +1,178 @@
+package collector
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSnapshotBatch(indices ...int64) []*models.ValidatorSnapshot {
+	batch := make([]*models.ValidatorSnapshot, 0, len(indices))
+	for _, index := range indices {
+		batch = append(batch, &models.ValidatorSnapshot{
+			Time:             time.Unix(1700000000+index, 0).UTC(),
+			ValidatorIndex:   index,
+			Balance:          32000000000 + index,
+			EffectiveBalance: 32000000000,
+			IsOnline:         true,
+		})
+	}
+	return batch
+}
+
+// collectReplay replays the spool and returns the validator indices applied, in order
+func collectReplay(t *testing.T, spool *SnapshotSpool) []int64 {
+	t.Helper()
+
+	var applied []int64
+	_, err := spool.Replay(func(batch []*models.ValidatorSnapshot) error {
+		for _, snapshot := range batch {
+			applied = append(applied, snapshot.ValidatorIndex)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return applied
+}
+
+func TestSnapshotSpool_ReplayInOrder(t *testing.T) {
+	spool, err := OpenSnapshotSpool(t.TempDir(), 0)
+	require.NoError(t, err)
+	defer spool.Close()
+
+	assert.False(t, spool.Pending())
+
+	require.NoError(t, spool.Append(testSnapshotBatch(1, 2)))
+	require.NoError(t, spool.Append(testSnapshotBatch(3)))
+	assert.True(t, spool.Pending())
+
+	assert.Equal(t, []int64{1, 2, 3}, collectReplay(t, spool))
+	assert.False(t, spool.Pending())
+
+	// Replaying again applies nothing
+	assert.Empty(t, collectReplay(t, spool))
+}
+
+func TestSnapshotSpool_RotatesSegments(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := OpenSnapshotSpool(dir, 64)
+	require.NoError(t, err)
+	defer spool.Close()
+
+	for i := int64(1); i <= 4; i++ {
+		require.NoError(t, spool.Append(testSnapshotBatch(i)))
+	}
+
+	segments, err := spool.segments()
+	require.NoError(t, err)
+	assert.Len(t, segments, 4)
+
+	assert.Equal(t, []int64{1, 2, 3, 4}, collectReplay(t, spool))
+
+	// Replayed segments are removed
+	segments, err = spool.segments()
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+}
+
+func TestSnapshotSpool_ResumesAfterApplyFailure(t *testing.T) {
+	spool, err := OpenSnapshotSpool(t.TempDir(), 0)
+	require.NoError(t, err)
+	defer spool.Close()
+
+	require.NoError(t, spool.Append(testSnapshotBatch(1)))
+	require.NoError(t, spool.Append(testSnapshotBatch(2)))
+	require.NoError(t, spool.Append(testSnapshotBatch(3)))
+
+	// Database goes away after the first batch
+	dbDown := errors.New("connection refused")
+	var applied []int64
+	replayed, err := spool.Replay(func(batch []*models.ValidatorSnapshot) error {
+		if batch[0].ValidatorIndex == 2 {
+			return dbDown
+		}
+		applied = append(applied, batch[0].ValidatorIndex)
+		return nil
+	})
+	assert.ErrorIs(t, err, dbDown)
+	assert.Equal(t, 1, replayed)
+	assert.Equal(t, []int64{1}, applied)
+	assert.True(t, spool.Pending())
+
+	// A batch spooled while the database was down queues behind the others
+	require.NoError(t, spool.Append(testSnapshotBatch(4)))
+
+	assert.Equal(t, []int64{2, 3, 4}, collectReplay(t, spool))
+	assert.False(t, spool.Pending())
+}
+
+func TestSnapshotSpool_ReopenResumesFromCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+
+	spool, err := OpenSnapshotSpool(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, spool.Append(testSnapshotBatch(1)))
+	require.NoError(t, spool.Append(testSnapshotBatch(2)))
+
+	_, err = spool.Replay(func(batch []*models.ValidatorSnapshot) error {
+		if batch[0].ValidatorIndex == 2 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	require.Error(t, err)
+	require.NoError(t, spool.Close())
+
+	// Simulate a restart
+	reopened, err := OpenSnapshotSpool(dir, 0)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	assert.True(t, reopened.Pending())
+	require.NoError(t, reopened.Append(testSnapshotBatch(3)))
+
+	// Batch 1 was checkpointed and must not be applied twice
+	assert.Equal(t, []int64{2, 3}, collectReplay(t, reopened))
+}
+
+func TestSnapshotSpool_DiscardsTornTail(t *testing.T) {
+	dir := t.TempDir()
+
+	spool, err := OpenSnapshotSpool(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, spool.Append(testSnapshotBatch(1)))
+	require.NoError(t, spool.Append(testSnapshotBatch(2)))
+	require.NoError(t, spool.Close())
+
+	// Simulate a crash part-way through writing a third record
+	segments, err := spool.segments()
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+
+	file, err := os.OpenFile(spool.segmentPath(segments[0]), os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0, 0, 1, 0, 0xde, 0xad, '[', '{'})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	reopened, err := OpenSnapshotSpool(dir, 0)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	assert.Equal(t, []int64{1, 2}, collectReplay(t, reopened))
+	assert.False(t, reopened.Pending())
+}
+
+func TestSnapshotSpool_AppendAfterClose(t *testing.T) {
+	spool, err := OpenSnapshotSpool(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	require.NoError(t, spool.Close())
+	require.NoError(t, spool.Close())
+	assert.Error(t, spool.Append(testSnapshotBatch(1)))
+}
@@ -0,0 +1,65 @@
+package collector
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// decodePayloadTransaction extracts the recipient and value from a raw transaction in an
+// execution payload: an RLP list for legacy transactions, or a type byte followed by an RLP list
+// for typed transactions (EIP-2718)
+func decodePayloadTransaction(raw string) (*types.PayloadTransaction, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty transaction")
+	}
+
+	// Position of the recipient in the transaction's field list; the value follows it
+	var toField int
+	switch {
+	case data[0] >= 0xc0:
+		toField = 3 // nonce, gasPrice, gas, to, value, ...
+	case data[0] == 0x01:
+		toField, data = 4, data[1:] // chainId, nonce, gasPrice, gas, to, value, ...
+	case data[0] >= 0x02 && data[0] <= 0x04:
+		toField, data = 5, data[1:] // chainId, nonce, maxPriorityFee, maxFee, gas, to, value, ...
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %#x", data[0])
+	}
+
+	var fields []rlp.RawValue
+	if err := rlp.DecodeBytes(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if len(fields) <= toField+1 {
+		return nil, fmt.Errorf("transaction has %d fields", len(fields))
+	}
+
+	var to []byte
+	if err := rlp.DecodeBytes(fields[toField], &to); err != nil {
+		return nil, fmt.Errorf("failed to decode recipient: %w", err)
+	}
+	value := new(big.Int)
+	if err := rlp.DecodeBytes(fields[toField+1], value); err != nil {
+		return nil, fmt.Errorf("failed to decode value: %w", err)
+	}
+
+	tx := &types.PayloadTransaction{Value: value}
+	if len(to) > 0 {
+		tx.To = "0x" + hex.EncodeToString(to)
+	}
+	return tx, nil
+}
+
+// weiToGwei converts a Wei amount to whole Gwei
+func weiToGwei(wei *big.Int) int64 {
+	return new(big.Int).Quo(wei, big.NewInt(1e9)).Int64()
+}
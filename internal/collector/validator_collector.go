@@ -2,8 +2,10 @@ package collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/birddigital/eth-validator-monitor/internal/database/models"
@@ -27,6 +29,27 @@ type ValidatorCollector struct {
 	validatorRepo   *repository.ValidatorRepository
 	snapshotRepo    *repository.SnapshotRepository
 
+	// Live validator-set updates
+	changeListener  *repository.ValidatorChangeListener
+
+	// Circuit breakers for beacon, database and cache calls
+	recovery        *ErrorRecovery
+
+	// On-disk spool for snapshot batches the database could not accept
+	spool               *SnapshotSpool
+	spoolReplayInterval time.Duration
+
+	// Trailing income attached to snapshots; nil until SetIncomeSource is called
+	income IncomeSource
+
+	// Network percentile attached to snapshots; nil until SetNetworkRankSource is called
+	networkRank NetworkRankSource
+
+	// Operator control
+	paused      atomic.Bool
+	genesisTime time.Time
+	history     types.RewardsClient
+
 	// Configuration
 	collectionInterval time.Duration
 	batchSize         int
@@ -44,19 +67,37 @@ type ValidatorCollector struct {
 	mu                 sync.RWMutex
 }
 
+// IncomeSource provides the trailing daily income (Gwei) and APR stored on snapshots
+type IncomeSource interface {
+	SnapshotIncome(validatorIndex int64) (dailyIncome int64, apr float64, ok bool)
+}
+
+// NetworkRankSource provides the network percentile and network average score stored on snapshots
+type NetworkRankSource interface {
+	SnapshotNetworkRank(validatorIndex int64) (percentile, average float64, ok bool)
+}
+
 // CollectorConfig contains configuration for the validator collector
 type CollectorConfig struct {
-	CollectionInterval time.Duration
-	BatchSize          int
-	WorkerPoolConfig   *WorkerPoolConfig
+	CollectionInterval  time.Duration
+	BatchSize           int
+	WorkerPoolConfig    *WorkerPoolConfig
+	ErrorRecovery       *ErrorRecovery // Shared with the beacon client; defaults to NewErrorRecovery()
+	Spool               *SnapshotSpool // Optional; batches are spooled here while the database is unavailable
+	SpoolReplayInterval time.Duration
+	GenesisTime         time.Time           // Used to map epochs to snapshot times when re-collecting
+	HistoryClient       types.RewardsClient // Historical state and attestation rewards for re-collecting; nil disables it
 }
 
 // DefaultCollectorConfig returns default collector configuration
 func DefaultCollectorConfig() *CollectorConfig {
 	return &CollectorConfig{
-		CollectionInterval: time.Second * 12, // Ethereum epoch time
-		BatchSize:          100,
-		WorkerPoolConfig:   DefaultWorkerPoolConfig(),
+		CollectionInterval:  time.Second * 12, // Ethereum epoch time
+		BatchSize:           100,
+		WorkerPoolConfig:    DefaultWorkerPoolConfig(),
+		ErrorRecovery:       NewErrorRecovery(),
+		SpoolReplayInterval: 30 * time.Second,
+		GenesisTime:         time.Unix(types.MainnetGenesisTime, 0),
 	}
 }
 
@@ -71,6 +112,11 @@ func NewValidatorCollector(
 ) *ValidatorCollector {
 	collectorCtx, cancel := context.WithCancel(ctx)
 
+	recovery := config.ErrorRecovery
+	if recovery == nil {
+		recovery = NewErrorRecovery()
+	}
+
 	return &ValidatorCollector{
 		beaconClient:       beaconClient,
 		pool:              pool,
@@ -79,6 +125,12 @@ func NewValidatorCollector(
 		workerPool:        NewWorkerPool(collectorCtx, config.WorkerPoolConfig),
 		validatorRepo:     repository.NewValidatorRepository(pool),
 		snapshotRepo:      repository.NewSnapshotRepository(pool),
+		changeListener:    repository.NewValidatorChangeListener(pool),
+		recovery:          recovery,
+		spool:             config.Spool,
+		spoolReplayInterval: config.SpoolReplayInterval,
+		genesisTime:         config.GenesisTime,
+		history:             config.HistoryClient,
 		collectionInterval: config.CollectionInterval,
 		batchSize:         config.BatchSize,
 		ctx:               collectorCtx,
@@ -108,8 +160,18 @@ func (c *ValidatorCollector) Start() error {
 	c.wg.Add(1)
 	go c.subscribeToHeadEvents()
 
+	// Start validator change listener
+	c.wg.Add(1)
+	go c.listenForValidatorChanges()
+
+	// Start spool replay
+	if c.spool != nil {
+		c.wg.Add(1)
+		go c.runSpoolReplay()
+	}
+
 	logger.FromContext(c.ctx).Info().
-		Int("validator_count", len(c.validators)).
+		Int("validator_count", c.monitoredCount()).
 		Msg("Validator collector started monitoring validators")
 	return nil
 }
@@ -125,14 +187,68 @@ func (c *ValidatorCollector) loadValidators() error {
 		return fmt.Errorf("failed to load validators: %w", err)
 	}
 
-	c.validators = make([]int64, len(validators))
+	indices := make([]int64, len(validators))
 	for i, v := range validators {
-		c.validators[i] = v.ValidatorIndex
+		indices[i] = v.ValidatorIndex
 	}
 
+	c.mu.Lock()
+	c.validators = indices
+	c.mu.Unlock()
+
 	return nil
 }
 
+// listenForValidatorChanges applies validator inserts, updates and deletes as they happen
+func (c *ValidatorCollector) listenForValidatorChanges() {
+	defer c.wg.Done()
+
+	resync := func() {
+		if err := c.loadValidators(); err != nil {
+			logger.FromContext(c.ctx).Error().
+				Err(err).
+				Msg("Failed to resync validator list")
+		}
+	}
+
+	if err := c.changeListener.Listen(c.ctx, c.applyValidatorChange, resync); err != nil && c.ctx.Err() == nil {
+		logger.FromContext(c.ctx).Error().
+			Err(err).
+			Msg("Validator change listener stopped")
+	}
+}
+
+// applyValidatorChange updates the monitoring list from a single change event
+func (c *ValidatorCollector) applyValidatorChange(event *repository.ValidatorChangeEvent) {
+	switch event.Op {
+	case repository.ValidatorChangeInsert, repository.ValidatorChangeUpdate:
+		if event.Monitored {
+			c.AddValidator(event.ValidatorIndex)
+		} else {
+			c.RemoveValidator(event.ValidatorIndex)
+		}
+	case repository.ValidatorChangeDelete:
+		c.RemoveValidator(event.ValidatorIndex)
+	}
+}
+
+// monitoredValidators returns a copy of the current monitoring list
+func (c *ValidatorCollector) monitoredValidators() []int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	validators := make([]int64, len(c.validators))
+	copy(validators, c.validators)
+	return validators
+}
+
+// monitoredCount returns the number of validators currently monitored
+func (c *ValidatorCollector) monitoredCount() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.validators)
+}
+
 // runCollectionLoop runs the main collection loop
 func (c *ValidatorCollector) runCollectionLoop() {
 	defer c.wg.Done()
@@ -155,19 +271,35 @@ func (c *ValidatorCollector) runCollectionLoop() {
 
 // collectAllValidators initiates collection for all monitored validators
 func (c *ValidatorCollector) collectAllValidators() {
+	if c.paused.Load() {
+		logger.FromContext(c.ctx).Debug().Msg("Skipping collection round, collector paused")
+		return
+	}
+
+	// Skip the round while the beacon node is known to be down; the breaker
+	// lets a probe through once its open timeout has elapsed
+	if c.recovery.ShouldCircuitBreak(ComponentBeaconValidators) {
+		logger.FromContext(c.ctx).Debug().
+			Str("component", ComponentBeaconValidators).
+			Msg("Skipping collection round, beacon circuit open")
+		return
+	}
+
 	c.mu.Lock()
 	c.lastCollectionTime = time.Now()
 	c.collectionsCount++
 	c.mu.Unlock()
 
+	validators := c.monitoredValidators()
+
 	// Submit tasks in batches to avoid overwhelming the queue
-	for i := 0; i < len(c.validators); i += c.batchSize {
+	for i := 0; i < len(validators); i += c.batchSize {
 		end := i + c.batchSize
-		if end > len(c.validators) {
-			end = len(c.validators)
+		if end > len(validators) {
+			end = len(validators)
 		}
 
-		batch := c.validators[i:end]
+		batch := validators[i:end]
 		for _, validatorIndex := range batch {
 			task := Task{
 				ID:             fmt.Sprintf("snapshot-%d-%d", validatorIndex, time.Now().Unix()),
@@ -204,10 +336,9 @@ func (c *ValidatorCollector) processResults() {
 	for {
 		select {
 		case <-c.ctx.Done():
-			// Flush remaining batch
-			if len(batchResults) > 0 {
-				c.storeBatch(batchResults)
-			}
+			// Flush remaining batch, including results that are already waiting
+			batchResults = append(batchResults, c.drainResults(resultChan)...)
+			c.flushOnShutdown(batchResults)
 			return
 
 		case result, ok := <-resultChan:
@@ -223,6 +354,7 @@ func (c *ValidatorCollector) processResults() {
 				c.mu.Lock()
 				c.errorsCount++
 				c.mu.Unlock()
+				c.workerPool.Done(1)
 				continue
 			}
 
@@ -232,6 +364,7 @@ func (c *ValidatorCollector) processResults() {
 				logger.FromContext(c.ctx).Error().
 					Err(err).
 					Msg("Failed to convert result to snapshot")
+				c.workerPool.Done(1)
 				continue
 			}
 
@@ -240,6 +373,7 @@ func (c *ValidatorCollector) processResults() {
 			// Store batch when it reaches the size limit
 			if len(batchResults) >= c.batchSize {
 				c.storeBatch(batchResults)
+				c.workerPool.Done(len(batchResults))
 				batchResults = make([]*models.ValidatorSnapshot, 0, c.batchSize)
 			}
 
@@ -247,6 +381,7 @@ func (c *ValidatorCollector) processResults() {
 			// Periodic flush of partial batches
 			if len(batchResults) > 0 {
 				c.storeBatch(batchResults)
+				c.workerPool.Done(len(batchResults))
 				batchResults = make([]*models.ValidatorSnapshot, 0, c.batchSize)
 			}
 		}
@@ -259,8 +394,9 @@ func (c *ValidatorCollector) storeBatch(snapshots []*models.ValidatorSnapshot) {
 		return
 	}
 
-	// Store in database
-	if err := c.snapshotRepo.BatchInsertSnapshots(c.ctx, snapshots); err != nil {
+	// Store in database, falling back to the on-disk spool
+	err := c.persistBatch(snapshots)
+	if err != nil {
 		logger.FromContext(c.ctx).Error().
 			Err(err).
 			Int("batch_size", len(snapshots)).
@@ -275,7 +411,15 @@ func (c *ValidatorCollector) storeBatch(snapshots []*models.ValidatorSnapshot) {
 		cacheItems[key] = snapshot
 	}
 
-	if err := c.cache.BatchSet(c.ctx, cacheItems, cache.GetLatestSnapshotTTL()); err != nil {
+	// The cache is optional: while its circuit is open readers fall back to the database
+	err = c.recovery.Execute(ComponentCache, func() error {
+		return c.cache.BatchSet(c.ctx, cacheItems, cache.GetLatestSnapshotTTL())
+	})
+	if errors.Is(err, ErrCircuitOpen) {
+		logger.FromContext(c.ctx).Debug().
+			Int("cache_item_count", len(cacheItems)).
+			Msg("Skipping cache update, cache circuit open")
+	} else if err != nil {
 		logger.FromContext(c.ctx).Warn().
 			Err(err).
 			Int("cache_item_count", len(cacheItems)).
@@ -294,6 +438,172 @@ func (c *ValidatorCollector) storeBatch(snapshots []*models.ValidatorSnapshot) {
 		Msg("Stored batch of snapshots")
 }
 
+// persistBatch writes a batch to the database. When the database is unavailable, or
+// earlier batches are still waiting in the spool, the batch is appended to the spool.
+func (c *ValidatorCollector) persistBatch(snapshots []*models.ValidatorSnapshot) error {
+	insert := func() error {
+		return c.snapshotRepo.BatchInsertSnapshots(c.ctx, snapshots)
+	}
+
+	if c.spool == nil {
+		return c.recovery.Execute(ComponentDatabase, insert)
+	}
+
+	// Earlier batches must reach the database first to preserve ordering
+	if c.spool.Pending() {
+		c.replaySpool()
+	}
+
+	var dbErr error
+	if !c.spool.Pending() {
+		dbErr = c.recovery.Execute(ComponentDatabase, insert)
+		if dbErr == nil {
+			return nil
+		}
+	}
+
+	if err := c.spool.Append(snapshots); err != nil {
+		return fmt.Errorf("failed to spool snapshot batch: %w", err)
+	}
+
+	event := logger.FromContext(c.ctx).Debug()
+	if dbErr != nil {
+		event = logger.FromContext(c.ctx).Warn().Err(dbErr)
+	}
+	event.
+		Int("batch_size", len(snapshots)).
+		Msg("Snapshot batch spooled to disk")
+
+	return nil
+}
+
+// replaySpool replays spooled batches into the database in order
+func (c *ValidatorCollector) replaySpool() {
+	if c.recovery.ShouldCircuitBreak(ComponentDatabase) {
+		return
+	}
+
+	replayed, err := c.spool.Replay(func(batch []*models.ValidatorSnapshot) error {
+		return c.recovery.Execute(ComponentDatabase, func() error {
+			return c.snapshotRepo.BatchInsertSnapshots(c.ctx, batch)
+		})
+	})
+
+	if replayed > 0 {
+		logger.FromContext(c.ctx).Info().
+			Int("snapshot_count", replayed).
+			Msg("Replayed spooled snapshots into database")
+	}
+	if err != nil && !errors.Is(err, ErrCircuitOpen) && c.ctx.Err() == nil {
+		logger.FromContext(c.ctx).Warn().
+			Err(err).
+			Msg("Spool replay stopped, will retry")
+	}
+}
+
+// runSpoolReplay periodically drains the spool so it empties even when no new batches arrive
+func (c *ValidatorCollector) runSpoolReplay() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.spoolReplayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if c.spool.Pending() {
+				c.replaySpool()
+			}
+		}
+	}
+}
+
+// drainResults converts results already buffered in the channel without blocking
+func (c *ValidatorCollector) drainResults(resultChan <-chan Result) []*models.ValidatorSnapshot {
+	var snapshots []*models.ValidatorSnapshot
+	for {
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				return snapshots
+			}
+			if result.Error != nil {
+				continue
+			}
+			if snapshot, err := c.resultToSnapshot(result); err == nil {
+				snapshots = append(snapshots, snapshot)
+			}
+		default:
+			return snapshots
+		}
+	}
+}
+
+// flushOnShutdown persists in-memory snapshots once the collector context is cancelled.
+// With a spool they are written to disk; otherwise a final bounded database write is attempted.
+func (c *ValidatorCollector) flushOnShutdown(snapshots []*models.ValidatorSnapshot) {
+	if len(snapshots) == 0 {
+		return
+	}
+
+	log := logger.FromContext(c.ctx)
+
+	if c.spool != nil {
+		if err := c.spool.Append(snapshots); err != nil {
+			log.Error().
+				Err(err).
+				Int("batch_size", len(snapshots)).
+				Msg("Failed to spool snapshots on shutdown")
+			return
+		}
+		log.Info().
+			Int("batch_size", len(snapshots)).
+			Msg("Spooled in-memory snapshots on shutdown")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := c.snapshotRepo.BatchInsertSnapshots(ctx, snapshots); err != nil {
+		log.Error().
+			Err(err).
+			Int("batch_size", len(snapshots)).
+			Msg("Failed to store snapshots on shutdown")
+	}
+}
+
+// FlushSpool syncs and closes the snapshot spool
+func (c *ValidatorCollector) FlushSpool() error {
+	if c.spool == nil {
+		return nil
+	}
+	return c.spool.Close()
+}
+
+// ClosePool closes the collector's database pool
+func (c *ValidatorCollector) ClosePool() {
+	if c.pool != nil {
+		c.pool.Close()
+	}
+}
+
+// SetIncomeSource sets the source of the daily income and APR recorded on snapshots
+func (c *ValidatorCollector) SetIncomeSource(source IncomeSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.income = source
+}
+
+// SetNetworkRankSource sets the source of the network percentile recorded on snapshots
+func (c *ValidatorCollector) SetNetworkRankSource(source NetworkRankSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.networkRank = source
+}
+
 // resultToSnapshot converts a collection result to a validator snapshot
 func (c *ValidatorCollector) resultToSnapshot(result Result) (*models.ValidatorSnapshot, error) {
 	data, ok := result.Data.(map[string]interface{})
@@ -333,6 +643,23 @@ func (c *ValidatorCollector) resultToSnapshot(result Result) (*models.ValidatorS
 		snapshot.AttestationEffectiveness = &effectiveness
 	}
 
+	c.mu.RLock()
+	income := c.income
+	networkRank := c.networkRank
+	c.mu.RUnlock()
+	if income != nil {
+		if dailyIncome, apr, ok := income.SnapshotIncome(snapshot.ValidatorIndex); ok {
+			snapshot.DailyIncome = &dailyIncome
+			snapshot.APR = &apr
+		}
+	}
+	if networkRank != nil {
+		if percentile, average, ok := networkRank.SnapshotNetworkRank(snapshot.ValidatorIndex); ok {
+			snapshot.NetworkPercentile = &percentile
+			snapshot.NetworkAverage = &average
+		}
+	}
+
 	return snapshot, nil
 }
 
@@ -358,6 +685,11 @@ func (c *ValidatorCollector) subscribeToHeadEvents() {
 					Msg("Head event channel closed, attempting to reconnect")
 				time.Sleep(time.Second * 5)
 
+				// Wait out an open events circuit instead of reconnecting to a failing node
+				if c.recovery.ShouldCircuitBreak(ComponentBeaconEvents) {
+					continue
+				}
+
 				// Try to reconnect
 				headChan, err = c.beaconClient.SubscribeToHead(c.ctx)
 				if err != nil {
@@ -396,6 +728,15 @@ func (c *ValidatorCollector) Stop() error {
 	// Wait for all goroutines to finish
 	c.wg.Wait()
 
+	// Spooled batches are fsynced on append; sync again in case the final flush raced a rotation
+	if c.spool != nil {
+		if err := c.spool.Sync(); err != nil {
+			logger.FromContext(c.ctx).Error().
+				Err(err).
+				Msg("Error syncing snapshot spool")
+		}
+	}
+
 	logger.FromContext(c.ctx).Info().Msg("Validator collector stopped successfully")
 	return nil
 }
@@ -408,21 +749,25 @@ func (c *ValidatorCollector) Stats() CollectorStats {
 	poolStats := c.workerPool.Stats()
 
 	return CollectorStats{
+		Paused:              c.paused.Load(),
 		ValidatorsMonitored: len(c.validators),
 		LastCollectionTime:  c.lastCollectionTime,
 		CollectionsCount:    c.collectionsCount,
 		ErrorsCount:         c.errorsCount,
 		PoolStats:          poolStats,
+		CircuitStates:      c.recovery.States(),
 	}
 }
 
 // CollectorStats contains collector statistics
 type CollectorStats struct {
+	Paused              bool
 	ValidatorsMonitored int
 	LastCollectionTime  time.Time
 	CollectionsCount    uint64
 	ErrorsCount         uint64
 	PoolStats           PoolStats
+	CircuitStates       map[string]CircuitState
 }
 
 // AddValidator adds a validator to the monitoring list
@@ -0,0 +1,225 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var statusTransitions = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_status_transitions_total",
+		Help: "Total lifecycle status transitions of monitored validators by status entered",
+	},
+	[]string{"status"},
+)
+
+// ValidatorStatusConfig contains configuration for the validator status job
+type ValidatorStatusConfig struct {
+	GenesisTime time.Time
+}
+
+// DefaultValidatorStatusConfig returns default validator status configuration
+func DefaultValidatorStatusConfig() *ValidatorStatusConfig {
+	return &ValidatorStatusConfig{
+		GenesisTime: time.Unix(types.MainnetGenesisTime, 0),
+	}
+}
+
+// ValidatorStatusJob follows monitored validators through the consensus lifecycle. Each run reads
+// their status and lifecycle epochs from the head state and records every status a validator
+// entered since the last run, dated by the epoch it began. Transitions of a validator already on
+// record raise lifecycle alerts; the first observation of a validator only fills in its history.
+type ValidatorStatusJob struct {
+	client        types.DiscoveryClient
+	validatorRepo *repository.ValidatorRepository
+	statusRepo    *repository.ValidatorStatusRepository
+	alertRepo     *repository.AlertRepository
+	config        *ValidatorStatusConfig
+}
+
+// NewValidatorStatusJob creates a new validator status job
+func NewValidatorStatusJob(client types.DiscoveryClient, pool *pgxpool.Pool, config *ValidatorStatusConfig) *ValidatorStatusJob {
+	return &ValidatorStatusJob{
+		client:        client,
+		validatorRepo: repository.NewValidatorRepository(pool),
+		statusRepo:    repository.NewValidatorStatusRepository(pool),
+		alertRepo:     repository.NewAlertRepository(pool),
+		config:        config,
+	}
+}
+
+// RunOnce reads the head state of the monitored validators and records their status changes
+func (j *ValidatorStatusJob) RunOnce(ctx context.Context) error {
+	monitored := true
+	validators, err := j.validatorRepo.ListValidators(ctx, &models.ValidatorFilter{
+		Monitored: &monitored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monitored validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	byIndex := make(map[int64]*models.Validator, len(validators))
+	ids := make([]string, 0, len(validators))
+	for _, v := range validators {
+		byIndex[v.ValidatorIndex] = v
+		ids = append(ids, strconv.FormatInt(v.ValidatorIndex, 10))
+	}
+
+	var identities []types.ValidatorIdentity
+	err = j.client.ScanValidators(ctx, ids, func(identity types.ValidatorIdentity) error {
+		identities = append(identities, identity)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read validator statuses: %w", err)
+	}
+
+	observed := types.EpochAtTime(j.config.GenesisTime, time.Now())
+	for _, identity := range identities {
+		v, ok := byIndex[identity.Index]
+		if !ok {
+			continue
+		}
+		if err := j.observe(ctx, v, identity, observed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// observe records a validator's status as read at epoch observed, alerting on transitions from a
+// status already on record
+func (j *ValidatorStatusJob) observe(ctx context.Context, v *models.Validator, identity types.ValidatorIdentity, observed int64) error {
+	status := models.LifecycleStatus(identity.Status)
+	if !status.Valid() {
+		logger.FromContext(ctx).Warn().
+			Int64("validator_index", v.ValidatorIndex).
+			Str("status", identity.Status).
+			Msg("Ignoring unknown validator status")
+		return nil
+	}
+
+	previous := v.Status
+	if previous != nil && *previous != status && !previous.CanTransition(status) {
+		// A lagging or misbehaving beacon node; the status on record stands
+		logger.FromContext(ctx).Warn().
+			Int64("validator_index", v.ValidatorIndex).
+			Str("from", string(*previous)).
+			Str("to", string(status)).
+			Msg("Ignoring invalid validator status transition")
+		return nil
+	}
+	if !applyIdentity(v, identity) && previous != nil && *previous == status {
+		return nil
+	}
+
+	transitions := models.PlanStatusTransitions(v, previous, status, observed, j.config.GenesisTime)
+	v.Status = &status
+	recorded, err := j.statusRepo.RecordStatus(ctx, v, transitions)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range recorded {
+		statusTransitions.WithLabelValues(string(t.Status)).Inc()
+		if previous == nil {
+			continue
+		}
+		if alert := lifecycleAlert(t); alert != nil {
+			if err := j.alertRepo.CreateAlert(ctx, alert); err != nil {
+				logger.FromContext(ctx).Error().
+					Err(err).
+					Int64("validator_index", t.ValidatorIndex).
+					Msg("Failed to create lifecycle alert")
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyIdentity copies the slashing and lifecycle epochs of the head state onto a validator,
+// reporting whether any of them changed
+func applyIdentity(v *models.Validator, identity types.ValidatorIdentity) bool {
+	changed := v.Slashed != identity.Slashed
+	v.Slashed = identity.Slashed
+
+	epochs := []struct {
+		dst **int64
+		src *int64
+	}{
+		{&v.ActivationEligibilityEpoch, identity.ActivationEligibilityEpoch},
+		{&v.ActivationEpoch, identity.ActivationEpoch},
+		{&v.ExitEpoch, identity.ExitEpoch},
+		{&v.WithdrawableEpoch, identity.WithdrawableEpoch},
+	}
+	for _, e := range epochs {
+		if (*e.dst == nil) != (e.src == nil) || *e.dst != nil && **e.dst != *e.src {
+			changed = true
+		}
+		*e.dst = e.src
+	}
+
+	return changed
+}
+
+// lifecycleAlert builds the alert for a validator entering a status, or nil for statuses that
+// need no attention. Slashing is critical and exits are warned about, since neither can be undone.
+func lifecycleAlert(t *models.StatusTransition) *models.Alert {
+	alert := &models.Alert{
+		Severity: models.SeverityInfo,
+		Message:  t.Summary(),
+		Source:   "validator_status",
+		Details: models.JSONB{
+			"status": string(t.Status),
+			"epoch":  t.Epoch,
+		},
+		Status: models.AlertStatusNew,
+	}
+	if t.PreviousStatus != nil {
+		alert.Details["previous_status"] = string(*t.PreviousStatus)
+	}
+
+	switch {
+	case t.Status.Slashed() && (t.PreviousStatus == nil || !t.PreviousStatus.Slashed()):
+		alert.AlertType = string(types.AlertTypeSlashed)
+		alert.Severity = models.SeverityCritical
+		alert.Title = "Validator slashed"
+	case t.Status == models.LifecycleActiveOngoing:
+		alert.AlertType = string(types.AlertTypeValidatorActivated)
+		alert.Title = "Validator activated"
+	case t.Status == models.LifecycleActiveExiting:
+		alert.AlertType = string(types.AlertTypeValidatorExiting)
+		alert.Severity = models.SeverityWarning
+		alert.Title = "Validator exit initiated"
+	case t.Status == models.LifecycleExitedUnslashed || t.Status == models.LifecycleExitedSlashed:
+		alert.AlertType = string(types.AlertTypeValidatorExited)
+		alert.Title = "Validator exited"
+	case t.Status == models.LifecycleWithdrawalPossible:
+		alert.AlertType = string(types.AlertTypeWithdrawable)
+		alert.Title = "Validator balance withdrawable"
+	case t.Status == models.LifecycleWithdrawalDone:
+		alert.AlertType = string(types.AlertTypeValidatorWithdrawn)
+		alert.Title = "Validator balance withdrawn"
+	default:
+		return nil
+	}
+
+	index := t.ValidatorIndex
+	alert.ValidatorIndex = &index
+	return alert
+}
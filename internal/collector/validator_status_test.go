@@ -0,0 +1,52 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleAlert(t *testing.T) {
+	status := func(s models.LifecycleStatus) *models.LifecycleStatus { return &s }
+	transition := func(from *models.LifecycleStatus, to models.LifecycleStatus) *models.StatusTransition {
+		return &models.StatusTransition{ValidatorIndex: 7, Status: to, PreviousStatus: from, Epoch: 1000}
+	}
+
+	alert := lifecycleAlert(transition(status(models.LifecycleActiveOngoing), models.LifecycleActiveSlashed))
+	require.NotNil(t, alert)
+	assert.Equal(t, string(types.AlertTypeSlashed), alert.AlertType)
+	assert.Equal(t, models.SeverityCritical, alert.Severity)
+	assert.Equal(t, int64(7), *alert.ValidatorIndex)
+	assert.Equal(t, "Slashed at epoch 1000", alert.Message)
+	assert.Equal(t, "active_ongoing", alert.Details["previous_status"])
+
+	alert = lifecycleAlert(transition(status(models.LifecycleActiveSlashed), models.LifecycleExitedSlashed))
+	require.NotNil(t, alert)
+	assert.Equal(t, string(types.AlertTypeValidatorExited), alert.AlertType, "a slashing is alerted once")
+
+	alert = lifecycleAlert(transition(status(models.LifecycleActiveOngoing), models.LifecycleActiveExiting))
+	require.NotNil(t, alert)
+	assert.Equal(t, models.SeverityWarning, alert.Severity)
+
+	assert.Nil(t, lifecycleAlert(transition(status(models.LifecyclePendingInitialized), models.LifecyclePendingQueued)),
+		"joining the activation queue needs no attention")
+}
+
+func TestApplyIdentity(t *testing.T) {
+	activation := int64(105)
+	v := &models.Validator{ValidatorIndex: 7, ActivationEpoch: &activation}
+
+	same := activation
+	assert.False(t, applyIdentity(v, types.ValidatorIdentity{Index: 7, ActivationEpoch: &same}))
+
+	exit := int64(500)
+	assert.True(t, applyIdentity(v, types.ValidatorIdentity{Index: 7, ActivationEpoch: &same, ExitEpoch: &exit}))
+	require.NotNil(t, v.ExitEpoch)
+	assert.Equal(t, exit, *v.ExitEpoch)
+
+	assert.True(t, applyIdentity(v, types.ValidatorIdentity{Index: 7, ActivationEpoch: &same, ExitEpoch: &exit, Slashed: true}))
+	assert.True(t, v.Slashed)
+}
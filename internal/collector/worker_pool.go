@@ -22,6 +22,10 @@ type WorkerPool struct {
 	tasksProcessed atomic.Uint64
 	tasksFailed    atomic.Uint64
 	activeWorkers  atomic.Int32
+	busyWorkers    atomic.Int32
+
+	// Submitted tasks whose results the consumer has not yet settled with Done
+	pending        atomic.Int64
 
 	// Configuration
 	maxRetries     int
@@ -122,6 +126,7 @@ func (p *WorkerPool) worker(id int) {
 			}
 
 			// Process task with timeout
+			p.busyWorkers.Add(1)
 			taskCtx, cancel := context.WithTimeout(p.ctx, p.taskTimeout)
 			result := p.processTask(taskCtx, task)
 			cancel()
@@ -129,7 +134,9 @@ func (p *WorkerPool) worker(id int) {
 			// Send result
 			select {
 			case p.resultQueue <- result:
+				p.busyWorkers.Add(-1)
 			case <-p.ctx.Done():
+				p.busyWorkers.Add(-1)
 				return
 			}
 
@@ -213,18 +220,28 @@ func (p *WorkerPool) executeTask(ctx context.Context, task Task) (interface{}, e
 	}
 }
 
-// Submit adds a task to the queue
+// Submit adds a task to the queue. The consumer must call Done once the task's result is settled.
 func (p *WorkerPool) Submit(task Task) error {
+	// Count the task before it is queued so Drain cannot miss it between queue and worker
+	p.pending.Add(1)
+
 	select {
 	case p.taskQueue <- task:
 		return nil
 	case <-p.ctx.Done():
+		p.pending.Add(-1)
 		return fmt.Errorf("worker pool is shutting down")
 	default:
+		p.pending.Add(-1)
 		return fmt.Errorf("task queue is full")
 	}
 }
 
+// Done marks n results as settled: stored, spooled or discarded by the consumer
+func (p *WorkerPool) Done(n int) {
+	p.pending.Add(int64(-n))
+}
+
 // SubmitWithPriority adds a high-priority task
 func (p *WorkerPool) SubmitWithPriority(task Task) error {
 	task.Priority = 1 // High priority
@@ -268,12 +285,35 @@ func (p *WorkerPool) Shutdown(timeout time.Duration) error {
 	}
 }
 
+// Drain blocks until every submitted task has been processed and its result settled with Done,
+// including results the consumer holds in a partial batch.
+// Callers must stop submitting tasks first or Drain may never return.
+func (p *WorkerPool) Drain(ctx context.Context) error {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		if p.pending.Load() == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("worker pool drain interrupted: %w", ctx.Err())
+		case <-p.ctx.Done():
+			return fmt.Errorf("worker pool is shutting down")
+		case <-ticker.C:
+		}
+	}
+}
+
 // Stats returns current pool statistics
 func (p *WorkerPool) Stats() PoolStats {
 	return PoolStats{
 		TasksProcessed: p.tasksProcessed.Load(),
 		TasksFailed:    p.tasksFailed.Load(),
 		ActiveWorkers:  p.activeWorkers.Load(),
+		BusyWorkers:    p.busyWorkers.Load(),
 		QueueSize:      len(p.taskQueue),
 		ResultQueueSize: len(p.resultQueue),
 	}
@@ -284,6 +324,7 @@ type PoolStats struct {
 	TasksProcessed  uint64
 	TasksFailed     uint64
 	ActiveWorkers   int32
+	BusyWorkers     int32 // Workers currently processing a task
 	QueueSize       int
 	ResultQueueSize int
 }
@@ -0,0 +1,55 @@
+package collector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerPool_DrainWaitsForSettledResults(t *testing.T) {
+	pool := NewWorkerPool(context.Background(), &WorkerPoolConfig{Workers: 1, QueueSize: 10, TaskTimeout: time.Second})
+	pool.Start()
+	defer pool.Shutdown(time.Second)
+
+	require.NoError(t, pool.Submit(Task{ID: "a", ValidatorIndex: 1}))
+	require.NoError(t, pool.Submit(Task{ID: "b", ValidatorIndex: 2}))
+
+	drained := make(chan error, 1)
+	go func() { drained <- pool.Drain(context.Background()) }()
+
+	// Both results are consumed but held in a batch that has not been stored yet
+	<-pool.Results()
+	<-pool.Results()
+	select {
+	case <-drained:
+		t.Fatal("Drain returned before the buffered batch was settled")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	pool.Done(2)
+	select {
+	case err := <-drained:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Drain did not return after every result was settled")
+	}
+}
+
+func TestWorkerPool_RejectedSubmitIsNotPending(t *testing.T) {
+	pool := NewWorkerPool(context.Background(), &WorkerPoolConfig{Workers: 1, QueueSize: 1, TaskTimeout: time.Second})
+
+	require.NoError(t, pool.Submit(Task{ID: "a"}))
+	assert.Error(t, pool.Submit(Task{ID: "b"}), "the queue is full while no worker runs")
+
+	pool.Start()
+	defer pool.Shutdown(time.Second)
+	<-pool.Results()
+	pool.Done(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, pool.Drain(ctx))
+}
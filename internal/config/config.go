@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
 	"github.com/joho/godotenv"
 )
 
@@ -34,6 +36,72 @@ type Config struct {
 
 	// Session configuration
 	Session SessionConfig
+
+	// Snapshot gap repair configuration
+	GapRepair GapRepairConfig
+
+	// Circuit breaker configuration
+	CircuitBreaker CircuitBreakerConfig
+
+	// Snapshot spool configuration
+	Spool SpoolConfig
+
+	// Rewards ledger configuration
+	RewardsLedger RewardsLedgerConfig
+
+	// Income and APR reporting configuration
+	Income IncomeConfig
+
+	// Network percentile ranking configuration
+	NetworkRank NetworkRankConfig
+
+	// Attestation miss root-cause analysis configuration
+	AttestationAnalysis AttestationAnalysisConfig
+
+	// Missed proposal analysis configuration
+	ProposalAnalysis ProposalAnalysisConfig
+
+	// MEV-boost relay configuration
+	Relays RelayConfig
+
+	// MEV-boost relay monitoring configuration
+	RelayMonitor RelayMonitorConfig
+
+	// Client diversity configuration
+	ClientDiversity ClientDiversityConfig
+
+	// Validator client and remote signer health configuration
+	SignerHealth SignerHealthConfig
+
+	// Correlated alert incident configuration
+	Incidents IncidentsConfig
+
+	// Statistical anomaly detection configuration
+	AnomalyDetection AnomalyDetectionConfig
+
+	// Effective balance step detection configuration
+	EffectiveBalance EffectiveBalanceConfig
+
+	// Withdrawal credential and exit request monitoring configuration
+	CredentialMonitor CredentialMonitorConfig
+
+	// Fee recipient verification configuration
+	FeeRecipient FeeRecipientConfig
+
+	// Execution client configuration
+	Execution ExecutionConfig
+
+	// Validator discovery configuration
+	Discovery DiscoveryConfig
+
+	// Validator lifecycle status tracking configuration
+	ValidatorStatus ValidatorStatusConfig
+
+	// Deposit monitoring configuration
+	DepositMonitor DepositMonitorConfig
+
+	// Light client verification of beacon data configuration
+	LightClient LightClientConfig
 }
 
 type ServerConfig struct {
@@ -72,7 +140,9 @@ type RedisConfig struct {
 }
 
 type BeaconChainConfig struct {
-	NodeURL string // e.g., "http://localhost:5052"
+	NodeURL     string // e.g., "http://localhost:5052"
+	GenesisTime int64  // Unix seconds; defaults to mainnet genesis
+	Mock        bool   // Use the in-process mock instead of NodeURL (development only)
 }
 
 type MonitoringConfig struct {
@@ -96,6 +166,252 @@ type JWTConfig struct {
 	RefreshTokenDuration time.Duration // Refresh token expiration (e.g., 168h)
 }
 
+type GapRepairConfig struct {
+	Enabled          bool          // Enable/disable the snapshot gap repair job
+	Interval         time.Duration // How often to scan for gaps (e.g., 10m)
+	WindowEpochs     int           // Sliding window of epochs to scan
+	MaxAttempts      int           // Attempts before a gap is marked irreparable
+	MaxRepairsPerRun int           // Upper bound on beacon requests per scan
+}
+
+// CircuitBreakerConfig holds per-component circuit breaker thresholds
+type CircuitBreakerConfig struct {
+	Beacon   BreakerThresholds
+	Database BreakerThresholds
+	Cache    BreakerThresholds
+}
+
+// SpoolConfig holds settings for the on-disk snapshot spool used while the database is unavailable
+type SpoolConfig struct {
+	Enabled        bool          // Enable/disable spooling snapshots to disk
+	Dir            string        // Directory holding spool segments and the replay checkpoint
+	MaxSegmentMB   int           // Segment size before rotating to a new file
+	ReplayInterval time.Duration // How often spooled snapshots are replayed into the database
+}
+
+// RewardsLedgerConfig holds settings for the per-epoch rewards and penalties ledger job
+type RewardsLedgerConfig struct {
+	Enabled         bool          // Enable/disable the rewards ledger job
+	Interval        time.Duration // How often to account new epochs (e.g., 6m24s, one epoch)
+	LookbackEpochs  int           // How far back to backfill missing epochs
+	MaxEpochsPerRun int           // Upper bound on epochs accounted per run
+}
+
+// IncomeConfig holds settings for daily income and APR reporting
+type IncomeConfig struct {
+	Windows        []string // Trailing windows reported by default, in days (e.g., 1d, 7d, 30d, 365d)
+	SnapshotWindow string   // Window used for the daily income and APR stored on snapshots
+}
+
+// NetworkRankConfig holds settings for ranking monitored validators against a network sample
+type NetworkRankConfig struct {
+	Enabled     bool          // Enable/disable the network rank job
+	Interval    time.Duration // How often to rank the latest finished epoch (e.g., 6m24s, one epoch)
+	SampleSize  int           // Random network validators scored each epoch
+	SizeRefresh time.Duration // How often the size of the validator set is re-measured
+}
+
+// AttestationAnalysisConfig holds settings for classifying the root cause of suboptimal attestations
+type AttestationAnalysisConfig struct {
+	Enabled         bool          // Enable/disable the attestation analysis job
+	Interval        time.Duration // How often to analyse newly finished epochs (e.g., 6m24s, one epoch)
+	LookbackEpochs  int           // How far back to analyse epochs missed while not running
+	MaxEpochsPerRun int           // Upper bound on epochs analysed per run
+}
+
+// ProposalAnalysisConfig holds settings for the root-cause analysis of missed block proposals
+type ProposalAnalysisConfig struct {
+	Enabled        bool          // Enable/disable the proposal analysis job
+	Interval       time.Duration // How often to analyse newly finished epochs (e.g., 6m24s, one epoch)
+	LookbackEpochs int           // How far back to analyse epochs missed while not running
+	LateThreshold  time.Duration // Blocks arriving later than this after the slot start are judged late
+}
+
+// RelayConfig holds the MEV-boost relays whose data API is queried
+type RelayConfig struct {
+	URLs    []string      // Relay base URLs (e.g., https://boost-relay.flashbots.net)
+	Timeout time.Duration // Timeout for each relay request
+}
+
+// RelayMonitorConfig holds settings for matching proposed blocks against relay deliveries
+type RelayMonitorConfig struct {
+	Enabled            bool          // Enable/disable the relay monitor job (needs MEV_RELAY_URLS)
+	Interval           time.Duration // How often to check new proposals (e.g., 6m24s, one epoch)
+	LookbackEpochs     int           // Epochs checked when the job starts
+	MaxSlotsPerRun     int           // Upper bound on slots whose proposals are checked per run
+	ShortfallTolerance float64       // Fraction of the promised value a builder payment may fall short by
+	MaxRetries         int           // Runs that retry a slot while relays are unreachable before it is recorded unverified
+}
+
+// ClientDiversityConfig holds settings for fingerprinting block clients and alerting on fleet concentration
+type ClientDiversityConfig struct {
+	Enabled                bool          // Enable/disable the client diversity job
+	Interval               time.Duration // How often to record fingerprinted blocks (e.g., 6m24s, one epoch)
+	LookbackEpochs         int           // Window of network shares
+	SupermajorityThreshold float64       // Network share from which a client is a supermajority client
+	FleetThreshold         float64       // Fleet share on a supermajority client that raises an alert
+}
+
+// SignerHealthConfig holds settings for scraping validator clients and remote signers
+type SignerHealthConfig struct {
+	Enabled   bool          // Enable/disable the signer health job (needs SIGNER_HEALTH_ENDPOINTS)
+	Interval  time.Duration // How often to scrape the endpoints (e.g., 1m)
+	Timeout   time.Duration // Timeout for each request
+	Endpoints []string      // Endpoints as name=kind@url (e.g., vc-1=keymanager@http://10.0.0.5:5062)
+	Tokens    []string      // Keymanager API bearer tokens as name=token
+}
+
+// IncidentsConfig holds settings for grouping correlated alerts into incidents
+type IncidentsConfig struct {
+	Enabled   bool          // Enable/disable the incident job
+	Interval  time.Duration // How often to group new alerts (e.g., 1m)
+	Window    time.Duration // Largest gap between consecutive alerts of one incident
+	MinAlerts int           // Correlated alerts needed to open an incident
+	Lookback  time.Duration // Age beyond which alerts are no longer grouped
+}
+
+// Targets returns the configured endpoints, each with the keymanager token of its validator client
+func (c SignerHealthConfig) Targets() []types.SignerTarget {
+	tokens := make(map[string]string, len(c.Tokens))
+	for _, entry := range c.Tokens {
+		if name, token, ok := strings.Cut(entry, "="); ok {
+			tokens[trim(name)] = trim(token)
+		}
+	}
+
+	targets := make([]types.SignerTarget, 0, len(c.Endpoints))
+	for _, entry := range c.Endpoints {
+		name, endpoint, ok := strings.Cut(entry, "=")
+		if !ok {
+			continue
+		}
+		kind, url, ok := strings.Cut(endpoint, "@")
+		if !ok {
+			continue
+		}
+		name = trim(name)
+		target := types.SignerTarget{
+			Name: name,
+			Kind: types.SignerKind(trim(kind)),
+			URL:  strings.TrimRight(trim(url), "/"),
+		}
+		if target.Kind == types.SignerKindKeymanager {
+			target.Token = tokens[name]
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// AnomalyDetectionConfig holds settings for detecting performance that deviates from its own history
+type AnomalyDetectionConfig struct {
+	Enabled      bool          // Enable/disable the anomaly detection job
+	Interval     time.Duration // How often to check the most recent window (e.g., 1h)
+	Lookback     time.Duration // History used to build each baseline
+	RecentWindow time.Duration // Most recent hours compared with the baseline
+	Alpha        float64       // EWMA smoothing factor for the baseline, between 0 and 1
+	Threshold    float64       // z-score beyond which the recent window is anomalous
+	MinSamples   int           // Hours of history required before a series is checked
+}
+
+// EffectiveBalanceConfig holds settings for detecting effective balance steps in the rewards ledger
+type EffectiveBalanceConfig struct {
+	Enabled        bool          // Enable/disable the effective balance job
+	Interval       time.Duration // How often to search the ledger for new steps (e.g., 6m24s, one epoch)
+	LookbackEpochs int           // Ledger epochs searched for steps on each run
+}
+
+// CredentialMonitorConfig holds settings for watching credential changes and execution layer exits
+type CredentialMonitorConfig struct {
+	Enabled        bool          // Enable/disable the credential monitor job
+	Interval       time.Duration // How often to scan new blocks (e.g., 12s, one slot)
+	LookbackEpochs int           // Epochs scanned when the job starts
+	MaxSlotsPerRun int           // Upper bound on blocks fetched per run
+	Allowlist      []string      // Allowed withdrawal addresses per validator tag (e.g., treasury=0xabc|0xdef, *=0x123)
+}
+
+// AllowlistByGroup returns the allowed withdrawal addresses keyed by validator tag, with "*"
+// applying to every validator
+func (c CredentialMonitorConfig) AllowlistByGroup() map[string][]string {
+	return addressesByGroup(c.Allowlist)
+}
+
+// FeeRecipientConfig holds settings for verifying the fee recipient of proposed blocks
+type FeeRecipientConfig struct {
+	Enabled        bool          // Enable/disable the fee recipient job
+	Interval       time.Duration // How often to check new proposals (e.g., 6m24s, one epoch)
+	LookbackEpochs int           // Epochs checked when the job starts
+	MaxSlotsPerRun int           // Upper bound on slots whose proposals are checked per run
+	Expected       []string      // Expected fee recipients per validator tag (e.g., pool-a=0xabc|0xdef, *=0x123)
+}
+
+// ExpectedByGroup returns the expected fee recipients keyed by validator tag, with "*" applying
+// to every validator
+func (c FeeRecipientConfig) ExpectedByGroup() map[string][]string {
+	return addressesByGroup(c.Expected)
+}
+
+// addressesByGroup parses tag=0xaddress|0xaddress entries into addresses keyed by tag
+func addressesByGroup(entries []string) map[string][]string {
+	groups := make(map[string][]string, len(entries))
+	for _, entry := range entries {
+		group, addresses, ok := strings.Cut(entry, "=")
+		if !ok {
+			continue
+		}
+		group = trim(group)
+		groups[group] = append(groups[group], splitAndTrim(addresses, "|")...)
+	}
+	return groups
+}
+
+// ExecutionConfig holds settings for the optional execution client JSON-RPC connection
+type ExecutionConfig struct {
+	NodeURL         string        // e.g., "http://localhost:8545"; empty disables execution layer features
+	Timeout         time.Duration // Timeout for each JSON-RPC request
+	DepositContract string        // Beacon chain deposit contract address; defaults to mainnet
+}
+
+// DiscoveryConfig holds settings for enrolling validators that match discovery rules
+type DiscoveryConfig struct {
+	Enabled           bool          // Enable/disable the discovery job
+	Interval          time.Duration // How often to evaluate discovery rules (e.g., 1h)
+	LookbackEpochs    int           // Epochs of blocks searched for fee recipients when the job starts
+	MaxSlotsPerRun    int           // Upper bound on blocks fetched per run for fee recipient rules
+	DepositStartBlock int           // Execution block depositor rules start searching from
+	MaxBlocksPerRun   int           // Upper bound on execution blocks searched per depositor rule per run
+	LogChunkBlocks    int           // Execution blocks per eth_getLogs request
+}
+
+// ValidatorStatusConfig holds settings for following monitored validators through the lifecycle
+type ValidatorStatusConfig struct {
+	Enabled  bool          // Enable/disable the validator status job
+	Interval time.Duration // How often to read validator statuses (e.g., 6m24s, one epoch)
+}
+
+// DepositMonitorConfig holds settings for following deposits to monitored validators
+type DepositMonitorConfig struct {
+	Enabled        bool          // Enable/disable the deposit monitor job
+	Interval       time.Duration // How often to scan new blocks and read the deposit queue (e.g., 6m24s, one epoch)
+	LookbackEpochs int           // Epochs scanned when the job starts
+	MaxSlotsPerRun int           // Upper bound on blocks fetched per run
+}
+
+// LightClientConfig holds settings for verifying beacon data with the light client sync protocol
+type LightClientConfig struct {
+	Enabled           bool          // Enable/disable light client verification of the beacon node
+	TrustedCheckpoint string        // Root of a finalized block to verify the chain from, obtained out of band
+	RejectUnverified  bool          // Refuse beacon data while verification fails, rather than only flagging it
+	Interval          time.Duration // How often to verify the latest finalized header (e.g., 6m24s, one epoch)
+	AncestorDepth     int           // Blocks before each newly verified finalized block checked by parent root
+}
+
+type BreakerThresholds struct {
+	ErrorThreshold int           // Consecutive failures that open the circuit
+	ErrorWindow    time.Duration // Window in which failures are counted
+	OpenTimeout    time.Duration // Time the circuit stays open before a probe is allowed
+}
+
 type SessionConfig struct {
 	SecretKey string        // Session secret key (min 32 chars, used for cookie signing)
 	MaxAge    time.Duration // Session expiration (e.g., 168h = 7 days)
@@ -145,7 +461,9 @@ func Load() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		BeaconChain: BeaconChainConfig{
-			NodeURL: getEnv("BEACON_NODE_URL", "http://localhost:5052"),
+			NodeURL:     getEnv("BEACON_NODE_URL", "http://localhost:5052"),
+			GenesisTime: int64(getEnvAsInt("BEACON_GENESIS_TIME", 1606824023)),
+			Mock:        getEnvAsBool("BEACON_MOCK", false),
 		},
 		Monitoring: MonitoringConfig{
 			PrometheusPort: getEnv("PROMETHEUS_PORT", "9090"),
@@ -172,6 +490,144 @@ func Load() (*Config, error) {
 			HttpOnly:  getEnvAsBool("SESSION_HTTP_ONLY", true),            // Prevent XSS by default
 			SameSite:  getEnv("SESSION_SAME_SITE", "Lax"),                 // CSRF protection
 		},
+		CircuitBreaker: CircuitBreakerConfig{
+			Beacon:   loadBreakerThresholds("CIRCUIT_BEACON", 10, 5*time.Minute, 30*time.Second),
+			Database: loadBreakerThresholds("CIRCUIT_DATABASE", 5, 1*time.Minute, 15*time.Second),
+			Cache:    loadBreakerThresholds("CIRCUIT_CACHE", 5, 1*time.Minute, 30*time.Second),
+		},
+		Spool: SpoolConfig{
+			Enabled:        getEnvAsBool("SPOOL_ENABLED", true),
+			Dir:            getEnv("SPOOL_DIR", "./data/spool"),
+			MaxSegmentMB:   getEnvAsInt("SPOOL_MAX_SEGMENT_MB", 16),
+			ReplayInterval: getEnvAsDuration("SPOOL_REPLAY_INTERVAL", 30*time.Second),
+		},
+		GapRepair: GapRepairConfig{
+			Enabled:          getEnvAsBool("GAP_REPAIR_ENABLED", true),
+			Interval:         getEnvAsDuration("GAP_REPAIR_INTERVAL", 10*time.Minute),
+			WindowEpochs:     getEnvAsInt("GAP_REPAIR_WINDOW_EPOCHS", 225), // ~1 day
+			MaxAttempts:      getEnvAsInt("GAP_REPAIR_MAX_ATTEMPTS", 5),
+			MaxRepairsPerRun: getEnvAsInt("GAP_REPAIR_MAX_REPAIRS_PER_RUN", 500),
+		},
+		RewardsLedger: RewardsLedgerConfig{
+			Enabled:         getEnvAsBool("REWARDS_LEDGER_ENABLED", true),
+			Interval:        getEnvAsDuration("REWARDS_LEDGER_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs:  getEnvAsInt("REWARDS_LEDGER_LOOKBACK_EPOCHS", 225),           // ~1 day
+			MaxEpochsPerRun: getEnvAsInt("REWARDS_LEDGER_MAX_EPOCHS_PER_RUN", 32),
+		},
+		Income: IncomeConfig{
+			Windows:        getEnvAsSlice("INCOME_WINDOWS", []string{"1d", "7d", "30d", "365d"}),
+			SnapshotWindow: getEnv("INCOME_SNAPSHOT_WINDOW", "7d"),
+		},
+		NetworkRank: NetworkRankConfig{
+			Enabled:     getEnvAsBool("NETWORK_RANK_ENABLED", true),
+			Interval:    getEnvAsDuration("NETWORK_RANK_INTERVAL", 384*time.Second), // one epoch
+			SampleSize:  getEnvAsInt("NETWORK_RANK_SAMPLE_SIZE", 1000),
+			SizeRefresh: getEnvAsDuration("NETWORK_RANK_SIZE_REFRESH", 24*time.Hour),
+		},
+		AttestationAnalysis: AttestationAnalysisConfig{
+			Enabled:         getEnvAsBool("ATTESTATION_ANALYSIS_ENABLED", true),
+			Interval:        getEnvAsDuration("ATTESTATION_ANALYSIS_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs:  getEnvAsInt("ATTESTATION_ANALYSIS_LOOKBACK_EPOCHS", 225),           // ~1 day
+			MaxEpochsPerRun: getEnvAsInt("ATTESTATION_ANALYSIS_MAX_EPOCHS_PER_RUN", 8),
+		},
+		ProposalAnalysis: ProposalAnalysisConfig{
+			Enabled:        getEnvAsBool("PROPOSAL_ANALYSIS_ENABLED", true),
+			Interval:       getEnvAsDuration("PROPOSAL_ANALYSIS_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs: getEnvAsInt("PROPOSAL_ANALYSIS_LOOKBACK_EPOCHS", 225),           // ~1 day
+			LateThreshold:  getEnvAsDuration("PROPOSAL_ANALYSIS_LATE_THRESHOLD", 4*time.Second),
+		},
+		Relays: RelayConfig{
+			URLs:    getEnvAsSlice("MEV_RELAY_URLS", nil),
+			Timeout: getEnvAsDuration("MEV_RELAY_TIMEOUT", 5*time.Second),
+		},
+		RelayMonitor: RelayMonitorConfig{
+			Enabled:            getEnvAsBool("RELAY_MONITOR_ENABLED", true),
+			Interval:           getEnvAsDuration("RELAY_MONITOR_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs:     getEnvAsInt("RELAY_MONITOR_LOOKBACK_EPOCHS", 225),           // ~1 day
+			MaxSlotsPerRun:     getEnvAsInt("RELAY_MONITOR_MAX_SLOTS_PER_RUN", 7200),
+			ShortfallTolerance: getEnvAsFloat("RELAY_MONITOR_SHORTFALL_TOLERANCE", 0.01),
+			MaxRetries:         getEnvAsInt("RELAY_MONITOR_MAX_RETRIES", 3),
+		},
+		ClientDiversity: ClientDiversityConfig{
+			Enabled:                getEnvAsBool("CLIENT_DIVERSITY_ENABLED", true),
+			Interval:               getEnvAsDuration("CLIENT_DIVERSITY_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs:         getEnvAsInt("CLIENT_DIVERSITY_LOOKBACK_EPOCHS", 225),           // ~1 day
+			SupermajorityThreshold: getEnvAsFloat("CLIENT_DIVERSITY_SUPERMAJORITY_THRESHOLD", 0.66),
+			FleetThreshold:         getEnvAsFloat("CLIENT_DIVERSITY_FLEET_THRESHOLD", 0.5),
+		},
+		SignerHealth: SignerHealthConfig{
+			Enabled:   getEnvAsBool("SIGNER_HEALTH_ENABLED", true),
+			Interval:  getEnvAsDuration("SIGNER_HEALTH_INTERVAL", time.Minute),
+			Timeout:   getEnvAsDuration("SIGNER_HEALTH_TIMEOUT", 5*time.Second),
+			Endpoints: getEnvAsSlice("SIGNER_HEALTH_ENDPOINTS", nil),
+			Tokens:    getEnvAsSlice("SIGNER_HEALTH_TOKENS", nil),
+		},
+		Incidents: IncidentsConfig{
+			Enabled:   getEnvAsBool("INCIDENTS_ENABLED", true),
+			Interval:  getEnvAsDuration("INCIDENTS_INTERVAL", time.Minute),
+			Window:    getEnvAsDuration("INCIDENTS_WINDOW", 10*time.Minute),
+			MinAlerts: getEnvAsInt("INCIDENTS_MIN_ALERTS", 3),
+			Lookback:  getEnvAsDuration("INCIDENTS_LOOKBACK", time.Hour),
+		},
+		AnomalyDetection: AnomalyDetectionConfig{
+			Enabled:      getEnvAsBool("ANOMALY_DETECTION_ENABLED", true),
+			Interval:     getEnvAsDuration("ANOMALY_DETECTION_INTERVAL", time.Hour),
+			Lookback:     getEnvAsDuration("ANOMALY_DETECTION_LOOKBACK", 7*24*time.Hour),
+			RecentWindow: getEnvAsDuration("ANOMALY_DETECTION_RECENT_WINDOW", 6*time.Hour),
+			Alpha:        getEnvAsFloat("ANOMALY_DETECTION_ALPHA", 0.1),
+			Threshold:    getEnvAsFloat("ANOMALY_DETECTION_THRESHOLD", 3),
+			MinSamples:   getEnvAsInt("ANOMALY_DETECTION_MIN_SAMPLES", 24),
+		},
+		EffectiveBalance: EffectiveBalanceConfig{
+			Enabled:        getEnvAsBool("EFFECTIVE_BALANCE_ENABLED", true),
+			Interval:       getEnvAsDuration("EFFECTIVE_BALANCE_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs: getEnvAsInt("EFFECTIVE_BALANCE_LOOKBACK_EPOCHS", 225),           // ~1 day
+		},
+		CredentialMonitor: CredentialMonitorConfig{
+			Enabled:        getEnvAsBool("CREDENTIAL_MONITOR_ENABLED", true),
+			Interval:       getEnvAsDuration("CREDENTIAL_MONITOR_INTERVAL", 12*time.Second), // one slot
+			LookbackEpochs: getEnvAsInt("CREDENTIAL_MONITOR_LOOKBACK_EPOCHS", 225),          // ~1 day
+			MaxSlotsPerRun: getEnvAsInt("CREDENTIAL_MONITOR_MAX_SLOTS_PER_RUN", 64),
+			Allowlist:      getEnvAsSlice("CREDENTIAL_MONITOR_ALLOWLIST", nil),
+		},
+		FeeRecipient: FeeRecipientConfig{
+			Enabled:        getEnvAsBool("FEE_RECIPIENT_ENABLED", true),
+			Interval:       getEnvAsDuration("FEE_RECIPIENT_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs: getEnvAsInt("FEE_RECIPIENT_LOOKBACK_EPOCHS", 225),           // ~1 day
+			MaxSlotsPerRun: getEnvAsInt("FEE_RECIPIENT_MAX_SLOTS_PER_RUN", 7200),
+			Expected:       getEnvAsSlice("FEE_RECIPIENT_EXPECTED", nil),
+		},
+		Execution: ExecutionConfig{
+			NodeURL:         getEnv("EXECUTION_NODE_URL", ""),
+			Timeout:         getEnvAsDuration("EXECUTION_TIMEOUT", 30*time.Second),
+			DepositContract: getEnv("DEPOSIT_CONTRACT_ADDRESS", "0x00000000219ab540356cBB839Cbe05303d7705Fa"),
+		},
+		Discovery: DiscoveryConfig{
+			Enabled:           getEnvAsBool("DISCOVERY_ENABLED", true),
+			Interval:          getEnvAsDuration("DISCOVERY_INTERVAL", time.Hour),
+			LookbackEpochs:    getEnvAsInt("DISCOVERY_LOOKBACK_EPOCHS", 225), // ~1 day
+			MaxSlotsPerRun:    getEnvAsInt("DISCOVERY_MAX_SLOTS_PER_RUN", 600),
+			DepositStartBlock: getEnvAsInt("DISCOVERY_DEPOSIT_START_BLOCK", 11052984), // mainnet deposit contract deployment
+			MaxBlocksPerRun:   getEnvAsInt("DISCOVERY_MAX_BLOCKS_PER_RUN", 1_000_000),
+			LogChunkBlocks:    getEnvAsInt("DISCOVERY_LOG_CHUNK_BLOCKS", 10_000),
+		},
+		ValidatorStatus: ValidatorStatusConfig{
+			Enabled:  getEnvAsBool("VALIDATOR_STATUS_ENABLED", true),
+			Interval: getEnvAsDuration("VALIDATOR_STATUS_INTERVAL", 384*time.Second), // one epoch
+		},
+		DepositMonitor: DepositMonitorConfig{
+			Enabled:        getEnvAsBool("DEPOSIT_MONITOR_ENABLED", true),
+			Interval:       getEnvAsDuration("DEPOSIT_MONITOR_INTERVAL", 384*time.Second), // one epoch
+			LookbackEpochs: getEnvAsInt("DEPOSIT_MONITOR_LOOKBACK_EPOCHS", 225),           // ~1 day
+			MaxSlotsPerRun: getEnvAsInt("DEPOSIT_MONITOR_MAX_SLOTS_PER_RUN", 64),
+		},
+		LightClient: LightClientConfig{
+			Enabled:           getEnvAsBool("LIGHT_CLIENT_ENABLED", false),
+			TrustedCheckpoint: getEnv("LIGHT_CLIENT_TRUSTED_CHECKPOINT", ""),
+			RejectUnverified:  getEnvAsBool("LIGHT_CLIENT_REJECT_UNVERIFIED", false),
+			Interval:          getEnvAsDuration("LIGHT_CLIENT_INTERVAL", 384*time.Second), // one epoch
+			AncestorDepth:     getEnvAsInt("LIGHT_CLIENT_ANCESTOR_DEPTH", 64),              // two epochs
+		},
 	}
 
 	// Validate the configuration
@@ -191,6 +647,15 @@ func MustLoad() *Config {
 	return cfg
 }
 
+// loadBreakerThresholds reads <PREFIX>_ERROR_THRESHOLD, <PREFIX>_ERROR_WINDOW and <PREFIX>_OPEN_TIMEOUT
+func loadBreakerThresholds(prefix string, threshold int, window, openTimeout time.Duration) BreakerThresholds {
+	return BreakerThresholds{
+		ErrorThreshold: getEnvAsInt(prefix+"_ERROR_THRESHOLD", threshold),
+		ErrorWindow:    getEnvAsDuration(prefix+"_ERROR_WINDOW", window),
+		OpenTimeout:    getEnvAsDuration(prefix+"_OPEN_TIMEOUT", openTimeout),
+	}
+}
+
 // getEnv retrieves environment variable or returns default
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -7,6 +7,10 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
 )
 
 // Validate checks that all required configuration is present and valid
@@ -48,6 +52,115 @@ func (c *Config) Validate() error {
 		errors = append(errors, err.Error())
 	}
 
+	// Validate Gap Repair
+	if err := c.validateGapRepair(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Circuit Breakers
+	if err := c.validateCircuitBreaker(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Snapshot Spool
+	if err := c.validateSpool(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Rewards Ledger
+	if err := c.validateRewardsLedger(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	if err := c.validateIncome(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Network Rank
+	if err := c.validateNetworkRank(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Attestation Analysis
+	if err := c.validateAttestationAnalysis(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Proposal Analysis
+	if err := c.validateProposalAnalysis(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Relays
+	if err := c.validateRelays(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Relay Monitor
+	if err := c.validateRelayMonitor(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Client Diversity
+	if err := c.validateClientDiversity(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Signer Health
+	if err := c.validateSignerHealth(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Incidents
+	if err := c.validateIncidents(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Anomaly Detection
+	if err := c.validateAnomalyDetection(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Effective Balance
+	if err := c.validateEffectiveBalance(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Credential Monitor
+	if err := c.validateCredentialMonitor(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Fee Recipient
+	if err := c.validateFeeRecipient(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Execution
+	if err := c.validateExecution(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Discovery
+	if err := c.validateDiscovery(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Validator Status
+	if err := c.validateValidatorStatus(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Deposit Monitor
+	if err := c.validateDepositMonitor(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate Light Client
+	if err := c.validateLightClient(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
 	if len(errors) > 0 {
 		return fmt.Errorf("configuration validation errors:\n  - %s",
 			strings.Join(errors, "\n  - "))
@@ -152,6 +265,477 @@ func (c *Config) validateBeaconChain() error {
 	return nil
 }
 
+func (c *Config) validateGapRepair() error {
+	if !c.GapRepair.Enabled {
+		return nil
+	}
+
+	if c.GapRepair.Interval <= 0 {
+		return fmt.Errorf("GAP_REPAIR_INTERVAL must be positive, got: %v", c.GapRepair.Interval)
+	}
+	if c.GapRepair.WindowEpochs <= 0 {
+		return fmt.Errorf("GAP_REPAIR_WINDOW_EPOCHS must be positive, got: %d", c.GapRepair.WindowEpochs)
+	}
+	if c.GapRepair.MaxAttempts <= 0 {
+		return fmt.Errorf("GAP_REPAIR_MAX_ATTEMPTS must be positive, got: %d", c.GapRepair.MaxAttempts)
+	}
+	if c.GapRepair.MaxRepairsPerRun <= 0 {
+		return fmt.Errorf("GAP_REPAIR_MAX_REPAIRS_PER_RUN must be positive, got: %d", c.GapRepair.MaxRepairsPerRun)
+	}
+
+	return nil
+}
+
+func (c *Config) validateSpool() error {
+	if !c.Spool.Enabled {
+		return nil
+	}
+
+	if c.Spool.Dir == "" {
+		return fmt.Errorf("SPOOL_DIR is required when SPOOL_ENABLED is true")
+	}
+	if c.Spool.MaxSegmentMB <= 0 {
+		return fmt.Errorf("SPOOL_MAX_SEGMENT_MB must be positive, got: %d", c.Spool.MaxSegmentMB)
+	}
+	if c.Spool.ReplayInterval <= 0 {
+		return fmt.Errorf("SPOOL_REPLAY_INTERVAL must be positive, got: %v", c.Spool.ReplayInterval)
+	}
+
+	return nil
+}
+
+func (c *Config) validateRewardsLedger() error {
+	if !c.RewardsLedger.Enabled {
+		return nil
+	}
+
+	if c.RewardsLedger.Interval <= 0 {
+		return fmt.Errorf("REWARDS_LEDGER_INTERVAL must be positive, got: %v", c.RewardsLedger.Interval)
+	}
+	if c.RewardsLedger.LookbackEpochs <= 0 {
+		return fmt.Errorf("REWARDS_LEDGER_LOOKBACK_EPOCHS must be positive, got: %d", c.RewardsLedger.LookbackEpochs)
+	}
+	if c.RewardsLedger.MaxEpochsPerRun <= 0 {
+		return fmt.Errorf("REWARDS_LEDGER_MAX_EPOCHS_PER_RUN must be positive, got: %d", c.RewardsLedger.MaxEpochsPerRun)
+	}
+
+	return nil
+}
+
+func (c *Config) validateIncome() error {
+	if _, err := models.ParseIncomeWindows(c.Income.Windows); err != nil {
+		return fmt.Errorf("INCOME_WINDOWS is invalid: %w", err)
+	}
+	if _, err := models.IncomeWindow(c.Income.SnapshotWindow).Duration(); err != nil {
+		return fmt.Errorf("INCOME_SNAPSHOT_WINDOW is invalid: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Config) validateNetworkRank() error {
+	if !c.NetworkRank.Enabled {
+		return nil
+	}
+
+	if c.NetworkRank.Interval <= 0 {
+		return fmt.Errorf("NETWORK_RANK_INTERVAL must be positive, got: %v", c.NetworkRank.Interval)
+	}
+	if c.NetworkRank.SampleSize <= 0 {
+		return fmt.Errorf("NETWORK_RANK_SAMPLE_SIZE must be positive, got: %d", c.NetworkRank.SampleSize)
+	}
+	if c.NetworkRank.SizeRefresh <= 0 {
+		return fmt.Errorf("NETWORK_RANK_SIZE_REFRESH must be positive, got: %v", c.NetworkRank.SizeRefresh)
+	}
+
+	return nil
+}
+
+func (c *Config) validateAttestationAnalysis() error {
+	if !c.AttestationAnalysis.Enabled {
+		return nil
+	}
+
+	if c.AttestationAnalysis.Interval <= 0 {
+		return fmt.Errorf("ATTESTATION_ANALYSIS_INTERVAL must be positive, got: %v", c.AttestationAnalysis.Interval)
+	}
+	if c.AttestationAnalysis.LookbackEpochs <= 0 {
+		return fmt.Errorf("ATTESTATION_ANALYSIS_LOOKBACK_EPOCHS must be positive, got: %d", c.AttestationAnalysis.LookbackEpochs)
+	}
+	if c.AttestationAnalysis.MaxEpochsPerRun <= 0 {
+		return fmt.Errorf("ATTESTATION_ANALYSIS_MAX_EPOCHS_PER_RUN must be positive, got: %d", c.AttestationAnalysis.MaxEpochsPerRun)
+	}
+
+	return nil
+}
+
+func (c *Config) validateProposalAnalysis() error {
+	if !c.ProposalAnalysis.Enabled {
+		return nil
+	}
+
+	if c.ProposalAnalysis.Interval <= 0 {
+		return fmt.Errorf("PROPOSAL_ANALYSIS_INTERVAL must be positive, got: %v", c.ProposalAnalysis.Interval)
+	}
+	if c.ProposalAnalysis.LookbackEpochs <= 0 {
+		return fmt.Errorf("PROPOSAL_ANALYSIS_LOOKBACK_EPOCHS must be positive, got: %d", c.ProposalAnalysis.LookbackEpochs)
+	}
+	if c.ProposalAnalysis.LateThreshold <= 0 || c.ProposalAnalysis.LateThreshold >= 12*time.Second {
+		return fmt.Errorf("PROPOSAL_ANALYSIS_LATE_THRESHOLD must be between 0 and 12s, got: %v", c.ProposalAnalysis.LateThreshold)
+	}
+
+	return nil
+}
+
+func (c *Config) validateRelays() error {
+	for _, relay := range c.Relays.URLs {
+		u, err := url.Parse(relay)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("MEV_RELAY_URLS must contain http(s) URLs, got: %s", relay)
+		}
+	}
+	if len(c.Relays.URLs) > 0 && c.Relays.Timeout <= 0 {
+		return fmt.Errorf("MEV_RELAY_TIMEOUT must be positive, got: %v", c.Relays.Timeout)
+	}
+
+	return nil
+}
+
+func (c *Config) validateRelayMonitor() error {
+	if !c.RelayMonitor.Enabled || len(c.Relays.URLs) == 0 {
+		return nil
+	}
+
+	if c.RelayMonitor.Interval <= 0 {
+		return fmt.Errorf("RELAY_MONITOR_INTERVAL must be positive, got: %v", c.RelayMonitor.Interval)
+	}
+	if c.RelayMonitor.LookbackEpochs <= 0 {
+		return fmt.Errorf("RELAY_MONITOR_LOOKBACK_EPOCHS must be positive, got: %d", c.RelayMonitor.LookbackEpochs)
+	}
+	if c.RelayMonitor.MaxSlotsPerRun <= 0 {
+		return fmt.Errorf("RELAY_MONITOR_MAX_SLOTS_PER_RUN must be positive, got: %d", c.RelayMonitor.MaxSlotsPerRun)
+	}
+	if c.RelayMonitor.ShortfallTolerance < 0 || c.RelayMonitor.ShortfallTolerance >= 1 {
+		return fmt.Errorf("RELAY_MONITOR_SHORTFALL_TOLERANCE must be between 0 and 1, got: %v", c.RelayMonitor.ShortfallTolerance)
+	}
+	if c.RelayMonitor.MaxRetries < 0 {
+		return fmt.Errorf("RELAY_MONITOR_MAX_RETRIES must not be negative, got: %d", c.RelayMonitor.MaxRetries)
+	}
+
+	return nil
+}
+
+func (c *Config) validateClientDiversity() error {
+	if !c.ClientDiversity.Enabled {
+		return nil
+	}
+
+	if c.ClientDiversity.Interval <= 0 {
+		return fmt.Errorf("CLIENT_DIVERSITY_INTERVAL must be positive, got: %v", c.ClientDiversity.Interval)
+	}
+	if c.ClientDiversity.LookbackEpochs <= 0 {
+		return fmt.Errorf("CLIENT_DIVERSITY_LOOKBACK_EPOCHS must be positive, got: %d", c.ClientDiversity.LookbackEpochs)
+	}
+	if c.ClientDiversity.SupermajorityThreshold <= 0 || c.ClientDiversity.SupermajorityThreshold > 1 {
+		return fmt.Errorf("CLIENT_DIVERSITY_SUPERMAJORITY_THRESHOLD must be between 0 and 1, got: %v", c.ClientDiversity.SupermajorityThreshold)
+	}
+	if c.ClientDiversity.FleetThreshold <= 0 || c.ClientDiversity.FleetThreshold > 1 {
+		return fmt.Errorf("CLIENT_DIVERSITY_FLEET_THRESHOLD must be between 0 and 1, got: %v", c.ClientDiversity.FleetThreshold)
+	}
+	// The job fetches no blocks itself; it fingerprints those a job scanning every block fetches
+	if !c.CredentialMonitor.Enabled && !c.DepositMonitor.Enabled && !c.RewardsLedger.Enabled {
+		return fmt.Errorf("CLIENT_DIVERSITY_ENABLED needs CREDENTIAL_MONITOR_ENABLED, DEPOSIT_MONITOR_ENABLED or REWARDS_LEDGER_ENABLED, which fetch the blocks it fingerprints")
+	}
+
+	return nil
+}
+
+func (c *Config) validateSignerHealth() error {
+	if !c.SignerHealth.Enabled || len(c.SignerHealth.Endpoints) == 0 {
+		return nil
+	}
+
+	if c.SignerHealth.Interval <= 0 {
+		return fmt.Errorf("SIGNER_HEALTH_INTERVAL must be positive, got: %v", c.SignerHealth.Interval)
+	}
+	if c.SignerHealth.Timeout <= 0 {
+		return fmt.Errorf("SIGNER_HEALTH_TIMEOUT must be positive, got: %v", c.SignerHealth.Timeout)
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range c.SignerHealth.Endpoints {
+		name, endpoint, ok := strings.Cut(entry, "=")
+		kind, rawURL, ok2 := strings.Cut(endpoint, "@")
+		if !ok || !ok2 || trim(name) == "" {
+			return fmt.Errorf("SIGNER_HEALTH_ENDPOINTS entries must have the form name=kind@url, got: %s", entry)
+		}
+		switch types.SignerKind(trim(kind)) {
+		case types.SignerKindMetrics, types.SignerKindKeymanager, types.SignerKindWeb3Signer:
+		default:
+			return fmt.Errorf("SIGNER_HEALTH_ENDPOINTS kind must be metrics, keymanager or web3signer, got: %s", kind)
+		}
+		u, err := url.Parse(trim(rawURL))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("SIGNER_HEALTH_ENDPOINTS must contain http(s) URLs, got: %s", rawURL)
+		}
+		key := trim(name) + "=" + trim(kind)
+		if seen[key] {
+			return fmt.Errorf("SIGNER_HEALTH_ENDPOINTS has more than one %s endpoint named %s", trim(kind), trim(name))
+		}
+		seen[key] = true
+	}
+	for _, entry := range c.SignerHealth.Tokens {
+		if name, token, ok := strings.Cut(entry, "="); !ok || trim(name) == "" || trim(token) == "" {
+			return fmt.Errorf("SIGNER_HEALTH_TOKENS entries must have the form name=token")
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) validateIncidents() error {
+	if !c.Incidents.Enabled {
+		return nil
+	}
+
+	if c.Incidents.Interval <= 0 {
+		return fmt.Errorf("INCIDENTS_INTERVAL must be positive, got: %v", c.Incidents.Interval)
+	}
+	if c.Incidents.Window <= 0 {
+		return fmt.Errorf("INCIDENTS_WINDOW must be positive, got: %v", c.Incidents.Window)
+	}
+	if c.Incidents.MinAlerts < 2 {
+		return fmt.Errorf("INCIDENTS_MIN_ALERTS must be at least 2, got: %d", c.Incidents.MinAlerts)
+	}
+	if c.Incidents.Lookback < c.Incidents.Window {
+		return fmt.Errorf("INCIDENTS_LOOKBACK must be at least INCIDENTS_WINDOW, got: %v", c.Incidents.Lookback)
+	}
+
+	return nil
+}
+
+func (c *Config) validateAnomalyDetection() error {
+	if !c.AnomalyDetection.Enabled {
+		return nil
+	}
+
+	if c.AnomalyDetection.Interval <= 0 {
+		return fmt.Errorf("ANOMALY_DETECTION_INTERVAL must be positive, got: %v", c.AnomalyDetection.Interval)
+	}
+	if c.AnomalyDetection.RecentWindow < time.Hour {
+		return fmt.Errorf("ANOMALY_DETECTION_RECENT_WINDOW must be at least 1h, got: %v", c.AnomalyDetection.RecentWindow)
+	}
+	if c.AnomalyDetection.Lookback <= c.AnomalyDetection.RecentWindow {
+		return fmt.Errorf("ANOMALY_DETECTION_LOOKBACK must be longer than ANOMALY_DETECTION_RECENT_WINDOW, got: %v", c.AnomalyDetection.Lookback)
+	}
+	if c.AnomalyDetection.Alpha <= 0 || c.AnomalyDetection.Alpha >= 1 {
+		return fmt.Errorf("ANOMALY_DETECTION_ALPHA must be between 0 and 1, got: %v", c.AnomalyDetection.Alpha)
+	}
+	if c.AnomalyDetection.Threshold <= 0 {
+		return fmt.Errorf("ANOMALY_DETECTION_THRESHOLD must be positive, got: %v", c.AnomalyDetection.Threshold)
+	}
+	if c.AnomalyDetection.MinSamples <= 0 {
+		return fmt.Errorf("ANOMALY_DETECTION_MIN_SAMPLES must be positive, got: %d", c.AnomalyDetection.MinSamples)
+	}
+
+	return nil
+}
+
+func (c *Config) validateEffectiveBalance() error {
+	if !c.EffectiveBalance.Enabled {
+		return nil
+	}
+
+	if c.EffectiveBalance.Interval <= 0 {
+		return fmt.Errorf("EFFECTIVE_BALANCE_INTERVAL must be positive, got: %v", c.EffectiveBalance.Interval)
+	}
+	if c.EffectiveBalance.LookbackEpochs <= 0 {
+		return fmt.Errorf("EFFECTIVE_BALANCE_LOOKBACK_EPOCHS must be positive, got: %d", c.EffectiveBalance.LookbackEpochs)
+	}
+
+	return nil
+}
+
+// executionAddressPattern matches a hex-encoded execution layer address
+var executionAddressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+func (c *Config) validateCredentialMonitor() error {
+	if !c.CredentialMonitor.Enabled {
+		return nil
+	}
+
+	if c.CredentialMonitor.Interval <= 0 {
+		return fmt.Errorf("CREDENTIAL_MONITOR_INTERVAL must be positive, got: %v", c.CredentialMonitor.Interval)
+	}
+	if c.CredentialMonitor.LookbackEpochs <= 0 {
+		return fmt.Errorf("CREDENTIAL_MONITOR_LOOKBACK_EPOCHS must be positive, got: %d", c.CredentialMonitor.LookbackEpochs)
+	}
+	if c.CredentialMonitor.MaxSlotsPerRun <= 0 {
+		return fmt.Errorf("CREDENTIAL_MONITOR_MAX_SLOTS_PER_RUN must be positive, got: %d", c.CredentialMonitor.MaxSlotsPerRun)
+	}
+	return validateAddressGroups("CREDENTIAL_MONITOR_ALLOWLIST", c.CredentialMonitor.Allowlist)
+}
+
+func (c *Config) validateFeeRecipient() error {
+	if !c.FeeRecipient.Enabled {
+		return nil
+	}
+
+	if c.FeeRecipient.Interval <= 0 {
+		return fmt.Errorf("FEE_RECIPIENT_INTERVAL must be positive, got: %v", c.FeeRecipient.Interval)
+	}
+	if c.FeeRecipient.LookbackEpochs <= 0 {
+		return fmt.Errorf("FEE_RECIPIENT_LOOKBACK_EPOCHS must be positive, got: %d", c.FeeRecipient.LookbackEpochs)
+	}
+	if c.FeeRecipient.MaxSlotsPerRun <= 0 {
+		return fmt.Errorf("FEE_RECIPIENT_MAX_SLOTS_PER_RUN must be positive, got: %d", c.FeeRecipient.MaxSlotsPerRun)
+	}
+	return validateAddressGroups("FEE_RECIPIENT_EXPECTED", c.FeeRecipient.Expected)
+}
+
+// validateAddressGroups checks that entries have the form tag=0xaddress|0xaddress
+func validateAddressGroups(name string, entries []string) error {
+	for _, entry := range entries {
+		group, addresses, ok := strings.Cut(entry, "=")
+		if !ok || trim(group) == "" {
+			return fmt.Errorf("%s entries must have the form tag=0xaddress|0xaddress, got: %s", name, entry)
+		}
+		for _, address := range splitAndTrim(addresses, "|") {
+			if !executionAddressPattern.MatchString(address) {
+				return fmt.Errorf("%s must contain execution addresses, got: %s", name, address)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) validateExecution() error {
+	if c.Execution.NodeURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(c.Execution.NodeURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("EXECUTION_NODE_URL must be an http(s) URL, got: %s", c.Execution.NodeURL)
+	}
+	if c.Execution.Timeout <= 0 {
+		return fmt.Errorf("EXECUTION_TIMEOUT must be positive, got: %v", c.Execution.Timeout)
+	}
+	if !executionAddressPattern.MatchString(c.Execution.DepositContract) {
+		return fmt.Errorf("DEPOSIT_CONTRACT_ADDRESS must be an execution address, got: %s", c.Execution.DepositContract)
+	}
+
+	return nil
+}
+
+func (c *Config) validateDiscovery() error {
+	if !c.Discovery.Enabled {
+		return nil
+	}
+
+	if c.Discovery.Interval <= 0 {
+		return fmt.Errorf("DISCOVERY_INTERVAL must be positive, got: %v", c.Discovery.Interval)
+	}
+	if c.Discovery.LookbackEpochs <= 0 {
+		return fmt.Errorf("DISCOVERY_LOOKBACK_EPOCHS must be positive, got: %d", c.Discovery.LookbackEpochs)
+	}
+	if c.Discovery.MaxSlotsPerRun <= 0 {
+		return fmt.Errorf("DISCOVERY_MAX_SLOTS_PER_RUN must be positive, got: %d", c.Discovery.MaxSlotsPerRun)
+	}
+	if c.Discovery.DepositStartBlock < 0 {
+		return fmt.Errorf("DISCOVERY_DEPOSIT_START_BLOCK must not be negative, got: %d", c.Discovery.DepositStartBlock)
+	}
+	if c.Discovery.MaxBlocksPerRun <= 0 {
+		return fmt.Errorf("DISCOVERY_MAX_BLOCKS_PER_RUN must be positive, got: %d", c.Discovery.MaxBlocksPerRun)
+	}
+	if c.Discovery.LogChunkBlocks <= 0 {
+		return fmt.Errorf("DISCOVERY_LOG_CHUNK_BLOCKS must be positive, got: %d", c.Discovery.LogChunkBlocks)
+	}
+
+	return nil
+}
+
+func (c *Config) validateValidatorStatus() error {
+	if !c.ValidatorStatus.Enabled {
+		return nil
+	}
+
+	if c.ValidatorStatus.Interval <= 0 {
+		return fmt.Errorf("VALIDATOR_STATUS_INTERVAL must be positive, got: %v", c.ValidatorStatus.Interval)
+	}
+
+	return nil
+}
+
+func (c *Config) validateDepositMonitor() error {
+	if !c.DepositMonitor.Enabled {
+		return nil
+	}
+
+	if c.DepositMonitor.Interval <= 0 {
+		return fmt.Errorf("DEPOSIT_MONITOR_INTERVAL must be positive, got: %v", c.DepositMonitor.Interval)
+	}
+	if c.DepositMonitor.LookbackEpochs <= 0 {
+		return fmt.Errorf("DEPOSIT_MONITOR_LOOKBACK_EPOCHS must be positive, got: %d", c.DepositMonitor.LookbackEpochs)
+	}
+	if c.DepositMonitor.MaxSlotsPerRun <= 0 {
+		return fmt.Errorf("DEPOSIT_MONITOR_MAX_SLOTS_PER_RUN must be positive, got: %d", c.DepositMonitor.MaxSlotsPerRun)
+	}
+
+	return nil
+}
+
+var blockRootPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+func (c *Config) validateLightClient() error {
+	if !c.LightClient.Enabled {
+		return nil
+	}
+
+	if !blockRootPattern.MatchString(c.LightClient.TrustedCheckpoint) {
+		return fmt.Errorf("LIGHT_CLIENT_TRUSTED_CHECKPOINT must be a block root, got: %q", c.LightClient.TrustedCheckpoint)
+	}
+	if c.LightClient.Interval <= 0 {
+		return fmt.Errorf("LIGHT_CLIENT_INTERVAL must be positive, got: %v", c.LightClient.Interval)
+	}
+	if c.LightClient.AncestorDepth < 0 {
+		return fmt.Errorf("LIGHT_CLIENT_ANCESTOR_DEPTH must not be negative, got: %d", c.LightClient.AncestorDepth)
+	}
+
+	return nil
+}
+
+func (c *Config) validateCircuitBreaker() error {
+	components := []struct {
+		prefix     string
+		thresholds BreakerThresholds
+	}{
+		{"CIRCUIT_BEACON", c.CircuitBreaker.Beacon},
+		{"CIRCUIT_DATABASE", c.CircuitBreaker.Database},
+		{"CIRCUIT_CACHE", c.CircuitBreaker.Cache},
+	}
+
+	var errors []string
+	for _, comp := range components {
+		if comp.thresholds.ErrorThreshold <= 0 {
+			errors = append(errors, fmt.Sprintf("%s_ERROR_THRESHOLD must be positive, got: %d", comp.prefix, comp.thresholds.ErrorThreshold))
+		}
+		if comp.thresholds.ErrorWindow <= 0 {
+			errors = append(errors, fmt.Sprintf("%s_ERROR_WINDOW must be positive, got: %v", comp.prefix, comp.thresholds.ErrorWindow))
+		}
+		if comp.thresholds.OpenTimeout <= 0 {
+			errors = append(errors, fmt.Sprintf("%s_OPEN_TIMEOUT must be positive, got: %v", comp.prefix, comp.thresholds.OpenTimeout))
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("circuit breaker config errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
 func (c *Config) validateMonitoring() error {
 	if c.Monitoring.PrometheusPort == "" {
 		return fmt.Errorf("PROMETHEUS_PORT is required")
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// AnomalyMetric names an hourly performance series checked for anomalies
+type AnomalyMetric string
+
+const (
+	AnomalyMetricEffectiveness     AnomalyMetric = "effectiveness"      // Mean attestation effectiveness (0-100)
+	AnomalyMetricInclusionDelay    AnomalyMetric = "inclusion_delay"    // Mean attestation inclusion delay in slots
+	AnomalyMetricAttestationIncome AnomalyMetric = "attestation_income" // Mean attestation rewards less attestation penalties per epoch, in Gwei
+)
+
+// AnomalyMetrics lists every metric checked for anomalies
+var AnomalyMetrics = []AnomalyMetric{
+	AnomalyMetricEffectiveness,
+	AnomalyMetricInclusionDelay,
+	AnomalyMetricAttestationIncome,
+}
+
+// HigherIsWorse reports whether an increase in the metric means worse performance
+func (m AnomalyMetric) HigherIsWorse() bool {
+	return m == AnomalyMetricInclusionDelay
+}
+
+// Description returns a human readable name for the metric
+func (m AnomalyMetric) Description() string {
+	switch m {
+	case AnomalyMetricEffectiveness:
+		return "attestation effectiveness"
+	case AnomalyMetricInclusionDelay:
+		return "inclusion delay"
+	case AnomalyMetricAttestationIncome:
+		return "attestation income"
+	default:
+		return string(m)
+	}
+}
+
+// PerformanceSample holds one hour of a validator's performance series. A field is nil when
+// the hour has no snapshot or ledger data for it.
+type PerformanceSample struct {
+	Time              time.Time `db:"bucket"` // Start of the hour
+	Effectiveness     *float64  `db:"effectiveness"`
+	InclusionDelay    *float64  `db:"inclusion_delay"`
+	AttestationIncome *float64  `db:"attestation_income"`
+}
+
+// Value returns the sample's value for a metric, or false if the hour has none
+func (s *PerformanceSample) Value(metric AnomalyMetric) (float64, bool) {
+	var v *float64
+	switch metric {
+	case AnomalyMetricEffectiveness:
+		v = s.Effectiveness
+	case AnomalyMetricInclusionDelay:
+		v = s.InclusionDelay
+	case AnomalyMetricAttestationIncome:
+		v = s.AttestationIncome
+	}
+	if v == nil {
+		return 0, false
+	}
+	return *v, true
+}
+
+// PerformanceAnomaly is a recent window of a validator's or tag group's performance that deviated
+// from its own baseline in the direction of worse performance
+type PerformanceAnomaly struct {
+	ValidatorIndex *int64        `db:"validator_index"` // Set for validator anomalies
+	Tag            *string       `db:"tag"`             // Set for tag group anomalies
+	Metric         AnomalyMetric `db:"metric"`
+	WindowStart    time.Time     `db:"window_start"`
+	WindowEnd      time.Time     `db:"window_end"`
+	Baseline       float64       `db:"baseline"`  // Exponentially weighted mean before the window
+	Deviation      float64       `db:"deviation"` // Exponentially weighted standard deviation before the window
+	Observed       float64       `db:"observed"`  // Mean over the window
+	ZScore         float64       `db:"z_score"`
+}
+
+// Summary returns a one-line description of the anomaly, suitable for an alert message
+func (a *PerformanceAnomaly) Summary() string {
+	subject := "Validator"
+	if a.ValidatorIndex != nil {
+		subject = fmt.Sprintf("Validator %d", *a.ValidatorIndex)
+	} else if a.Tag != nil {
+		subject = fmt.Sprintf("Validators tagged %s", *a.Tag)
+	}
+	return fmt.Sprintf("%s: %s over the last %.0fh was %.2f against a baseline of %.2f (z-score %.1f)",
+		subject, a.Metric.Description(), a.WindowEnd.Sub(a.WindowStart).Hours(), a.Observed, a.Baseline, a.ZScore)
+}
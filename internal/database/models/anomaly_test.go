@@ -0,0 +1,44 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPerformanceAnomalySummary tests the anomaly description used in alerts
+func TestPerformanceAnomalySummary(t *testing.T) {
+	end := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tag := "node:alpha"
+
+	drift := &PerformanceAnomaly{
+		ValidatorIndex: ptrInt64(42),
+		Metric:         AnomalyMetricEffectiveness,
+		WindowStart:    end.Add(-6 * time.Hour),
+		WindowEnd:      end,
+		Baseline:       98.1,
+		Observed:       93.25,
+		ZScore:         -4.85,
+	}
+	want := "Validator 42: attestation effectiveness over the last 6h was 93.25 against a baseline of 98.10 (z-score -4.8)"
+	if got := drift.Summary(); got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+
+	group := &PerformanceAnomaly{
+		Tag:         &tag,
+		Metric:      AnomalyMetricInclusionDelay,
+		WindowStart: end.Add(-6 * time.Hour),
+		WindowEnd:   end,
+		Baseline:    1.02,
+		Observed:    1.6,
+		ZScore:      5.8,
+	}
+	want = "Validators tagged node:alpha: inclusion delay over the last 6h was 1.60 against a baseline of 1.02 (z-score 5.8)"
+	if got := group.Summary(); got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+
+	if !AnomalyMetricInclusionDelay.HigherIsWorse() || AnomalyMetricAttestationIncome.HigherIsWorse() {
+		t.Error("only a rising inclusion delay should count as worse")
+	}
+}
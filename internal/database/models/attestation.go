@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// AttestationMissReason classifies why an attestation earned less than the ideal reward
+type AttestationMissReason string
+
+const (
+	AttestationMissNotIncluded   AttestationMissReason = "not_included"   // Never included on chain
+	AttestationMissWrongTarget   AttestationMissReason = "wrong_target"   // Voted for a non-canonical target checkpoint
+	AttestationMissWrongHead     AttestationMissReason = "wrong_head"     // Voted for a non-canonical head block
+	AttestationMissLateInclusion AttestationMissReason = "late_inclusion" // Skipped by blocks that could have included it
+	AttestationMissMissedBlock   AttestationMissReason = "missed_block"   // Late only because the following proposers missed their blocks
+)
+
+// MissBlame is the party most likely responsible for a suboptimal duty
+type MissBlame string
+
+const (
+	MissBlameNode    MissBlame = "node"    // Our validator client or beacon node
+	MissBlameNetwork MissBlame = "network" // Propagation between our node and the rest of the network
+	MissBlameChain   MissBlame = "chain"   // Other participants, such as a proposer missing its slot
+)
+
+// Blame returns the party most likely responsible for a miss of this kind. A vote for the wrong
+// target means our node was out of sync; a wrong head or late inclusion means our view or our
+// attestation reached the network too late.
+func (r AttestationMissReason) Blame() MissBlame {
+	switch r {
+	case AttestationMissNotIncluded, AttestationMissWrongTarget:
+		return MissBlameNode
+	case AttestationMissWrongHead, AttestationMissLateInclusion:
+		return MissBlameNetwork
+	default:
+		return MissBlameChain
+	}
+}
+
+// AttestationMiss records the root cause of a validator's suboptimal attestation in an epoch
+type AttestationMiss struct {
+	ValidatorIndex int64                 `db:"validator_index"`
+	Epoch          int64                 `db:"epoch"`
+	Time           time.Time             `db:"time"`
+	Slot           int64                 `db:"slot"` // Duty slot
+	Reason         AttestationMissReason `db:"reason"`
+	InclusionSlot  *int64                `db:"inclusion_slot"` // Nil when never included
+	InclusionDelay *int32                `db:"inclusion_delay"`
+}
+
+// AttestationMissCount is the number of suboptimal attestations with one root cause
+type AttestationMissCount struct {
+	Reason AttestationMissReason `db:"reason"`
+	Count  int64                 `db:"count"`
+}
+
+// Blame returns the party most likely responsible for misses with this root cause
+func (c AttestationMissCount) Blame() MissBlame {
+	return c.Reason.Blame()
+}
+
+// NetworkEpochStats summarises the attestation reward scores of a random sample of active
+// network validators for one epoch. Scores are rewards as a percentage of the ideal.
+type NetworkEpochStats struct {
+	Epoch              int64     `db:"epoch"`
+	Time               time.Time `db:"time"`
+	SampleSize         int32     `db:"sample_size"`
+	AverageScore       float64   `db:"average_score"`
+	MedianScore        float64   `db:"median_score"`
+	ActiveValidators   int64     `db:"active_validators"`    // Estimated from the sample; 0 if unknown
+	TotalActiveBalance int64     `db:"total_active_balance"` // Estimated effective balance of active validators in Gwei; 0 if unknown
+}
+
+// ValidatorNetworkRank is a monitored validator's attestation reward score for an epoch and
+// its percentile rank against the network sample
+type ValidatorNetworkRank struct {
+	ValidatorIndex int64     `db:"validator_index"`
+	Epoch          int64     `db:"epoch"`
+	Time           time.Time `db:"time"`
+	Score          float64   `db:"score"`
+	Percentile     float64   `db:"percentile"` // Percentage of the sample scoring at or below Score
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// AdminActionType identifies an operator action taken through the admin control plane
+type AdminActionType string
+
+const (
+	AdminActionPauseCollector  AdminActionType = "pause_collector"
+	AdminActionResumeCollector AdminActionType = "resume_collector"
+	AdminActionDrainPool       AdminActionType = "drain_pool"
+	AdminActionRecollect       AdminActionType = "recollect"
+)
+
+// AdminAction is an audit trail entry for an operator action
+type AdminAction struct {
+	ID         int64           `db:"id"`
+	Actor      string          `db:"actor"`       // Username, or user ID when no username is known
+	AuthMethod string          `db:"auth_method"` // session, jwt or api_key
+	Action     AdminActionType `db:"action"`
+	Params     JSONB           `db:"params"`
+	Success    bool            `db:"success"`
+	Error      *string         `db:"error"`
+	CreatedAt  time.Time       `db:"created_at"`
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// BlockClient is the consensus client identified from the graffiti of a canonical block
+type BlockClient struct {
+	Slot            int64                 `db:"slot"`
+	Epoch           int64                 `db:"epoch"`
+	ProposerIndex   int64                 `db:"proposer_index"`
+	Time            time.Time             `db:"time"` // Slot start
+	Graffiti        string                `db:"graffiti"`
+	Client          types.ConsensusClient `db:"client"`
+	Version         string                `db:"version"`
+	ExecutionClient string                `db:"execution_client"`
+}
+
+// ClientShare is the number and fraction of identified blocks or validators on a consensus client
+type ClientShare struct {
+	Client types.ConsensusClient
+	Count  int64
+	Share  float64 // Fraction of the identified blocks or validators
+}
+
+// ClientDistribution is how a set of blocks or validators is spread over consensus clients
+type ClientDistribution struct {
+	Total      int64         // Blocks or validators
+	Identified int64         // Those whose client is known
+	Shares     []ClientShare // Identified clients, largest first
+}
+
+// NewClientDistribution builds the distribution of the given counts per client
+func NewClientDistribution(counts map[types.ConsensusClient]int64) *ClientDistribution {
+	d := &ClientDistribution{Shares: []ClientShare{}}
+	for client, count := range counts {
+		d.Total += count
+		if client != types.ClientUnknown {
+			d.Identified += count
+			d.Shares = append(d.Shares, ClientShare{Client: client, Count: count})
+		}
+	}
+
+	for i := range d.Shares {
+		d.Shares[i].Share = float64(d.Shares[i].Count) / float64(d.Identified)
+	}
+	sort.Slice(d.Shares, func(a, b int) bool {
+		if d.Shares[a].Count != d.Shares[b].Count {
+			return d.Shares[a].Count > d.Shares[b].Count
+		}
+		return d.Shares[a].Client < d.Shares[b].Client
+	})
+	return d
+}
+
+// Dominant returns the client with the largest share, or false when no client was identified
+func (d *ClientDistribution) Dominant() (ClientShare, bool) {
+	if len(d.Shares) == 0 {
+		return ClientShare{}, false
+	}
+	return d.Shares[0], true
+}
+
+// ShareOf returns the share of a client, or zero when it was not seen
+func (d *ClientDistribution) ShareOf(client types.ConsensusClient) float64 {
+	for _, s := range d.Shares {
+		if s.Client == client {
+			return s.Share
+		}
+	}
+	return 0
+}
+
+// EpochClientDiversity is the client distribution of the canonical blocks in an epoch
+type EpochClientDiversity struct {
+	Epoch        int64
+	Time         time.Time // Epoch start
+	Distribution *ClientDistribution
+}
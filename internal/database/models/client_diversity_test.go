@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+func TestClientDistribution(t *testing.T) {
+	d := NewClientDistribution(map[types.ConsensusClient]int64{
+		types.ClientLighthouse: 30,
+		types.ClientPrysm:      10,
+		types.ClientTeku:       10,
+		types.ClientUnknown:    50,
+	})
+	if d.Total != 100 || d.Identified != 50 {
+		t.Errorf("Total, Identified = %d, %d, want 100, 50", d.Total, d.Identified)
+	}
+	if len(d.Shares) != 3 || d.Shares[1].Client != types.ClientPrysm {
+		t.Errorf("Shares = %+v, want identified clients largest first, ties by name", d.Shares)
+	}
+	if dominant, ok := d.Dominant(); !ok || dominant.Client != types.ClientLighthouse || dominant.Share != 0.6 {
+		t.Errorf("Dominant() = %+v, %v, want lighthouse with 0.6", dominant, ok)
+	}
+	if got := d.ShareOf(types.ClientNimbus); got != 0 {
+		t.Errorf("ShareOf(nimbus) = %v, want 0", got)
+	}
+
+	empty := NewClientDistribution(map[types.ConsensusClient]int64{types.ClientUnknown: 4})
+	if _, ok := empty.Dominant(); ok {
+		t.Error("Dominant() should be false when no client was identified")
+	}
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ConsolidationRequest is an EIP-7251 consolidation request included in a block that names a
+// monitored validator as its source or target
+type ConsolidationRequest struct {
+	Slot          int64     `db:"slot"`
+	Time          time.Time `db:"time"`
+	SourceAddress string    `db:"source_address"`
+	SourcePubkey  string    `db:"source_pubkey"`
+	TargetPubkey  string    `db:"target_pubkey"`
+	SourceIndex   *int64    `db:"source_index"` // Set when the source is a monitored validator
+	TargetIndex   *int64    `db:"target_index"` // Set when the target is a monitored validator
+}
+
+// IsSwitchToCompounding reports whether the request converts its source to compounding
+// credentials rather than merging it into another validator
+func (c *ConsolidationRequest) IsSwitchToCompounding() bool {
+	return strings.EqualFold(c.SourcePubkey, c.TargetPubkey)
+}
+
+// Internal reports whether both the source and the target are monitored validators
+func (c *ConsolidationRequest) Internal() bool {
+	return c.SourceIndex != nil && c.TargetIndex != nil
+}
+
+// Summary returns a one-line description of the request
+func (c *ConsolidationRequest) Summary() string {
+	if c.IsSwitchToCompounding() {
+		return "Requested switch to compounding (0x02) credentials"
+	}
+	return fmt.Sprintf("Requested consolidation of %s into %s",
+		consolidationParty(c.SourceIndex, c.SourcePubkey), consolidationParty(c.TargetIndex, c.TargetPubkey))
+}
+
+// consolidationParty names a monitored validator by index and any other by its public key prefix
+func consolidationParty(index *int64, pubkey string) string {
+	if index != nil {
+		return fmt.Sprintf("validator %d", *index)
+	}
+	if len(pubkey) > 12 {
+		pubkey = pubkey[:12] + "…"
+	}
+	return "external validator " + pubkey
+}
+
+// QueuedConsolidation is an entry in the beacon state's consolidation queue whose source is a
+// monitored validator
+type QueuedConsolidation struct {
+	SourceIndex int64     `db:"source_index"`
+	TargetIndex int64     `db:"target_index"`
+	FirstSeen   time.Time `db:"first_seen"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import "testing"
+
+func TestConsolidationRequestSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  ConsolidationRequest
+		internal bool
+		want     string
+	}{
+		{
+			name:     "switch to compounding",
+			request:  ConsolidationRequest{SourcePubkey: "0xAA", TargetPubkey: "0xaa", SourceIndex: ptrInt64(5), TargetIndex: ptrInt64(5)},
+			internal: true,
+			want:     "Requested switch to compounding (0x02) credentials",
+		},
+		{
+			name:     "between monitored validators",
+			request:  ConsolidationRequest{SourcePubkey: "0xaa", TargetPubkey: "0xbb", SourceIndex: ptrInt64(5), TargetIndex: ptrInt64(7)},
+			internal: true,
+			want:     "Requested consolidation of validator 5 into validator 7",
+		},
+		{
+			name:    "into an external validator",
+			request: ConsolidationRequest{SourcePubkey: "0xaa", TargetPubkey: "0x8f3c2d1e0b9a7766", SourceIndex: ptrInt64(5)},
+			want:    "Requested consolidation of validator 5 into external validator 0x8f3c2d1e0b…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.request.Summary(); got != tt.want {
+				t.Errorf("Summary() = %q, want %q", got, tt.want)
+			}
+			if got := tt.request.Internal(); got != tt.internal {
+				t.Errorf("Internal() = %v, want %v", got, tt.internal)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CredentialChange is a BLS to execution change of a monitored validator's withdrawal credentials
+type CredentialChange struct {
+	ValidatorIndex     int64     `db:"validator_index"`
+	Slot               int64     `db:"slot"`
+	Time               time.Time `db:"time"`
+	FromBLSPubkey      string    `db:"from_bls_pubkey"`
+	ToExecutionAddress string    `db:"to_execution_address"`
+	Allowlisted        bool      `db:"allowlisted"` // Whether the address was allowlisted for the validator
+}
+
+// Summary returns a one-line description of the change
+func (c *CredentialChange) Summary() string {
+	summary := fmt.Sprintf("Withdrawal credentials changed to %s", c.ToExecutionAddress)
+	if !c.Allowlisted {
+		summary += ", which is not on the allowlist"
+	}
+	return summary
+}
+
+// WithdrawalCredentials returns the execution (0x01) withdrawal credentials the change sets
+func (c *CredentialChange) WithdrawalCredentials() string {
+	return "0x01" + strings.Repeat("00", 11) + strings.ToLower(strings.TrimPrefix(c.ToExecutionAddress, "0x"))
+}
+
+// WithdrawalRequest is an EIP-7002 execution layer withdrawal request for a monitored validator
+type WithdrawalRequest struct {
+	ValidatorIndex int64     `db:"validator_index"`
+	Slot           int64     `db:"slot"`
+	Time           time.Time `db:"time"`
+	SourceAddress  string    `db:"source_address"`
+	Amount         int64     `db:"amount"` // Gwei; zero requests a full exit
+}
+
+// IsFullExit reports whether the request exits the validator rather than withdrawing part of its
+// balance
+func (r *WithdrawalRequest) IsFullExit() bool {
+	return r.Amount == 0
+}
+
+// Summary returns a one-line description of the request
+func (r *WithdrawalRequest) Summary() string {
+	if r.IsFullExit() {
+		return fmt.Sprintf("Exit requested from the execution layer by %s", r.SourceAddress)
+	}
+	return fmt.Sprintf("Partial withdrawal of %.4f ETH requested from the execution layer by %s",
+		float64(r.Amount)/1e9, r.SourceAddress)
+}
+
+// QueuedWithdrawal is a monitored validator's entry in the beacon state's partial withdrawal queue
+type QueuedWithdrawal struct {
+	ValidatorIndex    int64     `db:"validator_index"`
+	WithdrawableEpoch int64     `db:"withdrawable_epoch"`
+	Amount            int64     `db:"amount"` // Gwei
+	FirstSeen         time.Time `db:"first_seen"`
+}
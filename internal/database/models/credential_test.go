@@ -0,0 +1,37 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+func TestWithdrawalRequestSummary(t *testing.T) {
+	exit := WithdrawalRequest{SourceAddress: "0xbeef"}
+	if !exit.IsFullExit() {
+		t.Error("IsFullExit() = false for a zero amount")
+	}
+	if got, want := exit.Summary(), "Exit requested from the execution layer by 0xbeef"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+
+	partial := WithdrawalRequest{SourceAddress: "0xbeef", Amount: 1_500_000_000}
+	if got, want := partial.Summary(), "Partial withdrawal of 1.5000 ETH requested from the execution layer by 0xbeef"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+}
+
+// TestCredentialChangeWithdrawalCredentials tests the execution credentials a BLS change sets
+func TestCredentialChangeWithdrawalCredentials(t *testing.T) {
+	change := &CredentialChange{ToExecutionAddress: "0x" + strings.Repeat("AB", 20)}
+
+	got := change.WithdrawalCredentials()
+	want := "0x01" + strings.Repeat("00", 11) + strings.Repeat("ab", 20)
+	if got != want {
+		t.Errorf("WithdrawalCredentials() = %s, want %s", got, want)
+	}
+	if types.CredentialTypeOf(got) != types.CredentialTypeExecution {
+		t.Errorf("CredentialTypeOf(%s) = %s, want execution", got, types.CredentialTypeOf(got))
+	}
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DepositStatus is how far a deposit to a monitored validator has progressed
+type DepositStatus string
+
+const (
+	DepositStatusPending  DepositStatus = "pending"  // Waiting in the beacon state's deposit queue
+	DepositStatusCredited DepositStatus = "credited" // Added to the validator's balance
+)
+
+// ValidatorDeposit is a deposit to a monitored validator, seen in a block or in the deposit queue.
+// Queue fields hold the deposit's place in the queue when last observed and are nil once credited.
+type ValidatorDeposit struct {
+	ID                    int64         `db:"id"`
+	ValidatorIndex        int64         `db:"validator_index"`
+	Slot                  int64         `db:"slot"` // Slot of the including block; zero when only seen in the queue
+	Time                  time.Time     `db:"time"` // Start of Slot, or when first seen in the queue
+	WithdrawalCredentials string        `db:"withdrawal_credentials"`
+	Signature             string        `db:"signature"`
+	Amount                int64         `db:"amount"` // Gwei
+	Status                DepositStatus `db:"status"`
+	QueuePosition         *int64        `db:"queue_position"`     // Zero-based position in the deposit queue
+	QueueAmountAhead      *int64        `db:"queue_amount_ahead"` // Gwei queued ahead of the deposit
+	CreditedEpoch         *int64        `db:"credited_epoch"`     // First epoch the deposit was seen to have left the queue
+	UnexpectedReason      *string       `db:"unexpected_reason"`  // Why the deposit was alerted on; nil when expected
+	ObservedAt            time.Time     `db:"observed_at"`
+}
+
+// Summary returns a one-line description of the deposit
+func (d *ValidatorDeposit) Summary() string {
+	summary := fmt.Sprintf("Deposit of %.4f ETH", float64(d.Amount)/1e9)
+	switch {
+	case d.Status == DepositStatusCredited && d.CreditedEpoch != nil:
+		summary += fmt.Sprintf(" credited by epoch %d", *d.CreditedEpoch)
+	case d.QueuePosition != nil:
+		summary += fmt.Sprintf(" queued at position %d", *d.QueuePosition+1)
+	}
+	if d.UnexpectedReason != nil {
+		summary += ": " + *d.UnexpectedReason
+	}
+	return summary
+}
+
+// DepositConcern returns why a deposit to v with the given withdrawal credentials is unexpected,
+// or an empty string when it is not. A top-up naming different credentials was built by someone
+// other than the validator's owner, and a deposit to a validator that is exiting sits in the queue
+// until the validator is withdrawable and is then swept back out.
+func (v *Validator) DepositConcern(credentials string) string {
+	if v.Status != nil && v.Status.order() >= LifecycleActiveExiting.order() {
+		return fmt.Sprintf("validator is %s", *v.Status)
+	}
+	if v.WithdrawalCredentials != nil && !sameWithdrawalTarget(*v.WithdrawalCredentials, credentials) {
+		return fmt.Sprintf("withdrawal credentials %s do not match the validator's %s", credentials, *v.WithdrawalCredentials)
+	}
+	return ""
+}
+
+// sameWithdrawalTarget reports whether two withdrawal credentials withdraw to the same place.
+// Execution credentials (0x01 and compounding 0x02) match on address alone, since a validator may
+// have switched to compounding after its deposit data was built.
+func sameWithdrawalTarget(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	execution := func(c string) bool {
+		c = strings.ToLower(c)
+		return len(c) == 66 && (strings.HasPrefix(c, "0x01") || strings.HasPrefix(c, "0x02"))
+	}
+	return execution(a) && execution(b) && strings.EqualFold(a[26:], b[26:])
+}
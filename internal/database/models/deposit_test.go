@@ -0,0 +1,38 @@
+package models
+
+import "testing"
+
+func TestValidatorDepositConcern(t *testing.T) {
+	address := "00000000000000000000000000000000000000aa"
+	eth1 := "0x010000000000000000000000" + address
+	compounding := "0x020000000000000000000000" + address
+	other := "0x010000000000000000000000" + "00000000000000000000000000000000000000bb"
+
+	v := &Validator{ValidatorIndex: 7, WithdrawalCredentials: &compounding}
+	if got := v.DepositConcern(eth1); got != "" {
+		t.Errorf("DepositConcern() = %q, want none: a switch to compounding keeps the withdrawal address", got)
+	}
+	if got, want := v.DepositConcern(other), "withdrawal credentials "+other+" do not match the validator's "+compounding; got != want {
+		t.Errorf("DepositConcern() = %q, want %q", got, want)
+	}
+
+	exited := LifecycleExitedUnslashed
+	v.Status = &exited
+	if got := v.DepositConcern(eth1); got != "validator is exited_unslashed" {
+		t.Errorf("DepositConcern() = %q for an exited validator", got)
+	}
+	if got := (&Validator{}).DepositConcern(other); got != "" {
+		t.Errorf("DepositConcern() = %q, want none with nothing to compare against", got)
+	}
+
+	epoch, position, ahead := int64(110), int64(4), int64(0)
+	reason := "validator is exited_unslashed"
+	d := &ValidatorDeposit{Amount: 1_500_000_000, QueuePosition: &position, QueueAmountAhead: &ahead}
+	if got := d.Summary(); got != "Deposit of 1.5000 ETH queued at position 5" {
+		t.Errorf("Summary() = %q", got)
+	}
+	d.Status, d.CreditedEpoch, d.UnexpectedReason = DepositStatusCredited, &epoch, &reason
+	if got := d.Summary(); got != "Deposit of 1.5000 ETH credited by epoch 110: validator is exited_unslashed" {
+		t.Errorf("Summary() = %q", got)
+	}
+}
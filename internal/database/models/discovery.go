@@ -0,0 +1,94 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// DiscoveryKind is what a discovery rule matches validators by
+type DiscoveryKind string
+
+const (
+	DiscoveryKindWithdrawal   DiscoveryKind = "withdrawal"    // Withdrawal address or full withdrawal credentials
+	DiscoveryKindFeeRecipient DiscoveryKind = "fee_recipient" // Fee recipient of blocks the validator proposed
+	DiscoveryKindDepositor    DiscoveryKind = "depositor"     // Sender of the validator's deposits
+)
+
+// DiscoveryRule enrolls every validator matching its value as a monitored validator under a tag
+type DiscoveryRule struct {
+	ID        int64         `db:"id"`
+	Kind      DiscoveryKind `db:"kind"`
+	Value     string        `db:"value"` // Lowercase hex address, or withdrawal credentials
+	Tag       string        `db:"tag"`
+	NextBlock int64         `db:"next_block"` // First execution block not yet searched, for depositor rules
+	Enrolled  int           `db:"enrolled"`   // Validators enrolled by the rule so far
+	LastRunAt *time.Time    `db:"last_run_at"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+// NewDiscoveryRule validates and normalises a discovery rule. Withdrawal rules take an execution
+// address or 32-byte withdrawal credentials; the other kinds take an execution address.
+func NewDiscoveryRule(kind DiscoveryKind, value, tag string) (*DiscoveryRule, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	tag = strings.TrimSpace(tag)
+
+	switch kind {
+	case DiscoveryKindWithdrawal:
+		if !isHex(value, 20) && !isHex(value, 32) {
+			return nil, fmt.Errorf("withdrawal rules need an execution address or withdrawal credentials, got %q", value)
+		}
+	case DiscoveryKindFeeRecipient, DiscoveryKindDepositor:
+		if !isHex(value, 20) {
+			return nil, fmt.Errorf("%s rules need an execution address, got %q", kind, value)
+		}
+	default:
+		return nil, fmt.Errorf("unknown discovery kind %q", kind)
+	}
+	if tag == "" {
+		return nil, fmt.Errorf("a tag is required")
+	}
+
+	return &DiscoveryRule{Kind: kind, Value: value, Tag: tag}, nil
+}
+
+// MatchesCredentials reports whether a withdrawal rule matches withdrawal credentials. An address
+// matches 0x01 and 0x02 credentials that withdraw to it.
+func (r *DiscoveryRule) MatchesCredentials(withdrawalCredentials string) bool {
+	if r.Kind != DiscoveryKindWithdrawal {
+		return false
+	}
+	credentials := strings.ToLower(withdrawalCredentials)
+	if len(r.Value) == len(credentials) {
+		return r.Value == credentials
+	}
+	switch types.CredentialTypeOf(credentials) {
+	case types.CredentialTypeExecution, types.CredentialTypeCompounding:
+		return len(credentials) == 66 && "0x"+credentials[26:] == r.Value
+	}
+	return false
+}
+
+// isHex reports whether s is a 0x-prefixed hex string of n bytes
+func isHex(s string, n int) bool {
+	if len(s) != 2+2*n || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	for _, c := range s[2:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// DiscoveredDeposit is a deposit sent by the address of a depositor discovery rule
+type DiscoveredDeposit struct {
+	RuleID         int64  `db:"rule_id"`
+	Pubkey         string `db:"pubkey"`
+	BlockNumber    int64  `db:"block_number"`
+	TxHash         string `db:"tx_hash"`
+	ValidatorIndex *int64 `db:"validator_index"` // Set once the beacon chain has assigned an index
+}
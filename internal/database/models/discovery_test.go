@@ -0,0 +1,49 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiscoveryRule(t *testing.T) {
+	address := "0x" + strings.Repeat("ab", 20)
+
+	rule, err := NewDiscoveryRule(DiscoveryKindWithdrawal, " 0x"+strings.Repeat("AB", 20)+" ", "treasury")
+	if err != nil {
+		t.Fatalf("NewDiscoveryRule() error = %v", err)
+	}
+	if rule.Value != address {
+		t.Errorf("Value = %q, want the lowercase address", rule.Value)
+	}
+
+	tests := []struct {
+		credentials string
+		want        bool
+	}{
+		{"0x01" + strings.Repeat("00", 11) + strings.Repeat("ab", 20), true},
+		{"0x02" + strings.Repeat("00", 11) + strings.Repeat("AB", 20), true},
+		{"0x00" + strings.Repeat("00", 11) + strings.Repeat("ab", 20), false}, // BLS credentials hash a key
+		{"0x01" + strings.Repeat("00", 11) + strings.Repeat("cd", 20), false},
+	}
+	for _, tt := range tests {
+		if got := rule.MatchesCredentials(tt.credentials); got != tt.want {
+			t.Errorf("MatchesCredentials(%q) = %v, want %v", tt.credentials, got, tt.want)
+		}
+	}
+
+	credentials := "0x01" + strings.Repeat("00", 11) + strings.Repeat("ab", 20)
+	exact, err := NewDiscoveryRule(DiscoveryKindWithdrawal, credentials, "treasury")
+	if err != nil {
+		t.Fatalf("NewDiscoveryRule() error = %v", err)
+	}
+	if !exact.MatchesCredentials(credentials) {
+		t.Error("full credentials should match exactly")
+	}
+
+	if _, err := NewDiscoveryRule(DiscoveryKindDepositor, credentials, "treasury"); err == nil {
+		t.Error("depositor rules should reject withdrawal credentials")
+	}
+	if _, err := NewDiscoveryRule(DiscoveryKindFeeRecipient, address, ""); err == nil {
+		t.Error("rules should require a tag")
+	}
+}
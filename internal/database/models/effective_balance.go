@@ -0,0 +1,111 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// EffectiveBalanceStep is a change in a validator's effective balance between consecutive ledger epochs
+type EffectiveBalanceStep struct {
+	ValidatorIndex           int64     `db:"validator_index"`
+	Epoch                    int64     `db:"epoch"` // First epoch with the new effective balance
+	Time                     time.Time `db:"time"`
+	PreviousEffectiveBalance int64     `db:"previous_effective_balance"` // Gwei
+	EffectiveBalance         int64     `db:"effective_balance"`          // Gwei
+	Balance                  int64     `db:"balance"`                    // Balance at the start of Epoch, in Gwei
+	Penalties                int64     `db:"penalties"`                  // Penalties over the day before the step, in Gwei
+	Withdrawals              int64     `db:"withdrawals"`                // Withdrawals over the day before the step, in Gwei
+}
+
+// Down reports whether the effective balance decreased
+func (s *EffectiveBalanceStep) Down() bool {
+	return s.EffectiveBalance < s.PreviousEffectiveBalance
+}
+
+// CausedByPenalties reports whether the step was a decrease driven by penalties rather than by
+// withdrawals, which are the only other way an active validator's balance falls
+func (s *EffectiveBalanceStep) CausedByPenalties() bool {
+	return s.Down() && s.Penalties > s.Withdrawals
+}
+
+// Summary returns a one-line description of the step, suitable for an alert message
+func (s *EffectiveBalanceStep) Summary() string {
+	direction := "up"
+	if s.Down() {
+		direction = "down"
+	}
+	summary := fmt.Sprintf("Validator %d: effective balance stepped %s from %s to %s at epoch %d",
+		s.ValidatorIndex, direction, formatETH(s.PreviousEffectiveBalance), formatETH(s.EffectiveBalance), s.Epoch)
+	if s.Penalties > 0 {
+		summary += fmt.Sprintf(" after %.6f ETH of penalties over the previous day", float64(s.Penalties)/1e9)
+	}
+	return summary
+}
+
+// EffectiveBalanceForecast projects when a validator's effective balance will next step up or
+// down if its balance keeps moving at its current daily income
+type EffectiveBalanceForecast struct {
+	Balance              int64    // Gwei
+	EffectiveBalance     int64    // Gwei
+	MaxEffectiveBalance  int64    // Gwei
+	DailyIncome          int64    // Gwei per day; negative when penalties outweigh rewards
+	DownThreshold        int64    // Balance below which the effective balance steps down, in Gwei
+	UpThreshold          int64    // Balance above which the effective balance steps up, in Gwei; 0 at the maximum
+	NextEffectiveBalance int64    // Effective balance after the forecast step; EffectiveBalance when none is forecast
+	Days                 *float64 // Days until the forecast step, 0 at the next epoch; nil when none is forecast
+}
+
+// ForecastEffectiveBalance applies the hysteresis rules to a balance moving linearly at dailyIncome.
+// Income is assumed to stay in the balance, so a validator whose excess is swept by withdrawals
+// is only forecast to step down.
+func ForecastEffectiveBalance(balance, effectiveBalance, maxEffectiveBalance, dailyIncome int64) *EffectiveBalanceForecast {
+	f := &EffectiveBalanceForecast{
+		Balance:              balance,
+		EffectiveBalance:     effectiveBalance,
+		MaxEffectiveBalance:  maxEffectiveBalance,
+		DailyIncome:          dailyIncome,
+		NextEffectiveBalance: effectiveBalance,
+	}
+	f.DownThreshold, f.UpThreshold = types.HysteresisThresholds(effectiveBalance, maxEffectiveBalance)
+
+	var days float64
+	switch next := types.NextEffectiveBalance(balance, effectiveBalance, maxEffectiveBalance); {
+	case next != effectiveBalance:
+		// Already past a threshold; the step happens at the next epoch transition
+		f.NextEffectiveBalance = next
+	case dailyIncome > 0 && f.UpThreshold > 0:
+		f.NextEffectiveBalance = effectiveBalance + types.EffectiveBalanceIncrement
+		days = float64(f.UpThreshold+1-balance) / float64(dailyIncome)
+	case dailyIncome < 0:
+		f.NextEffectiveBalance = effectiveBalance - types.EffectiveBalanceIncrement
+		days = float64(balance-f.DownThreshold+1) / float64(-dailyIncome)
+	default:
+		return f
+	}
+
+	f.Days = &days
+	return f
+}
+
+// Summary returns a one-line description of the forecast step
+func (f *EffectiveBalanceForecast) Summary() string {
+	if f.Days == nil {
+		return "No step expected at current income"
+	}
+	direction := "up"
+	if f.NextEffectiveBalance < f.EffectiveBalance {
+		direction = "down"
+	}
+	if *f.Days == 0 {
+		return fmt.Sprintf("Steps %s to %s at the next epoch", direction, formatETH(f.NextEffectiveBalance))
+	}
+	return fmt.Sprintf("Steps %s to %s in about %.1f days", direction, formatETH(f.NextEffectiveBalance), *f.Days)
+}
+
+// formatETH formats a whole-increment Gwei amount in ETH
+func formatETH(gwei int64) string {
+	return strconv.FormatInt(gwei/types.EffectiveBalanceIncrement, 10) + " ETH"
+}
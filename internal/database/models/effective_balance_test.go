@@ -0,0 +1,65 @@
+package models
+
+import "testing"
+
+func TestForecastEffectiveBalance(t *testing.T) {
+	const eth = 1_000_000_000
+	tests := []struct {
+		name        string
+		balance     int64
+		eb          int64
+		max         int64
+		daily       int64
+		wantNext    int64
+		wantDays    float64 // -1 when no step is forecast
+		wantSummary string
+	}{
+		{"swept at maximum", 32*eth + 5_000_000, 32 * eth, 32 * eth, 2_500_000, 32 * eth, -1, "No step expected at current income"},
+		{"penalties at maximum", 31*eth + 760_000_000, 32 * eth, 32 * eth, -2_000_000, 31 * eth, 5.0000005, "Steps down to 31 ETH in about 5.0 days"},
+		{"below the downward threshold", 31*eth + 740_000_000, 32 * eth, 32 * eth, -2_000_000, 31 * eth, 0, "Steps down to 31 ETH at the next epoch"},
+		{"compounding growth", 40*eth + 250_000_000, 40 * eth, 2048 * eth, 10_000_000, 41 * eth, 100.0000001, "Steps up to 41 ETH in about 100.0 days"},
+		{"top-up past the upward threshold", 41*eth + 300_000_000, 40 * eth, 2048 * eth, 10_000_000, 41 * eth, 0, "Steps up to 41 ETH at the next epoch"},
+		{"no income", 31*eth + 900_000_000, 32 * eth, 32 * eth, 0, 32 * eth, -1, "No step expected at current income"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := ForecastEffectiveBalance(tt.balance, tt.eb, tt.max, tt.daily)
+			if f.NextEffectiveBalance != tt.wantNext {
+				t.Errorf("NextEffectiveBalance = %d, want %d", f.NextEffectiveBalance, tt.wantNext)
+			}
+			if tt.wantDays < 0 {
+				if f.Days != nil {
+					t.Errorf("Days = %v, want nil", *f.Days)
+				}
+			} else if f.Days == nil || *f.Days < tt.wantDays-1e-6 || *f.Days > tt.wantDays+1e-6 {
+				t.Errorf("Days = %v, want %v", f.Days, tt.wantDays)
+			}
+			if got := f.Summary(); got != tt.wantSummary {
+				t.Errorf("Summary() = %q, want %q", got, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestEffectiveBalanceStepSummary(t *testing.T) {
+	step := &EffectiveBalanceStep{
+		ValidatorIndex:           42,
+		Epoch:                    300_000,
+		PreviousEffectiveBalance: 32_000_000_000,
+		EffectiveBalance:         31_000_000_000,
+		Penalties:                2_250_000,
+	}
+	want := "Validator 42: effective balance stepped down from 32 ETH to 31 ETH at epoch 300000 after 0.002250 ETH of penalties over the previous day"
+	if got := step.Summary(); got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+	if !step.CausedByPenalties() {
+		t.Error("a decrease with penalties and no withdrawals should be caused by penalties")
+	}
+
+	step.Withdrawals = 1_000_000_000
+	if step.CausedByPenalties() {
+		t.Error("a decrease after a larger withdrawal should not be caused by penalties")
+	}
+}
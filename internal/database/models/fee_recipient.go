@@ -0,0 +1,76 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FeeRecipientStatus is the outcome of checking a proposed block's fee recipient
+type FeeRecipientStatus string
+
+const (
+	FeeRecipientCompliant   FeeRecipientStatus = "compliant"    // The block's fee recipient is an expected address
+	FeeRecipientBuilderPaid FeeRecipientStatus = "builder_paid" // A builder block whose final transaction pays an expected address
+	FeeRecipientMismatch    FeeRecipientStatus = "mismatch"     // Execution rewards went to another address
+)
+
+// Compliant reports whether the rewards of the block reached an expected address
+func (s FeeRecipientStatus) Compliant() bool {
+	return s == FeeRecipientCompliant || s == FeeRecipientBuilderPaid
+}
+
+// FeeRecipientCheck records where the execution rewards of a block proposed by a monitored
+// validator went, compared with the addresses expected for it
+type FeeRecipientCheck struct {
+	ValidatorIndex int64              `db:"validator_index"`
+	Slot           int64              `db:"slot"`
+	Time           time.Time          `db:"time"` // Slot start
+	BlockHash      string             `db:"block_hash"`
+	FeeRecipient   string             `db:"fee_recipient"`
+	Expected       []string           `db:"expected"`
+	PaymentTo      *string            `db:"payment_to"`   // Recipient of the block's final transaction, for builder blocks
+	PaymentGwei    *int64             `db:"payment_gwei"` // Value of the block's final transaction, for builder blocks
+	Status         FeeRecipientStatus `db:"status"`
+}
+
+// Summary describes the check, as shown in alerts
+func (c *FeeRecipientCheck) Summary() string {
+	switch c.Status {
+	case FeeRecipientCompliant:
+		return fmt.Sprintf("Block at slot %d paid the expected fee recipient %s", c.Slot, c.FeeRecipient)
+	case FeeRecipientBuilderPaid:
+		return fmt.Sprintf("Builder block at slot %d paid %.4f ETH to the expected address %s", c.Slot, float64(*c.PaymentGwei)/1e9, *c.PaymentTo)
+	default:
+		summary := fmt.Sprintf("Block at slot %d sent execution rewards to %s instead of %s", c.Slot, c.FeeRecipient, strings.Join(c.Expected, " or "))
+		if c.PaymentTo != nil {
+			summary += fmt.Sprintf(" (final transaction paid %s)", *c.PaymentTo)
+		}
+		return summary
+	}
+}
+
+// FeeRecipientCompliance returns the percentage of checks whose rewards reached an expected
+// address, or 100 without any checks
+func FeeRecipientCompliance(checks []*FeeRecipientCheck) float64 {
+	if len(checks) == 0 {
+		return 100
+	}
+
+	var compliant int
+	for _, c := range checks {
+		if c.Status.Compliant() {
+			compliant++
+		}
+	}
+	return float64(compliant) / float64(len(checks)) * 100
+}
+
+// ParseExecutionAddress returns an execution address in lowercase, or an error if it is not one
+func ParseExecutionAddress(address string) (string, error) {
+	address = strings.ToLower(strings.TrimSpace(address))
+	if !isHex(address, 20) {
+		return "", fmt.Errorf("invalid execution address %q", address)
+	}
+	return address, nil
+}
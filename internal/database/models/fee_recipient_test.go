@@ -0,0 +1,37 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFeeRecipientCompliance(t *testing.T) {
+	if got := FeeRecipientCompliance(nil); got != 100 {
+		t.Errorf("FeeRecipientCompliance(nil) = %v, want 100", got)
+	}
+
+	to := "0x" + strings.Repeat("11", 20)
+	checks := []*FeeRecipientCheck{
+		{Slot: 3, Status: FeeRecipientMismatch, FeeRecipient: "0x" + strings.Repeat("22", 20), Expected: []string{to}},
+		{Slot: 2, Status: FeeRecipientBuilderPaid, PaymentTo: &to, PaymentGwei: ptrInt64(50_000_000)},
+		{Slot: 1, Status: FeeRecipientCompliant},
+		{Slot: 0, Status: FeeRecipientCompliant},
+	}
+	if got := FeeRecipientCompliance(checks); got != 75 {
+		t.Errorf("FeeRecipientCompliance() = %v, want 75", got)
+	}
+
+	if got := checks[1].Summary(); !strings.Contains(got, "0.0500 ETH") {
+		t.Errorf("Summary() = %q, want the builder payment", got)
+	}
+	if got := checks[0].Summary(); !strings.Contains(got, "instead of "+to) {
+		t.Errorf("Summary() = %q, want the expected address", got)
+	}
+
+	if _, err := ParseExecutionAddress("0x1234"); err == nil {
+		t.Error("ParseExecutionAddress should reject short addresses")
+	}
+	if got, _ := ParseExecutionAddress(" 0x" + strings.Repeat("AB", 20)); got != "0x"+strings.Repeat("ab", 20) {
+		t.Errorf("ParseExecutionAddress() = %q, want the lowercase address", got)
+	}
+}
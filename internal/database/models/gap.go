@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// GapStatus represents the repair state of a missing snapshot epoch
+type GapStatus string
+
+const (
+	GapStatusMissing     GapStatus = "missing"
+	GapStatusRepaired    GapStatus = "repaired"
+	GapStatusIrreparable GapStatus = "irreparable"
+)
+
+// SnapshotGap represents an epoch for which no validator snapshot was collected
+type SnapshotGap struct {
+	ValidatorIndex int64      `db:"validator_index"`
+	Epoch          int64      `db:"epoch"`
+	Status         GapStatus  `db:"status"`
+	Attempts       int32      `db:"attempts"`
+	LastError      *string    `db:"last_error"`
+	DetectedAt     time.Time  `db:"detected_at"`
+	ResolvedAt     *time.Time `db:"resolved_at"`
+}
+
+// SnapshotGapCounts summarises snapshot gaps for a validator by status
+type SnapshotGapCounts struct {
+	Missing     int
+	Repaired    int
+	Irreparable int
+}
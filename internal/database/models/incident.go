@@ -0,0 +1,215 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// IncidentStatus represents the lifecycle state of an incident
+type IncidentStatus string
+
+const (
+	IncidentStatusOpen         IncidentStatus = "open"
+	IncidentStatusAcknowledged IncidentStatus = "acknowledged"
+	IncidentStatusResolved     IncidentStatus = "resolved"
+)
+
+// IncidentGroupKind is what the validators of an incident have in common
+type IncidentGroupKind string
+
+const (
+	IncidentGroupNode  IncidentGroupKind = "node"  // Keys loaded in the same validator client
+	IncidentGroupTag   IncidentGroupKind = "tag"   // Validators sharing a tag
+	IncidentGroupFleet IncidentGroupKind = "fleet" // Anything else, including alerts about no validator
+)
+
+// Incident groups alerts of one failure type raised close together on the same validator client
+// or tag
+type Incident struct {
+	ID             int64
+	FailureType    string // Alert type shared by the member alerts
+	GroupKind      IncidentGroupKind
+	GroupValue     string   // Validator client name or tag; empty for the fleet
+	Severity       Severity // Highest severity of the member alerts
+	Status         IncidentStatus
+	AlertCount     int
+	OpenAlerts     int // Member alerts not yet resolved, ignored or dismissed
+	ValidatorCount int
+	StartedAt      time.Time // Creation time of the earliest member alert
+	LastAlertAt    time.Time // Creation time of the latest member alert
+	AcknowledgedAt *time.Time
+	AcknowledgedBy *string
+	ResolvedAt     *time.Time
+	ResolvedBy     *string // Nil when the incident resolved itself with its member alerts
+}
+
+// Scope describes the group of the incident, e.g. "validator client vc-1"
+func (i *Incident) Scope() string {
+	switch i.GroupKind {
+	case IncidentGroupNode:
+		return "validator client " + i.GroupValue
+	case IncidentGroupTag:
+		return "tag " + i.GroupValue
+	default:
+		return "fleet"
+	}
+}
+
+// Title summarises the incident, e.g. "Offline: 400 validators on validator client vc-1"
+func (i *Incident) Title() string {
+	failure := strings.ReplaceAll(i.FailureType, "_", " ")
+	if failure != "" {
+		failure = strings.ToUpper(failure[:1]) + failure[1:]
+	}
+
+	affected := fmt.Sprintf("%d alerts", i.AlertCount)
+	if i.ValidatorCount > 0 {
+		affected = fmt.Sprintf("%d validators", i.ValidatorCount)
+	}
+	return fmt.Sprintf("%s: %s on %s", failure, affected, i.Scope())
+}
+
+// Duration returns how long the incident lasted, or has lasted so far when unresolved
+func (i *Incident) Duration(now time.Time) time.Duration {
+	if i.ResolvedAt != nil {
+		now = *i.ResolvedAt
+	}
+	return now.Sub(i.StartedAt)
+}
+
+// IncidentFilter contains filter criteria for listing incidents
+type IncidentFilter struct {
+	Status *IncidentStatus
+	Limit  int
+	Offset int
+}
+
+// IncidentCandidate is an alert not yet grouped into an incident, with what its validator shares
+// with others
+type IncidentCandidate struct {
+	AlertID        int32
+	ValidatorIndex *int64
+	AlertType      string
+	Severity       Severity
+	CreatedAt      time.Time
+	Node           *string // Validator client holding the key, when exactly one does
+	Tags           []string
+}
+
+// Group returns the group the alert is clustered in: its validator client when known, otherwise
+// its first tag in alphabetical order, otherwise the fleet
+func (c *IncidentCandidate) Group() (IncidentGroupKind, string) {
+	if c.Node != nil {
+		return IncidentGroupNode, *c.Node
+	}
+	if len(c.Tags) > 0 {
+		tags := append([]string(nil), c.Tags...)
+		sort.Strings(tags)
+		return IncidentGroupTag, tags[0]
+	}
+	return IncidentGroupFleet, ""
+}
+
+// IncidentEventKind classifies an entry of an incident timeline
+type IncidentEventKind string
+
+const (
+	IncidentEventOpened         IncidentEventKind = "opened"
+	IncidentEventAlertsRaised   IncidentEventKind = "alerts_raised"
+	IncidentEventAlertsResolved IncidentEventKind = "alerts_resolved"
+	IncidentEventAcknowledged   IncidentEventKind = "acknowledged"
+	IncidentEventResolved       IncidentEventKind = "resolved"
+)
+
+// IncidentEvent is an entry of an incident timeline
+type IncidentEvent struct {
+	Time    time.Time
+	Kind    IncidentEventKind
+	Count   int // Member alerts raised or resolved; zero for lifecycle events
+	Message string
+}
+
+// IncidentTimeline builds the timeline of an incident from its lifecycle and member alerts, oldest
+// first. Alerts raised or resolved within the same minute are collapsed into one event, so that a
+// flood of alerts reads as a handful of entries.
+func IncidentTimeline(incident *Incident, alerts []*Alert) []IncidentEvent {
+	events := []IncidentEvent{{Time: incident.StartedAt, Kind: IncidentEventOpened, Message: "Incident opened"}}
+
+	raised := make([]time.Time, 0, len(alerts))
+	var resolved []time.Time
+	for _, a := range alerts {
+		raised = append(raised, a.CreatedAt)
+		if a.ResolvedAt != nil {
+			resolved = append(resolved, *a.ResolvedAt)
+		}
+	}
+	events = append(events, collapseAlertEvents(raised, IncidentEventAlertsRaised, "raised")...)
+	events = append(events, collapseAlertEvents(resolved, IncidentEventAlertsResolved, "resolved")...)
+
+	if incident.AcknowledgedAt != nil {
+		events = append(events, IncidentEvent{
+			Time:    *incident.AcknowledgedAt,
+			Kind:    IncidentEventAcknowledged,
+			Message: "Acknowledged" + byUser(incident.AcknowledgedBy),
+		})
+	}
+	if incident.ResolvedAt != nil {
+		message := "Resolved: all alerts cleared"
+		if incident.ResolvedBy != nil {
+			message = "Resolved" + byUser(incident.ResolvedBy)
+		}
+		events = append(events, IncidentEvent{Time: *incident.ResolvedAt, Kind: IncidentEventResolved, Message: message})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events
+}
+
+// IncidentValidators returns the distinct validators of an incident's member alerts, in alert order
+func IncidentValidators(alerts []*Alert) []int64 {
+	seen := make(map[int64]bool)
+	var validators []int64
+	for _, a := range alerts {
+		if a.ValidatorIndex != nil && !seen[*a.ValidatorIndex] {
+			seen[*a.ValidatorIndex] = true
+			validators = append(validators, *a.ValidatorIndex)
+		}
+	}
+	return validators
+}
+
+// collapseAlertEvents buckets alert times by minute into one event each, timed at the bucket's
+// earliest alert
+func collapseAlertEvents(times []time.Time, kind IncidentEventKind, verb string) []IncidentEvent {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	var events []IncidentEvent
+	for _, t := range times {
+		if n := len(events); n > 0 && t.Truncate(time.Minute).Equal(events[n-1].Time.Truncate(time.Minute)) {
+			events[n-1].Count++
+			continue
+		}
+		events = append(events, IncidentEvent{Time: t, Kind: kind, Count: 1})
+	}
+
+	for i := range events {
+		noun := "alerts"
+		if events[i].Count == 1 {
+			noun = "alert"
+		}
+		events[i].Message = fmt.Sprintf("%d %s %s", events[i].Count, noun, verb)
+	}
+	return events
+}
+
+// byUser formats the user behind a lifecycle event, when known
+func byUser(user *string) string {
+	if user == nil || *user == "" {
+		return ""
+	}
+	return " by " + *user
+}
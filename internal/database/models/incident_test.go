@@ -0,0 +1,82 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIncidentCandidateGroup(t *testing.T) {
+	node := "vc-1"
+	c := &IncidentCandidate{Node: &node, Tags: []string{"lido"}}
+	if kind, value := c.Group(); kind != IncidentGroupNode || value != "vc-1" {
+		t.Errorf("Group() = %s, %s, want the validator client", kind, value)
+	}
+
+	c = &IncidentCandidate{Tags: []string{"rocketpool", "lido"}}
+	if kind, value := c.Group(); kind != IncidentGroupTag || value != "lido" {
+		t.Errorf("Group() = %s, %s, want the first tag alphabetically", kind, value)
+	}
+	if c.Tags[0] != "rocketpool" {
+		t.Error("Group() should not reorder the candidate's tags")
+	}
+
+	c = &IncidentCandidate{}
+	if kind, value := c.Group(); kind != IncidentGroupFleet || value != "" {
+		t.Errorf("Group() = %s, %s, want the fleet", kind, value)
+	}
+}
+
+func TestIncidentTimeline(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 10, 0, time.UTC)
+	acked := start.Add(5 * time.Minute)
+	resolved := start.Add(20 * time.Minute)
+	user := "alice"
+	incident := &Incident{
+		FailureType:    "offline",
+		GroupKind:      IncidentGroupNode,
+		GroupValue:     "vc-1",
+		AlertCount:     4,
+		ValidatorCount: 3,
+		StartedAt:      start,
+		AcknowledgedAt: &acked,
+		AcknowledgedBy: &user,
+		ResolvedAt:     &resolved,
+	}
+	if got := incident.Title(); got != "Offline: 3 validators on validator client vc-1" {
+		t.Errorf("Title() = %q", got)
+	}
+	if got := incident.Duration(start.Add(time.Hour)); got != 20*time.Minute {
+		t.Errorf("Duration() = %v, want the time until resolution", got)
+	}
+
+	alerts := []*Alert{
+		{CreatedAt: start},
+		{CreatedAt: start.Add(20 * time.Second)},
+		{CreatedAt: start.Add(40 * time.Second)},
+		{CreatedAt: start.Add(2 * time.Minute), ResolvedAt: &resolved},
+	}
+	events := IncidentTimeline(incident, alerts)
+
+	want := []struct {
+		kind    IncidentEventKind
+		message string
+	}{
+		{IncidentEventOpened, "Incident opened"},
+		{IncidentEventAlertsRaised, "3 alerts raised"},
+		{IncidentEventAlertsRaised, "1 alert raised"},
+		{IncidentEventAcknowledged, "Acknowledged by alice"},
+		{IncidentEventAlertsResolved, "1 alert resolved"},
+		{IncidentEventResolved, "Resolved: all alerts cleared"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("IncidentTimeline() returned %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		if events[i].Kind != w.kind || events[i].Message != w.message {
+			t.Errorf("event %d = %s %q, want %s %q", i, events[i].Kind, events[i].Message, w.kind, w.message)
+		}
+	}
+	if !events[1].Time.Equal(start) {
+		t.Errorf("collapsed events should be timed at their earliest alert, got %v", events[1].Time)
+	}
+}
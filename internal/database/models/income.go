@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// epochsPerDay and epochsPerYear scale per-epoch income (384s epochs)
+const (
+	epochsPerDay  = 225
+	epochsPerYear = epochsPerDay * 365
+)
+
+// IncomeWindow is a trailing window over which income and APR are computed, in days (e.g. "7d")
+type IncomeWindow string
+
+const (
+	IncomeWindowDay   IncomeWindow = "1d"
+	IncomeWindowWeek  IncomeWindow = "7d"
+	IncomeWindowMonth IncomeWindow = "30d"
+	IncomeWindowYear  IncomeWindow = "365d"
+)
+
+// DefaultIncomeWindows are the windows reported when none are configured
+var DefaultIncomeWindows = []IncomeWindow{IncomeWindowDay, IncomeWindowWeek, IncomeWindowMonth, IncomeWindowYear}
+
+// Duration returns the length of the window
+func (w IncomeWindow) Duration() (time.Duration, error) {
+	days, err := strconv.Atoi(strings.TrimSuffix(string(w), "d"))
+	if err != nil || !strings.HasSuffix(string(w), "d") || days <= 0 {
+		return 0, fmt.Errorf("invalid income window %q: expected a number of days such as 7d", w)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// ParseIncomeWindows parses and validates a list of income windows
+func ParseIncomeWindows(values []string) ([]IncomeWindow, error) {
+	windows := make([]IncomeWindow, 0, len(values))
+	for _, v := range values {
+		w := IncomeWindow(strings.TrimSpace(v))
+		if _, err := w.Duration(); err != nil {
+			return nil, err
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
+
+// IncomeSummary is consensus income for a validator, tag group or the whole portfolio over a
+// window. Withdrawals and deposits are not income: a sweep or top-up leaves Income unchanged.
+type IncomeSummary struct {
+	Window                 IncomeWindow `db:"-"`
+	Epochs                 int64        `db:"epochs"`                   // Distinct epochs with ledger entries
+	Income                 int64        `db:"income"`                   // Gwei: rewards less penalties and unexplained losses
+	EffectiveBalanceEpochs float64      `db:"effective_balance_epochs"` // Sum of effective balance over every validator-epoch
+}
+
+// DailyIncome returns average income per day in Gwei, scaled from the epochs covered
+func (s *IncomeSummary) DailyIncome() int64 {
+	if s.Epochs == 0 {
+		return 0
+	}
+	return s.Income * epochsPerDay / s.Epochs
+}
+
+// APR returns annualised income as a percentage of effective balance. Validators that
+// were only active for part of the window are weighted by the epochs they were active.
+func (s *IncomeSummary) APR() float64 {
+	if s.EffectiveBalanceEpochs <= 0 {
+		return 0
+	}
+	return float64(s.Income) / s.EffectiveBalanceEpochs * epochsPerYear * 100
+}
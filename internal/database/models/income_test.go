@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// TestIncomeSummary tests daily income and APR scaling
+func TestIncomeSummary(t *testing.T) {
+	// One validator at 32 ETH earning 12,000 Gwei per epoch for a full day
+	day := IncomeSummary{Epochs: 225, Income: 225 * 12_000, EffectiveBalanceEpochs: 225 * 32e9}
+	if got := day.DailyIncome(); got != 2_700_000 {
+		t.Errorf("DailyIncome() = %d, want 2700000", got)
+	}
+	if got := day.APR(); got < 3.07 || got > 3.08 {
+		t.Errorf("APR() = %f, want ~3.08", got)
+	}
+
+	// Two validators, one active for only half the epochs: the APR is unchanged
+	partial := IncomeSummary{Epochs: 100, Income: 150 * 12_000, EffectiveBalanceEpochs: 150 * 32e9}
+	if partial.APR() != day.APR() {
+		t.Errorf("APR() = %f, want %f", partial.APR(), day.APR())
+	}
+
+	var empty IncomeSummary
+	if empty.DailyIncome() != 0 || empty.APR() != 0 {
+		t.Errorf("empty summary should report zero income")
+	}
+}
+
+// TestIncomeWindow tests income window parsing
+func TestIncomeWindow(t *testing.T) {
+	d, err := IncomeWindowWeek.Duration()
+	if err != nil || d != 7*24*time.Hour {
+		t.Errorf("Duration() = %v, %v, want 168h", d, err)
+	}
+
+	windows, err := ParseIncomeWindows([]string{"1d", " 90d"})
+	if err != nil || len(windows) != 2 || windows[1] != "90d" {
+		t.Errorf("ParseIncomeWindows() = %v, %v", windows, err)
+	}
+
+	for _, invalid := range []string{"", "d", "7", "0d", "-1d", "1w"} {
+		if _, err := ParseIncomeWindows([]string{invalid}); err == nil {
+			t.Errorf("ParseIncomeWindows(%q) should fail", invalid)
+		}
+	}
+}
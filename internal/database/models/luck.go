@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// DutyCounts aggregates the rewards ledger and missed proposals of a group of monitored validators
+// over a period, as input to proposal luck analysis
+type DutyCounts struct {
+	Tag                    *string `db:"tag"`                      // Nil for all monitored validators
+	Validators             int64   `db:"validators"`               // Validators with ledger entries in the period
+	Epochs                 int64   `db:"epochs"`                   // Validator-epochs with ledger entries
+	EffectiveBalanceEpochs int64   `db:"effective_balance_epochs"` // Sum of effective balance over those validator-epochs, in Gwei
+	Proposals              int64   `db:"proposals"`                // Proposal duties, made or missed
+	SyncCommittees         int64   `db:"sync_committees"`          // Sync committee periods served
+}
+
+// DutyLuck compares the number of times a group was selected for a duty with the number expected
+// from its share of the network's active balance. Selections are modelled as a Poisson process.
+type DutyLuck struct {
+	Expected     float64 // Mean number of selections
+	Actual       int64
+	Percentile   float64 // Share of outcomes below Actual, counting half of Actual itself (0-100)
+	ExpectedLow  int64   // Lower bound of the central 95% of outcomes
+	ExpectedHigh int64   // Upper bound of the central 95% of outcomes
+}
+
+// Luck returns actual selections as a percentage of the expected number, or 0 if none were expected
+func (l *DutyLuck) Luck() float64 {
+	if l.Expected <= 0 {
+		return 0
+	}
+	return float64(l.Actual) / l.Expected * 100
+}
+
+// ProposalLuck reports how often a group of monitored validators was chosen to propose blocks and
+// serve on sync committees over a period, compared with what their effective balance warrants
+type ProposalLuck struct {
+	Tag            *string // Nil for all monitored validators
+	From           time.Time
+	To             time.Time
+	Validators     int64
+	Epochs         int64 // Validator-epochs with ledger entries
+	Proposals      DutyLuck
+	SyncCommittees DutyLuck
+}
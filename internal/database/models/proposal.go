@@ -0,0 +1,56 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProposalMissReason classifies why a scheduled block proposal did not become canonical
+type ProposalMissReason string
+
+const (
+	ProposalMissNoBlock      ProposalMissReason = "no_block"      // No block was published for the slot
+	ProposalMissOrphaned     ProposalMissReason = "orphaned"      // A block was published on time but reorged out
+	ProposalMissLate         ProposalMissReason = "late"          // A block was published after the late threshold and reorged out
+	ProposalMissRelayFailure ProposalMissReason = "relay_failure" // A relay delivered a payload but the block never appeared
+)
+
+// Description returns a human readable explanation of the verdict
+func (r ProposalMissReason) Description() string {
+	switch r {
+	case ProposalMissNoBlock:
+		return "no block was published"
+	case ProposalMissOrphaned:
+		return "the block was orphaned by a reorg"
+	case ProposalMissLate:
+		return "the block was published late and orphaned"
+	case ProposalMissRelayFailure:
+		return "the relay or builder failed to publish the block"
+	default:
+		return string(r)
+	}
+}
+
+// ProposalMiss records the verdict for a scheduled proposal that did not become canonical
+type ProposalMiss struct {
+	ValidatorIndex int64              `db:"validator_index"`
+	Slot           int64              `db:"slot"`
+	Epoch          int64              `db:"epoch"`
+	Time           time.Time          `db:"time"` // Slot start
+	Reason         ProposalMissReason `db:"reason"`
+	BlockRoot      *string            `db:"block_root"`     // Root of the non-canonical block, if one was seen
+	BlockDelayMs   *int32             `db:"block_delay_ms"` // Block arrival after slot start, if observed
+	Relay          *string            `db:"relay"`          // Relay that delivered the payload, for relay failures
+}
+
+// Summary describes the miss and its verdict, as shown in alerts and the validator timeline
+func (m *ProposalMiss) Summary() string {
+	summary := fmt.Sprintf("Missed block proposal at slot %d: %s", m.Slot, m.Reason.Description())
+	if m.BlockDelayMs != nil {
+		summary += fmt.Sprintf(" (%.1fs after slot start)", float64(*m.BlockDelayMs)/1000)
+	}
+	if m.Relay != nil {
+		summary += fmt.Sprintf(" (relay %s)", *m.Relay)
+	}
+	return summary
+}
@@ -0,0 +1,25 @@
+package models
+
+import "testing"
+
+// TestProposalMissSummary tests the missed proposal description used in alerts and the timeline
+func TestProposalMissSummary(t *testing.T) {
+	delay, relay := int32(5250), "relay.example"
+
+	late := &ProposalMiss{Slot: 3205, Reason: ProposalMissLate, BlockDelayMs: &delay}
+	want := "Missed block proposal at slot 3205: the block was published late and orphaned (5.2s after slot start)"
+	if got := late.Summary(); got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+
+	failed := &ProposalMiss{Slot: 3205, Reason: ProposalMissRelayFailure, Relay: &relay}
+	want = "Missed block proposal at slot 3205: the relay or builder failed to publish the block (relay relay.example)"
+	if got := failed.Summary(); got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+
+	noBlock := &ProposalMiss{Slot: 3205, Reason: ProposalMissNoBlock}
+	if got := noBlock.Summary(); got != "Missed block proposal at slot 3205: no block was published" {
+		t.Errorf("Summary() = %q", got)
+	}
+}
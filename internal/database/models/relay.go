@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BlockSource is how a block proposed by a monitored validator was built
+type BlockSource string
+
+const (
+	BlockSourceRelay      BlockSource = "relay"      // Built by a builder and delivered by an MEV-boost relay
+	BlockSourceLocal      BlockSource = "local"      // Built by the validator's own execution client
+	BlockSourceFallback   BlockSource = "fallback"   // Built locally although the validator is registered with a relay
+	BlockSourceUnverified BlockSource = "unverified" // Undecided because relays stayed unreachable
+)
+
+// RelayProposal records how a block proposed by a monitored validator was built and, for relay
+// blocks, the value the winning bid promised against the payment the proposer received
+type RelayProposal struct {
+	ValidatorIndex int64       `db:"validator_index"`
+	Slot           int64       `db:"slot"`
+	Time           time.Time   `db:"time"` // Slot start
+	BlockHash      string      `db:"block_hash"`
+	Source         BlockSource `db:"source"`
+	Relays         []string    `db:"relays"` // Relays that reported delivering the payload
+	BuilderPubkey  *string     `db:"builder_pubkey"`
+	PromisedGwei   *int64      `db:"promised_gwei"`
+	ReceivedGwei   *int64      `db:"received_gwei"` // Nil when the payment cannot be seen in the block
+}
+
+// Shortfall returns how much less than promised the proposer received, and whether both values
+// are known. It is negative when the builder paid more than promised.
+func (p *RelayProposal) Shortfall() (int64, bool) {
+	if p.PromisedGwei == nil || p.ReceivedGwei == nil {
+		return 0, false
+	}
+	return *p.PromisedGwei - *p.ReceivedGwei, true
+}
+
+// Underpaid reports whether the proposer received less than promised by more than tolerance, a
+// fraction of the promised value
+func (p *RelayProposal) Underpaid(tolerance float64) bool {
+	shortfall, ok := p.Shortfall()
+	return ok && shortfall > 0 && float64(shortfall) > float64(*p.PromisedGwei)*tolerance
+}
+
+// Summary describes the proposal, as shown in alerts
+func (p *RelayProposal) Summary() string {
+	switch p.Source {
+	case BlockSourceLocal:
+		return fmt.Sprintf("Block at slot %d was built locally", p.Slot)
+	case BlockSourceFallback:
+		return fmt.Sprintf("Block at slot %d fell back to local building although the validator is registered with a relay", p.Slot)
+	case BlockSourceUnverified:
+		return fmt.Sprintf("Block at slot %d could not be matched against the relays, some of which were unreachable", p.Slot)
+	}
+
+	summary := fmt.Sprintf("Block at slot %d was delivered by %s", p.Slot, strings.Join(p.Relays, ", "))
+	if p.PromisedGwei != nil {
+		summary += fmt.Sprintf("; the bid promised %.4f ETH", float64(*p.PromisedGwei)/1e9)
+	}
+	if p.ReceivedGwei != nil {
+		summary += fmt.Sprintf(" and the builder paid %.4f ETH", float64(*p.ReceivedGwei)/1e9)
+	}
+	return summary
+}
+
+// RelaySummary aggregates the proposals of monitored validators by relay, or by source for blocks
+// no relay delivered. A block delivered by several relays counts towards each.
+type RelaySummary struct {
+	Relay        string      `db:"relay"` // Relay name, or the source of locally built blocks
+	Source       BlockSource `db:"source"`
+	Proposals    int64       `db:"proposals"`
+	Verified     int64       `db:"verified"`      // Proposals whose builder payment could be seen
+	PromisedGwei int64       `db:"promised_gwei"` // Promised value of the verified proposals
+	ReceivedGwei int64       `db:"received_gwei"` // Payments received for the verified proposals
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRelayProposalShortfall(t *testing.T) {
+	p := &RelayProposal{Slot: 3201, Source: BlockSourceRelay, Relays: []string{"relay-a", "relay-b"}, PromisedGwei: ptrInt64(100_000_000)}
+	if _, ok := p.Shortfall(); ok {
+		t.Error("Shortfall() should be unknown without a received payment")
+	}
+	if p.Underpaid(0) {
+		t.Error("Underpaid() should be false without a received payment")
+	}
+
+	p.ReceivedGwei = ptrInt64(99_500_000)
+	if shortfall, ok := p.Shortfall(); !ok || shortfall != 500_000 {
+		t.Errorf("Shortfall() = %d, %v, want 500000, true", shortfall, ok)
+	}
+	if !p.Underpaid(0.001) || p.Underpaid(0.01) {
+		t.Error("Underpaid() should compare the shortfall with the tolerance")
+	}
+	if got := p.Summary(); !strings.Contains(got, "relay-a, relay-b") || !strings.Contains(got, "paid 0.0995 ETH") {
+		t.Errorf("Summary() = %q, want the relays and payment", got)
+	}
+
+	p.ReceivedGwei = ptrInt64(120_000_000)
+	if p.Underpaid(0) {
+		t.Error("Underpaid() should be false when the builder paid more than promised")
+	}
+}
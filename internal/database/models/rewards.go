@@ -0,0 +1,107 @@
+package models
+
+import "time"
+
+// RewardLedgerEntry breaks down a validator's balance change over one epoch, from the state at
+// the epoch's first slot to the state at the next epoch's first slot. All amounts are in Gwei.
+type RewardLedgerEntry struct {
+	ValidatorIndex     int64     `db:"validator_index"`
+	Epoch              int64     `db:"epoch"`
+	Time               time.Time `db:"time"`              // Start of the epoch
+	EffectiveBalance   int64     `db:"effective_balance"` // At the start of the epoch
+	BalanceStart       int64     `db:"balance_start"`
+	BalanceEnd         int64     `db:"balance_end"`
+	AttestationRewards int64     `db:"attestation_rewards"`
+	ProposalRewards    int64     `db:"proposal_rewards"`
+	SyncRewards        int64     `db:"sync_rewards"`
+	Penalties          int64     `db:"penalties"` // Attestation and sync penalties, as a positive amount
+	Withdrawals        int64     `db:"withdrawals"`
+	Deposits           int64     `db:"deposits"`
+	Other              int64     `db:"other"`          // Balance change not explained by the components above (e.g. slashing)
+	IdealRewards       int64     `db:"ideal_rewards"`  // Attestation rewards a perfect validator would have earned
+	SyncPenalties      int64     `db:"sync_penalties"` // Part of Penalties incurred for missed sync committee duties
+}
+
+// NetRewards returns consensus income for the epoch: rewards less penalties
+func (e *RewardLedgerEntry) NetRewards() int64 {
+	return e.AttestationRewards + e.ProposalRewards + e.SyncRewards - e.Penalties
+}
+
+// LedgerPeriod is the bucket size used when summarising the rewards ledger
+type LedgerPeriod string
+
+const (
+	LedgerPeriodDay   LedgerPeriod = "day"
+	LedgerPeriodWeek  LedgerPeriod = "week"
+	LedgerPeriodMonth LedgerPeriod = "month"
+)
+
+// IsValid reports whether p is a supported ledger period
+func (p LedgerPeriod) IsValid() bool {
+	switch p {
+	case LedgerPeriodDay, LedgerPeriodWeek, LedgerPeriodMonth:
+		return true
+	}
+	return false
+}
+
+// RewardLedgerSummary totals ledger entries over a period. All amounts are in Gwei.
+type RewardLedgerSummary struct {
+	PeriodStart        time.Time `db:"period_start"`
+	Epochs             int64     `db:"epochs"`
+	AttestationRewards int64     `db:"attestation_rewards"`
+	ProposalRewards    int64     `db:"proposal_rewards"`
+	SyncRewards        int64     `db:"sync_rewards"`
+	Penalties          int64     `db:"penalties"`
+	Withdrawals        int64     `db:"withdrawals"`
+	Deposits           int64     `db:"deposits"`
+	Other              int64     `db:"other"`
+	IdealRewards       int64     `db:"ideal_rewards"`
+}
+
+// Actual returns consensus income over the period: rewards less penalties
+func (s *RewardLedgerSummary) Actual() int64 {
+	return s.AttestationRewards + s.ProposalRewards + s.SyncRewards - s.Penalties
+}
+
+// Expected returns what the validator would have earned attesting perfectly with the
+// proposal and sync duties it was actually assigned
+func (s *RewardLedgerSummary) Expected() int64 {
+	return s.IdealRewards + s.ProposalRewards + s.SyncRewards
+}
+
+// Effectiveness returns actual income as a percentage of expected income
+func (s *RewardLedgerSummary) Effectiveness() float64 {
+	expected := s.Expected()
+	if expected <= 0 {
+		return 0
+	}
+	return float64(s.Actual()) / float64(expected) * 100
+}
+
+// DowntimeScope selects the validators a downtime cost covers: a single validator, an explicit
+// set of validators, the monitored validators carrying a tag, or the whole monitored fleet when
+// all are nil
+type DowntimeScope struct {
+	ValidatorIndex *int64
+	Validators     []int64
+	Tag            *string
+}
+
+// DowntimeCost is the consensus income lost over a time range compared with perfect performance,
+// broken down by duty. All amounts are in Gwei.
+type DowntimeCost struct {
+	From            time.Time `db:"-"`
+	To              time.Time `db:"-"`
+	Validators      int64     `db:"validators"`       // Validators with ledger entries in the range
+	Epochs          int64     `db:"epochs"`           // Distinct epochs with ledger entries
+	AttestationLoss int64     `db:"attestation_loss"` // Ideal attestation rewards not earned, plus attestation penalties
+	MissedProposals int64     `db:"missed_proposals"`
+	ProposalLoss    int64     `db:"proposal_loss"` // Missed proposals valued at the average proposal reward
+	SyncLoss        int64     `db:"sync_loss"`     // Sync rewards not earned, plus sync penalties
+}
+
+// Total returns the income lost across all duties
+func (c *DowntimeCost) Total() int64 {
+	return c.AttestationLoss + c.ProposalLoss + c.SyncLoss
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// SignerEndpoint is the latest scrape of a validator client or remote signer endpoint
+type SignerEndpoint struct {
+	Name      string
+	Kind      types.SignerKind
+	URL       string
+	Status    string // "healthy", "degraded" or "unhealthy"
+	Message   string
+	KeyCount  *int // Nil for endpoints that do not list keys
+	CheckedAt time.Time
+	HealthyAt *time.Time // Last time the endpoint was healthy
+}
+
+// KeyCoverage is where a monitored validator's key is loaded
+type KeyCoverage struct {
+	ValidatorIndex int64
+	Pubkey         string
+	Clients        []string // Validator clients whose keymanager API lists the key
+	RemoteClients  []string // Those of Clients that sign for it through a remote signer
+	Signers        []string // Web3Signer instances holding the key
+}
+
+// Missing reports whether the key is loaded in no validator client
+func (c *KeyCoverage) Missing() bool {
+	return len(c.Clients) == 0
+}
+
+// Duplicated reports whether the key is loaded in more than one validator client, which risks a
+// slashable double vote
+func (c *KeyCoverage) Duplicated() bool {
+	return len(c.Clients) > 1
+}
+
+// Unsigned reports whether a validator client signs for the key remotely while no Web3Signer
+// holds it; only meaningful when Web3Signer instances are scraped
+func (c *KeyCoverage) Unsigned() bool {
+	return len(c.RemoteClients) > 0 && len(c.Signers) == 0
+}
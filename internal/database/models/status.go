@@ -0,0 +1,181 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// LifecycleStatus is a validator's status in the consensus lifecycle, as reported by the beacon API
+type LifecycleStatus string
+
+const (
+	LifecyclePendingInitialized LifecycleStatus = "pending_initialized" // Deposited, not yet eligible for activation
+	LifecyclePendingQueued      LifecycleStatus = "pending_queued"      // Waiting in the activation queue
+	LifecycleActiveOngoing      LifecycleStatus = "active_ongoing"
+	LifecycleActiveExiting      LifecycleStatus = "active_exiting" // Exit initiated, still performing duties
+	LifecycleActiveSlashed      LifecycleStatus = "active_slashed"
+	LifecycleExitedUnslashed    LifecycleStatus = "exited_unslashed"
+	LifecycleExitedSlashed      LifecycleStatus = "exited_slashed"
+	LifecycleWithdrawalPossible LifecycleStatus = "withdrawal_possible"
+	LifecycleWithdrawalDone     LifecycleStatus = "withdrawal_done"
+)
+
+// lifecycleStatuses lists the statuses in the order a validator passes through them
+var lifecycleStatuses = []LifecycleStatus{
+	LifecyclePendingInitialized,
+	LifecyclePendingQueued,
+	LifecycleActiveOngoing,
+	LifecycleActiveExiting,
+	LifecycleActiveSlashed,
+	LifecycleExitedUnslashed,
+	LifecycleExitedSlashed,
+	LifecycleWithdrawalPossible,
+	LifecycleWithdrawalDone,
+}
+
+// lifecycleDescriptions describe entering each status
+var lifecycleDescriptions = map[LifecycleStatus]string{
+	LifecyclePendingInitialized: "Deposit processed",
+	LifecyclePendingQueued:      "Entered the activation queue",
+	LifecycleActiveOngoing:      "Activated",
+	LifecycleActiveExiting:      "Exit initiated",
+	LifecycleActiveSlashed:      "Slashed",
+	LifecycleExitedUnslashed:    "Exited",
+	LifecycleExitedSlashed:      "Exited after slashing",
+	LifecycleWithdrawalPossible: "Balance became withdrawable",
+	LifecycleWithdrawalDone:     "Balance fully withdrawn",
+}
+
+// order returns the position of s in the lifecycle, or -1 for an unknown status
+func (s LifecycleStatus) order() int {
+	for i, status := range lifecycleStatuses {
+		if status == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// Valid reports whether s is a known lifecycle status
+func (s LifecycleStatus) Valid() bool {
+	return s.order() >= 0
+}
+
+// Slashed reports whether s is one of the slashed statuses
+func (s LifecycleStatus) Slashed() bool {
+	return s == LifecycleActiveSlashed || s == LifecycleExitedSlashed
+}
+
+// CanTransition reports whether a validator can move from s to next, directly or through the
+// statuses in between. Validators only move forward through the lifecycle, and once slashed never
+// return to an unslashed exit.
+func (s LifecycleStatus) CanTransition(next LifecycleStatus) bool {
+	if !s.Valid() || !next.Valid() || next.order() <= s.order() {
+		return false
+	}
+	return !(s.Slashed() && next == LifecycleExitedUnslashed)
+}
+
+// Phase returns the coarse validator status of s
+func (s LifecycleStatus) Phase() types.ValidatorStatus {
+	switch s {
+	case LifecyclePendingInitialized, LifecyclePendingQueued:
+		return types.StatusPending
+	case LifecycleActiveOngoing:
+		return types.StatusActive
+	case LifecycleActiveExiting:
+		return types.StatusExiting
+	case LifecycleActiveSlashed, LifecycleExitedSlashed:
+		return types.StatusSlashed
+	case LifecycleExitedUnslashed, LifecycleWithdrawalPossible, LifecycleWithdrawalDone:
+		return types.StatusExited
+	default:
+		return types.StatusUnknown
+	}
+}
+
+// lifecycleEpoch returns the epoch the chain records for the start of a status, if any
+func (v *Validator) lifecycleEpoch(s LifecycleStatus) *int64 {
+	switch s {
+	case LifecyclePendingQueued:
+		return v.ActivationEligibilityEpoch
+	case LifecycleActiveOngoing:
+		return v.ActivationEpoch
+	case LifecycleExitedUnslashed, LifecycleExitedSlashed:
+		return v.ExitEpoch
+	case LifecycleWithdrawalPossible:
+		return v.WithdrawableEpoch
+	default:
+		return nil
+	}
+}
+
+// StatusTransition records a validator entering a lifecycle status
+type StatusTransition struct {
+	ValidatorIndex int64
+	Status         LifecycleStatus
+	PreviousStatus *LifecycleStatus // Nil for the first status on record
+	Epoch          int64            // Epoch the status began, or was first observed when the chain does not record it
+	Time           time.Time        // Start of Epoch
+	ObservedAt     time.Time
+}
+
+// Summary describes the transition, e.g. "Activated at epoch 1234"
+func (t *StatusTransition) Summary() string {
+	description, ok := lifecycleDescriptions[t.Status]
+	if !ok {
+		description = "Status changed to " + string(t.Status)
+	}
+	return fmt.Sprintf("%s at epoch %d", description, t.Epoch)
+}
+
+// PlanStatusTransitions returns the transitions that took a validator from previous, nil when it
+// has not been observed before, to current, observed at epoch observed. Statuses passed through
+// between observations are included when the validator's epochs record when they began, so that
+// a validator first seen exited still has its activation on record. It returns nil when current
+// is previous or cannot follow it.
+func PlanStatusTransitions(v *Validator, previous *LifecycleStatus, current LifecycleStatus, observed int64, genesis time.Time) []*StatusTransition {
+	if previous != nil && !previous.CanTransition(current) {
+		return nil
+	}
+
+	var transitions []*StatusTransition
+	add := func(status LifecycleStatus, epoch int64) {
+		var from *LifecycleStatus
+		if previous != nil {
+			p := *previous
+			from = &p
+		}
+		transitions = append(transitions, &StatusTransition{
+			ValidatorIndex: v.ValidatorIndex,
+			Status:         status,
+			PreviousStatus: from,
+			Epoch:          epoch,
+			Time:           types.EpochStartTime(genesis, epoch),
+		})
+		previous = &status
+	}
+
+	for _, status := range lifecycleStatuses[:current.order()] {
+		if previous != nil && !previous.CanTransition(status) || !status.CanTransition(current) {
+			continue
+		}
+		// The exit that was taken depends on whether the validator was slashed
+		if (status == LifecycleExitedUnslashed || status == LifecycleExitedSlashed) && status.Slashed() != v.Slashed {
+			continue
+		}
+		if epoch := v.lifecycleEpoch(status); epoch != nil && *epoch <= observed {
+			add(status, *epoch)
+		}
+	}
+
+	epoch := observed
+	if e := v.lifecycleEpoch(current); e != nil && *e <= observed {
+		epoch = *e
+	}
+	add(current, epoch)
+
+	return transitions
+}
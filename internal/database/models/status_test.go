@@ -0,0 +1,89 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+func TestLifecycleStatusCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to LifecycleStatus
+		want     bool
+	}{
+		{LifecyclePendingQueued, LifecycleActiveOngoing, true},
+		{LifecyclePendingInitialized, LifecycleActiveOngoing, true},
+		{LifecycleActiveExiting, LifecycleActiveSlashed, true},
+		{LifecycleExitedUnslashed, LifecycleExitedSlashed, true},
+		{LifecycleActiveSlashed, LifecycleWithdrawalPossible, true},
+		{LifecycleActiveSlashed, LifecycleExitedUnslashed, false},
+		{LifecycleActiveOngoing, LifecyclePendingQueued, false},
+		{LifecycleActiveOngoing, LifecycleActiveOngoing, false},
+		{LifecycleActiveOngoing, "active", false},
+	}
+	for _, tt := range tests {
+		if got := tt.from.CanTransition(tt.to); got != tt.want {
+			t.Errorf("%s.CanTransition(%s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestPlanStatusTransitions(t *testing.T) {
+	genesis := time.Unix(types.MainnetGenesisTime, 0)
+	v := &Validator{
+		ValidatorIndex:             7,
+		ActivationEligibilityEpoch: ptrInt64(100),
+		ActivationEpoch:            ptrInt64(105),
+		ExitEpoch:                  ptrInt64(500),
+		WithdrawableEpoch:          ptrInt64(756),
+	}
+
+	// A validator first seen withdrawable gets the statuses the chain dates on record
+	transitions := PlanStatusTransitions(v, nil, LifecycleWithdrawalPossible, 800, genesis)
+	want := []struct {
+		status LifecycleStatus
+		epoch  int64
+	}{
+		{LifecyclePendingQueued, 100},
+		{LifecycleActiveOngoing, 105},
+		{LifecycleExitedUnslashed, 500},
+		{LifecycleWithdrawalPossible, 756},
+	}
+	if len(transitions) != len(want) {
+		t.Fatalf("PlanStatusTransitions() returned %d transitions, want %d", len(transitions), len(want))
+	}
+	for i, w := range want {
+		if transitions[i].Status != w.status || transitions[i].Epoch != w.epoch {
+			t.Errorf("transition %d = %s at %d, want %s at %d", i, transitions[i].Status, transitions[i].Epoch, w.status, w.epoch)
+		}
+	}
+	if transitions[0].PreviousStatus != nil || *transitions[1].PreviousStatus != LifecyclePendingQueued {
+		t.Error("each transition should start from the status before it")
+	}
+	if !transitions[1].Time.Equal(types.EpochStartTime(genesis, 105)) {
+		t.Errorf("transition time = %v, want the start of its epoch", transitions[1].Time)
+	}
+	if got := transitions[1].Summary(); got != "Activated at epoch 105" {
+		t.Errorf("Summary() = %q", got)
+	}
+
+	// The chain does not date an exit initiation, so it is placed at the observation
+	active := LifecycleActiveOngoing
+	transitions = PlanStatusTransitions(v, &active, LifecycleActiveExiting, 450, genesis)
+	if len(transitions) != 1 || transitions[0].Epoch != 450 || *transitions[0].PreviousStatus != active {
+		t.Errorf("PlanStatusTransitions() = %+v, want a single exit initiation at the observed epoch", transitions)
+	}
+
+	// A slashed validator leaves through the slashed exit
+	v.Slashed = true
+	slashed := LifecycleActiveSlashed
+	transitions = PlanStatusTransitions(v, &slashed, LifecycleWithdrawalPossible, 800, genesis)
+	if len(transitions) != 2 || transitions[0].Status != LifecycleExitedSlashed {
+		t.Errorf("PlanStatusTransitions() = %+v, want the slashed exit then withdrawability", transitions)
+	}
+
+	if got := PlanStatusTransitions(v, &slashed, LifecycleActiveOngoing, 800, genesis); got != nil {
+		t.Errorf("PlanStatusTransitions() = %+v, want nil for a status going backwards", got)
+	}
+}
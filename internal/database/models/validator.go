@@ -8,21 +8,22 @@ import (
 
 // Validator represents an Ethereum validator
 type Validator struct {
-	ID                         int32     `db:"id"`
-	ValidatorIndex             int64     `db:"validator_index"`
-	Pubkey                     string    `db:"pubkey"`
-	WithdrawalCredentials      *string   `db:"withdrawal_credentials"`
-	EffectiveBalance           int64     `db:"effective_balance"`
-	Slashed                    bool      `db:"slashed"`
-	ActivationEpoch            *int64    `db:"activation_epoch"`
-	ActivationEligibilityEpoch *int64    `db:"activation_eligibility_epoch"`
-	ExitEpoch                  *int64    `db:"exit_epoch"`
-	WithdrawableEpoch          *int64    `db:"withdrawable_epoch"`
-	Name                       *string   `db:"name"`
-	Tags                       Tags      `db:"tags"`
-	Monitored                  bool      `db:"monitored"`
-	CreatedAt                  time.Time `db:"created_at"`
-	UpdatedAt                  time.Time `db:"updated_at"`
+	ID                         int32            `db:"id"`
+	ValidatorIndex             int64            `db:"validator_index"`
+	Pubkey                     string           `db:"pubkey"`
+	WithdrawalCredentials      *string          `db:"withdrawal_credentials"`
+	EffectiveBalance           int64            `db:"effective_balance"`
+	Slashed                    bool             `db:"slashed"`
+	ActivationEpoch            *int64           `db:"activation_epoch"`
+	ActivationEligibilityEpoch *int64           `db:"activation_eligibility_epoch"`
+	ExitEpoch                  *int64           `db:"exit_epoch"`
+	WithdrawableEpoch          *int64           `db:"withdrawable_epoch"`
+	Status                     *LifecycleStatus `db:"status"` // Nil until first observed
+	Name                       *string          `db:"name"`
+	Tags                       Tags             `db:"tags"`
+	Monitored                  bool             `db:"monitored"`
+	CreatedAt                  time.Time        `db:"created_at"`
+	UpdatedAt                  time.Time        `db:"updated_at"`
 }
 
 // ValidatorSnapshot represents a point-in-time validator state
@@ -45,6 +46,8 @@ type ValidatorSnapshot struct {
 	ConsecutiveMissedAttestations int32  `db:"consecutive_missed_attestations"`
 	DailyIncome                *int64    `db:"daily_income"`
 	APR                        *float64  `db:"apr"`
+	NetworkPercentile          *float64  `db:"network_percentile"`
+	NetworkAverage             *float64  `db:"network_average"`
 }
 
 // Alert represents a validator alert
@@ -123,6 +126,19 @@ func (t *Tags) Scan(value interface{}) error {
 	return nil
 }
 
+// NodeTagPrefix marks the tag naming the node a validator runs on, e.g. node:eu-west-1
+const NodeTagPrefix = "node:"
+
+// Node returns the node named by a node: tag, or "" if the validator has none
+func (t Tags) Node() string {
+	for _, tag := range t {
+		if strings.HasPrefix(tag, NodeTagPrefix) {
+			return strings.TrimPrefix(tag, NodeTagPrefix)
+		}
+	}
+	return ""
+}
+
 // JSONB represents a JSONB database column
 type JSONB map[string]interface{}
 
@@ -211,4 +227,4 @@ type AlertFilter struct {
 	EndTime        *time.Time
 	Limit          int
 	Offset         int
-}
\ No newline at end of file
+}
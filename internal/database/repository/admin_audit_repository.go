@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdminAuditRepository handles the admin action audit trail
+type AdminAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAdminAuditRepository creates a new admin audit repository
+func NewAdminAuditRepository(pool *pgxpool.Pool) *AdminAuditRepository {
+	return &AdminAuditRepository{
+		pool: pool,
+	}
+}
+
+// RecordAction appends an action to the audit trail, setting its ID and CreatedAt
+func (r *AdminAuditRepository) RecordAction(ctx context.Context, action *models.AdminAction) error {
+	var params []byte
+	if action.Params != nil {
+		encoded, err := json.Marshal(action.Params)
+		if err != nil {
+			return fmt.Errorf("failed to encode audit params: %w", err)
+		}
+		params = encoded
+	}
+
+	query := `
+		INSERT INTO admin_audit_log (actor, auth_method, action, params, success, error)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`
+
+	err := r.pool.QueryRow(ctx, query,
+		action.Actor,
+		action.AuthMethod,
+		action.Action,
+		params,
+		action.Success,
+		action.Error,
+	).Scan(&action.ID, &action.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	return nil
+}
+
+// ListActions returns audit trail entries, most recent first
+func (r *AdminAuditRepository) ListActions(ctx context.Context, limit, offset int) ([]*models.AdminAction, error) {
+	query := `
+		SELECT id, actor, auth_method, action, params, success, error, created_at
+		FROM admin_audit_log
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list admin actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []*models.AdminAction
+	for rows.Next() {
+		action := &models.AdminAction{}
+		var params []byte
+
+		err := rows.Scan(
+			&action.ID,
+			&action.Actor,
+			&action.AuthMethod,
+			&action.Action,
+			&params,
+			&action.Success,
+			&action.Error,
+			&action.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan admin action: %w", err)
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &action.Params); err != nil {
+				return nil, fmt.Errorf("failed to decode audit params: %w", err)
+			}
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, rows.Err()
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminAuditRepository_RecordAndList(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	repo := NewAdminAuditRepository(pool)
+	ctx := context.Background()
+
+	pause := &models.AdminAction{
+		Actor:      "alice",
+		AuthMethod: "session",
+		Action:     models.AdminActionPauseCollector,
+		Success:    true,
+	}
+	require.NoError(t, repo.RecordAction(ctx, pause))
+	assert.NotZero(t, pause.ID)
+	assert.False(t, pause.CreatedAt.IsZero())
+
+	failure := "validator not found: 42"
+	recollect := &models.AdminAction{
+		Actor:      "bob",
+		AuthMethod: "api_key",
+		Action:     models.AdminActionRecollect,
+		Params:     models.JSONB{"validator_index": float64(42), "from_epoch": float64(100)},
+		Success:    false,
+		Error:      &failure,
+	}
+	require.NoError(t, repo.RecordAction(ctx, recollect))
+
+	actions, err := repo.ListActions(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+
+	// Most recent first
+	assert.Equal(t, models.AdminActionRecollect, actions[0].Action)
+	assert.Equal(t, "bob", actions[0].Actor)
+	assert.False(t, actions[0].Success)
+	require.NotNil(t, actions[0].Error)
+	assert.Equal(t, failure, *actions[0].Error)
+	assert.Equal(t, float64(42), actions[0].Params["validator_index"])
+
+	assert.Equal(t, models.AdminActionPauseCollector, actions[1].Action)
+	assert.Nil(t, actions[1].Params)
+
+	// Pagination
+	actions, err = repo.ListActions(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "alice", actions[0].Actor)
+}
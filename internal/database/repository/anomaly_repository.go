@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AnomalyRepository reads the hourly performance series checked for anomalies and records the
+// anomalies found
+type AnomalyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAnomalyRepository creates a new anomaly repository
+func NewAnomalyRepository(pool *pgxpool.Pool) *AnomalyRepository {
+	return &AnomalyRepository{
+		pool: pool,
+	}
+}
+
+// HourlySamples returns the hourly performance series of every monitored validator over [from, to),
+// oldest first. Effectiveness and inclusion delay are averaged from snapshots; attestation income
+// comes from the rewards ledger, since snapshot balances also move with withdrawals and deposits.
+func (r *AnomalyRepository) HourlySamples(ctx context.Context, from, to time.Time) (map[int64][]*models.PerformanceSample, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH snapshots AS (
+			SELECT s.validator_index, date_trunc('hour', s.time) AS bucket,
+				AVG(s.attestation_effectiveness) AS effectiveness,
+				AVG(s.attestation_inclusion_delay)::double precision AS inclusion_delay
+			FROM validator_snapshots s
+			JOIN validators v ON v.validator_index = s.validator_index
+			WHERE v.monitored = TRUE AND s.time >= $1 AND s.time < $2
+			GROUP BY 1, 2
+		),
+		ledger AS (
+			SELECT l.validator_index, date_trunc('hour', l.time) AS bucket,
+				AVG(l.attestation_rewards - (l.penalties - l.sync_penalties))::double precision AS attestation_income
+			FROM validator_rewards_ledger l
+			JOIN validators v ON v.validator_index = l.validator_index
+			WHERE v.monitored = TRUE AND l.time >= $1 AND l.time < $2
+			GROUP BY 1, 2
+		)
+		SELECT COALESCE(s.validator_index, l.validator_index), COALESCE(s.bucket, l.bucket),
+			s.effectiveness, s.inclusion_delay, l.attestation_income
+		FROM snapshots s
+		FULL OUTER JOIN ledger l ON l.validator_index = s.validator_index AND l.bucket = s.bucket
+		ORDER BY 1, 2`,
+		from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hourly performance samples: %w", err)
+	}
+	defer rows.Close()
+
+	samples := make(map[int64][]*models.PerformanceSample)
+	for rows.Next() {
+		var validatorIndex int64
+		s := &models.PerformanceSample{}
+		if err := rows.Scan(&validatorIndex, &s.Time, &s.Effectiveness, &s.InclusionDelay, &s.AttestationIncome); err != nil {
+			return nil, fmt.Errorf("failed to scan performance sample: %w", err)
+		}
+		samples[validatorIndex] = append(samples[validatorIndex], s)
+	}
+
+	return samples, rows.Err()
+}
+
+// Record stores an anomaly and reports whether it starts a new one. It returns false if the
+// anomaly was already recorded, or if the same subject and metric were anomalous in a window
+// ending within the hour before, so an alert is raised once per episode.
+func (r *AnomalyRepository) Record(ctx context.Context, a *models.PerformanceAnomaly) (bool, error) {
+	var onset bool
+	err := r.pool.QueryRow(ctx, `
+		WITH inserted AS (
+			INSERT INTO performance_anomalies (
+				validator_index, tag, metric, window_start, window_end, baseline, deviation, observed, z_score
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (COALESCE(validator_index, -1), COALESCE(tag, ''), metric, window_end) DO NOTHING
+			RETURNING id
+		)
+		SELECT EXISTS (SELECT 1 FROM inserted) AND NOT EXISTS (
+			SELECT 1 FROM performance_anomalies
+			WHERE validator_index IS NOT DISTINCT FROM $1 AND tag IS NOT DISTINCT FROM $2 AND metric = $3
+				AND window_end >= $5::timestamptz - INTERVAL '1 hour' AND window_end < $5
+		)`,
+		a.ValidatorIndex, a.Tag, a.Metric, a.WindowStart, a.WindowEnd,
+		a.Baseline, a.Deviation, a.Observed, a.ZScore,
+	).Scan(&onset)
+	if err != nil {
+		return false, fmt.Errorf("failed to record performance anomaly: %w", err)
+	}
+
+	return onset, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnomalyRepository_HourlySamples(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(600)))
+	unmonitored := testutil.ValidatorFixture(601)
+	unmonitored.Monitored = false
+	require.NoError(t, validatorRepo.CreateValidator(ctx, unmonitored))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	hour := time.Now().UTC().Truncate(time.Hour).Add(-3 * time.Hour)
+
+	// Two snapshots in the first hour, none in the second
+	snapshotRepo := NewSnapshotRepository(pool)
+	first := testutil.ValidatorSnapshotFixture(600, hour.Add(10*time.Minute))
+	second := testutil.ValidatorSnapshotFixture(600, hour.Add(40*time.Minute))
+	lower, delay := 96.5, int32(2)
+	second.AttestationEffectiveness = &lower
+	second.AttestationInclusionDelay = &delay
+	require.NoError(t, snapshotRepo.InsertSnapshot(ctx, first))
+	require.NoError(t, snapshotRepo.InsertSnapshot(ctx, second))
+	require.NoError(t, snapshotRepo.InsertSnapshot(ctx, testutil.ValidatorSnapshotFixture(601, hour.Add(10*time.Minute))))
+
+	// Ledger entries in both hours; attestation income excludes sync penalties
+	epoch := types.EpochAtTime(genesis, hour.Add(time.Hour)) + 1
+	entry := ledgerEntryFixture(600, epoch, genesis)
+	entry.Penalties = 1500
+	entry.SyncPenalties = 500
+	require.NoError(t, NewRewardsLedgerRepository(pool).UpsertEntries(ctx, []*models.RewardLedgerEntry{
+		ledgerEntryFixture(600, types.EpochAtTime(genesis, hour)+1, genesis),
+		entry,
+	}))
+
+	samples, err := NewAnomalyRepository(pool).HourlySamples(ctx, hour, hour.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, samples, 1, "unmonitored validators are excluded")
+	series := samples[600]
+	require.Len(t, series, 2)
+
+	assert.True(t, series[0].Time.Equal(hour))
+	assert.InDelta(t, 97.5, *series[0].Effectiveness, 1e-9)
+	assert.InDelta(t, 1.5, *series[0].InclusionDelay, 1e-9)
+	assert.InDelta(t, 14000, *series[0].AttestationIncome, 1e-9)
+
+	assert.True(t, series[1].Time.Equal(hour.Add(time.Hour)))
+	assert.Nil(t, series[1].Effectiveness)
+	assert.Nil(t, series[1].InclusionDelay)
+	assert.InDelta(t, 14000, *series[1].AttestationIncome, 1e-9)
+}
+
+func TestAnomalyRepository_Record(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	repo := NewAnomalyRepository(pool)
+
+	index, tag := int64(600), "node:alpha"
+	end := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	anomaly := func(index *int64, tag *string, metric models.AnomalyMetric, windowEnd time.Time) *models.PerformanceAnomaly {
+		return &models.PerformanceAnomaly{
+			ValidatorIndex: index,
+			Tag:            tag,
+			Metric:         metric,
+			WindowStart:    windowEnd.Add(-6 * time.Hour),
+			WindowEnd:      windowEnd,
+			Baseline:       98,
+			Deviation:      1,
+			Observed:       93,
+			ZScore:         -5,
+		}
+	}
+
+	onset, err := repo.Record(ctx, anomaly(&index, nil, models.AnomalyMetricEffectiveness, end))
+	require.NoError(t, err)
+	assert.True(t, onset)
+
+	onset, err = repo.Record(ctx, anomaly(&index, nil, models.AnomalyMetricEffectiveness, end))
+	require.NoError(t, err)
+	assert.False(t, onset, "the same window is recorded once")
+
+	onset, err = repo.Record(ctx, anomaly(&index, nil, models.AnomalyMetricEffectiveness, end.Add(time.Hour)))
+	require.NoError(t, err)
+	assert.False(t, onset, "the next hour continues the anomaly")
+
+	onset, err = repo.Record(ctx, anomaly(&index, nil, models.AnomalyMetricInclusionDelay, end.Add(time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, onset, "another metric starts its own anomaly")
+
+	onset, err = repo.Record(ctx, anomaly(nil, &tag, models.AnomalyMetricEffectiveness, end.Add(time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, onset, "a tag group is a separate subject")
+
+	onset, err = repo.Record(ctx, anomaly(&index, nil, models.AnomalyMetricEffectiveness, end.Add(4*time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, onset, "an anomaly after a gap starts again")
+}
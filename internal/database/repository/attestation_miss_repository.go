@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AttestationMissRepository handles root causes of suboptimal attestations
+type AttestationMissRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttestationMissRepository creates a new attestation miss repository
+func NewAttestationMissRepository(pool *pgxpool.Pool) *AttestationMissRepository {
+	return &AttestationMissRepository{
+		pool: pool,
+	}
+}
+
+// SaveEpoch atomically stores the misses found in an epoch and marks the epoch as analysed.
+// duties is the number of attestation duties checked.
+func (r *AttestationMissRepository) SaveEpoch(ctx context.Context, epoch int64, duties int, misses []*models.AttestationMiss) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if len(misses) > 0 {
+		query := `
+			INSERT INTO attestation_misses (
+				validator_index, epoch, time, slot, reason, inclusion_slot, inclusion_delay
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (validator_index, epoch) DO UPDATE SET
+				time = EXCLUDED.time,
+				slot = EXCLUDED.slot,
+				reason = EXCLUDED.reason,
+				inclusion_slot = EXCLUDED.inclusion_slot,
+				inclusion_delay = EXCLUDED.inclusion_delay`
+
+		batch := &pgx.Batch{}
+		for _, m := range misses {
+			batch.Queue(query, m.ValidatorIndex, m.Epoch, m.Time, m.Slot, m.Reason, m.InclusionSlot, m.InclusionDelay)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for range misses {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return fmt.Errorf("failed to upsert attestation miss: %w", err)
+			}
+		}
+		if err := results.Close(); err != nil {
+			return fmt.Errorf("failed to upsert attestation misses: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO attestation_analysis_epochs (epoch, duties, misses, analyzed_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (epoch) DO UPDATE SET
+			duties = EXCLUDED.duties,
+			misses = EXCLUDED.misses,
+			analyzed_at = EXCLUDED.analyzed_at`,
+		epoch, duties, len(misses),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark epoch analysed: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit attestation misses: %w", err)
+	}
+
+	return nil
+}
+
+// AnalyzedEpochs returns the epochs in [from, to] that have already been analysed
+func (r *AttestationMissRepository) AnalyzedEpochs(ctx context.Context, from, to int64) (map[int64]bool, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT epoch FROM attestation_analysis_epochs
+		WHERE epoch >= $1 AND epoch <= $2`,
+		from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analysed epochs: %w", err)
+	}
+	defer rows.Close()
+
+	analyzed := make(map[int64]bool)
+	for rows.Next() {
+		var epoch int64
+		if err := rows.Scan(&epoch); err != nil {
+			return nil, fmt.Errorf("failed to scan analysed epoch: %w", err)
+		}
+		analyzed[epoch] = true
+	}
+
+	return analyzed, rows.Err()
+}
+
+// GetMisses returns a validator's misses with epoch start times in [from, to), newest first
+func (r *AttestationMissRepository) GetMisses(ctx context.Context, validatorIndex int64, from, to time.Time) ([]*models.AttestationMiss, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, epoch, time, slot, reason, inclusion_slot, inclusion_delay
+		FROM attestation_misses
+		WHERE validator_index = $1 AND time >= $2 AND time < $3
+		ORDER BY epoch DESC`,
+		validatorIndex, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attestation misses: %w", err)
+	}
+	defer rows.Close()
+
+	var misses []*models.AttestationMiss
+	for rows.Next() {
+		m := &models.AttestationMiss{}
+		if err := rows.Scan(&m.ValidatorIndex, &m.Epoch, &m.Time, &m.Slot, &m.Reason, &m.InclusionSlot, &m.InclusionDelay); err != nil {
+			return nil, fmt.Errorf("failed to scan attestation miss: %w", err)
+		}
+		misses = append(misses, m)
+	}
+
+	return misses, rows.Err()
+}
+
+// CountsByValidator returns miss counts by reason for each of the given validators over [from, to).
+// Validators without misses are omitted.
+func (r *AttestationMissRepository) CountsByValidator(ctx context.Context, validatorIndices []int64, from, to time.Time) (map[int64][]*models.AttestationMissCount, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, reason, COUNT(*)
+		FROM attestation_misses
+		WHERE validator_index = ANY($1) AND time >= $2 AND time < $3
+		GROUP BY validator_index, reason
+		ORDER BY validator_index, COUNT(*) DESC, reason`,
+		validatorIndices, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count attestation misses by validator: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int64][]*models.AttestationMissCount)
+	for rows.Next() {
+		var index int64
+		c := &models.AttestationMissCount{}
+		if err := rows.Scan(&index, &c.Reason, &c.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan attestation miss count: %w", err)
+		}
+		counts[index] = append(counts[index], c)
+	}
+
+	return counts, rows.Err()
+}
+
+// CountsByNode returns miss counts by reason for each node, as named by the validators' node: tags,
+// over [from, to). Misses by validators without a node tag are grouped under "".
+func (r *AttestationMissRepository) CountsByNode(ctx context.Context, from, to time.Time) (map[string][]*models.AttestationMissCount, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT COALESCE(n.node, ''), m.reason, COUNT(*)
+		FROM attestation_misses m
+		JOIN validators v ON v.validator_index = m.validator_index
+		LEFT JOIN LATERAL (
+			SELECT substr(tag, length($3::text) + 1) AS node
+			FROM unnest(v.tags) AS tag
+			WHERE starts_with(tag, $3::text)
+			LIMIT 1
+		) n ON true
+		WHERE m.time >= $1 AND m.time < $2
+		GROUP BY 1, m.reason
+		ORDER BY 1, COUNT(*) DESC, m.reason`,
+		from, to, models.NodeTagPrefix,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count attestation misses by node: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string][]*models.AttestationMissCount)
+	for rows.Next() {
+		var node string
+		c := &models.AttestationMissCount{}
+		if err := rows.Scan(&node, &c.Reason, &c.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan attestation miss count: %w", err)
+		}
+		counts[node] = append(counts[node], c)
+	}
+
+	return counts, rows.Err()
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationMissRepository_SaveAndCount(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	for i, tags := range [][]string{{"prod", "node:alpha"}, {"node:alpha"}, {"prod"}} {
+		v := testutil.ValidatorFixture(int64(300 + i))
+		v.Tags = tags
+		require.NoError(t, validatorRepo.CreateValidator(ctx, v))
+	}
+
+	repo := NewAttestationMissRepository(pool)
+	epochTime := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	inclusionSlot, inclusionDelay := int64(3205), int32(5)
+
+	miss := func(index, epoch int64, reason models.AttestationMissReason) *models.AttestationMiss {
+		return &models.AttestationMiss{ValidatorIndex: index, Epoch: epoch, Time: epochTime, Slot: epoch * 32, Reason: reason}
+	}
+	late := miss(300, 100, models.AttestationMissLateInclusion)
+	late.InclusionSlot, late.InclusionDelay = &inclusionSlot, &inclusionDelay
+
+	require.NoError(t, repo.SaveEpoch(ctx, 100, 3, []*models.AttestationMiss{
+		late,
+		miss(301, 100, models.AttestationMissNotIncluded),
+		miss(302, 100, models.AttestationMissNotIncluded),
+	}))
+	require.NoError(t, repo.SaveEpoch(ctx, 101, 3, []*models.AttestationMiss{miss(300, 101, models.AttestationMissNotIncluded)}))
+	require.NoError(t, repo.SaveEpoch(ctx, 102, 3, nil))
+
+	analyzed, err := repo.AnalyzedEpochs(ctx, 99, 102)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]bool{100: true, 101: true, 102: true}, analyzed)
+
+	from, to := epochTime.Add(-time.Hour), epochTime.Add(time.Hour)
+
+	misses, err := repo.GetMisses(ctx, 300, from, to)
+	require.NoError(t, err)
+	require.Len(t, misses, 2)
+	assert.Equal(t, int64(101), misses[0].Epoch, "newest first")
+	assert.Nil(t, misses[0].InclusionSlot)
+	assert.Equal(t, models.AttestationMissLateInclusion, misses[1].Reason)
+	assert.Equal(t, int32(5), *misses[1].InclusionDelay)
+
+	byValidator, err := repo.CountsByValidator(ctx, []int64{300, 301, 399}, from, to)
+	require.NoError(t, err)
+	assert.Len(t, byValidator, 2)
+	assert.Len(t, byValidator[300], 2)
+	assert.Equal(t, []*models.AttestationMissCount{{Reason: models.AttestationMissNotIncluded, Count: 1}}, byValidator[301])
+
+	byNode, err := repo.CountsByNode(ctx, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.AttestationMissCount{
+		{Reason: models.AttestationMissNotIncluded, Count: 2},
+		{Reason: models.AttestationMissLateInclusion, Count: 1},
+	}, byNode["alpha"])
+	assert.Equal(t, []*models.AttestationMissCount{{Reason: models.AttestationMissNotIncluded, Count: 1}}, byNode[""])
+}
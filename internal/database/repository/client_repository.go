@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ClientRepository stores the consensus clients identified from block graffiti
+type ClientRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewClientRepository creates a new client repository
+func NewClientRepository(pool *pgxpool.Pool) *ClientRepository {
+	return &ClientRepository{
+		pool: pool,
+	}
+}
+
+// RecordBlocks stores the clients of canonical blocks and returns those newly recorded; blocks
+// already recorded are skipped
+func (r *ClientRepository) RecordBlocks(ctx context.Context, blocks []*models.BlockClient) ([]*models.BlockClient, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, b := range blocks {
+		batch.Queue(`
+			INSERT INTO block_clients (slot, epoch, proposer_index, time, graffiti, client, version, execution_client)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (slot) DO NOTHING`,
+			b.Slot, b.Epoch, b.ProposerIndex, b.Time, b.Graffiti, b.Client, b.Version, b.ExecutionClient,
+		)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	var recorded []*models.BlockClient
+	for _, b := range blocks {
+		tag, err := results.Exec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to record block client: %w", err)
+		}
+		if tag.RowsAffected() > 0 {
+			recorded = append(recorded, b)
+		}
+	}
+
+	return recorded, nil
+}
+
+// EpochDiversity returns the client distribution of each of the most recent epochs recorded,
+// newest first
+func (r *ClientRepository) EpochDiversity(ctx context.Context, epochs int) ([]*models.EpochClientDiversity, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT epoch, MIN(time - (slot - epoch * 32) * INTERVAL '12 seconds'), client, COUNT(*)
+		FROM block_clients
+		WHERE epoch > (SELECT MAX(epoch) FROM block_clients) - $1
+		GROUP BY epoch, client
+		ORDER BY epoch DESC`,
+		epochs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client diversity: %w", err)
+	}
+	defer rows.Close()
+
+	var diversity []*models.EpochClientDiversity
+	var counts map[types.ConsensusClient]int64
+	for rows.Next() {
+		var d models.EpochClientDiversity
+		var client types.ConsensusClient
+		var count int64
+		if err := rows.Scan(&d.Epoch, &d.Time, &client, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan client diversity: %w", err)
+		}
+
+		if n := len(diversity); n == 0 || diversity[n-1].Epoch != d.Epoch {
+			if n > 0 {
+				diversity[n-1].Distribution = models.NewClientDistribution(counts)
+			}
+			counts = make(map[types.ConsensusClient]int64)
+			diversity = append(diversity, &d)
+		}
+		counts[client] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if n := len(diversity); n > 0 {
+		diversity[n-1].Distribution = models.NewClientDistribution(counts)
+	}
+
+	return diversity, nil
+}
+
+// NetworkDistribution returns the client distribution of the blocks in the most recent epochs
+// recorded
+func (r *ClientRepository) NetworkDistribution(ctx context.Context, epochs int) (*models.ClientDistribution, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT client, COUNT(*)
+		FROM block_clients
+		WHERE epoch > (SELECT MAX(epoch) FROM block_clients) - $1
+		GROUP BY client`,
+		epochs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network client distribution: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[types.ConsensusClient]int64)
+	for rows.Next() {
+		var client types.ConsensusClient
+		var count int64
+		if err := rows.Scan(&client, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan network client distribution: %w", err)
+		}
+		counts[client] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models.NewClientDistribution(counts), nil
+}
+
+// ValidatorClients attributes each monitored validator that has proposed, optionally only those
+// carrying a tag, to the client of its most recent identified proposal, or of its most recent
+// proposal when none was identified
+func (r *ClientRepository) ValidatorClients(ctx context.Context, tag *string) ([]*models.BlockClient, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT DISTINCT ON (b.proposer_index)
+			b.slot, b.epoch, b.proposer_index, b.time, b.graffiti, b.client, b.version, b.execution_client
+		FROM block_clients b
+		JOIN validators v ON v.validator_index = b.proposer_index
+		WHERE v.monitored = TRUE AND ($1::text IS NULL OR $1 = ANY(v.tags))
+		ORDER BY b.proposer_index, b.client <> 'unknown' DESC, b.slot DESC`,
+		tag,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator clients: %w", err)
+	}
+	defer rows.Close()
+
+	var clients []*models.BlockClient
+	for rows.Next() {
+		b := &models.BlockClient{}
+		if err := rows.Scan(&b.Slot, &b.Epoch, &b.ProposerIndex, &b.Time, &b.Graffiti, &b.Client, &b.Version,
+			&b.ExecutionClient); err != nil {
+			return nil, fmt.Errorf("failed to scan validator client: %w", err)
+		}
+		clients = append(clients, b)
+	}
+
+	return clients, rows.Err()
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRepository_DiversityAndFleet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(830)))
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(831)))
+
+	repo := NewClientRepository(pool)
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	blocks := []*models.BlockClient{
+		{Slot: 3200, ProposerIndex: 830, Client: types.ClientLighthouse, Version: "5.1.3"},
+		{Slot: 3201, ProposerIndex: 900, Client: types.ClientLighthouse},
+		{Slot: 3202, ProposerIndex: 901, Client: types.ClientUnknown},
+		{Slot: 3232, ProposerIndex: 831, Client: types.ClientTeku, ExecutionClient: "geth"},
+		{Slot: 3233, ProposerIndex: 830, Client: types.ClientUnknown},
+	}
+	for _, b := range blocks {
+		b.Epoch = b.Slot / types.SlotsPerEpoch
+		b.Time = types.SlotStartTime(genesis, b.Slot)
+	}
+	recorded, err := repo.RecordBlocks(ctx, blocks)
+	require.NoError(t, err)
+	assert.Len(t, recorded, 5)
+	recorded, err = repo.RecordBlocks(ctx, blocks[:2])
+	require.NoError(t, err)
+	assert.Empty(t, recorded, "recorded blocks are skipped")
+
+	diversity, err := repo.EpochDiversity(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, diversity, 2)
+	assert.Equal(t, int64(101), diversity[0].Epoch)
+	assert.Equal(t, types.EpochStartTime(genesis, 100), diversity[1].Time.UTC())
+	assert.Equal(t, int64(3), diversity[1].Distribution.Total)
+	assert.Equal(t, 1.0, diversity[1].Distribution.ShareOf(types.ClientLighthouse))
+
+	network, err := repo.NetworkDistribution(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), network.Total, "only the latest epoch")
+
+	clients, err := repo.ValidatorClients(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, clients, 2, "only monitored validators")
+	assert.Equal(t, types.ClientLighthouse, clients[0].Client, "the latest identified proposal wins")
+	assert.Equal(t, int64(3200), clients[0].Slot)
+	assert.Equal(t, "geth", clients[1].ExecutionClient)
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ConsolidationRepository stores consolidation requests that name monitored validators
+type ConsolidationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewConsolidationRepository creates a new consolidation repository
+func NewConsolidationRepository(pool *pgxpool.Pool) *ConsolidationRepository {
+	return &ConsolidationRepository{
+		pool: pool,
+	}
+}
+
+// SaveRequests stores consolidation requests, ignoring any already recorded
+func (r *ConsolidationRepository) SaveRequests(ctx context.Context, requests []*models.ConsolidationRequest) error {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, c := range requests {
+		batch.Queue(`
+			INSERT INTO consolidation_requests (
+				slot, time, source_address, source_pubkey, target_pubkey, source_index, target_index
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (slot, source_pubkey, target_pubkey) DO NOTHING`,
+			c.Slot, c.Time, c.SourceAddress, c.SourcePubkey, c.TargetPubkey, c.SourceIndex, c.TargetIndex,
+		)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for range requests {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to save consolidation request: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetForValidator returns the consolidation requests naming a validator as source or target over
+// [from, to), newest first
+func (r *ConsolidationRepository) GetForValidator(ctx context.Context, validatorIndex int64, from, to time.Time) ([]*models.ConsolidationRequest, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT slot, time, source_address, source_pubkey, target_pubkey, source_index, target_index
+		FROM consolidation_requests
+		WHERE (source_index = $1 OR target_index = $1) AND time >= $2 AND time < $3
+		ORDER BY time DESC`,
+		validatorIndex, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consolidation requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []*models.ConsolidationRequest
+	for rows.Next() {
+		c := &models.ConsolidationRequest{}
+		if err := rows.Scan(&c.Slot, &c.Time, &c.SourceAddress, &c.SourcePubkey, &c.TargetPubkey, &c.SourceIndex, &c.TargetIndex); err != nil {
+			return nil, fmt.Errorf("failed to scan consolidation request: %w", err)
+		}
+		requests = append(requests, c)
+	}
+
+	return requests, rows.Err()
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsolidationRepository_SaveAndGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	source, target := testutil.ValidatorFixture(700), testutil.ValidatorFixture(701)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, source))
+	require.NoError(t, validatorRepo.CreateValidator(ctx, target))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	sourceIndex, targetIndex := int64(700), int64(701)
+	requests := []*models.ConsolidationRequest{
+		{
+			Slot: 3201, Time: types.SlotStartTime(genesis, 3201), SourceAddress: "0xdead",
+			SourcePubkey: source.Pubkey, TargetPubkey: target.Pubkey,
+			SourceIndex: &sourceIndex, TargetIndex: &targetIndex,
+		},
+		{
+			Slot: 3300, Time: types.SlotStartTime(genesis, 3300), SourceAddress: "0xdead",
+			SourcePubkey: target.Pubkey, TargetPubkey: target.Pubkey,
+			SourceIndex: &targetIndex, TargetIndex: &targetIndex,
+		},
+	}
+
+	repo := NewConsolidationRepository(pool)
+	require.NoError(t, repo.SaveRequests(ctx, requests))
+	require.NoError(t, repo.SaveRequests(ctx, requests[:1]), "duplicates are ignored")
+
+	from, to := genesis, types.SlotStartTime(genesis, 4000)
+	forSource, err := repo.GetForValidator(ctx, 700, from, to)
+	require.NoError(t, err)
+	require.Len(t, forSource, 1)
+	assert.Equal(t, int64(3201), forSource[0].Slot)
+	assert.True(t, forSource[0].Internal())
+
+	forTarget, err := repo.GetForValidator(ctx, 701, from, to)
+	require.NoError(t, err)
+	require.Len(t, forTarget, 2)
+	assert.True(t, forTarget[0].IsSwitchToCompounding(), "newest first")
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CredentialRepository stores withdrawal credential changes and execution layer withdrawal
+// requests for monitored validators
+type CredentialRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCredentialRepository creates a new credential repository
+func NewCredentialRepository(pool *pgxpool.Pool) *CredentialRepository {
+	return &CredentialRepository{
+		pool: pool,
+	}
+}
+
+// RecordChange stores a credential change and the validator's new withdrawal credentials in one
+// transaction, reporting false if the change was already recorded. Credentials are only replaced
+// while they are still BLS (0x00) ones, so replaying an old change cannot undo a later one.
+func (r *CredentialRepository) RecordChange(ctx context.Context, c *models.CredentialChange) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO validator_credential_changes (
+			validator_index, slot, time, from_bls_pubkey, to_execution_address, allowlisted
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (validator_index, slot) DO NOTHING`,
+		c.ValidatorIndex, c.Slot, c.Time, c.FromBLSPubkey, c.ToExecutionAddress, c.Allowlisted,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record credential change: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE validators
+		SET withdrawal_credentials = $2
+		WHERE validator_index = $1
+			AND (withdrawal_credentials IS NULL OR lower(withdrawal_credentials) LIKE '0x00%')`,
+		c.ValidatorIndex, c.WithdrawalCredentials(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update withdrawal credentials: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdateCredentials stores a validator's withdrawal credentials as read from the beacon state
+func (r *CredentialRepository) UpdateCredentials(ctx context.Context, validatorIndex int64, credentials string) error {
+	query := `UPDATE validators SET withdrawal_credentials = $2 WHERE validator_index = $1`
+
+	if _, err := r.pool.Exec(ctx, query, validatorIndex, credentials); err != nil {
+		return fmt.Errorf("failed to update withdrawal credentials: %w", err)
+	}
+
+	return nil
+}
+
+// RecordWithdrawalRequest stores a withdrawal request, reporting false if it was already recorded
+func (r *CredentialRepository) RecordWithdrawalRequest(ctx context.Context, w *models.WithdrawalRequest) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO validator_withdrawal_requests (
+			validator_index, slot, time, source_address, amount
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (validator_index, slot, source_address, amount) DO NOTHING`,
+		w.ValidatorIndex, w.Slot, w.Time, w.SourceAddress, w.Amount,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record withdrawal request: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetChanges returns a validator's credential changes, newest first
+func (r *CredentialRepository) GetChanges(ctx context.Context, validatorIndex int64) ([]*models.CredentialChange, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, slot, time, from_bls_pubkey, to_execution_address, allowlisted
+		FROM validator_credential_changes
+		WHERE validator_index = $1
+		ORDER BY slot DESC`,
+		validatorIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*models.CredentialChange
+	for rows.Next() {
+		c := &models.CredentialChange{}
+		if err := rows.Scan(&c.ValidatorIndex, &c.Slot, &c.Time, &c.FromBLSPubkey, &c.ToExecutionAddress, &c.Allowlisted); err != nil {
+			return nil, fmt.Errorf("failed to scan credential change: %w", err)
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+// GetWithdrawalRequests returns a validator's execution layer withdrawal requests, newest first
+func (r *CredentialRepository) GetWithdrawalRequests(ctx context.Context, validatorIndex int64) ([]*models.WithdrawalRequest, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, slot, time, source_address, amount
+		FROM validator_withdrawal_requests
+		WHERE validator_index = $1
+		ORDER BY slot DESC, id DESC`,
+		validatorIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdrawal requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []*models.WithdrawalRequest
+	for rows.Next() {
+		w := &models.WithdrawalRequest{}
+		if err := rows.Scan(&w.ValidatorIndex, &w.Slot, &w.Time, &w.SourceAddress, &w.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan withdrawal request: %w", err)
+		}
+		requests = append(requests, w)
+	}
+
+	return requests, rows.Err()
+}
+
+// HasWithdrawalRequest reports whether a partial withdrawal of at least amount Gwei was recorded
+// for a validator from a block. The beacon chain caps a request at the balance above the
+// minimum, so the queued amount may be lower than the requested one.
+func (r *CredentialRepository) HasWithdrawalRequest(ctx context.Context, validatorIndex, amount int64) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM validator_withdrawal_requests
+			WHERE validator_index = $1 AND amount >= $2 AND amount > 0
+		)`,
+		validatorIndex, amount,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check withdrawal requests: %w", err)
+	}
+
+	return exists, nil
+}
+
+// RecordQueuedWithdrawal stores a partial withdrawal queue entry, reporting false if it was
+// already recorded
+func (r *CredentialRepository) RecordQueuedWithdrawal(ctx context.Context, w *models.QueuedWithdrawal) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO validator_queued_withdrawals (validator_index, withdrawable_epoch, amount)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (validator_index, withdrawable_epoch, amount) DO NOTHING`,
+		w.ValidatorIndex, w.WithdrawableEpoch, w.Amount,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record queued withdrawal: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// RecordQueuedConsolidation stores a consolidation queue entry, reporting false if it was already
+// recorded
+func (r *CredentialRepository) RecordQueuedConsolidation(ctx context.Context, c *models.QueuedConsolidation) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO validator_queued_consolidations (source_index, target_index)
+		VALUES ($1, $2)
+		ON CONFLICT (source_index, target_index) DO NOTHING`,
+		c.SourceIndex, c.TargetIndex,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record queued consolidation: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialRepository_RecordAndGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	require.NoError(t, NewValidatorRepository(pool).CreateValidator(ctx, testutil.ValidatorFixture(800)))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	repo := NewCredentialRepository(pool)
+
+	change := &models.CredentialChange{
+		ValidatorIndex:     800,
+		Slot:               3201,
+		Time:               types.SlotStartTime(genesis, 3201),
+		FromBLSPubkey:      "0xaa",
+		ToExecutionAddress: "0xbeef",
+	}
+	inserted, err := repo.RecordChange(ctx, change)
+	require.NoError(t, err)
+	assert.True(t, inserted)
+	inserted, err = repo.RecordChange(ctx, change)
+	require.NoError(t, err)
+	assert.False(t, inserted, "a change is recorded once")
+
+	validator, err := NewValidatorRepository(pool).GetValidatorByIndex(ctx, 800)
+	require.NoError(t, err)
+	require.NotNil(t, validator.WithdrawalCredentials)
+	assert.Equal(t, "0x01"+strings.Repeat("00", 11)+"beef", *validator.WithdrawalCredentials,
+		"the credentials change with the recorded operation")
+
+	compounding := "0x02" + strings.Repeat("00", 11) + "beef"
+	require.NoError(t, repo.UpdateCredentials(ctx, 800, compounding))
+	validator, err = NewValidatorRepository(pool).GetValidatorByIndex(ctx, 800)
+	require.NoError(t, err)
+	assert.Equal(t, compounding, *validator.WithdrawalCredentials)
+
+	changes, err := repo.GetChanges(ctx, 800)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "0xbeef", changes[0].ToExecutionAddress)
+	assert.False(t, changes[0].Allowlisted)
+
+	for _, slot := range []int64{3300, 3400} {
+		inserted, err := repo.RecordWithdrawalRequest(ctx, &models.WithdrawalRequest{
+			ValidatorIndex: 800,
+			Slot:           slot,
+			Time:           types.SlotStartTime(genesis, slot),
+			SourceAddress:  "0xbeef",
+			Amount:         (3400 - slot) * 1_000_000,
+		})
+		require.NoError(t, err)
+		assert.True(t, inserted)
+	}
+
+	requests, err := repo.GetWithdrawalRequests(ctx, 800)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.True(t, requests[0].IsFullExit(), "newest first")
+	assert.Equal(t, int64(100_000_000), requests[1].Amount)
+
+	requested, err := repo.HasWithdrawalRequest(ctx, 800, 50_000_000)
+	require.NoError(t, err)
+	assert.True(t, requested, "a queued amount below the requested one was capped")
+	requested, err = repo.HasWithdrawalRequest(ctx, 800, 200_000_000)
+	require.NoError(t, err)
+	assert.False(t, requested)
+
+	queued := &models.QueuedWithdrawal{ValidatorIndex: 800, WithdrawableEpoch: 400, Amount: 100_000_000}
+	inserted, err = repo.RecordQueuedWithdrawal(ctx, queued)
+	require.NoError(t, err)
+	assert.True(t, inserted)
+	inserted, err = repo.RecordQueuedWithdrawal(ctx, queued)
+	require.NoError(t, err)
+	assert.False(t, inserted, "a queue entry is recorded once")
+
+	consolidation := &models.QueuedConsolidation{SourceIndex: 800, TargetIndex: 900}
+	inserted, err = repo.RecordQueuedConsolidation(ctx, consolidation)
+	require.NoError(t, err)
+	assert.True(t, inserted)
+	inserted, err = repo.RecordQueuedConsolidation(ctx, consolidation)
+	require.NoError(t, err)
+	assert.False(t, inserted)
+}
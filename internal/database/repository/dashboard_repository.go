@@ -29,6 +29,12 @@ type AggregateMetrics struct {
 	AvgEffectiveness  float64 `json:"avg_effectiveness"`
 	TotalBalanceGwei  int64   `json:"total_balance_gwei"`
 	SlashedValidators int     `json:"slashed_validators"`
+
+	// Portfolio income per window; filled in by the dashboard service, not by GetAggregateMetrics
+	Income []*models.IncomeSummary `json:"income,omitempty"`
+
+	// Proposal luck of the fleet over the last 30 days; filled in by the dashboard service
+	ProposalLuck *models.ProposalLuck `json:"proposal_luck,omitempty"`
 }
 
 // ValidatorSummary represents a top-performing validator
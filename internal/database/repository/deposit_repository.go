@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DepositRepository stores deposits to monitored validators and their progress through the
+// deposit queue
+type DepositRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDepositRepository creates a new deposit repository
+func NewDepositRepository(pool *pgxpool.Pool) *DepositRepository {
+	return &DepositRepository{
+		pool: pool,
+	}
+}
+
+// RecordDeposit stores a deposit, reporting false if it was already recorded. The ID and
+// observation time of a new deposit are set on d.
+func (r *DepositRepository) RecordDeposit(ctx context.Context, d *models.ValidatorDeposit) (bool, error) {
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO validator_deposits (
+			validator_index, slot, time, withdrawal_credentials, signature, amount, status,
+			queue_position, queue_amount_ahead, credited_epoch, unexpected_reason
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (validator_index, slot, signature, amount) DO NOTHING
+		RETURNING id, observed_at`,
+		d.ValidatorIndex, d.Slot, d.Time, d.WithdrawalCredentials, d.Signature, d.Amount, d.Status,
+		d.QueuePosition, d.QueueAmountAhead, d.CreditedEpoch, d.UnexpectedReason,
+	).Scan(&d.ID, &d.ObservedAt)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to record deposit: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdateQueue stores the place in the queue of deposits still pending and marks the given
+// deposits credited at their CreditedEpoch
+func (r *DepositRepository) UpdateQueue(ctx context.Context, queued, credited []*models.ValidatorDeposit) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, d := range queued {
+		_, err := tx.Exec(ctx, `
+			UPDATE validator_deposits
+			SET queue_position = $2, queue_amount_ahead = $3
+			WHERE id = $1`,
+			d.ID, d.QueuePosition, d.QueueAmountAhead,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update deposit queue position: %w", err)
+		}
+	}
+
+	for _, d := range credited {
+		_, err := tx.Exec(ctx, `
+			UPDATE validator_deposits
+			SET status = 'credited', credited_epoch = $2, queue_position = NULL, queue_amount_ahead = NULL
+			WHERE id = $1`,
+			d.ID, d.CreditedEpoch,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to mark deposit credited: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingDeposits returns the deposits not yet credited, oldest first
+func (r *DepositRepository) GetPendingDeposits(ctx context.Context) ([]*models.ValidatorDeposit, error) {
+	return r.queryDeposits(ctx, `
+		WHERE status = 'pending'
+		ORDER BY slot, id`)
+}
+
+// GetDeposits returns a validator's deposits, newest first
+func (r *DepositRepository) GetDeposits(ctx context.Context, validatorIndex int64) ([]*models.ValidatorDeposit, error) {
+	return r.queryDeposits(ctx, `
+		WHERE validator_index = $1
+		ORDER BY time DESC, id DESC`,
+		validatorIndex,
+	)
+}
+
+// queryDeposits returns the deposits selected by a WHERE and ORDER BY clause
+func (r *DepositRepository) queryDeposits(ctx context.Context, clause string, args ...interface{}) ([]*models.ValidatorDeposit, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, validator_index, slot, time, withdrawal_credentials, signature, amount, status,
+			queue_position, queue_amount_ahead, credited_epoch, unexpected_reason, observed_at
+		FROM validator_deposits
+		`+clause,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposits: %w", err)
+	}
+	defer rows.Close()
+
+	var deposits []*models.ValidatorDeposit
+	for rows.Next() {
+		d := &models.ValidatorDeposit{}
+		err := rows.Scan(
+			&d.ID, &d.ValidatorIndex, &d.Slot, &d.Time, &d.WithdrawalCredentials, &d.Signature, &d.Amount, &d.Status,
+			&d.QueuePosition, &d.QueueAmountAhead, &d.CreditedEpoch, &d.UnexpectedReason, &d.ObservedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan deposit: %w", err)
+		}
+		deposits = append(deposits, d)
+	}
+
+	return deposits, rows.Err()
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositRepository_QueueLifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	require.NoError(t, NewValidatorRepository(pool).CreateValidator(ctx, testutil.ValidatorFixture(880)))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	repo := NewDepositRepository(pool)
+
+	position, ahead := int64(12), int64(40_000_000_000)
+	reason := "validator is active_exiting"
+	first := &models.ValidatorDeposit{
+		ValidatorIndex:        880,
+		Slot:                  3201,
+		Time:                  types.SlotStartTime(genesis, 3201),
+		WithdrawalCredentials: "0x01",
+		Signature:             "0x5e",
+		Amount:                1_000_000_000,
+		Status:                models.DepositStatusPending,
+		QueuePosition:         &position,
+		QueueAmountAhead:      &ahead,
+	}
+	second := &models.ValidatorDeposit{
+		ValidatorIndex:        880,
+		Slot:                  3300,
+		Time:                  types.SlotStartTime(genesis, 3300),
+		WithdrawalCredentials: "0x01",
+		Signature:             "0x5f",
+		Amount:                2_000_000_000,
+		Status:                models.DepositStatusPending,
+		UnexpectedReason:      &reason,
+	}
+	for _, d := range []*models.ValidatorDeposit{first, second} {
+		inserted, err := repo.RecordDeposit(ctx, d)
+		require.NoError(t, err)
+		assert.True(t, inserted)
+		assert.NotZero(t, d.ID)
+	}
+	inserted, err := repo.RecordDeposit(ctx, &models.ValidatorDeposit{
+		ValidatorIndex: 880, Slot: 3201, Time: first.Time, WithdrawalCredentials: "0x01", Signature: "0x5e",
+		Amount: 1_000_000_000, Status: models.DepositStatusPending,
+	})
+	require.NoError(t, err)
+	assert.False(t, inserted, "a deposit is recorded once")
+
+	pending, err := repo.GetPendingDeposits(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, first.ID, pending[0].ID, "oldest first")
+
+	epoch := int64(110)
+	position = 3
+	first.CreditedEpoch = &epoch
+	second.QueuePosition = &position
+	require.NoError(t, repo.UpdateQueue(ctx, []*models.ValidatorDeposit{second}, []*models.ValidatorDeposit{first}))
+
+	pending, err = repo.GetPendingDeposits(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, second.ID, pending[0].ID)
+	require.NotNil(t, pending[0].QueuePosition)
+	assert.Equal(t, int64(3), *pending[0].QueuePosition)
+
+	deposits, err := repo.GetDeposits(ctx, 880)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	assert.Equal(t, second.ID, deposits[0].ID, "newest first")
+	require.NotNil(t, deposits[0].UnexpectedReason)
+	assert.Equal(t, reason, *deposits[0].UnexpectedReason)
+	assert.Equal(t, models.DepositStatusCredited, deposits[1].Status)
+	require.NotNil(t, deposits[1].CreditedEpoch)
+	assert.Equal(t, epoch, *deposits[1].CreditedEpoch)
+	assert.Nil(t, deposits[1].QueuePosition, "credited deposits leave the queue")
+}
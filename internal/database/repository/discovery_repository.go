@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DiscoveryRepository stores validator discovery rules and the deposits found for them
+type DiscoveryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDiscoveryRepository creates a new discovery repository
+func NewDiscoveryRepository(pool *pgxpool.Pool) *DiscoveryRepository {
+	return &DiscoveryRepository{
+		pool: pool,
+	}
+}
+
+// CreateRule stores a new discovery rule
+func (r *DiscoveryRepository) CreateRule(ctx context.Context, rule *models.DiscoveryRule) error {
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO discovery_rules (kind, value, tag, next_block)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`,
+		rule.Kind, rule.Value, rule.Tag, rule.NextBlock,
+	).Scan(&rule.ID, &rule.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create discovery rule: %w", err)
+	}
+
+	return nil
+}
+
+// ListRules returns every discovery rule, oldest first
+func (r *DiscoveryRepository) ListRules(ctx context.Context) ([]*models.DiscoveryRule, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, kind, value, tag, next_block, enrolled, last_run_at, created_at
+		FROM discovery_rules
+		ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list discovery rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*models.DiscoveryRule
+	for rows.Next() {
+		rule := &models.DiscoveryRule{}
+		if err := rows.Scan(&rule.ID, &rule.Kind, &rule.Value, &rule.Tag, &rule.NextBlock,
+			&rule.Enrolled, &rule.LastRunAt, &rule.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan discovery rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// DeleteRule removes a discovery rule, reporting false if it did not exist. Validators it
+// enrolled stay monitored.
+func (r *DiscoveryRepository) DeleteRule(ctx context.Context, id int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM discovery_rules WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete discovery rule: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// CompleteRun records a finished run of a rule: the validators it enrolled and, for depositor
+// rules, the next execution block to search
+func (r *DiscoveryRepository) CompleteRun(ctx context.Context, id int64, nextBlock int64, enrolled int) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE discovery_rules
+		SET next_block = $2, enrolled = enrolled + $3, last_run_at = NOW()
+		WHERE id = $1`,
+		id, nextBlock, enrolled,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to complete discovery run: %w", err)
+	}
+
+	return nil
+}
+
+// RecordDeposits stores deposits found for a depositor rule, ignoring keys already recorded
+func (r *DiscoveryRepository) RecordDeposits(ctx context.Context, ruleID int64, deposits []*models.DiscoveredDeposit) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, d := range deposits {
+		batch.Queue(`
+			INSERT INTO discovered_deposits (rule_id, pubkey, block_number, tx_hash)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (rule_id, pubkey) DO NOTHING`,
+			ruleID, d.Pubkey, d.BlockNumber, d.TxHash,
+		)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for range deposits {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to record discovered deposit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// PendingDeposits returns the public keys deposited for a rule that have no validator index yet
+func (r *DiscoveryRepository) PendingDeposits(ctx context.Context, ruleID int64) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pubkey FROM discovered_deposits
+		WHERE rule_id = $1 AND validator_index IS NULL
+		ORDER BY block_number`,
+		ruleID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending deposits: %w", err)
+	}
+	defer rows.Close()
+
+	pubkeys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan pending deposit: %w", err)
+	}
+
+	return pubkeys, nil
+}
+
+// ResolveDeposit records the validator index assigned to a deposited public key
+func (r *DiscoveryRepository) ResolveDeposit(ctx context.Context, ruleID int64, pubkey string, validatorIndex int64) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE discovered_deposits SET validator_index = $3
+		WHERE rule_id = $1 AND pubkey = $2`,
+		ruleID, pubkey, validatorIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to resolve discovered deposit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoveryRepository_RulesAndDeposits(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	repo := NewDiscoveryRepository(pool)
+
+	rule, err := models.NewDiscoveryRule(models.DiscoveryKindDepositor, "0x1111111111111111111111111111111111111111", "pool-a")
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateRule(ctx, rule))
+	assert.NotZero(t, rule.ID)
+	assert.Error(t, repo.CreateRule(ctx, rule), "a rule is stored once per tag")
+
+	deposits := []*models.DiscoveredDeposit{
+		{Pubkey: "0xaa", BlockNumber: 10, TxHash: "0xa1"},
+		{Pubkey: "0xbb", BlockNumber: 11, TxHash: "0xa2"},
+	}
+	require.NoError(t, repo.RecordDeposits(ctx, rule.ID, deposits))
+	require.NoError(t, repo.RecordDeposits(ctx, rule.ID, deposits[:1]), "deposits are recorded once")
+
+	pending, err := repo.PendingDeposits(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0xaa", "0xbb"}, pending)
+
+	require.NoError(t, repo.ResolveDeposit(ctx, rule.ID, "0xaa", 900))
+	pending, err = repo.PendingDeposits(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0xbb"}, pending)
+
+	require.NoError(t, repo.CompleteRun(ctx, rule.ID, 12, 1))
+	require.NoError(t, repo.CompleteRun(ctx, rule.ID, 20, 2))
+
+	rules, err := repo.ListRules(ctx)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, int64(20), rules[0].NextBlock)
+	assert.Equal(t, 3, rules[0].Enrolled)
+	assert.NotNil(t, rules[0].LastRunAt)
+
+	deleted, err := repo.DeleteRule(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = repo.DeleteRule(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
+
+func TestValidatorRepository_EnrollValidators(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	repo := NewValidatorRepository(pool)
+
+	existing := testutil.ValidatorFixture(901)
+	existing.Monitored = false
+	require.NoError(t, repo.CreateValidator(ctx, existing))
+
+	validators := []*models.Validator{testutil.ValidatorFixture(901), testutil.ValidatorFixture(902)}
+	enrolled, err := repo.EnrollValidators(ctx, "pool-a", validators)
+	require.NoError(t, err)
+	assert.Equal(t, 2, enrolled)
+
+	v, err := repo.GetValidatorByIndex(ctx, 901)
+	require.NoError(t, err)
+	assert.True(t, v.Monitored)
+	assert.Contains(t, []string(v.Tags), "pool-a")
+
+	removed, err := repo.GetValidatorByIndex(ctx, 902)
+	require.NoError(t, err)
+	removed.Monitored = false
+	require.NoError(t, repo.UpdateValidator(ctx, removed))
+	enrolled, err = repo.EnrollValidators(ctx, "pool-a", validators)
+	require.NoError(t, err)
+	assert.Zero(t, enrolled, "validators already tagged are not enrolled again")
+
+	v, err = repo.GetValidatorByIndex(ctx, 902)
+	require.NoError(t, err)
+	assert.False(t, v.Monitored, "removal from monitoring is respected")
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// proposalRewardWindow is the period before the end of a range whose proposals value a missed one
+const proposalRewardWindow = 30 * 24 * time.Hour
+
+// DowntimeCostRepository computes income lost to imperfect duty performance from the rewards
+// ledger and the missed proposal verdicts
+type DowntimeCostRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDowntimeCostRepository creates a new downtime cost repository
+func NewDowntimeCostRepository(pool *pgxpool.Pool) *DowntimeCostRepository {
+	return &DowntimeCostRepository{
+		pool: pool,
+	}
+}
+
+// Cost returns the income lost by the validators in scope over [from, to).
+//
+// Attestation loss is the ideal attestation reward for each epoch's effective balance less the
+// reward earned, plus attestation penalties. A missed sync committee slot forgoes a reward equal
+// to the penalty it incurs, so sync loss is twice the sync penalties. Missed proposals are valued
+// at the average consensus proposal reward earned by monitored validators over the 30 days before
+// to, since the ledger cannot know what a block that was never produced would have paid.
+func (r *DowntimeCostRepository) Cost(ctx context.Context, scope models.DowntimeScope, from, to time.Time) (*models.DowntimeCost, error) {
+	query := `
+		WITH scope AS (
+			SELECT validator_index FROM validators
+			WHERE CASE
+				WHEN $1::bigint IS NOT NULL THEN validator_index = $1
+				WHEN $6::bigint[] IS NOT NULL THEN validator_index = ANY($6)
+				ELSE monitored = TRUE AND ($2::text IS NULL OR $2 = ANY(tags))
+			END
+		),
+		ledger AS (
+			SELECT
+				COUNT(DISTINCT l.validator_index) AS validators,
+				COUNT(DISTINCT l.epoch) AS epochs,
+				COALESCE(SUM(GREATEST(l.ideal_rewards - l.attestation_rewards, 0) + l.penalties - l.sync_penalties), 0) AS attestation_loss,
+				COALESCE(SUM(l.sync_penalties), 0) * 2 AS sync_loss
+			FROM validator_rewards_ledger l
+			JOIN scope s ON s.validator_index = l.validator_index
+			WHERE l.time >= $3 AND l.time < $4
+		),
+		misses AS (
+			SELECT COUNT(*) AS missed_proposals
+			FROM proposal_misses m
+			JOIN scope s ON s.validator_index = m.validator_index
+			WHERE m.time >= $3 AND m.time < $4
+		),
+		proposal_value AS (
+			SELECT COALESCE(AVG(l.proposal_rewards), 0)::bigint AS average
+			FROM validator_rewards_ledger l
+			JOIN validators v ON v.validator_index = l.validator_index
+			WHERE v.monitored = TRUE AND l.proposal_rewards > 0 AND l.time >= $5 AND l.time < $4
+		)
+		SELECT ledger.validators, ledger.epochs, ledger.attestation_loss, misses.missed_proposals,
+			misses.missed_proposals * proposal_value.average, ledger.sync_loss
+		FROM ledger, misses, proposal_value`
+
+	cost := &models.DowntimeCost{From: from, To: to}
+	err := r.pool.QueryRow(ctx, query,
+		scope.ValidatorIndex, scope.Tag, from, to, to.Add(-proposalRewardWindow), scope.Validators,
+	).Scan(
+		&cost.Validators, &cost.Epochs, &cost.AttestationLoss,
+		&cost.MissedProposals, &cost.ProposalLoss, &cost.SyncLoss,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute downtime cost: %w", err)
+	}
+
+	return cost, nil
+}
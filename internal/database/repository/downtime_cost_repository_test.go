@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowntimeCostRepository_Cost(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	tagged := testutil.ValidatorFixture(500)
+	tagged.Tags = []string{"node:alpha"}
+	require.NoError(t, validatorRepo.CreateValidator(ctx, tagged))
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(501)))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	epoch := types.EpochAtTime(genesis, time.Now().Add(-2*time.Hour))
+
+	// 500: 1,000 short of ideal plus 1,000 penalties, a 500 sync penalty and an earlier proposal
+	first := ledgerEntryFixture(500, epoch, genesis)
+	first.Penalties = 1500
+	first.SyncPenalties = 500
+	proposal := ledgerEntryFixture(500, epoch-10, genesis)
+	proposal.ProposalRewards = 40000000
+	// 501: a perfect epoch
+	perfect := ledgerEntryFixture(501, epoch, genesis)
+	perfect.AttestationRewards = perfect.IdealRewards
+	perfect.Penalties = 0
+	require.NoError(t, NewRewardsLedgerRepository(pool).UpsertEntries(ctx, []*models.RewardLedgerEntry{first, proposal, perfect}))
+
+	slotTime := types.EpochStartTime(genesis, epoch)
+	require.NoError(t, NewProposalMissRepository(pool).SaveEpoch(ctx, epoch, 1, []*models.ProposalMiss{
+		{ValidatorIndex: 500, Slot: epoch * 32, Epoch: epoch, Time: slotTime, Reason: models.ProposalMissNoBlock},
+	}))
+
+	repo := NewDowntimeCostRepository(pool)
+	from, to := slotTime, slotTime.Add(time.Hour)
+
+	index, tag, otherTag := int64(500), "node:alpha", "node:beta"
+	cost, err := repo.Cost(ctx, models.DowntimeScope{ValidatorIndex: &index}, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cost.Validators)
+	assert.Equal(t, int64(1), cost.Epochs)
+	assert.Equal(t, int64(2000), cost.AttestationLoss)
+	assert.Equal(t, int64(1000), cost.SyncLoss)
+	assert.Equal(t, int64(1), cost.MissedProposals)
+	assert.Equal(t, int64(40000000), cost.ProposalLoss)
+	assert.Equal(t, int64(40003000), cost.Total())
+
+	byTag, err := repo.Cost(ctx, models.DowntimeScope{Tag: &tag}, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, cost.Total(), byTag.Total())
+
+	bySet, err := repo.Cost(ctx, models.DowntimeScope{Validators: []int64{500, 501}}, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), bySet.Validators)
+	assert.Equal(t, cost.Total(), bySet.Total())
+
+	fleet, err := repo.Cost(ctx, models.DowntimeScope{}, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fleet.Validators)
+	assert.Equal(t, cost.Total(), fleet.Total(), "a perfect validator adds nothing")
+
+	none, err := repo.Cost(ctx, models.DowntimeScope{Tag: &otherTag}, from, to)
+	require.NoError(t, err)
+	assert.Zero(t, none.Validators)
+	assert.Zero(t, none.Total())
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// stepCauseEpochs is how many ledger epochs before a step are searched for its cause (~1 day)
+const stepCauseEpochs = 225
+
+// EffectiveBalanceRepository finds effective balance steps in the rewards ledger and records them
+type EffectiveBalanceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEffectiveBalanceRepository creates a new effective balance repository
+func NewEffectiveBalanceRepository(pool *pgxpool.Pool) *EffectiveBalanceRepository {
+	return &EffectiveBalanceRepository{
+		pool: pool,
+	}
+}
+
+// DetectSteps returns the effective balance changes of monitored validators at or after fromEpoch,
+// ordered by validator and epoch. A change is only reported between consecutive ledger epochs,
+// so a gap in the ledger hides the step rather than dating it wrongly.
+func (r *EffectiveBalanceRepository) DetectSteps(ctx context.Context, fromEpoch int64) ([]*models.EffectiveBalanceStep, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH ledger AS (
+			SELECT l.validator_index, l.epoch, l.time, l.effective_balance, l.balance_start,
+				LAG(l.epoch) OVER w AS previous_epoch,
+				LAG(l.effective_balance) OVER w AS previous_effective_balance,
+				COALESCE(SUM(l.penalties) OVER (w ROWS BETWEEN $2 PRECEDING AND 1 PRECEDING), 0) AS penalties,
+				COALESCE(SUM(l.withdrawals) OVER (w ROWS BETWEEN $2 PRECEDING AND 1 PRECEDING), 0) AS withdrawals
+			FROM validator_rewards_ledger l
+			JOIN validators v ON v.validator_index = l.validator_index
+			WHERE v.monitored = TRUE AND l.epoch >= $1::bigint - $2
+			WINDOW w AS (PARTITION BY l.validator_index ORDER BY l.epoch)
+		)
+		SELECT validator_index, epoch, time, previous_effective_balance, effective_balance,
+			balance_start, penalties::bigint, withdrawals::bigint
+		FROM ledger
+		WHERE epoch >= $1 AND previous_epoch = epoch - 1 AND previous_effective_balance <> effective_balance
+		ORDER BY validator_index, epoch`,
+		fromEpoch, int64(stepCauseEpochs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect effective balance steps: %w", err)
+	}
+	defer rows.Close()
+
+	var steps []*models.EffectiveBalanceStep
+	for rows.Next() {
+		s := &models.EffectiveBalanceStep{}
+		if err := rows.Scan(
+			&s.ValidatorIndex, &s.Epoch, &s.Time, &s.PreviousEffectiveBalance, &s.EffectiveBalance,
+			&s.Balance, &s.Penalties, &s.Withdrawals,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan effective balance step: %w", err)
+		}
+		steps = append(steps, s)
+	}
+
+	return steps, rows.Err()
+}
+
+// Record stores a step and reports whether it was new
+func (r *EffectiveBalanceRepository) Record(ctx context.Context, s *models.EffectiveBalanceStep) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO effective_balance_steps (
+			validator_index, epoch, time, previous_effective_balance, effective_balance, balance, penalties, withdrawals
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (validator_index, epoch) DO NOTHING`,
+		s.ValidatorIndex, s.Epoch, s.Time, s.PreviousEffectiveBalance, s.EffectiveBalance,
+		s.Balance, s.Penalties, s.Withdrawals,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record effective balance step: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
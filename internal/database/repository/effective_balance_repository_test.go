@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveBalanceRepository_DetectAndRecord(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(600)))
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(601)))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	var entries []*models.RewardLedgerEntry
+	for epoch := int64(100); epoch < 106; epoch++ {
+		penalised := ledgerEntryFixture(600, epoch, genesis)
+		penalised.Penalties = 300_000_000
+		if epoch >= 103 {
+			penalised.EffectiveBalance = 31_000_000_000
+			penalised.BalanceStart = 31_740_000_000
+		}
+		entries = append(entries, penalised)
+
+		// Validator 601 skips epoch 103, so its step at 104 cannot be dated
+		if epoch == 103 {
+			continue
+		}
+		gap := ledgerEntryFixture(601, epoch, genesis)
+		if epoch >= 104 {
+			gap.EffectiveBalance = 31_000_000_000
+		}
+		entries = append(entries, gap)
+	}
+	require.NoError(t, NewRewardsLedgerRepository(pool).UpsertEntries(ctx, entries))
+
+	repo := NewEffectiveBalanceRepository(pool)
+	steps, err := repo.DetectSteps(ctx, 101)
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	step := steps[0]
+	assert.Equal(t, int64(600), step.ValidatorIndex)
+	assert.Equal(t, int64(103), step.Epoch)
+	assert.Equal(t, int64(32_000_000_000), step.PreviousEffectiveBalance)
+	assert.Equal(t, int64(31_000_000_000), step.EffectiveBalance)
+	assert.Equal(t, int64(31_740_000_000), step.Balance)
+	assert.Equal(t, int64(900_000_000), step.Penalties, "penalties of epochs 100 to 102")
+	assert.True(t, step.CausedByPenalties())
+
+	steps, err = repo.DetectSteps(ctx, 104)
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	inserted, err := repo.Record(ctx, step)
+	require.NoError(t, err)
+	assert.True(t, inserted)
+
+	inserted, err = repo.Record(ctx, step)
+	require.NoError(t, err)
+	assert.False(t, inserted, "a step is recorded once")
+}
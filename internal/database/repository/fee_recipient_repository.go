@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FeeRecipientRepository stores expected fee recipients and the checks of proposed blocks
+// against them
+type FeeRecipientRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFeeRecipientRepository creates a new fee recipient repository
+func NewFeeRecipientRepository(pool *pgxpool.Pool) *FeeRecipientRepository {
+	return &FeeRecipientRepository{
+		pool: pool,
+	}
+}
+
+// SetExpected sets the fee recipient expected for a validator, overriding its tags. A nil
+// address removes the override.
+func (r *FeeRecipientRepository) SetExpected(ctx context.Context, validatorIndex int64, address *string) error {
+	var err error
+	if address == nil {
+		_, err = r.pool.Exec(ctx, `DELETE FROM validator_fee_recipients WHERE validator_index = $1`, validatorIndex)
+	} else {
+		_, err = r.pool.Exec(ctx, `
+			INSERT INTO validator_fee_recipients (validator_index, address)
+			VALUES ($1, $2)
+			ON CONFLICT (validator_index) DO UPDATE SET address = EXCLUDED.address, updated_at = NOW()`,
+			validatorIndex, *address,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set expected fee recipient: %w", err)
+	}
+
+	return nil
+}
+
+// GetExpected returns the per-validator fee recipient overrides by validator index
+func (r *FeeRecipientRepository) GetExpected(ctx context.Context) (map[int64]string, error) {
+	rows, err := r.pool.Query(ctx, `SELECT validator_index, address FROM validator_fee_recipients`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expected fee recipients: %w", err)
+	}
+	defer rows.Close()
+
+	expected := make(map[int64]string)
+	for rows.Next() {
+		var index int64
+		var address string
+		if err := rows.Scan(&index, &address); err != nil {
+			return nil, fmt.Errorf("failed to scan expected fee recipient: %w", err)
+		}
+		expected[index] = address
+	}
+
+	return expected, rows.Err()
+}
+
+// RecordCheck stores the check of a proposed block, reporting false if it was already recorded
+func (r *FeeRecipientRepository) RecordCheck(ctx context.Context, c *models.FeeRecipientCheck) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO fee_recipient_checks (
+			validator_index, slot, time, block_hash, fee_recipient, expected, payment_to, payment_gwei, status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (validator_index, slot) DO NOTHING`,
+		c.ValidatorIndex, c.Slot, c.Time, c.BlockHash, c.FeeRecipient, c.Expected, c.PaymentTo, c.PaymentGwei, c.Status,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record fee recipient check: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetChecks returns a validator's most recent fee recipient checks, newest first
+func (r *FeeRecipientRepository) GetChecks(ctx context.Context, validatorIndex int64, limit int) ([]*models.FeeRecipientCheck, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, slot, time, block_hash, fee_recipient, expected, payment_to, payment_gwei, status
+		FROM fee_recipient_checks
+		WHERE validator_index = $1
+		ORDER BY slot DESC
+		LIMIT $2`,
+		validatorIndex, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee recipient checks: %w", err)
+	}
+	defer rows.Close()
+
+	var checks []*models.FeeRecipientCheck
+	for rows.Next() {
+		c := &models.FeeRecipientCheck{}
+		if err := rows.Scan(&c.ValidatorIndex, &c.Slot, &c.Time, &c.BlockHash, &c.FeeRecipient, &c.Expected,
+			&c.PaymentTo, &c.PaymentGwei, &c.Status); err != nil {
+			return nil, fmt.Errorf("failed to scan fee recipient check: %w", err)
+		}
+		checks = append(checks, c)
+	}
+
+	return checks, rows.Err()
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeRecipientRepository_ExpectedAndChecks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	require.NoError(t, NewValidatorRepository(pool).CreateValidator(ctx, testutil.ValidatorFixture(810)))
+
+	repo := NewFeeRecipientRepository(pool)
+
+	first, second := "0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"
+	require.NoError(t, repo.SetExpected(ctx, 810, &first))
+	require.NoError(t, repo.SetExpected(ctx, 810, &second))
+	expected, err := repo.GetExpected(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{810: second}, expected)
+
+	require.NoError(t, repo.SetExpected(ctx, 810, nil))
+	expected, err = repo.GetExpected(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, expected)
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	payment := int64(50_000_000)
+	for _, check := range []*models.FeeRecipientCheck{
+		{ValidatorIndex: 810, Slot: 3201, Status: models.FeeRecipientCompliant, FeeRecipient: first},
+		{ValidatorIndex: 810, Slot: 3301, Status: models.FeeRecipientBuilderPaid, FeeRecipient: "0xbuilder", PaymentTo: &first, PaymentGwei: &payment},
+	} {
+		check.Time = types.SlotStartTime(genesis, check.Slot)
+		check.BlockHash = "0xb1"
+		check.Expected = []string{first}
+		inserted, err := repo.RecordCheck(ctx, check)
+		require.NoError(t, err)
+		assert.True(t, inserted)
+
+		inserted, err = repo.RecordCheck(ctx, check)
+		require.NoError(t, err)
+		assert.False(t, inserted, "a block is checked once")
+	}
+
+	checks, err := repo.GetChecks(ctx, 810, 10)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, int64(3301), checks[0].Slot)
+	assert.Equal(t, models.FeeRecipientBuilderPaid, checks[0].Status)
+	require.NotNil(t, checks[0].PaymentGwei)
+	assert.Equal(t, payment, *checks[0].PaymentGwei)
+	assert.Equal(t, []string{first}, checks[1].Expected)
+}
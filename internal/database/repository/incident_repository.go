@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// settledAlertStatuses are the alert statuses that need no further attention
+const settledAlertStatuses = `'resolved', 'ignored', 'dismissed'`
+
+// incidentSelect selects incidents with the counts and highest severity of their member alerts
+const incidentSelect = `
+	SELECT i.id, i.failure_type, i.group_kind, i.group_value, COALESCE(m.severity, 'info'), i.status,
+		COALESCE(m.alerts, 0), COALESCE(m.open_alerts, 0), COALESCE(m.validators, 0),
+		i.started_at, i.last_alert_at, i.acknowledged_at, i.acknowledged_by, i.resolved_at, i.resolved_by
+	FROM incidents i
+	LEFT JOIN LATERAL (
+		SELECT
+			COUNT(*) AS alerts,
+			COUNT(*) FILTER (WHERE a.status NOT IN (` + settledAlertStatuses + `)) AS open_alerts,
+			COUNT(DISTINCT a.validator_index) AS validators,
+			(array_agg(a.severity ORDER BY CASE a.severity
+				WHEN 'critical' THEN 4 WHEN 'error' THEN 3 WHEN 'warning' THEN 2 ELSE 1 END DESC))[1] AS severity
+		FROM incident_alerts ia
+		JOIN alerts a ON a.id = ia.alert_id
+		WHERE ia.incident_id = i.id
+	) m ON TRUE`
+
+// IncidentRepository groups alerts into incidents and tracks their lifecycle
+type IncidentRepository struct {
+	pool   *pgxpool.Pool
+	alerts *AlertRepository
+}
+
+// NewIncidentRepository creates a new incident repository
+func NewIncidentRepository(pool *pgxpool.Pool) *IncidentRepository {
+	return &IncidentRepository{
+		pool:   pool,
+		alerts: NewAlertRepository(pool),
+	}
+}
+
+// ListCandidates returns the unsettled alerts created since the given time that belong to no
+// incident, oldest first, with the validator client holding each validator's key when exactly one
+// keymanager API lists it, and the validator's tags
+func (r *IncidentRepository) ListCandidates(ctx context.Context, since time.Time) ([]*models.IncidentCandidate, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT a.id, a.validator_index, a.alert_type, a.severity, a.created_at, node.name, COALESCE(v.tags, '{}')
+		FROM alerts a
+		LEFT JOIN validators v ON v.validator_index = a.validator_index
+		LEFT JOIN LATERAL (
+			SELECT MIN(k.name) AS name
+			FROM signer_keys k
+			WHERE k.kind = 'keymanager' AND k.pubkey = LOWER(v.pubkey)
+			HAVING COUNT(DISTINCT k.name) = 1
+		) node ON TRUE
+		WHERE a.created_at >= $1
+			AND a.status NOT IN (`+settledAlertStatuses+`)
+			AND NOT EXISTS (SELECT 1 FROM incident_alerts ia WHERE ia.alert_id = a.id)
+		ORDER BY a.created_at, a.id`,
+		since,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*models.IncidentCandidate
+	for rows.Next() {
+		c := &models.IncidentCandidate{}
+		if err := rows.Scan(&c.AlertID, &c.ValidatorIndex, &c.AlertType, &c.Severity, &c.CreatedAt, &c.Node,
+			&c.Tags); err != nil {
+			return nil, fmt.Errorf("failed to scan incident candidate: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, rows.Err()
+}
+
+// CreateIncident atomically stores a new incident with its member alerts, setting its ID
+func (r *IncidentRepository) CreateIncident(ctx context.Context, incident *models.Incident, alertIDs []int32) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO incidents (failure_type, group_kind, group_value, status, started_at, last_alert_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`,
+		incident.FailureType, incident.GroupKind, incident.GroupValue, models.IncidentStatusOpen,
+		incident.StartedAt, incident.LastAlertAt,
+	).Scan(&incident.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create incident: %w", err)
+	}
+	incident.Status = models.IncidentStatusOpen
+
+	if err := addIncidentAlerts(ctx, tx, incident.ID, alertIDs); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit incident: %w", err)
+	}
+
+	return nil
+}
+
+// AttachAlerts atomically adds alerts created between first and last to an existing incident,
+// widening its time span to cover them
+func (r *IncidentRepository) AttachAlerts(ctx context.Context, incidentID int64, alertIDs []int32, first, last time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := addIncidentAlerts(ctx, tx, incidentID, alertIDs); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE incidents
+		SET started_at = LEAST(started_at, $2), last_alert_at = GREATEST(last_alert_at, $3)
+		WHERE id = $1`,
+		incidentID, first, last,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update incident span: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit incident alerts: %w", err)
+	}
+
+	return nil
+}
+
+// addIncidentAlerts records alerts as members of an incident; alerts already in an incident are
+// left where they are
+func addIncidentAlerts(ctx context.Context, tx pgx.Tx, incidentID int64, alertIDs []int32) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO incident_alerts (alert_id, incident_id)
+		SELECT unnest($2::bigint[]), $1
+		ON CONFLICT (alert_id) DO NOTHING`,
+		incidentID, alertIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add incident alerts: %w", err)
+	}
+	return nil
+}
+
+// ResolveSettled resolves the unresolved incidents whose member alerts are all settled, at the
+// time the last of them was resolved, and returns how many were resolved
+func (r *IncidentRepository) ResolveSettled(ctx context.Context) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE incidents i
+		SET status = 'resolved',
+			resolved_at = COALESCE((
+				SELECT MAX(a.resolved_at)
+				FROM incident_alerts ia
+				JOIN alerts a ON a.id = ia.alert_id
+				WHERE ia.incident_id = i.id
+			), NOW())
+		WHERE i.status <> 'resolved'
+			AND NOT EXISTS (
+				SELECT 1
+				FROM incident_alerts ia
+				JOIN alerts a ON a.id = ia.alert_id
+				WHERE ia.incident_id = i.id AND a.status NOT IN (`+settledAlertStatuses+`)
+			)`,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve settled incidents: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Acknowledge marks an open incident and its unsettled member alerts as acknowledged. It reports
+// false when the incident does not exist or is no longer open.
+func (r *IncidentRepository) Acknowledge(ctx context.Context, id int64, by string) (bool, error) {
+	return r.transition(ctx, id, `
+		UPDATE incidents
+		SET status = 'acknowledged', acknowledged_at = NOW(), acknowledged_by = $2
+		WHERE id = $1 AND status = 'open'`, by, `
+		UPDATE alerts a
+		SET status = 'acknowledged', acknowledged_at = NOW(), updated_at = NOW()
+		FROM incident_alerts ia
+		WHERE ia.alert_id = a.id AND ia.incident_id = $1
+			AND a.status NOT IN ('acknowledged', `+settledAlertStatuses+`)`,
+	)
+}
+
+// Resolve marks an unresolved incident and its unsettled member alerts as resolved. It reports
+// false when the incident does not exist or is already resolved.
+func (r *IncidentRepository) Resolve(ctx context.Context, id int64, by string) (bool, error) {
+	return r.transition(ctx, id, `
+		UPDATE incidents
+		SET status = 'resolved', resolved_at = NOW(), resolved_by = $2
+		WHERE id = $1 AND status <> 'resolved'`, by, `
+		UPDATE alerts a
+		SET status = 'resolved', resolved_at = NOW(), updated_at = NOW()
+		FROM incident_alerts ia
+		WHERE ia.alert_id = a.id AND ia.incident_id = $1
+			AND a.status NOT IN (`+settledAlertStatuses+`)`,
+	)
+}
+
+// transition atomically applies a status change to an incident and then to its member alerts,
+// skipping the alerts when the incident did not change
+func (r *IncidentRepository) transition(ctx context.Context, id int64, incidentSQL, by, alertsSQL string) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, incidentSQL, id, by)
+	if err != nil {
+		return false, fmt.Errorf("failed to update incident: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+	if _, err := tx.Exec(ctx, alertsSQL, id); err != nil {
+		return false, fmt.Errorf("failed to update incident alerts: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit incident update: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetIncident returns an incident by ID, or nil when it does not exist
+func (r *IncidentRepository) GetIncident(ctx context.Context, id int64) (*models.Incident, error) {
+	rows, err := r.pool.Query(ctx, incidentSelect+` WHERE i.id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+	incidents, err := scanIncidents(rows)
+	if err != nil || len(incidents) == 0 {
+		return nil, err
+	}
+	return incidents[0], nil
+}
+
+// ListIncidents returns incidents, optionally only those with a status, most recently started first
+func (r *IncidentRepository) ListIncidents(ctx context.Context, filter *models.IncidentFilter) ([]*models.Incident, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	var status *string
+	if filter.Status != nil {
+		s := string(*filter.Status)
+		status = &s
+	}
+
+	rows, err := r.pool.Query(ctx, incidentSelect+`
+		WHERE $1::text IS NULL OR i.status = $1
+		ORDER BY i.started_at DESC, i.id DESC
+		LIMIT $2 OFFSET $3`,
+		status, limit, filter.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incidents: %w", err)
+	}
+	return scanIncidents(rows)
+}
+
+// ListUnresolved returns the incidents that are not resolved, oldest first
+func (r *IncidentRepository) ListUnresolved(ctx context.Context) ([]*models.Incident, error) {
+	rows, err := r.pool.Query(ctx, incidentSelect+`
+		WHERE i.status <> 'resolved'
+		ORDER BY i.started_at, i.id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unresolved incidents: %w", err)
+	}
+	return scanIncidents(rows)
+}
+
+// ListAlerts returns the member alerts of an incident, oldest first
+func (r *IncidentRepository) ListAlerts(ctx context.Context, incidentID int64) ([]*models.Alert, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT a.id, a.validator_index, a.alert_type, a.severity, a.title, a.message, a.source,
+		       a.details, a.status, a.acknowledged_at, a.resolved_at, a.created_at, a.updated_at
+		FROM alerts a
+		JOIN incident_alerts ia ON ia.alert_id = a.id
+		WHERE ia.incident_id = $1
+		ORDER BY a.created_at, a.id`,
+		incidentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident alerts: %w", err)
+	}
+	defer rows.Close()
+
+	alerts, err := r.alerts.scanAlerts(rows)
+	if err != nil {
+		return nil, err
+	}
+	return alerts, rows.Err()
+}
+
+// scanIncidents scans and closes rows selected with incidentSelect
+func scanIncidents(rows pgx.Rows) ([]*models.Incident, error) {
+	defer rows.Close()
+
+	var incidents []*models.Incident
+	for rows.Next() {
+		i := &models.Incident{}
+		if err := rows.Scan(
+			&i.ID, &i.FailureType, &i.GroupKind, &i.GroupValue, &i.Severity, &i.Status,
+			&i.AlertCount, &i.OpenAlerts, &i.ValidatorCount,
+			&i.StartedAt, &i.LastAlertAt, &i.AcknowledgedAt, &i.AcknowledgedBy, &i.ResolvedAt, &i.ResolvedBy,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan incident: %w", err)
+		}
+		incidents = append(incidents, i)
+	}
+
+	return incidents, rows.Err()
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentRepository_Lifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	var keys []types.LoadedKey
+	for _, index := range []int64{860, 861, 862} {
+		v := testutil.ValidatorFixture(index)
+		v.Tags = []string{"lido"}
+		require.NoError(t, validatorRepo.CreateValidator(ctx, v))
+		if index != 862 {
+			keys = append(keys, types.LoadedKey{Pubkey: strings.ToLower(v.Pubkey)})
+		}
+	}
+	vc := types.SignerTarget{Name: "vc-1", Kind: types.SignerKindKeymanager, URL: "http://vc-1:5062"}
+	require.NoError(t, NewSignerRepository(pool).RecordCheck(ctx, vc, &types.SignerCheck{Status: "healthy", Keys: keys}, time.Now()))
+
+	alertRepo := NewAlertRepository(pool)
+	var alerts []*models.Alert
+	for i, index := range []int64{860, 861, 862} {
+		severity := models.SeverityWarning
+		if i == 1 {
+			severity = models.SeverityCritical
+		}
+		alert := &models.Alert{ValidatorIndex: &index, AlertType: "offline", Severity: severity,
+			Title: "Validator offline", Message: "offline", Status: models.AlertStatusNew}
+		require.NoError(t, alertRepo.CreateAlert(ctx, alert))
+		alerts = append(alerts, alert)
+	}
+	require.NoError(t, alertRepo.ResolveAlert(ctx, alerts[2].ID))
+
+	repo := NewIncidentRepository(pool)
+	candidates, err := repo.ListCandidates(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, candidates, 2, "settled alerts are not grouped")
+	require.NotNil(t, candidates[0].Node)
+	assert.Equal(t, "vc-1", *candidates[0].Node)
+	assert.Equal(t, []string{"lido"}, candidates[0].Tags)
+
+	incident := &models.Incident{FailureType: "offline", GroupKind: models.IncidentGroupNode, GroupValue: "vc-1",
+		StartedAt: candidates[0].CreatedAt, LastAlertAt: candidates[1].CreatedAt}
+	require.NoError(t, repo.CreateIncident(ctx, incident, []int32{alerts[0].ID, alerts[1].ID}))
+	require.NotZero(t, incident.ID)
+
+	candidates, err = repo.ListCandidates(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, candidates, "grouped alerts are no longer candidates")
+
+	got, err := repo.GetIncident(ctx, incident.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, models.SeverityCritical, got.Severity)
+	assert.Equal(t, 2, got.AlertCount)
+	assert.Equal(t, 2, got.OpenAlerts)
+	assert.Equal(t, 2, got.ValidatorCount)
+
+	// Acknowledging the incident acknowledges its alerts, and only works once
+	ok, err := repo.Acknowledge(ctx, incident.ID, "alice")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = repo.Acknowledge(ctx, incident.ID, "alice")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	members, err := repo.ListAlerts(ctx, incident.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, models.AlertStatusAcknowledged, members[0].Status)
+
+	unresolved, err := repo.ListUnresolved(ctx)
+	require.NoError(t, err)
+	require.Len(t, unresolved, 1)
+	assert.Equal(t, models.IncidentStatusAcknowledged, unresolved[0].Status)
+	require.NotNil(t, unresolved[0].AcknowledgedBy)
+	assert.Equal(t, "alice", *unresolved[0].AcknowledgedBy)
+
+	// The incident resolves itself once all its alerts are resolved
+	require.NoError(t, alertRepo.ResolveAlert(ctx, alerts[0].ID))
+	resolved, err := repo.ResolveSettled(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, resolved)
+	require.NoError(t, alertRepo.ResolveAlert(ctx, alerts[1].ID))
+	resolved, err = repo.ResolveSettled(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resolved)
+
+	status := models.IncidentStatusResolved
+	list, err := repo.ListIncidents(ctx, &models.IncidentFilter{Status: &status})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.NotNil(t, list[0].ResolvedAt)
+	assert.Nil(t, list[0].ResolvedBy)
+	assert.Zero(t, list[0].OpenAlerts)
+
+	missing, err := repo.GetIncident(ctx, incident.ID+1)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// LuckRepository aggregates the duty selections of monitored validators and the network's active
+// balance for proposal luck analysis
+type LuckRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLuckRepository creates a new luck repository
+func NewLuckRepository(pool *pgxpool.Pool) *LuckRepository {
+	return &LuckRepository{
+		pool: pool,
+	}
+}
+
+// DutyCounts returns duty counts over [from, to) for all monitored validators, followed by each
+// tag carried by a monitored validator in tag order.
+//
+// A proposal duty is a ledger epoch with proposal rewards or a missed proposal; a validator
+// proposing twice in one epoch counts once, which is rare enough not to matter. A sync committee
+// period is counted when any of its epochs in the range has sync rewards or penalties.
+func (r *LuckRepository) DutyCounts(ctx context.Context, from, to time.Time) ([]*models.DutyCounts, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH members AS (
+			SELECT validator_index, NULL::text AS tag FROM validators WHERE monitored = TRUE
+			UNION ALL
+			SELECT validator_index, unnest(tags) FROM validators WHERE monitored = TRUE
+		),
+		ledger AS (
+			SELECT validator_index,
+				COUNT(*) AS epochs,
+				SUM(effective_balance) AS effective_balance_epochs,
+				COUNT(*) FILTER (WHERE proposal_rewards > 0) AS proposals,
+				COUNT(DISTINCT epoch / $3) FILTER (WHERE sync_rewards <> 0 OR sync_penalties <> 0) AS sync_committees
+			FROM validator_rewards_ledger
+			WHERE time >= $1 AND time < $2
+			GROUP BY validator_index
+		),
+		misses AS (
+			SELECT validator_index, COUNT(*) AS missed
+			FROM proposal_misses
+			WHERE time >= $1 AND time < $2
+			GROUP BY validator_index
+		)
+		SELECT m.tag,
+			COUNT(l.validator_index),
+			COALESCE(SUM(l.epochs), 0)::bigint,
+			COALESCE(SUM(l.effective_balance_epochs), 0)::bigint,
+			(COALESCE(SUM(l.proposals), 0) + COALESCE(SUM(x.missed), 0))::bigint,
+			COALESCE(SUM(l.sync_committees), 0)::bigint
+		FROM members m
+		LEFT JOIN ledger l ON l.validator_index = m.validator_index
+		LEFT JOIN misses x ON x.validator_index = m.validator_index
+		GROUP BY m.tag
+		ORDER BY m.tag NULLS FIRST`,
+		from, to, types.EpochsPerSyncCommitteePeriod,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get duty counts: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []*models.DutyCounts
+	for rows.Next() {
+		c := &models.DutyCounts{}
+		if err := rows.Scan(&c.Tag, &c.Validators, &c.Epochs, &c.EffectiveBalanceEpochs, &c.Proposals, &c.SyncCommittees); err != nil {
+			return nil, fmt.Errorf("failed to scan duty counts: %w", err)
+		}
+		counts = append(counts, c)
+	}
+
+	return counts, rows.Err()
+}
+
+// AverageActiveBalance returns the mean estimated total active balance of the network over
+// [from, to) in Gwei, falling back to the latest estimate before to. It returns 0 if the network
+// rank job has not recorded an estimate.
+func (r *LuckRepository) AverageActiveBalance(ctx context.Context, from, to time.Time) (int64, error) {
+	var balance int64
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(
+			(SELECT AVG(total_active_balance)::bigint FROM network_epoch_stats
+			 WHERE total_active_balance > 0 AND time >= $1 AND time < $2),
+			(SELECT total_active_balance FROM network_epoch_stats
+			 WHERE total_active_balance > 0 AND time < $2
+			 ORDER BY epoch DESC LIMIT 1),
+			0
+		)`,
+		from, to,
+	).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get network active balance: %w", err)
+	}
+
+	return balance, nil
+}
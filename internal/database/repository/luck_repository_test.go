@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLuckRepository_DutyCounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	tagged := testutil.ValidatorFixture(500)
+	tagged.Tags = []string{"staking"}
+	require.NoError(t, validatorRepo.CreateValidator(ctx, tagged))
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(501)))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	var entries []*models.RewardLedgerEntry
+	for epoch := int64(100); epoch < 104; epoch++ {
+		entries = append(entries, ledgerEntryFixture(500, epoch, genesis), ledgerEntryFixture(501, epoch, genesis))
+	}
+	entries[2].ProposalRewards = 40_000_000 // Validator 500, epoch 101
+	entries[1].SyncRewards = 20_000         // Validator 501, epochs 100 and 101 share a sync period
+	entries[3].SyncPenalties = 20_000
+	require.NoError(t, NewRewardsLedgerRepository(pool).UpsertEntries(ctx, entries))
+
+	require.NoError(t, NewProposalMissRepository(pool).SaveEpoch(ctx, 102, 1, []*models.ProposalMiss{
+		{ValidatorIndex: 501, Slot: 102 * types.SlotsPerEpoch, Epoch: 102, Time: types.EpochStartTime(genesis, 102), Reason: models.ProposalMissNoBlock},
+	}))
+
+	repo := NewLuckRepository(pool)
+	counts, err := repo.DutyCounts(ctx, types.EpochStartTime(genesis, 100), types.EpochStartTime(genesis, 104))
+	require.NoError(t, err)
+	require.Len(t, counts, 2)
+
+	assert.Nil(t, counts[0].Tag)
+	assert.Equal(t, int64(2), counts[0].Validators)
+	assert.Equal(t, int64(8), counts[0].Epochs)
+	assert.Equal(t, int64(8*32_000_000_000), counts[0].EffectiveBalanceEpochs)
+	assert.Equal(t, int64(2), counts[0].Proposals, "one proposed, one missed")
+	assert.Equal(t, int64(1), counts[0].SyncCommittees)
+
+	assert.Equal(t, "staking", *counts[1].Tag)
+	assert.Equal(t, int64(1), counts[1].Validators)
+	assert.Equal(t, int64(1), counts[1].Proposals)
+	assert.Equal(t, int64(0), counts[1].SyncCommittees)
+
+	// The network balance falls back to the latest estimate before the range
+	balance, err := repo.AverageActiveBalance(ctx, genesis, types.EpochStartTime(genesis, 104))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), balance)
+
+	rankRepo := NewNetworkRankRepository(pool)
+	for epoch, total := range map[int64]int64{90: 30e15, 100: 32e15, 102: 34e15} {
+		require.NoError(t, rankRepo.SaveEpoch(ctx, &models.NetworkEpochStats{
+			Epoch: epoch, Time: types.EpochStartTime(genesis, epoch), SampleSize: 1000, TotalActiveBalance: total,
+		}, nil))
+	}
+
+	balance, err = repo.AverageActiveBalance(ctx, types.EpochStartTime(genesis, 100), types.EpochStartTime(genesis, 104))
+	require.NoError(t, err)
+	assert.Equal(t, int64(33e15), balance)
+
+	balance, err = repo.AverageActiveBalance(ctx, types.EpochStartTime(genesis, 95), types.EpochStartTime(genesis, 99))
+	require.NoError(t, err)
+	assert.Equal(t, int64(30e15), balance)
+}
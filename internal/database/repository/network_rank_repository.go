@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NetworkRankRepository handles network sample statistics and validator percentile ranks
+type NetworkRankRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewNetworkRankRepository creates a new network rank repository
+func NewNetworkRankRepository(pool *pgxpool.Pool) *NetworkRankRepository {
+	return &NetworkRankRepository{
+		pool: pool,
+	}
+}
+
+// SaveEpoch atomically stores an epoch's network statistics and the ranks of monitored validators,
+// replacing anything already stored for the epoch
+func (r *NetworkRankRepository) SaveEpoch(ctx context.Context, stats *models.NetworkEpochStats, ranks []*models.ValidatorNetworkRank) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO network_epoch_stats (
+			epoch, time, sample_size, average_score, median_score, active_validators, total_active_balance
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (epoch) DO UPDATE SET
+			time = EXCLUDED.time,
+			sample_size = EXCLUDED.sample_size,
+			average_score = EXCLUDED.average_score,
+			median_score = EXCLUDED.median_score,
+			active_validators = EXCLUDED.active_validators,
+			total_active_balance = EXCLUDED.total_active_balance`,
+		stats.Epoch, stats.Time, stats.SampleSize, stats.AverageScore, stats.MedianScore,
+		stats.ActiveValidators, stats.TotalActiveBalance,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert network epoch stats: %w", err)
+	}
+
+	if len(ranks) > 0 {
+		query := `
+			INSERT INTO validator_network_ranks (validator_index, epoch, time, score, percentile)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (validator_index, epoch) DO UPDATE SET
+				time = EXCLUDED.time,
+				score = EXCLUDED.score,
+				percentile = EXCLUDED.percentile`
+
+		batch := &pgx.Batch{}
+		for _, rank := range ranks {
+			batch.Queue(query, rank.ValidatorIndex, rank.Epoch, rank.Time, rank.Score, rank.Percentile)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for range ranks {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return fmt.Errorf("failed to upsert network rank: %w", err)
+			}
+		}
+		if err := results.Close(); err != nil {
+			return fmt.Errorf("failed to upsert network ranks: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit network ranks: %w", err)
+	}
+
+	return nil
+}
+
+// LatestEpoch returns the most recent epoch with network statistics, or false if none are stored
+func (r *NetworkRankRepository) LatestEpoch(ctx context.Context) (int64, bool, error) {
+	var epoch *int64
+	err := r.pool.QueryRow(ctx, `SELECT MAX(epoch) FROM network_epoch_stats`).Scan(&epoch)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get latest network epoch: %w", err)
+	}
+	if epoch == nil {
+		return 0, false, nil
+	}
+	return *epoch, true, nil
+}
+
+// GetEpochStats returns the network statistics for an epoch, or nil if it has not been sampled
+func (r *NetworkRankRepository) GetEpochStats(ctx context.Context, epoch int64) (*models.NetworkEpochStats, error) {
+	stats := &models.NetworkEpochStats{}
+	err := r.pool.QueryRow(ctx, `
+		SELECT epoch, time, sample_size, average_score, median_score, active_validators, total_active_balance
+		FROM network_epoch_stats
+		WHERE epoch = $1`,
+		epoch,
+	).Scan(
+		&stats.Epoch, &stats.Time, &stats.SampleSize, &stats.AverageScore, &stats.MedianScore,
+		&stats.ActiveValidators, &stats.TotalActiveBalance,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network epoch stats: %w", err)
+	}
+	return stats, nil
+}
+
+// GetRanks returns the ranks of the given validators at an epoch, keyed by validator index
+func (r *NetworkRankRepository) GetRanks(ctx context.Context, epoch int64, validatorIndices []int64) (map[int64]*models.ValidatorNetworkRank, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, epoch, time, score, percentile
+		FROM validator_network_ranks
+		WHERE epoch = $1 AND validator_index = ANY($2)`,
+		epoch, validatorIndices,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network ranks: %w", err)
+	}
+	defer rows.Close()
+
+	ranks := make(map[int64]*models.ValidatorNetworkRank, len(validatorIndices))
+	for rows.Next() {
+		rank := &models.ValidatorNetworkRank{}
+		if err := rows.Scan(&rank.ValidatorIndex, &rank.Epoch, &rank.Time, &rank.Score, &rank.Percentile); err != nil {
+			return nil, fmt.Errorf("failed to scan network rank: %w", err)
+		}
+		ranks[rank.ValidatorIndex] = rank
+	}
+
+	return ranks, rows.Err()
+}
+
+// GetLatestRank returns a validator's most recent rank and the network statistics for the same
+// epoch. Both are nil if the validator has never been ranked.
+func (r *NetworkRankRepository) GetLatestRank(ctx context.Context, validatorIndex int64) (*models.ValidatorNetworkRank, *models.NetworkEpochStats, error) {
+	rank := &models.ValidatorNetworkRank{}
+	stats := &models.NetworkEpochStats{}
+	err := r.pool.QueryRow(ctx, `
+		SELECT r.validator_index, r.epoch, r.time, r.score, r.percentile,
+			s.epoch, s.time, s.sample_size, s.average_score, s.median_score,
+			s.active_validators, s.total_active_balance
+		FROM validator_network_ranks r
+		JOIN network_epoch_stats s ON s.epoch = r.epoch
+		WHERE r.validator_index = $1
+		ORDER BY r.epoch DESC
+		LIMIT 1`,
+		validatorIndex,
+	).Scan(
+		&rank.ValidatorIndex, &rank.Epoch, &rank.Time, &rank.Score, &rank.Percentile,
+		&stats.Epoch, &stats.Time, &stats.SampleSize, &stats.AverageScore, &stats.MedianScore,
+		&stats.ActiveValidators, &stats.TotalActiveBalance,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest network rank: %w", err)
+	}
+	return rank, stats, nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkRankRepository_SaveAndGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	repo := NewNetworkRankRepository(pool)
+	ctx := context.Background()
+
+	_, ok, err := repo.LatestEpoch(ctx)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	rank, stats, err := repo.GetLatestRank(ctx, 123)
+	require.NoError(t, err)
+	assert.Nil(t, rank)
+	assert.Nil(t, stats)
+
+	epochTime := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for epoch := int64(100); epoch <= 101; epoch++ {
+		err := repo.SaveEpoch(ctx,
+			&models.NetworkEpochStats{Epoch: epoch, Time: epochTime, SampleSize: 1000, AverageScore: 97.5, MedianScore: 98.9},
+			[]*models.ValidatorNetworkRank{
+				{ValidatorIndex: 123, Epoch: epoch, Time: epochTime, Score: 99.1, Percentile: float64(epoch) - 40},
+				{ValidatorIndex: 456, Epoch: epoch, Time: epochTime, Score: 50, Percentile: 2.5},
+			},
+		)
+		require.NoError(t, err)
+		epochTime = epochTime.Add(384 * time.Second)
+	}
+
+	// Saving an epoch again replaces its rows
+	err = repo.SaveEpoch(ctx,
+		&models.NetworkEpochStats{Epoch: 101, Time: epochTime, SampleSize: 900, AverageScore: 96, MedianScore: 98, ActiveValidators: 1_000_000, TotalActiveBalance: 32_000_000 * 1_000_000_000},
+		[]*models.ValidatorNetworkRank{{ValidatorIndex: 123, Epoch: 101, Time: epochTime, Score: 99.5, Percentile: 75}},
+	)
+	require.NoError(t, err)
+
+	latest, ok, err := repo.LatestEpoch(ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(101), latest)
+
+	stats, err = repo.GetEpochStats(ctx, 101)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	assert.Equal(t, int32(900), stats.SampleSize)
+	assert.Equal(t, 96.0, stats.AverageScore)
+	assert.Equal(t, int64(1_000_000), stats.ActiveValidators)
+	assert.Equal(t, int64(32_000_000*1_000_000_000), stats.TotalActiveBalance)
+
+	missing, err := repo.GetEpochStats(ctx, 99)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	ranks, err := repo.GetRanks(ctx, 100, []int64{123, 456, 789})
+	require.NoError(t, err)
+	require.Len(t, ranks, 2)
+	assert.Equal(t, 60.0, ranks[123].Percentile)
+
+	rank, stats, err = repo.GetLatestRank(ctx, 123)
+	require.NoError(t, err)
+	require.NotNil(t, rank)
+	assert.Equal(t, int64(101), rank.Epoch)
+	assert.Equal(t, 75.0, rank.Percentile)
+	assert.Equal(t, 96.0, stats.AverageScore)
+}
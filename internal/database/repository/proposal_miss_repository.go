@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ProposalMissRepository handles verdicts for missed block proposals
+type ProposalMissRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewProposalMissRepository creates a new proposal miss repository
+func NewProposalMissRepository(pool *pgxpool.Pool) *ProposalMissRepository {
+	return &ProposalMissRepository{
+		pool: pool,
+	}
+}
+
+// SaveEpoch atomically stores the missed proposals found in an epoch and marks the epoch as analysed.
+// duties is the number of proposal duties checked.
+func (r *ProposalMissRepository) SaveEpoch(ctx context.Context, epoch int64, duties int, misses []*models.ProposalMiss) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if len(misses) > 0 {
+		query := `
+			INSERT INTO proposal_misses (
+				slot, validator_index, epoch, time, reason, block_root, block_delay_ms, relay
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (slot) DO UPDATE SET
+				validator_index = EXCLUDED.validator_index,
+				epoch = EXCLUDED.epoch,
+				time = EXCLUDED.time,
+				reason = EXCLUDED.reason,
+				block_root = EXCLUDED.block_root,
+				block_delay_ms = EXCLUDED.block_delay_ms,
+				relay = EXCLUDED.relay`
+
+		batch := &pgx.Batch{}
+		for _, m := range misses {
+			batch.Queue(query, m.Slot, m.ValidatorIndex, m.Epoch, m.Time, m.Reason, m.BlockRoot, m.BlockDelayMs, m.Relay)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for range misses {
+			if _, err := results.Exec(); err != nil {
+				results.Close()
+				return fmt.Errorf("failed to upsert proposal miss: %w", err)
+			}
+		}
+		if err := results.Close(); err != nil {
+			return fmt.Errorf("failed to upsert proposal misses: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO proposal_analysis_epochs (epoch, duties, misses, analyzed_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (epoch) DO UPDATE SET
+			duties = EXCLUDED.duties,
+			misses = EXCLUDED.misses,
+			analyzed_at = EXCLUDED.analyzed_at`,
+		epoch, duties, len(misses),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark epoch analysed: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit proposal misses: %w", err)
+	}
+
+	return nil
+}
+
+// AnalyzedEpochs returns the epochs in [from, to] that have already been analysed
+func (r *ProposalMissRepository) AnalyzedEpochs(ctx context.Context, from, to int64) (map[int64]bool, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT epoch FROM proposal_analysis_epochs
+		WHERE epoch >= $1 AND epoch <= $2`,
+		from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analysed epochs: %w", err)
+	}
+	defer rows.Close()
+
+	analyzed := make(map[int64]bool)
+	for rows.Next() {
+		var epoch int64
+		if err := rows.Scan(&epoch); err != nil {
+			return nil, fmt.Errorf("failed to scan analysed epoch: %w", err)
+		}
+		analyzed[epoch] = true
+	}
+
+	return analyzed, rows.Err()
+}
+
+// GetMisses returns a validator's missed proposals with slot times in [from, to), newest first
+func (r *ProposalMissRepository) GetMisses(ctx context.Context, validatorIndex int64, from, to time.Time) ([]*models.ProposalMiss, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, slot, epoch, time, reason, block_root, block_delay_ms, relay
+		FROM proposal_misses
+		WHERE validator_index = $1 AND time >= $2 AND time < $3
+		ORDER BY slot DESC`,
+		validatorIndex, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proposal misses: %w", err)
+	}
+	defer rows.Close()
+
+	var misses []*models.ProposalMiss
+	for rows.Next() {
+		m := &models.ProposalMiss{}
+		if err := rows.Scan(&m.ValidatorIndex, &m.Slot, &m.Epoch, &m.Time, &m.Reason, &m.BlockRoot, &m.BlockDelayMs, &m.Relay); err != nil {
+			return nil, fmt.Errorf("failed to scan proposal miss: %w", err)
+		}
+		misses = append(misses, m)
+	}
+
+	return misses, rows.Err()
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalMissRepository_SaveAndGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(400)))
+
+	repo := NewProposalMissRepository(pool)
+	slotTime := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	root, delay, relay := "0xorphan", int32(5200), "relay.example"
+
+	require.NoError(t, repo.SaveEpoch(ctx, 100, 2, []*models.ProposalMiss{
+		{ValidatorIndex: 400, Slot: 3205, Epoch: 100, Time: slotTime, Reason: models.ProposalMissLate, BlockRoot: &root, BlockDelayMs: &delay},
+	}))
+	require.NoError(t, repo.SaveEpoch(ctx, 101, 1, []*models.ProposalMiss{
+		{ValidatorIndex: 400, Slot: 3240, Epoch: 101, Time: slotTime.Add(7 * time.Minute), Reason: models.ProposalMissRelayFailure, Relay: &relay},
+	}))
+	require.NoError(t, repo.SaveEpoch(ctx, 102, 1, nil))
+
+	analyzed, err := repo.AnalyzedEpochs(ctx, 99, 102)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]bool{100: true, 101: true, 102: true}, analyzed)
+
+	misses, err := repo.GetMisses(ctx, 400, slotTime.Add(-time.Hour), slotTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, misses, 2)
+	assert.Equal(t, int64(3240), misses[0].Slot, "newest first")
+	assert.Equal(t, models.ProposalMissRelayFailure, misses[0].Reason)
+	assert.Equal(t, "relay.example", *misses[0].Relay)
+	assert.Nil(t, misses[0].BlockRoot)
+	assert.Equal(t, models.ProposalMissLate, misses[1].Reason)
+	assert.Equal(t, "0xorphan", *misses[1].BlockRoot)
+	assert.Equal(t, int32(5200), *misses[1].BlockDelayMs)
+
+	// Analysed misses appear in the validator timeline with their verdict
+	timeline, err := NewValidatorDetailRepository(pool).GetValidatorTimeline(ctx, 400)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, "missed_proposal", timeline[0].Type)
+	assert.Equal(t, int64(3240), *timeline[0].Slot)
+	assert.Equal(t, misses[0].Summary(), timeline[0].Description)
+}
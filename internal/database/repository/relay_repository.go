@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RelayRepository stores how the blocks proposed by monitored validators were built and what
+// relay blocks paid
+type RelayRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRelayRepository creates a new relay repository
+func NewRelayRepository(pool *pgxpool.Pool) *RelayRepository {
+	return &RelayRepository{
+		pool: pool,
+	}
+}
+
+// RecordProposal stores a proposal, reporting false if it was already recorded
+func (r *RelayRepository) RecordProposal(ctx context.Context, p *models.RelayProposal) (bool, error) {
+	relays := p.Relays
+	if relays == nil {
+		relays = []string{}
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO relay_proposals (
+			validator_index, slot, time, block_hash, source, relays, builder_pubkey, promised_gwei, received_gwei
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (validator_index, slot) DO NOTHING`,
+		p.ValidatorIndex, p.Slot, p.Time, p.BlockHash, p.Source, relays, p.BuilderPubkey, p.PromisedGwei, p.ReceivedGwei,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record relay proposal: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetProposals returns the most recent proposals of a validator, or of every validator when
+// validatorIndex is nil, newest first
+func (r *RelayRepository) GetProposals(ctx context.Context, validatorIndex *int64, limit int) ([]*models.RelayProposal, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, slot, time, block_hash, source, relays, builder_pubkey, promised_gwei, received_gwei
+		FROM relay_proposals
+		WHERE $1::BIGINT IS NULL OR validator_index = $1
+		ORDER BY slot DESC, validator_index
+		LIMIT $2`,
+		validatorIndex, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relay proposals: %w", err)
+	}
+	defer rows.Close()
+
+	var proposals []*models.RelayProposal
+	for rows.Next() {
+		p := &models.RelayProposal{}
+		if err := rows.Scan(&p.ValidatorIndex, &p.Slot, &p.Time, &p.BlockHash, &p.Source, &p.Relays,
+			&p.BuilderPubkey, &p.PromisedGwei, &p.ReceivedGwei); err != nil {
+			return nil, fmt.Errorf("failed to scan relay proposal: %w", err)
+		}
+		proposals = append(proposals, p)
+	}
+
+	return proposals, rows.Err()
+}
+
+// Summarize aggregates the proposals in [from, to) by relay, followed by locally built blocks
+func (r *RelayRepository) Summarize(ctx context.Context, from, to time.Time) ([]*models.RelaySummary, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			COALESCE(d.relay, p.source) AS relay,
+			p.source,
+			COUNT(*) AS proposals,
+			COUNT(p.received_gwei) AS verified,
+			COALESCE(SUM(p.promised_gwei) FILTER (WHERE p.received_gwei IS NOT NULL), 0) AS promised_gwei,
+			COALESCE(SUM(p.received_gwei), 0) AS received_gwei
+		FROM relay_proposals p
+		LEFT JOIN LATERAL unnest(p.relays) AS d(relay) ON TRUE
+		WHERE p.time >= $1 AND p.time < $2
+		GROUP BY 1, 2
+		ORDER BY p.source = 'relay' DESC, 1`,
+		from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize relay proposals: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*models.RelaySummary
+	for rows.Next() {
+		s := &models.RelaySummary{}
+		if err := rows.Scan(&s.Relay, &s.Source, &s.Proposals, &s.Verified, &s.PromisedGwei, &s.ReceivedGwei); err != nil {
+			return nil, fmt.Errorf("failed to scan relay summary: %w", err)
+		}
+		summaries = append(summaries, s)
+	}
+
+	return summaries, rows.Err()
+}
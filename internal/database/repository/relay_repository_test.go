@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayRepository_ProposalsAndSummary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(820)))
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(821)))
+
+	repo := NewRelayRepository(pool)
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	builder := "0xbuilder"
+	promised, received, unverified := int64(60_000_000), int64(50_000_000), int64(30_000_000)
+	proposals := []*models.RelayProposal{
+		{ValidatorIndex: 820, Slot: 3201, Source: models.BlockSourceRelay, Relays: []string{"relay-a", "relay-b"},
+			BuilderPubkey: &builder, PromisedGwei: &promised, ReceivedGwei: &received},
+		{ValidatorIndex: 821, Slot: 3202, Source: models.BlockSourceRelay, Relays: []string{"relay-a"},
+			BuilderPubkey: &builder, PromisedGwei: &unverified},
+		{ValidatorIndex: 820, Slot: 3301, Source: models.BlockSourceFallback},
+	}
+	for _, p := range proposals {
+		p.Time = types.SlotStartTime(genesis, p.Slot)
+		p.BlockHash = "0xb1"
+		inserted, err := repo.RecordProposal(ctx, p)
+		require.NoError(t, err)
+		assert.True(t, inserted)
+
+		inserted, err = repo.RecordProposal(ctx, p)
+		require.NoError(t, err)
+		assert.False(t, inserted, "a block is recorded once")
+	}
+
+	index := int64(820)
+	stored, err := repo.GetProposals(ctx, &index, 10)
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	assert.Equal(t, models.BlockSourceFallback, stored[0].Source)
+	assert.Empty(t, stored[0].Relays)
+	assert.Equal(t, []string{"relay-a", "relay-b"}, stored[1].Relays)
+	shortfall, ok := stored[1].Shortfall()
+	assert.True(t, ok)
+	assert.Equal(t, int64(10_000_000), shortfall)
+
+	all, err := repo.GetProposals(ctx, nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	summaries, err := repo.Summarize(ctx, genesis, types.SlotStartTime(genesis, 4000))
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	assert.Equal(t, models.RelaySummary{Relay: "relay-a", Source: models.BlockSourceRelay, Proposals: 2, Verified: 1,
+		PromisedGwei: promised, ReceivedGwei: received}, *summaries[0])
+	assert.Equal(t, "relay-b", summaries[1].Relay)
+	assert.Equal(t, models.RelaySummary{Relay: "fallback", Source: models.BlockSourceFallback, Proposals: 1}, *summaries[2])
+}
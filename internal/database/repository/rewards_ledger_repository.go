@@ -0,0 +1,310 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RewardsLedgerRepository handles the per-epoch rewards and penalties ledger
+type RewardsLedgerRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRewardsLedgerRepository creates a new rewards ledger repository
+func NewRewardsLedgerRepository(pool *pgxpool.Pool) *RewardsLedgerRepository {
+	return &RewardsLedgerRepository{
+		pool: pool,
+	}
+}
+
+// UpsertEntries stores ledger entries, replacing any existing entry for the same validator and epoch
+func (r *RewardsLedgerRepository) UpsertEntries(ctx context.Context, entries []*models.RewardLedgerEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO validator_rewards_ledger (
+			validator_index, epoch, time, effective_balance, balance_start, balance_end,
+			attestation_rewards, proposal_rewards, sync_rewards, penalties,
+			withdrawals, deposits, other, ideal_rewards, sync_penalties
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (validator_index, epoch) DO UPDATE SET
+			time = EXCLUDED.time,
+			effective_balance = EXCLUDED.effective_balance,
+			balance_start = EXCLUDED.balance_start,
+			balance_end = EXCLUDED.balance_end,
+			attestation_rewards = EXCLUDED.attestation_rewards,
+			proposal_rewards = EXCLUDED.proposal_rewards,
+			sync_rewards = EXCLUDED.sync_rewards,
+			penalties = EXCLUDED.penalties,
+			withdrawals = EXCLUDED.withdrawals,
+			deposits = EXCLUDED.deposits,
+			other = EXCLUDED.other,
+			ideal_rewards = EXCLUDED.ideal_rewards,
+			sync_penalties = EXCLUDED.sync_penalties`
+
+	batch := &pgx.Batch{}
+	for _, e := range entries {
+		batch.Queue(query,
+			e.ValidatorIndex, e.Epoch, e.Time, e.EffectiveBalance, e.BalanceStart, e.BalanceEnd,
+			e.AttestationRewards, e.ProposalRewards, e.SyncRewards, e.Penalties,
+			e.Withdrawals, e.Deposits, e.Other, e.IdealRewards, e.SyncPenalties,
+		)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for range entries {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to upsert ledger entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// LatestEpochs returns the most recent ledger epoch for each validator that has any entries
+func (r *RewardsLedgerRepository) LatestEpochs(ctx context.Context, validatorIndices []int64) (map[int64]int64, error) {
+	query := `
+		SELECT validator_index, MAX(epoch)
+		FROM validator_rewards_ledger
+		WHERE validator_index = ANY($1)
+		GROUP BY validator_index`
+
+	rows, err := r.pool.Query(ctx, query, validatorIndices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest ledger epochs: %w", err)
+	}
+	defer rows.Close()
+
+	latest := make(map[int64]int64, len(validatorIndices))
+	for rows.Next() {
+		var index, epoch int64
+		if err := rows.Scan(&index, &epoch); err != nil {
+			return nil, fmt.Errorf("failed to scan latest ledger epoch: %w", err)
+		}
+		latest[index] = epoch
+	}
+
+	return latest, rows.Err()
+}
+
+// GetEntries returns a validator's ledger entries with start times in [from, to), oldest first
+func (r *RewardsLedgerRepository) GetEntries(ctx context.Context, validatorIndex int64, from, to time.Time) ([]*models.RewardLedgerEntry, error) {
+	query := `
+		SELECT validator_index, epoch, time, effective_balance, balance_start, balance_end,
+			attestation_rewards, proposal_rewards, sync_rewards, penalties,
+			withdrawals, deposits, other, ideal_rewards, sync_penalties
+		FROM validator_rewards_ledger
+		WHERE validator_index = $1 AND time >= $2 AND time < $3
+		ORDER BY epoch`
+
+	rows, err := r.pool.Query(ctx, query, validatorIndex, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.RewardLedgerEntry
+	for rows.Next() {
+		e := &models.RewardLedgerEntry{}
+		err := rows.Scan(
+			&e.ValidatorIndex, &e.Epoch, &e.Time, &e.EffectiveBalance, &e.BalanceStart, &e.BalanceEnd,
+			&e.AttestationRewards, &e.ProposalRewards, &e.SyncRewards, &e.Penalties,
+			&e.Withdrawals, &e.Deposits, &e.Other, &e.IdealRewards, &e.SyncPenalties,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// Summarize totals a validator's ledger in [from, to) by day, week or month (UTC), oldest first.
+// Periods with no entries are omitted.
+func (r *RewardsLedgerRepository) Summarize(ctx context.Context, validatorIndex int64, period models.LedgerPeriod, from, to time.Time) ([]*models.RewardLedgerSummary, error) {
+	if !period.IsValid() {
+		return nil, fmt.Errorf("invalid ledger period: %q", period)
+	}
+
+	query := `
+		SELECT date_trunc($4, time AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' AS period_start,
+			COUNT(*),
+			COALESCE(SUM(attestation_rewards), 0),
+			COALESCE(SUM(proposal_rewards), 0),
+			COALESCE(SUM(sync_rewards), 0),
+			COALESCE(SUM(penalties), 0),
+			COALESCE(SUM(withdrawals), 0),
+			COALESCE(SUM(deposits), 0),
+			COALESCE(SUM(other), 0),
+			COALESCE(SUM(ideal_rewards), 0)
+		FROM validator_rewards_ledger
+		WHERE validator_index = $1 AND time >= $2 AND time < $3
+		GROUP BY period_start
+		ORDER BY period_start`
+
+	rows, err := r.pool.Query(ctx, query, validatorIndex, from, to, string(period))
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize ledger: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*models.RewardLedgerSummary
+	for rows.Next() {
+		s, err := scanLedgerSummary(rows)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, s)
+	}
+
+	return summaries, rows.Err()
+}
+
+// Total totals a validator's ledger in [from, to). PeriodStart is set to from.
+func (r *RewardsLedgerRepository) Total(ctx context.Context, validatorIndex int64, from, to time.Time) (*models.RewardLedgerSummary, error) {
+	query := `
+		SELECT $2::timestamptz,
+			COUNT(*),
+			COALESCE(SUM(attestation_rewards), 0),
+			COALESCE(SUM(proposal_rewards), 0),
+			COALESCE(SUM(sync_rewards), 0),
+			COALESCE(SUM(penalties), 0),
+			COALESCE(SUM(withdrawals), 0),
+			COALESCE(SUM(deposits), 0),
+			COALESCE(SUM(other), 0),
+			COALESCE(SUM(ideal_rewards), 0)
+		FROM validator_rewards_ledger
+		WHERE validator_index = $1 AND time >= $2 AND time < $3`
+
+	summary, err := scanLedgerSummary(r.pool.QueryRow(ctx, query, validatorIndex, from, to))
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// Performance returns a validator's expected and actual rewards and reward effectiveness over
+// [from, to) from the ledger totals
+func (r *RewardsLedgerRepository) Performance(ctx context.Context, validatorIndex int64, from, to time.Time) (*types.ValidatorPerformance, error) {
+	total, err := r.Total(ctx, validatorIndex, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorPerformance{
+		ValidatorIndex:  int(validatorIndex),
+		Timestamp:       to,
+		ExpectedRewards: big.NewInt(total.Expected()),
+		ActualRewards:   big.NewInt(total.Actual()),
+		Effectiveness:   total.Effectiveness(),
+	}, nil
+}
+
+// scanLedgerSummary scans a row produced by the summary queries
+func scanLedgerSummary(row pgx.Row) (*models.RewardLedgerSummary, error) {
+	s := &models.RewardLedgerSummary{}
+	err := row.Scan(
+		&s.PeriodStart, &s.Epochs,
+		&s.AttestationRewards, &s.ProposalRewards, &s.SyncRewards, &s.Penalties,
+		&s.Withdrawals, &s.Deposits, &s.Other, &s.IdealRewards,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan ledger summary: %w", err)
+	}
+	return s, nil
+}
+
+// ledgerIncomeColumns aggregates ledger rows into the columns of models.IncomeSummary.
+// Unexplained decreases (e.g. slashing) count against income; unexplained increases are
+// left out because they are most likely deposits the ledger could not attribute.
+const ledgerIncomeColumns = `
+	COUNT(DISTINCT l.epoch),
+	COALESCE(SUM(l.attestation_rewards + l.proposal_rewards + l.sync_rewards - l.penalties + LEAST(l.other, 0)), 0),
+	COALESCE(SUM(l.effective_balance)::float8, 0)`
+
+// IncomeByValidator returns consensus income over [from, to) for each of the given validators.
+// Validators with no ledger entries in the range are omitted.
+func (r *RewardsLedgerRepository) IncomeByValidator(ctx context.Context, validatorIndices []int64, from, to time.Time) (map[int64]*models.IncomeSummary, error) {
+	query := `
+		SELECT l.validator_index,` + ledgerIncomeColumns + `
+		FROM validator_rewards_ledger l
+		WHERE l.validator_index = ANY($1) AND l.time >= $2 AND l.time < $3
+		GROUP BY l.validator_index`
+
+	rows, err := r.pool.Query(ctx, query, validatorIndices, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query validator income: %w", err)
+	}
+	defer rows.Close()
+
+	income := make(map[int64]*models.IncomeSummary)
+	for rows.Next() {
+		var index int64
+		s := &models.IncomeSummary{}
+		if err := rows.Scan(&index, &s.Epochs, &s.Income, &s.EffectiveBalanceEpochs); err != nil {
+			return nil, fmt.Errorf("failed to scan validator income: %w", err)
+		}
+		income[index] = s
+	}
+
+	return income, rows.Err()
+}
+
+// IncomeByTag returns consensus income over [from, to) for monitored validators grouped by tag.
+// A validator with several tags counts towards each of them.
+func (r *RewardsLedgerRepository) IncomeByTag(ctx context.Context, from, to time.Time) (map[string]*models.IncomeSummary, error) {
+	query := `
+		SELECT tag,` + ledgerIncomeColumns + `
+		FROM validator_rewards_ledger l
+		JOIN validators v ON v.validator_index = l.validator_index
+		CROSS JOIN LATERAL unnest(v.tags) AS tag
+		WHERE v.monitored = TRUE AND l.time >= $1 AND l.time < $2
+		GROUP BY tag`
+
+	rows, err := r.pool.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tag income: %w", err)
+	}
+	defer rows.Close()
+
+	income := make(map[string]*models.IncomeSummary)
+	for rows.Next() {
+		var tag string
+		s := &models.IncomeSummary{}
+		if err := rows.Scan(&tag, &s.Epochs, &s.Income, &s.EffectiveBalanceEpochs); err != nil {
+			return nil, fmt.Errorf("failed to scan tag income: %w", err)
+		}
+		income[tag] = s
+	}
+
+	return income, rows.Err()
+}
+
+// PortfolioIncome returns consensus income over [from, to) for all monitored validators
+func (r *RewardsLedgerRepository) PortfolioIncome(ctx context.Context, from, to time.Time) (*models.IncomeSummary, error) {
+	query := `
+		SELECT` + ledgerIncomeColumns + `
+		FROM validator_rewards_ledger l
+		JOIN validators v ON v.validator_index = l.validator_index
+		WHERE v.monitored = TRUE AND l.time >= $1 AND l.time < $2`
+
+	s := &models.IncomeSummary{}
+	if err := r.pool.QueryRow(ctx, query, from, to).Scan(&s.Epochs, &s.Income, &s.EffectiveBalanceEpochs); err != nil {
+		return nil, fmt.Errorf("failed to query portfolio income: %w", err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ledgerEntryFixture(validatorIndex, epoch int64, genesis time.Time) *models.RewardLedgerEntry {
+	return &models.RewardLedgerEntry{
+		ValidatorIndex:     validatorIndex,
+		Epoch:              epoch,
+		Time:               types.EpochStartTime(genesis, epoch),
+		EffectiveBalance:   32000000000,
+		BalanceStart:       32000000000,
+		BalanceEnd:         32000014000,
+		AttestationRewards: 15000,
+		Penalties:          1000,
+		IdealRewards:       16000,
+	}
+}
+
+func TestRewardsLedgerRepository_UpsertAndSummarize(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	repo := NewRewardsLedgerRepository(pool)
+	ctx := context.Background()
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+
+	// Two epochs on one day, one on the next (225 epochs per day)
+	day := types.EpochAtTime(genesis, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) + 1
+	entries := []*models.RewardLedgerEntry{
+		ledgerEntryFixture(123, day, genesis),
+		ledgerEntryFixture(123, day+1, genesis),
+		ledgerEntryFixture(123, day+225, genesis),
+	}
+	require.NoError(t, repo.UpsertEntries(ctx, entries))
+
+	// Upserting again replaces rather than duplicates
+	entries[0].ProposalRewards = 50000000
+	require.NoError(t, repo.UpsertEntries(ctx, entries[:1]))
+
+	latest, err := repo.LatestEpochs(ctx, []int64{123, 456})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int64{123: day + 225}, latest)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 3)
+
+	daily, err := repo.Summarize(ctx, 123, models.LedgerPeriodDay, from, to)
+	require.NoError(t, err)
+	require.Len(t, daily, 2)
+	assert.Equal(t, from, daily[0].PeriodStart.UTC())
+	assert.Equal(t, int64(2), daily[0].Epochs)
+	assert.Equal(t, int64(30000), daily[0].AttestationRewards)
+	assert.Equal(t, int64(50000000), daily[0].ProposalRewards)
+	assert.Equal(t, from.AddDate(0, 0, 1), daily[1].PeriodStart.UTC())
+
+	total, err := repo.Total(ctx, 123, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total.Epochs)
+	assert.Equal(t, int64(45000+50000000-3000), total.Actual())
+	assert.Equal(t, int64(48000+50000000), total.Expected())
+
+	perf, err := repo.Performance(ctx, 123, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(45000+50000000-3000), perf.ActualRewards.Int64())
+	assert.Equal(t, int64(48000+50000000), perf.ExpectedRewards.Int64())
+	assert.InDelta(t, total.Effectiveness(), perf.Effectiveness, 1e-9)
+
+	_, err = repo.Summarize(ctx, 123, models.LedgerPeriod("year"), from, to)
+	assert.Error(t, err)
+}
+
+func TestRewardsLedgerRepository_Income(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	for i, tags := range [][]string{{"prod", "eu"}, {"prod"}} {
+		v := testutil.ValidatorFixture(int64(200 + i))
+		v.Tags = tags
+		require.NoError(t, validatorRepo.CreateValidator(ctx, v))
+	}
+
+	repo := NewRewardsLedgerRepository(pool)
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	start := types.EpochAtTime(genesis, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) + 1
+
+	// A 0.5 ETH sweep and a 1 ETH top-up are not income; a slashing-sized loss is
+	sweep := ledgerEntryFixture(200, start, genesis)
+	sweep.Withdrawals = 500000000
+	topUp := ledgerEntryFixture(200, start+1, genesis)
+	topUp.Deposits = 1000000000
+	topUp.Other = 7000 // Unmatched deposit remainder, ignored
+	slashed := ledgerEntryFixture(201, start, genesis)
+	slashed.Other = -4000
+	require.NoError(t, repo.UpsertEntries(ctx, []*models.RewardLedgerEntry{sweep, topUp, slashed}))
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	byValidator, err := repo.IncomeByValidator(ctx, []int64{200, 201, 202}, from, to)
+	require.NoError(t, err)
+	require.Len(t, byValidator, 2)
+	assert.Equal(t, int64(2), byValidator[200].Epochs)
+	assert.Equal(t, int64(28000), byValidator[200].Income)
+	assert.Equal(t, float64(64000000000), byValidator[200].EffectiveBalanceEpochs)
+	assert.Equal(t, int64(10000), byValidator[201].Income)
+
+	byTag, err := repo.IncomeByTag(ctx, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(38000), byTag["prod"].Income)
+	assert.Equal(t, int64(2), byTag["prod"].Epochs, "epochs are counted once across validators")
+	assert.Equal(t, int64(28000), byTag["eu"].Income)
+
+	portfolio, err := repo.PortfolioIncome(ctx, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(38000), portfolio.Income)
+	assert.Equal(t, float64(96000000000), portfolio.EffectiveBalanceEpochs)
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SignerRepository stores the health of validator client and remote signer endpoints and the keys
+// they have loaded
+type SignerRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSignerRepository creates a new signer repository
+func NewSignerRepository(pool *pgxpool.Pool) *SignerRepository {
+	return &SignerRepository{
+		pool: pool,
+	}
+}
+
+// RecordCheck atomically stores the outcome of scraping an endpoint. The endpoint's loaded keys
+// are replaced when the check lists keys, and kept from the last successful scrape otherwise.
+func (r *SignerRepository) RecordCheck(ctx context.Context, target types.SignerTarget, check *types.SignerCheck, at time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var keyCount *int
+	if check.Keys != nil {
+		n := len(check.Keys)
+		keyCount = &n
+	}
+	var healthyAt *time.Time
+	if check.Status == "healthy" {
+		healthyAt = &at
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO signer_endpoints (name, kind, url, status, message, key_count, checked_at, healthy_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (name, kind) DO UPDATE SET
+			url = EXCLUDED.url,
+			status = EXCLUDED.status,
+			message = EXCLUDED.message,
+			key_count = COALESCE(EXCLUDED.key_count, signer_endpoints.key_count),
+			checked_at = EXCLUDED.checked_at,
+			healthy_at = COALESCE(EXCLUDED.healthy_at, signer_endpoints.healthy_at)`,
+		target.Name, target.Kind, target.URL, check.Status, check.Message, keyCount, at, healthyAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record signer endpoint: %w", err)
+	}
+
+	if check.Keys != nil {
+		pubkeys := make([]string, len(check.Keys))
+		remote := make([]bool, len(check.Keys))
+		for i, k := range check.Keys {
+			pubkeys[i] = k.Pubkey
+			remote[i] = k.Remote
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM signer_keys WHERE name = $1 AND kind = $2`, target.Name, target.Kind); err != nil {
+			return fmt.Errorf("failed to clear signer keys: %w", err)
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO signer_keys (name, kind, pubkey, remote, seen_at)
+			SELECT $1, $2, k.pubkey, k.remote, $5
+			FROM unnest($3::text[], $4::boolean[]) AS k(pubkey, remote)
+			ON CONFLICT (name, kind, pubkey) DO NOTHING`,
+			target.Name, target.Kind, pubkeys, remote, at,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record signer keys: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit signer check: %w", err)
+	}
+
+	return nil
+}
+
+// PruneEndpoints removes the endpoints, and their keys, that are no longer configured
+func (r *SignerRepository) PruneEndpoints(ctx context.Context, targets []types.SignerTarget) error {
+	names := make([]string, len(targets))
+	kinds := make([]string, len(targets))
+	for i, t := range targets {
+		names[i] = t.Name
+		kinds[i] = string(t.Kind)
+	}
+
+	for _, table := range []string{"signer_keys", "signer_endpoints"} {
+		_, err := r.pool.Exec(ctx, `
+			DELETE FROM `+table+`
+			WHERE (name, kind) NOT IN (SELECT * FROM unnest($1::text[], $2::text[]))`,
+			names, kinds,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to prune %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// ListEndpoints returns the latest scrape of every endpoint, ordered by name and kind
+func (r *SignerRepository) ListEndpoints(ctx context.Context) ([]*models.SignerEndpoint, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT name, kind, url, status, message, key_count, checked_at, healthy_at
+		FROM signer_endpoints
+		ORDER BY name, kind`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signer endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var endpoints []*models.SignerEndpoint
+	for rows.Next() {
+		e := &models.SignerEndpoint{}
+		if err := rows.Scan(&e.Name, &e.Kind, &e.URL, &e.Status, &e.Message, &e.KeyCount, &e.CheckedAt,
+			&e.HealthyAt); err != nil {
+			return nil, fmt.Errorf("failed to scan signer endpoint: %w", err)
+		}
+		endpoints = append(endpoints, e)
+	}
+
+	return endpoints, rows.Err()
+}
+
+// KeyCoverage returns where the key of every monitored validator is loaded, ordered by validator
+// index
+func (r *SignerRepository) KeyCoverage(ctx context.Context) ([]*models.KeyCoverage, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			v.validator_index,
+			v.pubkey,
+			COALESCE(array_agg(DISTINCT k.name) FILTER (WHERE k.kind = 'keymanager'), '{}'),
+			COALESCE(array_agg(DISTINCT k.name) FILTER (WHERE k.kind = 'keymanager' AND k.remote), '{}'),
+			COALESCE(array_agg(DISTINCT k.name) FILTER (WHERE k.kind = 'web3signer'), '{}')
+		FROM validators v
+		LEFT JOIN signer_keys k ON k.pubkey = LOWER(v.pubkey)
+		WHERE v.monitored = TRUE
+		GROUP BY v.validator_index, v.pubkey
+		ORDER BY v.validator_index`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key coverage: %w", err)
+	}
+	defer rows.Close()
+
+	var coverage []*models.KeyCoverage
+	for rows.Next() {
+		c := &models.KeyCoverage{}
+		if err := rows.Scan(&c.ValidatorIndex, &c.Pubkey, &c.Clients, &c.RemoteClients, &c.Signers); err != nil {
+			return nil, fmt.Errorf("failed to scan key coverage: %w", err)
+		}
+		coverage = append(coverage, c)
+	}
+
+	return coverage, rows.Err()
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerRepository_ChecksAndCoverage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	var pubkeys []string
+	for _, index := range []int64{840, 841, 842} {
+		v := testutil.ValidatorFixture(index)
+		require.NoError(t, validatorRepo.CreateValidator(ctx, v))
+		pubkeys = append(pubkeys, strings.ToLower(v.Pubkey))
+	}
+
+	repo := NewSignerRepository(pool)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	vc1 := types.SignerTarget{Name: "vc-1", Kind: types.SignerKindKeymanager, URL: "http://vc-1:5062"}
+	vc2 := types.SignerTarget{Name: "vc-2", Kind: types.SignerKindKeymanager, URL: "http://vc-2:5062"}
+	signer := types.SignerTarget{Name: "signer", Kind: types.SignerKindWeb3Signer, URL: "http://signer:9000"}
+	metrics := types.SignerTarget{Name: "vc-1", Kind: types.SignerKindMetrics, URL: "http://vc-1:5064/metrics"}
+
+	require.NoError(t, repo.RecordCheck(ctx, vc1, &types.SignerCheck{Status: "healthy",
+		Keys: []types.LoadedKey{{Pubkey: pubkeys[0]}, {Pubkey: pubkeys[1], Remote: true}}}, now))
+	require.NoError(t, repo.RecordCheck(ctx, vc2, &types.SignerCheck{Status: "healthy",
+		Keys: []types.LoadedKey{{Pubkey: pubkeys[0]}}}, now))
+	require.NoError(t, repo.RecordCheck(ctx, signer, &types.SignerCheck{Status: "healthy",
+		Keys: []types.LoadedKey{{Pubkey: pubkeys[1]}}}, now))
+	require.NoError(t, repo.RecordCheck(ctx, metrics, &types.SignerCheck{Status: "healthy"}, now))
+
+	coverage, err := repo.KeyCoverage(ctx)
+	require.NoError(t, err)
+	require.Len(t, coverage, 3)
+	assert.Equal(t, []string{"vc-1", "vc-2"}, coverage[0].Clients)
+	assert.True(t, coverage[0].Duplicated())
+	assert.Equal(t, []string{"vc-1"}, coverage[1].RemoteClients)
+	assert.Equal(t, []string{"signer"}, coverage[1].Signers)
+	assert.False(t, coverage[1].Unsigned())
+	assert.True(t, coverage[2].Missing())
+
+	// A failed scrape keeps the keys of the last successful one
+	later := now.Add(time.Minute)
+	require.NoError(t, repo.RecordCheck(ctx, vc2, &types.SignerCheck{Status: "unhealthy", Message: "connection refused"}, later))
+	endpoints, err := repo.ListEndpoints(ctx)
+	require.NoError(t, err)
+	require.Len(t, endpoints, 4)
+	assert.Equal(t, "vc-2", endpoints[3].Name)
+	assert.Equal(t, "unhealthy", endpoints[3].Status)
+	require.NotNil(t, endpoints[3].KeyCount)
+	assert.Equal(t, 1, *endpoints[3].KeyCount)
+	assert.True(t, endpoints[3].HealthyAt.Equal(now))
+	assert.Nil(t, endpoints[2].KeyCount, "metrics endpoints do not list keys")
+
+	// Dropping vc-2 from the configuration removes its keys
+	require.NoError(t, repo.PruneEndpoints(ctx, []types.SignerTarget{vc1, signer, metrics}))
+	coverage, err = repo.KeyCoverage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vc-1"}, coverage[0].Clients)
+}
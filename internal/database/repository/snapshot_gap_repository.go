@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SnapshotGapRepository handles snapshot gap tracking database operations
+type SnapshotGapRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSnapshotGapRepository creates a new snapshot gap repository
+func NewSnapshotGapRepository(pool *pgxpool.Pool) *SnapshotGapRepository {
+	return &SnapshotGapRepository{
+		pool: pool,
+	}
+}
+
+// FindMissingEpochs returns the epochs in [fromEpoch, toEpoch] with no snapshot for the validator.
+// Epochs already marked irreparable are excluded so they are not retried.
+func (r *SnapshotGapRepository) FindMissingEpochs(ctx context.Context, validatorIndex, fromEpoch, toEpoch int64, genesis time.Time) ([]int64, error) {
+	if toEpoch < fromEpoch {
+		return nil, nil
+	}
+
+	query := `
+		SELECT e.epoch
+		FROM generate_series($2::bigint, $3::bigint) AS e(epoch)
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM validator_snapshots s
+			WHERE s.validator_index = $1
+			  AND s.time >= to_timestamp($4 + e.epoch * $5)
+			  AND s.time < to_timestamp($4 + (e.epoch + 1) * $5)
+		)
+		AND NOT EXISTS (
+			SELECT 1
+			FROM snapshot_gaps g
+			WHERE g.validator_index = $1
+			  AND g.epoch = e.epoch
+			  AND g.status = 'irreparable'
+		)
+		ORDER BY e.epoch`
+
+	epochSeconds := int64(types.EpochDuration / time.Second)
+
+	rows, err := r.pool.Query(ctx, query, validatorIndex, fromEpoch, toEpoch, genesis.Unix(), epochSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find missing epochs: %w", err)
+	}
+	defer rows.Close()
+
+	var epochs []int64
+	for rows.Next() {
+		var epoch int64
+		if err := rows.Scan(&epoch); err != nil {
+			return nil, fmt.Errorf("failed to scan missing epoch: %w", err)
+		}
+		epochs = append(epochs, epoch)
+	}
+
+	return epochs, rows.Err()
+}
+
+// RecordGaps records detected gaps as missing so they are counted before a repair is attempted.
+// Gaps already recorded keep their status and attempts.
+func (r *SnapshotGapRepository) RecordGaps(ctx context.Context, validatorIndex int64, epochs []int64) error {
+	if len(epochs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO snapshot_gaps (validator_index, epoch, status, attempts)
+		SELECT $1, epoch, 'missing', 0
+		FROM unnest($2::bigint[]) AS epoch
+		ON CONFLICT (validator_index, epoch) DO NOTHING`
+
+	if _, err := r.pool.Exec(ctx, query, validatorIndex, epochs); err != nil {
+		return fmt.Errorf("failed to record gaps: %w", err)
+	}
+
+	return nil
+}
+
+// RecordGapAttempt records a failed repair attempt and returns the total attempts for the gap
+func (r *SnapshotGapRepository) RecordGapAttempt(ctx context.Context, validatorIndex, epoch int64, lastError string) (int32, error) {
+	query := `
+		INSERT INTO snapshot_gaps (validator_index, epoch, status, attempts, last_error)
+		VALUES ($1, $2, 'missing', 1, $3)
+		ON CONFLICT (validator_index, epoch) DO UPDATE SET
+			status = 'missing',
+			attempts = snapshot_gaps.attempts + 1,
+			last_error = EXCLUDED.last_error
+		RETURNING attempts`
+
+	var attempts int32
+	if err := r.pool.QueryRow(ctx, query, validatorIndex, epoch, lastError).Scan(&attempts); err != nil {
+		return 0, fmt.Errorf("failed to record gap attempt: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// MarkGapRepaired marks a gap as backfilled from beacon state
+func (r *SnapshotGapRepository) MarkGapRepaired(ctx context.Context, validatorIndex, epoch int64) error {
+	query := `
+		INSERT INTO snapshot_gaps (validator_index, epoch, status, attempts, resolved_at)
+		VALUES ($1, $2, 'repaired', 1, NOW())
+		ON CONFLICT (validator_index, epoch) DO UPDATE SET
+			status = 'repaired',
+			attempts = snapshot_gaps.attempts + 1,
+			last_error = NULL,
+			resolved_at = NOW()`
+
+	if _, err := r.pool.Exec(ctx, query, validatorIndex, epoch); err != nil {
+		return fmt.Errorf("failed to mark gap repaired: %w", err)
+	}
+
+	return nil
+}
+
+// MarkGapIrreparable marks a gap as permanently missing so it is reported rather than retried
+func (r *SnapshotGapRepository) MarkGapIrreparable(ctx context.Context, validatorIndex, epoch int64, reason string) error {
+	query := `
+		INSERT INTO snapshot_gaps (validator_index, epoch, status, attempts, last_error, resolved_at)
+		VALUES ($1, $2, 'irreparable', 1, $3, NOW())
+		ON CONFLICT (validator_index, epoch) DO UPDATE SET
+			status = 'irreparable',
+			last_error = EXCLUDED.last_error,
+			resolved_at = NOW()`
+
+	if _, err := r.pool.Exec(ctx, query, validatorIndex, epoch, reason); err != nil {
+		return fmt.Errorf("failed to mark gap irreparable: %w", err)
+	}
+
+	return nil
+}
+
+// GetGapCounts returns gap counts by status for a validator from the given epoch onwards
+func (r *SnapshotGapRepository) GetGapCounts(ctx context.Context, validatorIndex, fromEpoch int64) (*models.SnapshotGapCounts, error) {
+	query := `
+		SELECT status, COUNT(*)
+		FROM snapshot_gaps
+		WHERE validator_index = $1 AND epoch >= $2
+		GROUP BY status`
+
+	rows, err := r.pool.Query(ctx, query, validatorIndex, fromEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gap counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := &models.SnapshotGapCounts{}
+	for rows.Next() {
+		var status models.GapStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan gap count: %w", err)
+		}
+
+		switch status {
+		case models.GapStatusMissing:
+			counts.Missing = count
+		case models.GapStatusRepaired:
+			counts.Repaired = count
+		case models.GapStatusIrreparable:
+			counts.Irreparable = count
+		}
+	}
+
+	return counts, rows.Err()
+}
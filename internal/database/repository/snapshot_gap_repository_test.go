@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotGapRepository_FindMissingEpochs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	snapshotRepo := NewSnapshotRepository(pool)
+	repo := NewSnapshotGapRepository(pool)
+	ctx := context.Background()
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+
+	// Snapshots for epochs 100 and 102 only
+	for _, epoch := range []int64{100, 102} {
+		snapshot := testutil.ValidatorSnapshotFixture(123, types.EpochStartTime(genesis, epoch).Add(time.Minute))
+		require.NoError(t, snapshotRepo.InsertSnapshot(ctx, snapshot))
+	}
+
+	missing, err := repo.FindMissingEpochs(ctx, 123, 100, 104, genesis)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{101, 103, 104}, missing)
+
+	// Irreparable gaps are not reported again
+	require.NoError(t, repo.MarkGapIrreparable(ctx, 123, 103, "state pruned"))
+
+	missing, err = repo.FindMissingEpochs(ctx, 123, 100, 104, genesis)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{101, 104}, missing)
+}
+
+func TestSnapshotGapRepository_GapCounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	repo := NewSnapshotGapRepository(pool)
+	ctx := context.Background()
+
+	attempts, err := repo.RecordGapAttempt(ctx, 123, 10, "timeout")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), attempts)
+
+	attempts, err = repo.RecordGapAttempt(ctx, 123, 10, "timeout")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), attempts)
+
+	require.NoError(t, repo.MarkGapRepaired(ctx, 123, 11))
+	require.NoError(t, repo.MarkGapIrreparable(ctx, 123, 12, "state pruned"))
+
+	// Detected gaps count as missing before any attempt; recorded gaps keep their status
+	require.NoError(t, repo.RecordGaps(ctx, 123, []int64{10, 11, 13}))
+
+	counts, err := repo.GetGapCounts(ctx, 123, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, counts.Missing)
+	assert.Equal(t, 1, counts.Repaired)
+	assert.Equal(t, 1, counts.Irreparable)
+}
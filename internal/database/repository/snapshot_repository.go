@@ -23,39 +23,47 @@ func NewSnapshotRepository(pool *pgxpool.Pool) *SnapshotRepository {
 	}
 }
 
+// insertSnapshotQuery inserts a single validator snapshot; arguments come from snapshotValues
+const insertSnapshotQuery = `
+	INSERT INTO validator_snapshots (
+		time, validator_index, balance, effective_balance,
+		attestation_effectiveness, attestation_inclusion_delay,
+		attestation_head_vote, attestation_source_vote, attestation_target_vote,
+		proposals_scheduled, proposals_executed, proposals_missed,
+		sync_committee_participation, slashed, is_online,
+		consecutive_missed_attestations, daily_income, apr,
+		network_percentile, network_average
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
+
+// snapshotValues returns snapshot column values in validator_snapshots column order
+func snapshotValues(s *models.ValidatorSnapshot) []interface{} {
+	return []interface{}{
+		s.Time,
+		s.ValidatorIndex,
+		s.Balance,
+		s.EffectiveBalance,
+		s.AttestationEffectiveness,
+		s.AttestationInclusionDelay,
+		s.AttestationHeadVote,
+		s.AttestationSourceVote,
+		s.AttestationTargetVote,
+		s.ProposalsScheduled,
+		s.ProposalsExecuted,
+		s.ProposalsMissed,
+		s.SyncCommitteeParticipation,
+		s.Slashed,
+		s.IsOnline,
+		s.ConsecutiveMissedAttestations,
+		s.DailyIncome,
+		s.APR,
+		s.NetworkPercentile,
+		s.NetworkAverage,
+	}
+}
+
 // InsertSnapshot inserts a single validator snapshot
 func (r *SnapshotRepository) InsertSnapshot(ctx context.Context, snapshot *models.ValidatorSnapshot) error {
-	query := `
-		INSERT INTO validator_snapshots (
-			time, validator_index, balance, effective_balance,
-			attestation_effectiveness, attestation_inclusion_delay,
-			attestation_head_vote, attestation_source_vote, attestation_target_vote,
-			proposals_scheduled, proposals_executed, proposals_missed,
-			sync_committee_participation, slashed, is_online,
-			consecutive_missed_attestations, daily_income, apr
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)`
-
-	_, err := r.pool.Exec(ctx, query,
-		snapshot.Time,
-		snapshot.ValidatorIndex,
-		snapshot.Balance,
-		snapshot.EffectiveBalance,
-		snapshot.AttestationEffectiveness,
-		snapshot.AttestationInclusionDelay,
-		snapshot.AttestationHeadVote,
-		snapshot.AttestationSourceVote,
-		snapshot.AttestationTargetVote,
-		snapshot.ProposalsScheduled,
-		snapshot.ProposalsExecuted,
-		snapshot.ProposalsMissed,
-		snapshot.SyncCommitteeParticipation,
-		snapshot.Slashed,
-		snapshot.IsOnline,
-		snapshot.ConsecutiveMissedAttestations,
-		snapshot.DailyIncome,
-		snapshot.APR,
-	)
-
+	_, err := r.pool.Exec(ctx, insertSnapshotQuery, snapshotValues(snapshot)...)
 	if err != nil {
 		return fmt.Errorf("failed to insert snapshot: %w", err)
 	}
@@ -63,6 +71,35 @@ func (r *SnapshotRepository) InsertSnapshot(ctx context.Context, snapshot *model
 	return nil
 }
 
+// ReplaceSnapshots atomically replaces a validator's snapshots in [from, to) with the given snapshot.
+// It returns the number of snapshots removed.
+func (r *SnapshotRepository) ReplaceSnapshots(ctx context.Context, snapshot *models.ValidatorSnapshot, from, to time.Time) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		DELETE FROM validator_snapshots
+		WHERE validator_index = $1 AND time >= $2 AND time < $3`,
+		snapshot.ValidatorIndex, from, to,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete snapshots: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, insertSnapshotQuery, snapshotValues(snapshot)...); err != nil {
+		return 0, fmt.Errorf("failed to insert snapshot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit snapshot replacement: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
 // BatchInsertSnapshots efficiently inserts multiple snapshots using COPY
 func (r *SnapshotRepository) BatchInsertSnapshots(ctx context.Context, snapshots []*models.ValidatorSnapshot) error {
 	if len(snapshots) == 0 {
@@ -71,27 +108,7 @@ func (r *SnapshotRepository) BatchInsertSnapshots(ctx context.Context, snapshots
 
 	// Use COPY for maximum performance with TimescaleDB
 	copyFrom := pgx.CopyFromSlice(len(snapshots), func(i int) ([]interface{}, error) {
-		s := snapshots[i]
-		return []interface{}{
-			s.Time,
-			s.ValidatorIndex,
-			s.Balance,
-			s.EffectiveBalance,
-			s.AttestationEffectiveness,
-			s.AttestationInclusionDelay,
-			s.AttestationHeadVote,
-			s.AttestationSourceVote,
-			s.AttestationTargetVote,
-			s.ProposalsScheduled,
-			s.ProposalsExecuted,
-			s.ProposalsMissed,
-			s.SyncCommitteeParticipation,
-			s.Slashed,
-			s.IsOnline,
-			s.ConsecutiveMissedAttestations,
-			s.DailyIncome,
-			s.APR,
-		}, nil
+		return snapshotValues(snapshots[i]), nil
 	})
 
 	_, err := r.pool.CopyFrom(
@@ -104,6 +121,7 @@ func (r *SnapshotRepository) BatchInsertSnapshots(ctx context.Context, snapshots
 			"proposals_scheduled", "proposals_executed", "proposals_missed",
 			"sync_committee_participation", "slashed", "is_online",
 			"consecutive_missed_attestations", "daily_income", "apr",
+			"network_percentile", "network_average",
 		},
 		copyFrom,
 	)
@@ -124,7 +142,8 @@ func (r *SnapshotRepository) GetLatestSnapshot(ctx context.Context, validatorInd
 			   attestation_head_vote, attestation_source_vote, attestation_target_vote,
 			   proposals_scheduled, proposals_executed, proposals_missed,
 			   sync_committee_participation, slashed, is_online,
-			   consecutive_missed_attestations, daily_income, apr
+			   consecutive_missed_attestations, daily_income, apr,
+			   network_percentile, network_average
 		FROM validator_snapshots
 		WHERE validator_index = $1
 		ORDER BY time DESC
@@ -149,6 +168,8 @@ func (r *SnapshotRepository) GetLatestSnapshot(ctx context.Context, validatorInd
 		&snapshot.ConsecutiveMissedAttestations,
 		&snapshot.DailyIncome,
 		&snapshot.APR,
+		&snapshot.NetworkPercentile,
+		&snapshot.NetworkAverage,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -170,7 +191,8 @@ func (r *SnapshotRepository) GetSnapshots(ctx context.Context, filter *models.Sn
 			   attestation_head_vote, attestation_source_vote, attestation_target_vote,
 			   proposals_scheduled, proposals_executed, proposals_missed,
 			   sync_committee_participation, slashed, is_online,
-			   consecutive_missed_attestations, daily_income, apr
+			   consecutive_missed_attestations, daily_income, apr,
+			   network_percentile, network_average
 		FROM validator_snapshots
 		WHERE validator_index = $1`)
 
@@ -231,6 +253,8 @@ func (r *SnapshotRepository) GetSnapshots(ctx context.Context, filter *models.Sn
 			&snapshot.ConsecutiveMissedAttestations,
 			&snapshot.DailyIncome,
 			&snapshot.APR,
+			&snapshot.NetworkPercentile,
+			&snapshot.NetworkAverage,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan snapshot: %w", err)
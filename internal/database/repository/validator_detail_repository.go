@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -42,7 +43,14 @@ type ValidatorDetails struct {
 	ConsecutiveMissedAttestations *int     `json:"consecutive_missed_attestations"`
 	DailyIncome                  *string   `json:"daily_income"`
 	APR                          *float64  `json:"apr"`
+	NetworkPercentile            *float64  `json:"network_percentile"`
+	NetworkAverage               *float64  `json:"network_average"`
 	LastUpdate                   *time.Time `json:"last_update"`
+
+	// Snapshot gaps detected in the last 7 days
+	MissingSnapshotEpochs        int       `json:"missing_snapshot_epochs"`
+	RepairedSnapshotEpochs       int       `json:"repaired_snapshot_epochs"`
+	IrreparableSnapshotEpochs    int       `json:"irreparable_snapshot_epochs"`
 }
 
 // EffectivenessPoint represents a point in the effectiveness timeline
@@ -107,7 +115,12 @@ func (r *ValidatorDetailRepository) GetValidatorDetails(ctx context.Context, val
 			vs.consecutive_missed_attestations,
 			vs.daily_income,
 			vs.apr,
-			vs.time as last_update
+			vs.network_percentile,
+			vs.network_average,
+			vs.time as last_update,
+			sg.missing,
+			sg.repaired,
+			sg.irreparable
 		FROM validators v
 		LEFT JOIN LATERAL (
 			SELECT *
@@ -116,6 +129,15 @@ func (r *ValidatorDetailRepository) GetValidatorDetails(ctx context.Context, val
 			ORDER BY time DESC
 			LIMIT 1
 		) vs ON true
+		LEFT JOIN LATERAL (
+			SELECT
+				COUNT(*) FILTER (WHERE status = 'missing') as missing,
+				COUNT(*) FILTER (WHERE status = 'repaired') as repaired,
+				COUNT(*) FILTER (WHERE status = 'irreparable') as irreparable
+			FROM snapshot_gaps
+			WHERE validator_index = v.validator_index
+			  AND detected_at > NOW() - INTERVAL '7 days'
+		) sg ON true
 		WHERE v.validator_index = $1
 	`
 
@@ -140,7 +162,12 @@ func (r *ValidatorDetailRepository) GetValidatorDetails(ctx context.Context, val
 		&details.ConsecutiveMissedAttestations,
 		&details.DailyIncome,
 		&details.APR,
+		&details.NetworkPercentile,
+		&details.NetworkAverage,
 		&details.LastUpdate,
+		&details.MissingSnapshotEpochs,
+		&details.RepairedSnapshotEpochs,
+		&details.IrreparableSnapshotEpochs,
 	)
 
 	if err != nil {
@@ -269,8 +296,16 @@ func (r *ValidatorDetailRepository) GetRecentAlerts(ctx context.Context, validat
 	return alerts, nil
 }
 
-// GetValidatorTimeline returns key lifecycle events from snapshots
-// Note: Since there's no validator_events table, we'll create a timeline from snapshots
+// Range and size of the validator timeline; they match the snapshot query below
+const (
+	timelineWindow = 30 * 24 * time.Hour
+	timelineLimit  = 50
+)
+
+// GetValidatorTimeline returns key events from snapshots, analysed proposal misses, consolidation
+// requests and the validator's lifecycle status transitions. Status transitions are kept however
+// long ago they happened, since they mark when the validator became active, exiting or
+// withdrawable.
 func (r *ValidatorDetailRepository) GetValidatorTimeline(ctx context.Context, validatorIndex int64) ([]TimelineEvent, error) {
 	query := `
 		SELECT
@@ -318,5 +353,62 @@ func (r *ValidatorDetailRepository) GetValidatorTimeline(ctx context.Context, va
 		return nil, fmt.Errorf("error iterating timeline rows: %w", err)
 	}
 
-	return events, nil
+	from, to := time.Now().Add(-timelineWindow), time.Now()
+	misses, err := NewProposalMissRepository(r.pool).GetMisses(ctx, validatorIndex, from, to)
+	if err != nil {
+		return nil, err
+	}
+	consolidations, err := NewConsolidationRepository(r.pool).GetForValidator(ctx, validatorIndex, from, to)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := NewValidatorStatusRepository(r.pool).GetTransitions(ctx, validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	if len(misses) == 0 && len(consolidations) == 0 && len(transitions) == 0 {
+		return events, nil
+	}
+
+	// Analysed misses carry the slot and verdict, so they replace the snapshot-derived events
+	merged := make([]TimelineEvent, 0, len(events)+len(misses)+len(consolidations)+len(transitions))
+	for _, e := range events {
+		if len(misses) == 0 || e.Type != "missed_proposal" {
+			merged = append(merged, e)
+		}
+	}
+	for _, m := range misses {
+		epoch, slot := m.Epoch, m.Slot
+		merged = append(merged, TimelineEvent{
+			Type:        "missed_proposal",
+			Epoch:       &epoch,
+			Slot:        &slot,
+			Description: m.Summary(),
+			Timestamp:   m.Time,
+		})
+	}
+	for _, c := range consolidations {
+		slot := c.Slot
+		merged = append(merged, TimelineEvent{
+			Type:        "consolidation_requested",
+			Slot:        &slot,
+			Description: c.Summary(),
+			Timestamp:   c.Time,
+		})
+	}
+	for _, t := range transitions {
+		epoch := t.Epoch
+		merged = append(merged, TimelineEvent{
+			Type:        string(t.Status),
+			Epoch:       &epoch,
+			Description: t.Summary(),
+			Timestamp:   t.Time,
+		})
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Timestamp.After(merged[j].Timestamp) })
+	if len(merged) > timelineLimit {
+		merged = merged[:timelineLimit]
+	}
+
+	return merged, nil
 }
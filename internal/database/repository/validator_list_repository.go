@@ -11,18 +11,20 @@ import (
 )
 
 type ValidatorListFilter struct {
-	Search    string // Search by validator index or pubkey prefix
-	Status    string // Filter by status (active, exited, slashed, etc.)
-	SortBy    string // Sort field (effectiveness, balance, index)
-	SortOrder string // asc or desc
-	Limit     int    // Page size (default 20)
-	Offset    int    // For pagination
+	Search      string // Search by validator index or pubkey prefix
+	Status      string // Filter by status (active, exited, slashed, etc.)
+	Credentials string // Filter by withdrawal credential type (0x00, 0x01, 0x02)
+	SortBy      string // Sort field (effectiveness, balance, index)
+	SortOrder   string // asc or desc
+	Limit       int    // Page size (default 20)
+	Offset      int    // For pagination
 }
 
 type ValidatorListItem struct {
 	Index                    uint64    `json:"index"`
 	Pubkey                   string    `json:"pubkey"`
 	Status                   string    `json:"status"`
+	CredentialType           string    `json:"credential_type"`
 	Balance                  uint64    `json:"balance"`
 	EffectiveBalance         uint64    `json:"effective_balance"`
 	AttestationEffectiveness float64   `json:"attestation_effectiveness"`
@@ -137,6 +139,7 @@ func (r *ValidatorListRepository) buildListQuery(filter ValidatorListFilter) (st
 			v.validator_index AS index,
 			v.pubkey,
 			v.status,
+			COALESCE(LOWER(LEFT(v.withdrawal_credentials, 4)), '') AS credential_type,
 			s.balance,
 			s.effective_balance,
 			s.attestation_effectiveness,
@@ -169,6 +172,12 @@ func (r *ValidatorListRepository) buildListQuery(filter ValidatorListFilter) (st
 		argIdx++
 	}
 
+	if filter.Credentials != "" {
+		query += fmt.Sprintf(` AND LOWER(LEFT(v.withdrawal_credentials, 4)) = $%d`, argIdx)
+		args = append(args, filter.Credentials)
+		argIdx++
+	}
+
 	// Order by to get latest snapshot per validator
 	query += ` ORDER BY v.validator_index, s.created_at DESC`
 
@@ -210,6 +219,12 @@ func (r *ValidatorListRepository) buildCountQuery(filter ValidatorListFilter) (s
 		argIdx++
 	}
 
+	if filter.Credentials != "" {
+		query += fmt.Sprintf(` AND LOWER(LEFT(v.withdrawal_credentials, 4)) = $%d`, argIdx)
+		args = append(args, filter.Credentials)
+		argIdx++
+	}
+
 	return query, args
 }
 
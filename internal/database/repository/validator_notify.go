@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ValidatorChangesChannel is the Postgres NOTIFY channel used for validator set changes
+const ValidatorChangesChannel = "validator_changes"
+
+// ValidatorChangeOp identifies the kind of change made to a validator row
+type ValidatorChangeOp string
+
+const (
+	ValidatorChangeInsert ValidatorChangeOp = "insert"
+	ValidatorChangeUpdate ValidatorChangeOp = "update"
+	ValidatorChangeDelete ValidatorChangeOp = "delete"
+)
+
+// ValidatorChangeEvent is the payload sent on ValidatorChangesChannel
+type ValidatorChangeEvent struct {
+	Op             ValidatorChangeOp `json:"op"`
+	ValidatorIndex int64             `json:"validator_index"`
+	Monitored      bool              `json:"monitored"`
+}
+
+// ParseValidatorChangeEvent decodes a NOTIFY payload into a ValidatorChangeEvent
+func ParseValidatorChangeEvent(payload string) (*ValidatorChangeEvent, error) {
+	var event ValidatorChangeEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil, fmt.Errorf("failed to decode validator change payload: %w", err)
+	}
+
+	switch event.Op {
+	case ValidatorChangeInsert, ValidatorChangeUpdate, ValidatorChangeDelete:
+	default:
+		return nil, fmt.Errorf("unknown validator change op: %q", event.Op)
+	}
+
+	return &event, nil
+}
+
+// notifyValidatorChange publishes a change event for listeners such as the collector.
+// Notification failures are logged rather than returned: the row change itself succeeded
+// and listeners resynchronise on reconnect.
+func (r *ValidatorRepository) notifyValidatorChange(ctx context.Context, op ValidatorChangeOp, validatorIndex int64, monitored bool) {
+	payload, err := json.Marshal(ValidatorChangeEvent{
+		Op:             op,
+		ValidatorIndex: validatorIndex,
+		Monitored:      monitored,
+	})
+	if err != nil {
+		return
+	}
+
+	if _, err := r.pool.Exec(ctx, "SELECT pg_notify($1, $2)", ValidatorChangesChannel, string(payload)); err != nil {
+		logger.FromContext(ctx).Warn().
+			Err(err).
+			Int64("validator_index", validatorIndex).
+			Str("op", string(op)).
+			Msg("Failed to publish validator change notification")
+	}
+}
+
+// ValidatorChangeListener listens for validator change notifications on a dedicated connection
+type ValidatorChangeListener struct {
+	pool           *pgxpool.Pool
+	reconnectDelay time.Duration
+}
+
+// NewValidatorChangeListener creates a new validator change listener
+func NewValidatorChangeListener(pool *pgxpool.Pool) *ValidatorChangeListener {
+	return &ValidatorChangeListener{
+		pool:           pool,
+		reconnectDelay: 5 * time.Second,
+	}
+}
+
+// Listen blocks until ctx is cancelled, invoking onEvent for every change notification.
+// onResync is invoked after every (re)connection so callers can reload state that may
+// have changed while no listener was attached.
+func (l *ValidatorChangeListener) Listen(ctx context.Context, onEvent func(*ValidatorChangeEvent), onResync func()) error {
+	for {
+		err := l.listenOnce(ctx, onEvent, onResync)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		logger.FromContext(ctx).Warn().
+			Err(err).
+			Dur("reconnect_delay", l.reconnectDelay).
+			Msg("Validator change listener disconnected, reconnecting")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(l.reconnectDelay):
+		}
+	}
+}
+
+// listenOnce acquires a connection, subscribes and processes notifications until an error occurs
+func (l *ValidatorChangeListener) listenOnce(ctx context.Context, onEvent func(*ValidatorChangeEvent), onResync func()) error {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire listener connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+ValidatorChangesChannel); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", ValidatorChangesChannel, err)
+	}
+
+	if onResync != nil {
+		onResync()
+	}
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed waiting for notification: %w", err)
+		}
+
+		event, err := ParseValidatorChangeEvent(notification.Payload)
+		if err != nil {
+			logger.FromContext(ctx).Warn().
+				Err(err).
+				Str("payload", notification.Payload).
+				Msg("Ignoring malformed validator change notification")
+			continue
+		}
+
+		onEvent(event)
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValidatorChangeEvent(t *testing.T) {
+	event, err := ParseValidatorChangeEvent(`{"op":"update","validator_index":42,"monitored":false}`)
+	require.NoError(t, err)
+	assert.Equal(t, ValidatorChangeUpdate, event.Op)
+	assert.Equal(t, int64(42), event.ValidatorIndex)
+	assert.False(t, event.Monitored)
+}
+
+func TestParseValidatorChangeEvent_Invalid(t *testing.T) {
+	_, err := ParseValidatorChangeEvent(`not json`)
+	assert.Error(t, err)
+
+	_, err = ParseValidatorChangeEvent(`{"op":"truncate","validator_index":1}`)
+	assert.Error(t, err)
+}
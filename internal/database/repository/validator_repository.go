@@ -52,6 +52,8 @@ func (r *ValidatorRepository) CreateValidator(ctx context.Context, validator *mo
 		return fmt.Errorf("failed to create validator: %w", err)
 	}
 
+	r.notifyValidatorChange(ctx, ValidatorChangeInsert, validator.ValidatorIndex, validator.Monitored)
+
 	return nil
 }
 
@@ -97,16 +99,65 @@ func (r *ValidatorRepository) BatchCreateValidators(ctx context.Context, validat
 		return fmt.Errorf("failed to batch create validators: %w", err)
 	}
 
+	for _, v := range validators {
+		r.notifyValidatorChange(ctx, ValidatorChangeInsert, v.ValidatorIndex, v.Monitored)
+	}
+
 	return nil
 }
 
+// EnrollValidators adds validators to monitoring under a tag, creating any that are not yet known.
+// Validators already carrying the tag are left alone, so one removed from monitoring after being
+// enrolled is not enrolled again. It returns the number of validators enrolled.
+func (r *ValidatorRepository) EnrollValidators(ctx context.Context, tag string, validators []*models.Validator) (int, error) {
+	if len(validators) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		INSERT INTO validators (validator_index, pubkey, withdrawal_credentials, tags, monitored)
+		VALUES ($1, $2, $3, ARRAY[$4::text], true)
+		ON CONFLICT (validator_index) DO UPDATE SET
+			monitored = true,
+			tags = array_append(validators.tags, $4::text)
+		WHERE NOT ($4::text = ANY(validators.tags))`
+
+	batch := &pgx.Batch{}
+	for _, v := range validators {
+		batch.Queue(query, v.ValidatorIndex, v.Pubkey, v.WithdrawalCredentials, tag)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	defer results.Close()
+
+	var enrolled []int64
+	for _, v := range validators {
+		cmd, err := results.Exec()
+		if err != nil {
+			return 0, fmt.Errorf("failed to enroll validator %d: %w", v.ValidatorIndex, err)
+		}
+		if cmd.RowsAffected() == 1 {
+			enrolled = append(enrolled, v.ValidatorIndex)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return 0, fmt.Errorf("failed to enroll validators: %w", err)
+	}
+
+	for _, index := range enrolled {
+		r.notifyValidatorChange(ctx, ValidatorChangeUpdate, index, true)
+	}
+
+	return len(enrolled), nil
+}
+
 // GetValidatorByIndex retrieves a validator by index
 func (r *ValidatorRepository) GetValidatorByIndex(ctx context.Context, index int64) (*models.Validator, error) {
 	validator := &models.Validator{}
 	query := `
 		SELECT id, validator_index, pubkey, withdrawal_credentials, effective_balance,
 			   slashed, activation_epoch, activation_eligibility_epoch, exit_epoch,
-			   withdrawable_epoch, name, tags, monitored, created_at, updated_at
+			   withdrawable_epoch, status, name, tags, monitored, created_at, updated_at
 		FROM validators
 		WHERE validator_index = $1`
 
@@ -121,6 +172,7 @@ func (r *ValidatorRepository) GetValidatorByIndex(ctx context.Context, index int
 		&validator.ActivationEligibilityEpoch,
 		&validator.ExitEpoch,
 		&validator.WithdrawableEpoch,
+		&validator.Status,
 		&validator.Name,
 		&validator.Tags,
 		&validator.Monitored,
@@ -144,7 +196,7 @@ func (r *ValidatorRepository) ListValidators(ctx context.Context, filter *models
 	query.WriteString(`
 		SELECT id, validator_index, pubkey, withdrawal_credentials, effective_balance,
 			   slashed, activation_epoch, activation_eligibility_epoch, exit_epoch,
-			   withdrawable_epoch, name, tags, monitored, created_at, updated_at
+			   withdrawable_epoch, status, name, tags, monitored, created_at, updated_at
 		FROM validators
 		WHERE 1=1`)
 
@@ -216,6 +268,7 @@ func (r *ValidatorRepository) ListValidators(ctx context.Context, filter *models
 			&validator.ActivationEligibilityEpoch,
 			&validator.ExitEpoch,
 			&validator.WithdrawableEpoch,
+			&validator.Status,
 			&validator.Name,
 			&validator.Tags,
 			&validator.Monitored,
@@ -252,6 +305,8 @@ func (r *ValidatorRepository) UpdateValidator(ctx context.Context, validator *mo
 		return fmt.Errorf("failed to update validator: %w", err)
 	}
 
+	r.notifyValidatorChange(ctx, ValidatorChangeUpdate, validator.ValidatorIndex, validator.Monitored)
+
 	return nil
 }
 
@@ -259,11 +314,15 @@ func (r *ValidatorRepository) UpdateValidator(ctx context.Context, validator *mo
 func (r *ValidatorRepository) DeleteValidator(ctx context.Context, validatorIndex int64) error {
 	query := `DELETE FROM validators WHERE validator_index = $1`
 
-	_, err := r.pool.Exec(ctx, query, validatorIndex)
+	tag, err := r.pool.Exec(ctx, query, validatorIndex)
 	if err != nil {
 		return fmt.Errorf("failed to delete validator: %w", err)
 	}
 
+	if tag.RowsAffected() > 0 {
+		r.notifyValidatorChange(ctx, ValidatorChangeDelete, validatorIndex, false)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ValidatorStatusRepository stores the lifecycle status of validators and the history of their
+// transitions
+type ValidatorStatusRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewValidatorStatusRepository creates a new validator status repository
+func NewValidatorStatusRepository(pool *pgxpool.Pool) *ValidatorStatusRepository {
+	return &ValidatorStatusRepository{
+		pool: pool,
+	}
+}
+
+// RecordStatus stores a validator's status, slashing and lifecycle epochs together with the
+// transitions that led to its status. It returns the transitions that were not already on record.
+func (r *ValidatorStatusRepository) RecordStatus(ctx context.Context, v *models.Validator, transitions []*models.StatusTransition) ([]*models.StatusTransition, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE validators
+		SET status = $2, slashed = $3, activation_eligibility_epoch = $4, activation_epoch = $5,
+			exit_epoch = $6, withdrawable_epoch = $7
+		WHERE validator_index = $1`,
+		v.ValidatorIndex, v.Status, v.Slashed, v.ActivationEligibilityEpoch, v.ActivationEpoch,
+		v.ExitEpoch, v.WithdrawableEpoch,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update validator status: %w", err)
+	}
+
+	var recorded []*models.StatusTransition
+	for _, t := range transitions {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO validator_status_transitions (validator_index, status, previous_status, epoch, time)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (validator_index, status) DO NOTHING
+			RETURNING observed_at`,
+			t.ValidatorIndex, t.Status, t.PreviousStatus, t.Epoch, t.Time,
+		).Scan(&t.ObservedAt)
+		if err == pgx.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to record status transition: %w", err)
+		}
+		recorded = append(recorded, t)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return recorded, nil
+}
+
+// GetTransitions returns a validator's status transitions, oldest first
+func (r *ValidatorStatusRepository) GetTransitions(ctx context.Context, validatorIndex int64) ([]*models.StatusTransition, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT validator_index, status, previous_status, epoch, time, observed_at
+		FROM validator_status_transitions
+		WHERE validator_index = $1
+		ORDER BY epoch, observed_at`,
+		validatorIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status transitions: %w", err)
+	}
+	defer rows.Close()
+
+	var transitions []*models.StatusTransition
+	for rows.Next() {
+		t := &models.StatusTransition{}
+		if err := rows.Scan(&t.ValidatorIndex, &t.Status, &t.PreviousStatus, &t.Epoch, &t.Time, &t.ObservedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan status transition: %w", err)
+		}
+		transitions = append(transitions, t)
+	}
+
+	return transitions, rows.Err()
+}
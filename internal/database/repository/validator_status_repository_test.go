@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/testutil"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStatusRepository_RecordAndGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	pool := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(context.Background(), pool)
+
+	ctx := context.Background()
+	validatorRepo := NewValidatorRepository(pool)
+	require.NoError(t, validatorRepo.CreateValidator(ctx, testutil.ValidatorFixture(870)))
+
+	genesis := time.Unix(types.MainnetGenesisTime, 0).UTC()
+	repo := NewValidatorStatusRepository(pool)
+	current := time.Now()
+	observed := types.EpochAtTime(genesis, current)
+
+	v, err := validatorRepo.GetValidatorByIndex(ctx, 870)
+	require.NoError(t, err)
+	require.Nil(t, v.Status, "no status before the first observation")
+
+	eligibility, activation := observed-20, observed-10
+	v.ActivationEligibilityEpoch, v.ActivationEpoch = &eligibility, &activation
+	active := models.LifecycleActiveOngoing
+	transitions := models.PlanStatusTransitions(v, v.Status, active, observed, genesis)
+	v.Status = &active
+	recorded, err := repo.RecordStatus(ctx, v, transitions)
+	require.NoError(t, err)
+	require.Len(t, recorded, 2)
+	assert.False(t, recorded[0].ObservedAt.IsZero())
+
+	recorded, err = repo.RecordStatus(ctx, v, transitions)
+	require.NoError(t, err)
+	assert.Empty(t, recorded, "transitions are recorded once")
+
+	exiting := models.LifecycleActiveExiting
+	transitions = models.PlanStatusTransitions(v, v.Status, exiting, observed, genesis)
+	v.Status = &exiting
+	_, err = repo.RecordStatus(ctx, v, transitions)
+	require.NoError(t, err)
+
+	stored, err := validatorRepo.GetValidatorByIndex(ctx, 870)
+	require.NoError(t, err)
+	require.NotNil(t, stored.Status)
+	assert.Equal(t, exiting, *stored.Status)
+	require.NotNil(t, stored.ActivationEpoch)
+	assert.Equal(t, activation, *stored.ActivationEpoch)
+
+	history, err := repo.GetTransitions(ctx, 870)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, models.LifecyclePendingQueued, history[0].Status)
+	assert.Nil(t, history[0].PreviousStatus)
+	assert.Equal(t, models.LifecycleActiveOngoing, history[1].Status)
+	assert.Equal(t, activation, history[1].Epoch)
+	assert.Equal(t, exiting, history[2].Status)
+	require.NotNil(t, history[2].PreviousStatus)
+	assert.Equal(t, active, *history[2].PreviousStatus)
+
+	timeline, err := NewValidatorDetailRepository(pool).GetValidatorTimeline(ctx, 870)
+	require.NoError(t, err)
+	require.Len(t, timeline, 3)
+	assert.Equal(t, string(exiting), timeline[0].Type, "newest first")
+	require.NotNil(t, timeline[0].Epoch)
+	assert.Equal(t, observed, *timeline[0].Epoch)
+	assert.Equal(t, fmt.Sprintf("Activated at epoch %d", activation), timeline[1].Description)
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/birddigital/eth-validator-monitor/internal/api/respond"
 	"github.com/birddigital/eth-validator-monitor/internal/auth"
 	"github.com/birddigital/eth-validator-monitor/internal/storage"
 	"github.com/go-chi/chi/v5"
@@ -59,19 +60,19 @@ func (h *APIKeyHandlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 	// Get authenticated user ID from context (set by auth middleware)
 	userID, ok := auth.GetUserIDFromContext(r.Context())
 	if !ok {
-		respondError(w, "Unauthorized", http.StatusUnauthorized)
+		respond.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
 	var req CreateAPIKeyRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondValidationError(w, "Invalid request body", map[string]string{"body": "invalid JSON"}, http.StatusBadRequest)
+		respond.ValidationError(w, "Invalid request body", map[string]string{"body": "invalid JSON"}, http.StatusBadRequest)
 		return
 	}
 
 	// Validate name
 	if req.Name == "" {
-		respondValidationError(w, "Validation failed", map[string]string{
+		respond.ValidationError(w, "Validation failed", map[string]string{
 			"name": "API key name is required",
 		}, http.StatusBadRequest)
 		return
@@ -81,7 +82,7 @@ func (h *APIKeyHandlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 	var expiresAt *time.Time
 	if req.ExpiresIn != nil {
 		if *req.ExpiresIn <= 0 {
-			respondValidationError(w, "Validation failed", map[string]string{
+			respond.ValidationError(w, "Validation failed", map[string]string{
 				"expiresIn": "must be a positive number of days",
 			}, http.StatusBadRequest)
 			return
@@ -93,7 +94,7 @@ func (h *APIKeyHandlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 	// Create API key
 	apiKey, plainKey, err := h.apiKeyRepo.CreateAPIKey(r.Context(), userID, req.Name, expiresAt)
 	if err != nil {
-		respondError(w, "Failed to create API key", http.StatusInternalServerError)
+		respond.Error(w, "Failed to create API key", http.StatusInternalServerError)
 		return
 	}
 
@@ -107,7 +108,7 @@ func (h *APIKeyHandlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 		ExpiresAt: apiKey.ExpiresAt,
 	}
 
-	respondJSON(w, response, http.StatusCreated)
+	respond.JSON(w, response, http.StatusCreated)
 }
 
 // ListAPIKeys handles GET /api/keys
@@ -116,14 +117,14 @@ func (h *APIKeyHandlers) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
 	// Get authenticated user ID from context
 	userID, ok := auth.GetUserIDFromContext(r.Context())
 	if !ok {
-		respondError(w, "Unauthorized", http.StatusUnauthorized)
+		respond.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
 	// Retrieve API keys
 	apiKeys, err := h.apiKeyRepo.ListAPIKeysByUser(r.Context(), userID)
 	if err != nil {
-		respondError(w, "Failed to retrieve API keys", http.StatusInternalServerError)
+		respond.Error(w, "Failed to retrieve API keys", http.StatusInternalServerError)
 		return
 	}
 
@@ -144,7 +145,7 @@ func (h *APIKeyHandlers) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	respondJSON(w, response, http.StatusOK)
+	respond.JSON(w, response, http.StatusOK)
 }
 
 // RevokeAPIKey handles DELETE /api/keys/{id}
@@ -153,7 +154,7 @@ func (h *APIKeyHandlers) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
 	// Get authenticated user ID from context
 	userID, ok := auth.GetUserIDFromContext(r.Context())
 	if !ok {
-		respondError(w, "Unauthorized", http.StatusUnauthorized)
+		respond.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
@@ -161,7 +162,7 @@ func (h *APIKeyHandlers) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
 	keyIDStr := chi.URLParam(r, "id")
 	keyID, err := strconv.Atoi(keyIDStr)
 	if err != nil {
-		respondError(w, "Invalid API key ID", http.StatusBadRequest)
+		respond.Error(w, "Invalid API key ID", http.StatusBadRequest)
 		return
 	}
 
@@ -169,40 +170,13 @@ func (h *APIKeyHandlers) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
 	err = h.apiKeyRepo.RevokeAPIKey(r.Context(), keyID, userID)
 	if err != nil {
 		if err == storage.ErrAPIKeyNotFound {
-			respondError(w, "API key not found or already revoked", http.StatusNotFound)
+			respond.Error(w, "API key not found or already revoked", http.StatusNotFound)
 			return
 		}
-		respondError(w, "Failed to revoke API key", http.StatusInternalServerError)
+		respond.Error(w, "Failed to revoke API key", http.StatusInternalServerError)
 		return
 	}
 
 	// Return success with no content
 	w.WriteHeader(http.StatusNoContent)
 }
-
-// respondJSON sends a JSON response with the given status code
-func respondJSON(w http.ResponseWriter, data interface{}, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		// Log error but can't change headers at this point
-		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-	}
-}
-
-// respondError sends a standard error response
-func respondError(w http.ResponseWriter, message string, statusCode int) {
-	respondJSON(w, ErrorResponse{
-		Error:   http.StatusText(statusCode),
-		Message: message,
-	}, statusCode)
-}
-
-// respondValidationError sends a validation error with field-level details
-func respondValidationError(w http.ResponseWriter, message string, fields map[string]string, statusCode int) {
-	respondJSON(w, ErrorResponse{
-		Error:   "Validation Error",
-		Message: message,
-		Fields:  fields,
-	}, statusCode)
-}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/birddigital/eth-validator-monitor/internal/api/respond"
 	"github.com/birddigital/eth-validator-monitor/internal/auth"
 )
 
@@ -44,18 +45,11 @@ type UserResponse struct {
 	Roles    []string `json:"roles"`
 }
 
-// ErrorResponse is the standard error response with optional field-level errors
-type ErrorResponse struct {
-	Error   string            `json:"error"`
-	Message string            `json:"message,omitempty"`
-	Fields  map[string]string `json:"fields,omitempty"` // Field-specific errors
-}
-
 // Register handles POST /api/auth/register
 func (h *AuthHandlers) Register(w http.ResponseWriter, r *http.Request) {
 	var req RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondValidationError(w, "Invalid request body", map[string]string{"body": "invalid JSON"}, http.StatusBadRequest)
+		respond.ValidationError(w, "Invalid request body", map[string]string{"body": "invalid JSON"}, http.StatusBadRequest)
 		return
 	}
 
@@ -64,39 +58,39 @@ func (h *AuthHandlers) Register(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		// Handle validation errors with field-level details
 		if verr, ok := err.(*auth.ValidationError); ok {
-			respondValidationError(w, "Validation failed", verr.Fields, http.StatusBadRequest)
+			respond.ValidationError(w, "Validation failed", verr.Fields, http.StatusBadRequest)
 			return
 		}
 
 		// Handle duplicate user error
 		if err == auth.ErrUserAlreadyExists {
-			respondValidationError(w, "User already exists", map[string]string{
+			respond.ValidationError(w, "User already exists", map[string]string{
 				"username": "username or email already exists",
 			}, http.StatusConflict)
 			return
 		}
 
 		// Handle other errors
-		respondError(w, "Registration failed", http.StatusInternalServerError)
+		respond.Error(w, "Registration failed", http.StatusInternalServerError)
 		return
 	}
 
 	// Create session for new user
 	session, err := h.sessionStore.Get(r)
 	if err != nil {
-		respondError(w, "Session error", http.StatusInternalServerError)
+		respond.Error(w, "Session error", http.StatusInternalServerError)
 		return
 	}
 
 	h.sessionStore.SetUserSession(session, user.ID, user.Username)
 
 	if err := h.sessionStore.Save(r, w, session); err != nil {
-		respondError(w, "Failed to save session", http.StatusInternalServerError)
+		respond.Error(w, "Failed to save session", http.StatusInternalServerError)
 		return
 	}
 
 	// Return user info
-	respondJSON(w, UserResponse{
+	respond.JSON(w, UserResponse{
 		ID:       user.ID.String(),
 		Username: user.Username,
 		Email:    user.Email,
@@ -108,7 +102,7 @@ func (h *AuthHandlers) Register(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondValidationError(w, "Invalid request body", map[string]string{"body": "invalid JSON"}, http.StatusBadRequest)
+		respond.ValidationError(w, "Invalid request body", map[string]string{"body": "invalid JSON"}, http.StatusBadRequest)
 		return
 	}
 
@@ -117,39 +111,39 @@ func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		// Handle validation errors
 		if verr, ok := err.(*auth.ValidationError); ok {
-			respondValidationError(w, "Validation failed", verr.Fields, http.StatusBadRequest)
+			respond.ValidationError(w, "Validation failed", verr.Fields, http.StatusBadRequest)
 			return
 		}
 
 		// Handle invalid credentials (don't reveal if user exists)
 		if err == auth.ErrInvalidCredentials {
-			respondValidationError(w, "Invalid credentials", map[string]string{
+			respond.ValidationError(w, "Invalid credentials", map[string]string{
 				"credentials": "invalid username or password",
 			}, http.StatusUnauthorized)
 			return
 		}
 
 		// Handle other errors
-		respondError(w, "Login failed", http.StatusInternalServerError)
+		respond.Error(w, "Login failed", http.StatusInternalServerError)
 		return
 	}
 
 	// Create session
 	session, err := h.sessionStore.Get(r)
 	if err != nil {
-		respondError(w, "Session error", http.StatusInternalServerError)
+		respond.Error(w, "Session error", http.StatusInternalServerError)
 		return
 	}
 
 	h.sessionStore.SetUserSession(session, user.ID, user.Username)
 
 	if err := h.sessionStore.Save(r, w, session); err != nil {
-		respondError(w, "Failed to save session", http.StatusInternalServerError)
+		respond.Error(w, "Failed to save session", http.StatusInternalServerError)
 		return
 	}
 
 	// Return user info
-	respondJSON(w, UserResponse{
+	respond.JSON(w, UserResponse{
 		ID:       user.ID.String(),
 		Username: user.Username,
 		Email:    user.Email,
@@ -161,59 +155,39 @@ func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandlers) Logout(w http.ResponseWriter, r *http.Request) {
 	session, err := h.sessionStore.Get(r)
 	if err != nil {
-		respondError(w, "Session error", http.StatusInternalServerError)
+		respond.Error(w, "Session error", http.StatusInternalServerError)
 		return
 	}
 
 	h.sessionStore.Destroy(session)
 
 	if err := h.sessionStore.Save(r, w, session); err != nil {
-		respondError(w, "Failed to clear session", http.StatusInternalServerError)
+		respond.Error(w, "Failed to clear session", http.StatusInternalServerError)
 		return
 	}
 
-	respondJSON(w, map[string]string{"message": "Logged out successfully"}, http.StatusOK)
+	respond.JSON(w, map[string]string{"message": "Logged out successfully"}, http.StatusOK)
 }
 
 // Me handles GET /api/auth/me - returns current authenticated user
 func (h *AuthHandlers) Me(w http.ResponseWriter, r *http.Request) {
 	userID, ok := auth.GetSessionUserIDFromContext(r.Context())
 	if !ok {
-		respondError(w, "Not authenticated", http.StatusUnauthorized)
+		respond.Error(w, "Not authenticated", http.StatusUnauthorized)
 		return
 	}
 
 	// Fetch full user details
 	user, err := h.authService.GetUserByID(r.Context(), userID)
 	if err != nil {
-		respondError(w, "Failed to fetch user", http.StatusInternalServerError)
+		respond.Error(w, "Failed to fetch user", http.StatusInternalServerError)
 		return
 	}
 
-	respondJSON(w, UserResponse{
+	respond.JSON(w, UserResponse{
 		ID:       user.ID.String(),
 		Username: user.Username,
 		Email:    user.Email,
 		Roles:    user.Roles,
 	}, http.StatusOK)
 }
-
-// Helper functions for consistent JSON responses
-
-func respondJSON(w http.ResponseWriter, data interface{}, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(data)
-}
-
-func respondError(w http.ResponseWriter, message string, statusCode int) {
-	respondJSON(w, ErrorResponse{Error: message}, statusCode)
-}
-
-func respondValidationError(w http.ResponseWriter, message string, fields map[string]string, statusCode int) {
-	respondJSON(w, ErrorResponse{
-		Error:   http.StatusText(statusCode),
-		Message: message,
-		Fields:  fields,
-	}, statusCode)
-}
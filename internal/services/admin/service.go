@@ -0,0 +1,185 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/auth"
+	"github.com/birddigital/eth-validator-monitor/internal/collector"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// DefaultAuditLimit is the number of audit entries returned when no limit is given
+	DefaultAuditLimit = 50
+
+	// MaxAuditLimit caps the number of audit entries returned per request
+	MaxAuditLimit = 500
+
+	// drainTimeout bounds how long a drain request waits for the worker pool
+	drainTimeout = 2 * time.Minute
+)
+
+var adminActions = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "admin_actions_total",
+		Help: "Total admin control plane actions by action and result (success, failure)",
+	},
+	[]string{"action", "result"},
+)
+
+// Collector is the part of the validator collector exposed to operators
+type Collector interface {
+	Pause() bool
+	Resume() bool
+	DrainPool(ctx context.Context) error
+	Recollect(ctx context.Context, validatorIndex, fromEpoch, toEpoch int64) (*collector.RecollectResult, error)
+	Stats() collector.CollectorStats
+}
+
+// AuditLog stores the admin action audit trail
+type AuditLog interface {
+	RecordAction(ctx context.Context, action *models.AdminAction) error
+	ListActions(ctx context.Context, limit, offset int) ([]*models.AdminAction, error)
+}
+
+// Service executes operator actions against the collector and records each one in the audit trail.
+// Callers are responsible for authorising the request.
+type Service struct {
+	collector Collector
+	audit     AuditLog
+}
+
+// NewService creates a new admin service
+func NewService(collector Collector, audit AuditLog) *Service {
+	return &Service{
+		collector: collector,
+		audit:     audit,
+	}
+}
+
+// Status returns live collector and worker pool statistics
+func (s *Service) Status() collector.CollectorStats {
+	return s.collector.Stats()
+}
+
+// Pause stops scheduled collection
+func (s *Service) Pause(ctx context.Context) collector.CollectorStats {
+	wasRunning := s.collector.Pause()
+	s.record(ctx, models.AdminActionPauseCollector, models.JSONB{"was_running": wasRunning}, nil)
+	return s.collector.Stats()
+}
+
+// Resume restarts scheduled collection
+func (s *Service) Resume(ctx context.Context) collector.CollectorStats {
+	wasPaused := s.collector.Resume()
+	s.record(ctx, models.AdminActionResumeCollector, models.JSONB{"was_paused": wasPaused}, nil)
+	return s.collector.Stats()
+}
+
+// DrainPool pauses collection and waits for queued work to be stored
+func (s *Service) DrainPool(ctx context.Context) (collector.CollectorStats, error) {
+	drainCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+	defer cancel()
+
+	err := s.collector.DrainPool(drainCtx)
+	s.record(ctx, models.AdminActionDrainPool, nil, err)
+	return s.collector.Stats(), err
+}
+
+// Recollect forces re-collection of a validator's snapshots for an epoch range
+func (s *Service) Recollect(ctx context.Context, validatorIndex, fromEpoch, toEpoch int64) (*collector.RecollectResult, error) {
+	result, err := s.collector.Recollect(ctx, validatorIndex, fromEpoch, toEpoch)
+
+	params := models.JSONB{
+		"validator_index": validatorIndex,
+		"from_epoch":      fromEpoch,
+		"to_epoch":        toEpoch,
+	}
+	if result != nil {
+		params["recollected"] = result.Recollected
+		params["unavailable"] = len(result.UnavailableEpochs)
+	}
+	s.record(ctx, models.AdminActionRecollect, params, err)
+
+	return result, err
+}
+
+// AuditLog returns recorded admin actions, most recent first
+func (s *Service) AuditLog(ctx context.Context, limit, offset int) ([]*models.AdminAction, error) {
+	if limit <= 0 {
+		limit = DefaultAuditLimit
+	}
+	if limit > MaxAuditLimit {
+		limit = MaxAuditLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.audit.ListActions(ctx, limit, offset)
+}
+
+// record writes an audit entry for an action. The action has already taken effect,
+// so a failure to record it is logged rather than returned.
+func (s *Service) record(ctx context.Context, action models.AdminActionType, params models.JSONB, actionErr error) {
+	entry := &models.AdminAction{
+		Actor:      actorFromContext(ctx),
+		AuthMethod: authMethodFromContext(ctx),
+		Action:     action,
+		Params:     params,
+		Success:    actionErr == nil,
+	}
+	if actionErr != nil {
+		msg := actionErr.Error()
+		entry.Error = &msg
+	}
+
+	result := "success"
+	if actionErr != nil {
+		result = "failure"
+	}
+	adminActions.WithLabelValues(string(action), result).Inc()
+
+	log := logger.FromContext(ctx)
+	log.Info().
+		Str("actor", entry.Actor).
+		Str("auth_method", entry.AuthMethod).
+		Str("action", string(action)).
+		Bool("success", entry.Success).
+		Msg("Admin action")
+
+	// Record even if the request was cancelled mid-action
+	auditCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := s.audit.RecordAction(auditCtx, entry); err != nil {
+		log.Error().
+			Err(err).
+			Str("actor", entry.Actor).
+			Str("action", string(action)).
+			Msg("Failed to record admin action in audit trail")
+	}
+}
+
+// actorFromContext identifies the operator by username, falling back to user ID
+func actorFromContext(ctx context.Context) string {
+	if username, ok := auth.GetUsernameFromContext(ctx); ok {
+		return username
+	}
+	if userID, ok := auth.GetUserIDFromContext(ctx); ok {
+		return userID.String()
+	}
+	return "unknown"
+}
+
+// authMethodFromContext returns how the operator authenticated
+func authMethodFromContext(ctx context.Context) string {
+	if method, ok := auth.GetAuthMethodFromContext(ctx); ok {
+		return method
+	}
+	return "unknown"
+}
@@ -0,0 +1,149 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/birddigital/eth-validator-monitor/internal/auth"
+	"github.com/birddigital/eth-validator-monitor/internal/collector"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCollector struct {
+	paused       bool
+	drainErr     error
+	recollectErr error
+}
+
+func (f *fakeCollector) Pause() bool {
+	was := f.paused
+	f.paused = true
+	return !was
+}
+
+func (f *fakeCollector) Resume() bool {
+	was := f.paused
+	f.paused = false
+	return was
+}
+
+func (f *fakeCollector) DrainPool(ctx context.Context) error {
+	f.paused = true
+	return f.drainErr
+}
+
+func (f *fakeCollector) Recollect(ctx context.Context, validatorIndex, fromEpoch, toEpoch int64) (*collector.RecollectResult, error) {
+	if f.recollectErr != nil {
+		return nil, f.recollectErr
+	}
+	return &collector.RecollectResult{
+		ValidatorIndex:    validatorIndex,
+		FromEpoch:         fromEpoch,
+		ToEpoch:           toEpoch,
+		Recollected:       int(toEpoch - fromEpoch + 1),
+		UnavailableEpochs: []int64{},
+	}, nil
+}
+
+func (f *fakeCollector) Stats() collector.CollectorStats {
+	return collector.CollectorStats{Paused: f.paused}
+}
+
+type fakeAuditLog struct {
+	actions   []*models.AdminAction
+	recordErr error
+	limit     int
+	offset    int
+}
+
+func (f *fakeAuditLog) RecordAction(ctx context.Context, action *models.AdminAction) error {
+	if f.recordErr != nil {
+		return f.recordErr
+	}
+	f.actions = append(f.actions, action)
+	return nil
+}
+
+func (f *fakeAuditLog) ListActions(ctx context.Context, limit, offset int) ([]*models.AdminAction, error) {
+	f.limit, f.offset = limit, offset
+	return f.actions, nil
+}
+
+func sessionContext(username string) context.Context {
+	ctx := context.WithValue(context.Background(), auth.SessionUserIDKey, uuid.New())
+	return context.WithValue(ctx, auth.SessionUsernameKey, username)
+}
+
+func TestService_PauseResumeAreAudited(t *testing.T) {
+	c := &fakeCollector{}
+	audit := &fakeAuditLog{}
+	svc := NewService(c, audit)
+	ctx := sessionContext("alice")
+
+	stats := svc.Pause(ctx)
+	assert.True(t, stats.Paused)
+
+	stats = svc.Resume(ctx)
+	assert.False(t, stats.Paused)
+
+	require.Len(t, audit.actions, 2)
+	assert.Equal(t, models.AdminActionPauseCollector, audit.actions[0].Action)
+	assert.Equal(t, "alice", audit.actions[0].Actor)
+	assert.Equal(t, auth.AuthMethodSession, audit.actions[0].AuthMethod)
+	assert.True(t, audit.actions[0].Success)
+	assert.Equal(t, true, audit.actions[0].Params["was_running"])
+
+	assert.Equal(t, models.AdminActionResumeCollector, audit.actions[1].Action)
+	assert.Equal(t, true, audit.actions[1].Params["was_paused"])
+}
+
+func TestService_FailedActionsAreAudited(t *testing.T) {
+	c := &fakeCollector{recollectErr: collector.ErrValidatorNotFound}
+	audit := &fakeAuditLog{}
+	svc := NewService(c, audit)
+
+	// API key requests carry only a user ID
+	userID := uuid.New()
+	ctx := context.WithValue(context.Background(), auth.APIKeyUserIDKey, userID)
+
+	_, err := svc.Recollect(ctx, 42, 100, 110)
+	assert.ErrorIs(t, err, collector.ErrValidatorNotFound)
+
+	require.Len(t, audit.actions, 1)
+	entry := audit.actions[0]
+	assert.Equal(t, models.AdminActionRecollect, entry.Action)
+	assert.Equal(t, userID.String(), entry.Actor)
+	assert.Equal(t, auth.AuthMethodAPIKey, entry.AuthMethod)
+	assert.False(t, entry.Success)
+	require.NotNil(t, entry.Error)
+	assert.Equal(t, int64(42), entry.Params["validator_index"])
+}
+
+func TestService_AuditFailureDoesNotFailAction(t *testing.T) {
+	c := &fakeCollector{}
+	svc := NewService(c, &fakeAuditLog{recordErr: errors.New("database down")})
+
+	stats, err := svc.DrainPool(sessionContext("alice"))
+	require.NoError(t, err)
+	assert.True(t, stats.Paused)
+}
+
+func TestService_AuditLogLimits(t *testing.T) {
+	audit := &fakeAuditLog{}
+	svc := NewService(&fakeCollector{}, audit)
+	ctx := context.Background()
+
+	_, err := svc.AuditLog(ctx, 0, -5)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAuditLimit, audit.limit)
+	assert.Equal(t, 0, audit.offset)
+
+	_, err = svc.AuditLog(ctx, 10000, 20)
+	require.NoError(t, err)
+	assert.Equal(t, MaxAuditLimit, audit.limit)
+	assert.Equal(t, 20, audit.offset)
+}
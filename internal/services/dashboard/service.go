@@ -2,11 +2,14 @@ package dashboard
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/birddigital/eth-validator-monitor/internal/database/models"
 	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/services/income"
+	"github.com/birddigital/eth-validator-monitor/internal/services/luck"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -38,6 +41,9 @@ var (
 	)
 )
 
+// luckWindow is the period over which the dashboard reports proposal luck
+const luckWindow = 30 * 24 * time.Hour
+
 // DashboardData represents the complete dashboard state
 type DashboardData struct {
 	Metrics       *repository.AggregateMetrics   `json:"metrics"`
@@ -50,12 +56,16 @@ type DashboardData struct {
 // Service handles dashboard data aggregation with caching
 type Service struct {
 	dashboardRepo *repository.DashboardRepository
+	income        *income.Service // Optional; portfolio income is omitted when nil
+	luck          *luck.Service   // Optional; proposal luck is omitted when nil
 }
 
 // NewService creates a new dashboard service
-func NewService(dashboardRepo *repository.DashboardRepository) *Service {
+func NewService(dashboardRepo *repository.DashboardRepository, incomeService *income.Service, luckService *luck.Service) *Service {
 	return &Service{
 		dashboardRepo: dashboardRepo,
+		income:        incomeService,
+		luck:          luckService,
 	}
 }
 
@@ -82,7 +92,7 @@ func (s *Service) GetDashboardData(ctx context.Context) (*DashboardData, error)
 		queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 		defer cancel()
 
-		metrics, err := s.dashboardRepo.GetAggregateMetrics(queryCtx)
+		metrics, err := s.aggregateMetrics(queryCtx)
 		resultCh <- queryResult{metrics: metrics, err: err}
 	}()
 
@@ -178,7 +188,33 @@ func (s *Service) GetAggregateMetrics(ctx context.Context) (*repository.Aggregat
 	timer := prometheus.NewTimer(dashboardQueryDuration.WithLabelValues("metrics"))
 	defer timer.ObserveDuration()
 
-	return s.dashboardRepo.GetAggregateMetrics(ctx)
+	return s.aggregateMetrics(ctx)
+}
+
+// aggregateMetrics fetches aggregate metrics together with portfolio income and proposal luck
+func (s *Service) aggregateMetrics(ctx context.Context) (*repository.AggregateMetrics, error) {
+	metrics, err := s.dashboardRepo.GetAggregateMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.income != nil {
+		metrics.Income, err = s.income.ForPortfolio(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch portfolio income: %w", err)
+		}
+	}
+
+	if s.luck != nil {
+		now := time.Now()
+		metrics.ProposalLuck, err = s.luck.ForPortfolio(ctx, now.Add(-luckWindow), now)
+		// Luck is unknown until the network rank job has estimated the network's balance
+		if err != nil && !errors.Is(err, luck.ErrNoNetworkBalance) {
+			return nil, fmt.Errorf("failed to fetch proposal luck: %w", err)
+		}
+	}
+
+	return metrics, nil
 }
 
 // GetRecentAlerts fetches only recent alerts
@@ -59,12 +59,16 @@ type DBPinger interface {
 	Stat() PoolStats
 }
 
+// CheckFunc reports the health of an additional component
+type CheckFunc func(ctx context.Context) *ComponentStatus
+
 // Monitor performs periodic health checks and broadcasts status via SSE
 type Monitor struct {
 	db          DBPinger
 	redis       *redis.Client
 	broadcaster *sse.Broadcaster
 	interval    time.Duration
+	checks      []CheckFunc
 
 	mu     sync.RWMutex
 	status map[string]*ComponentStatus
@@ -101,6 +105,12 @@ func NewMonitor(db DBPinger, redis *redis.Client, broadcaster *sse.Broadcaster,
 	}
 }
 
+// AddCheck registers an additional component check, run alongside the built-in ones. It must be
+// called before Start.
+func (m *Monitor) AddCheck(check CheckFunc) {
+	m.checks = append(m.checks, check)
+}
+
 // Start begins periodic health checking and broadcasting
 func (m *Monitor) Start() {
 	m.wg.Add(1)
@@ -160,7 +170,7 @@ func (m *Monitor) performHealthChecks() {
 
 	// Run checks in parallel
 	var wg sync.WaitGroup
-	results := make(chan *ComponentStatus, 2)
+	results := make(chan *ComponentStatus, 2+len(m.checks))
 
 	// Check database
 	wg.Add(1)
@@ -176,6 +186,15 @@ func (m *Monitor) performHealthChecks() {
 		results <- m.checkRedis(ctx)
 	}()
 
+	// Run additional checks
+	for _, check := range m.checks {
+		wg.Add(1)
+		go func(check CheckFunc) {
+			defer wg.Done()
+			results <- check(ctx)
+		}(check)
+	}
+
 	// Close results channel when all checks complete
 	go func() {
 		wg.Wait()
@@ -176,6 +176,21 @@ func TestMonitor_PerformHealthChecks(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestMonitor_AddCheck(t *testing.T) {
+	monitor := NewMonitor(nil, nil, nil, DefaultMonitorConfig())
+	monitor.AddCheck(func(ctx context.Context) *ComponentStatus {
+		return &ComponentStatus{Name: "signers", Status: "degraded", Message: "1 keys not loaded", LastCheck: time.Now()}
+	})
+
+	monitor.performHealthChecks()
+
+	status := monitor.GetStatus()
+	require.NotNil(t, status["signers"])
+	assert.Equal(t, "degraded", status["signers"].Status)
+	assert.Equal(t, "1 keys not loaded", status["signers"].Message)
+	assert.Equal(t, "unhealthy", status["database"].Status)
+}
+
 func TestMonitor_StartStop(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	require.NoError(t, err)
@@ -0,0 +1,157 @@
+package income
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+)
+
+// Store aggregates consensus income from the rewards ledger
+type Store interface {
+	IncomeByValidator(ctx context.Context, validatorIndices []int64, from, to time.Time) (map[int64]*models.IncomeSummary, error)
+	IncomeByTag(ctx context.Context, from, to time.Time) (map[string]*models.IncomeSummary, error)
+	PortfolioIncome(ctx context.Context, from, to time.Time) (*models.IncomeSummary, error)
+}
+
+// Service computes daily income and APR over trailing windows for validators, tag groups
+// and the whole portfolio
+type Service struct {
+	store   Store
+	windows []models.IncomeWindow
+	now     func() time.Time
+}
+
+// NewService creates a new income service. windows are reported when a caller does not
+// ask for specific ones; nil uses models.DefaultIncomeWindows.
+func NewService(store Store, windows []models.IncomeWindow) *Service {
+	if len(windows) == 0 {
+		windows = models.DefaultIncomeWindows
+	}
+	return &Service{
+		store:   store,
+		windows: windows,
+		now:     time.Now,
+	}
+}
+
+// Windows returns the default windows
+func (s *Service) Windows() []models.IncomeWindow {
+	return s.windows
+}
+
+// ForValidators returns income for each validator in each window. Every validator gets one
+// summary per window, with zero epochs when the ledger has no entries for it.
+func (s *Service) ForValidators(ctx context.Context, validatorIndices []int64, windows []models.IncomeWindow) (map[int64][]*models.IncomeSummary, error) {
+	windows = s.resolve(windows)
+	result := make(map[int64][]*models.IncomeSummary, len(validatorIndices))
+
+	for _, w := range windows {
+		from, to, err := s.bounds(w)
+		if err != nil {
+			return nil, err
+		}
+
+		byValidator, err := s.store.IncomeByValidator(ctx, validatorIndices, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, index := range validatorIndices {
+			result[index] = append(result[index], withWindow(byValidator[index], w))
+		}
+	}
+
+	return result, nil
+}
+
+// ForValidator returns a validator's income in each window
+func (s *Service) ForValidator(ctx context.Context, validatorIndex int64, windows []models.IncomeWindow) ([]*models.IncomeSummary, error) {
+	result, err := s.ForValidators(ctx, []int64{validatorIndex}, windows)
+	if err != nil {
+		return nil, err
+	}
+	return result[validatorIndex], nil
+}
+
+// ForTags returns income for every tag carried by a monitored validator, in each window.
+// Tags with no entries in a window get a summary with zero epochs.
+func (s *Service) ForTags(ctx context.Context, windows []models.IncomeWindow) (map[string][]*models.IncomeSummary, error) {
+	windows = s.resolve(windows)
+	byWindow := make([]map[string]*models.IncomeSummary, len(windows))
+	tags := make(map[string]struct{})
+
+	for i, w := range windows {
+		from, to, err := s.bounds(w)
+		if err != nil {
+			return nil, err
+		}
+
+		byTag, err := s.store.IncomeByTag(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		byWindow[i] = byTag
+		for tag := range byTag {
+			tags[tag] = struct{}{}
+		}
+	}
+
+	result := make(map[string][]*models.IncomeSummary, len(tags))
+	for tag := range tags {
+		for i, w := range windows {
+			result[tag] = append(result[tag], withWindow(byWindow[i][tag], w))
+		}
+	}
+
+	return result, nil
+}
+
+// ForPortfolio returns income across all monitored validators in each window
+func (s *Service) ForPortfolio(ctx context.Context, windows []models.IncomeWindow) ([]*models.IncomeSummary, error) {
+	windows = s.resolve(windows)
+	result := make([]*models.IncomeSummary, 0, len(windows))
+
+	for _, w := range windows {
+		from, to, err := s.bounds(w)
+		if err != nil {
+			return nil, err
+		}
+
+		summary, err := s.store.PortfolioIncome(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, withWindow(summary, w))
+	}
+
+	return result, nil
+}
+
+// resolve substitutes the default windows when none are requested
+func (s *Service) resolve(windows []models.IncomeWindow) []models.IncomeWindow {
+	if len(windows) == 0 {
+		return s.windows
+	}
+	return windows
+}
+
+// bounds returns the [from, to) range of a trailing window ending now
+func (s *Service) bounds(w models.IncomeWindow) (time.Time, time.Time, error) {
+	d, err := w.Duration()
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to resolve window: %w", err)
+	}
+	to := s.now()
+	return to.Add(-d), to, nil
+}
+
+// withWindow labels a summary with its window, substituting an empty summary for nil
+func withWindow(s *models.IncomeSummary, w models.IncomeWindow) *models.IncomeSummary {
+	if s == nil {
+		s = &models.IncomeSummary{}
+	}
+	s.Window = w
+	return s
+}
@@ -0,0 +1,90 @@
+package income
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore returns fixed income for ranges starting at or after since, and nothing before
+type fakeStore struct {
+	since     time.Time
+	validator map[int64]*models.IncomeSummary
+	tag       map[string]*models.IncomeSummary
+	ranges    [][2]time.Time
+}
+
+func (f *fakeStore) IncomeByValidator(ctx context.Context, validatorIndices []int64, from, to time.Time) (map[int64]*models.IncomeSummary, error) {
+	f.ranges = append(f.ranges, [2]time.Time{from, to})
+	result := make(map[int64]*models.IncomeSummary)
+	for _, index := range validatorIndices {
+		if s, ok := f.validator[index]; ok && !from.Before(f.since) {
+			copied := *s
+			result[index] = &copied
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeStore) IncomeByTag(ctx context.Context, from, to time.Time) (map[string]*models.IncomeSummary, error) {
+	result := make(map[string]*models.IncomeSummary)
+	if from.Before(f.since) {
+		return result, nil
+	}
+	for tag, s := range f.tag {
+		copied := *s
+		result[tag] = &copied
+	}
+	return result, nil
+}
+
+func (f *fakeStore) PortfolioIncome(ctx context.Context, from, to time.Time) (*models.IncomeSummary, error) {
+	return &models.IncomeSummary{Epochs: 225, Income: 2_700_000}, nil
+}
+
+func TestService_ForValidators(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeStore{
+		since:     now.Add(-48 * time.Hour),
+		validator: map[int64]*models.IncomeSummary{1: {Epochs: 225, Income: 2_700_000, EffectiveBalanceEpochs: 225 * 32e9}},
+	}
+	svc := NewService(store, nil)
+	svc.now = func() time.Time { return now }
+
+	result, err := svc.ForValidators(context.Background(), []int64{1, 2}, []models.IncomeWindow{models.IncomeWindowDay, models.IncomeWindowWeek})
+	require.NoError(t, err)
+
+	require.Len(t, result[1], 2)
+	assert.Equal(t, models.IncomeWindowDay, result[1][0].Window)
+	assert.Equal(t, int64(2_700_000), result[1][0].DailyIncome())
+	assert.Equal(t, models.IncomeWindowWeek, result[1][1].Window)
+	assert.Equal(t, int64(0), result[1][1].Epochs, "no ledger entries in the weekly range")
+
+	require.Len(t, result[2], 2, "validators without entries still get one summary per window")
+	assert.Equal(t, int64(0), result[2][0].Epochs)
+
+	assert.Equal(t, [2]time.Time{now.Add(-24 * time.Hour), now}, store.ranges[0])
+	assert.Equal(t, [2]time.Time{now.Add(-7 * 24 * time.Hour), now}, store.ranges[1])
+}
+
+func TestService_ForTagsAndPortfolio(t *testing.T) {
+	store := &fakeStore{tag: map[string]*models.IncomeSummary{"prod": {Epochs: 10, Income: 100}}}
+	svc := NewService(store, []models.IncomeWindow{"2d"})
+
+	tags, err := svc.ForTags(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, tags["prod"], 1)
+	assert.Equal(t, models.IncomeWindow("2d"), tags["prod"][0].Window)
+
+	portfolio, err := svc.ForPortfolio(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, portfolio, 1)
+	assert.Equal(t, int64(2_700_000), portfolio[0].DailyIncome())
+
+	_, err = svc.ForPortfolio(context.Background(), []models.IncomeWindow{"week"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package luck
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
+)
+
+// ErrNoNetworkBalance is returned when the network's active balance has not been estimated yet,
+// so the expected number of selections is unknown
+var ErrNoNetworkBalance = errors.New("network active balance unknown")
+
+// confidence is the share of outcomes covered by the expected range
+const confidence = 0.95
+
+// Store aggregates duty selections and the network's active balance
+type Store interface {
+	DutyCounts(ctx context.Context, from, to time.Time) ([]*models.DutyCounts, error)
+	AverageActiveBalance(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+// Service compares the block proposals and sync committee seats of monitored validators with the
+// number expected from their share of the network's active balance
+type Service struct {
+	store Store
+}
+
+// NewService creates a new proposal luck service
+func NewService(store Store) *Service {
+	return &Service{
+		store: store,
+	}
+}
+
+// ForPeriod returns proposal luck over [from, to) for all monitored validators, followed by each
+// tag in tag order.
+//
+// Every slot's proposer, and every sync committee seat, is drawn with probability proportional to
+// effective balance, so each validator-epoch in the ledger expects SlotsPerEpoch proposals and
+// SyncCommitteeSize / EpochsPerSyncCommitteePeriod seats, scaled by its share of the network's
+// total active balance.
+func (s *Service) ForPeriod(ctx context.Context, from, to time.Time) ([]*models.ProposalLuck, error) {
+	totalBalance, err := s.store.AverageActiveBalance(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if totalBalance <= 0 {
+		return nil, ErrNoNetworkBalance
+	}
+
+	counts, err := s.store.DutyCounts(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(counts) == 0 || counts[0].Tag != nil {
+		// No monitored validators; the portfolio is still reported
+		counts = append([]*models.DutyCounts{{}}, counts...)
+	}
+
+	result := make([]*models.ProposalLuck, 0, len(counts))
+	for _, c := range counts {
+		share := float64(c.EffectiveBalanceEpochs) / float64(totalBalance)
+		result = append(result, &models.ProposalLuck{
+			Tag:            c.Tag,
+			From:           from,
+			To:             to,
+			Validators:     c.Validators,
+			Epochs:         c.Epochs,
+			Proposals:      dutyLuck(share*types.SlotsPerEpoch, c.Proposals),
+			SyncCommittees: dutyLuck(share*types.SyncCommitteeSize/types.EpochsPerSyncCommitteePeriod, c.SyncCommittees),
+		})
+	}
+
+	return result, nil
+}
+
+// ForPortfolio returns proposal luck over [from, to) for all monitored validators
+func (s *Service) ForPortfolio(ctx context.Context, from, to time.Time) (*models.ProposalLuck, error) {
+	result, err := s.ForPeriod(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return result[0], nil
+}
+
+// dutyLuck places an actual count within the Poisson distribution with the expected mean
+func dutyLuck(expected float64, actual int64) models.DutyLuck {
+	tail := (1 - confidence) / 2
+	return models.DutyLuck{
+		Expected:     expected,
+		Actual:       actual,
+		Percentile:   (poissonCDF(actual-1, expected) + poissonPMF(actual, expected)/2) * 100,
+		ExpectedLow:  poissonQuantile(tail, expected),
+		ExpectedHigh: poissonQuantile(1-tail, expected),
+	}
+}
+
+// poissonPMF returns the probability of exactly k events at mean lambda
+func poissonPMF(k int64, lambda float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	if lambda <= 0 {
+		if k == 0 {
+			return 1
+		}
+		return 0
+	}
+	lgamma, _ := math.Lgamma(float64(k) + 1)
+	return math.Exp(float64(k)*math.Log(lambda) - lambda - lgamma)
+}
+
+// poissonCDF returns the probability of at most k events at mean lambda
+func poissonCDF(k int64, lambda float64) float64 {
+	var sum float64
+	for i := int64(0); i <= k; i++ {
+		sum += poissonPMF(i, lambda)
+	}
+	return math.Min(sum, 1)
+}
+
+// poissonQuantile returns the smallest count whose cumulative probability reaches q
+func poissonQuantile(q, lambda float64) int64 {
+	var sum float64
+	for k := int64(0); ; k++ {
+		sum += poissonPMF(k, lambda)
+		// Past the mean the remaining mass only shrinks; stop once rounding would stall the sum
+		if sum >= q || float64(k) > lambda && poissonPMF(k, lambda) < 1e-300 {
+			return k
+		}
+	}
+}
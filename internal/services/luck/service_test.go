@@ -0,0 +1,90 @@
+package luck
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore returns fixed duty counts and network balance
+type fakeStore struct {
+	counts  []*models.DutyCounts
+	balance int64
+}
+
+func (f *fakeStore) DutyCounts(ctx context.Context, from, to time.Time) ([]*models.DutyCounts, error) {
+	return f.counts, nil
+}
+
+func (f *fakeStore) AverageActiveBalance(ctx context.Context, from, to time.Time) (int64, error) {
+	return f.balance, nil
+}
+
+func TestService_ForPeriod(t *testing.T) {
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-30 * 24 * time.Hour)
+	tag := "lido"
+
+	// 100 validators of 32 ETH for 6750 epochs in a network of 1,000,000: each epoch expects
+	// 32 * 100 / 1e6 = 0.0032 proposals, 21.6 over the period, and 1.35 sync committee seats
+	store := &fakeStore{
+		balance: 1_000_000 * 32e9,
+		counts: []*models.DutyCounts{
+			{Validators: 100, Epochs: 675_000, EffectiveBalanceEpochs: 675_000 * 32e9, Proposals: 30, SyncCommittees: 1},
+			{Tag: &tag, Validators: 10, Epochs: 67_500, EffectiveBalanceEpochs: 67_500 * 32e9, Proposals: 0},
+		},
+	}
+
+	result, err := NewService(store).ForPeriod(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	fleet := result[0]
+	assert.Nil(t, fleet.Tag)
+	assert.Equal(t, from, fleet.From)
+	assert.Equal(t, int64(100), fleet.Validators)
+	assert.InDelta(t, 21.6, fleet.Proposals.Expected, 1e-9)
+	assert.Equal(t, int64(30), fleet.Proposals.Actual)
+	assert.InDelta(t, 138.9, fleet.Proposals.Luck(), 0.1)
+	assert.Greater(t, fleet.Proposals.Percentile, 95.0, "30 against 21.6 is unusually lucky")
+	assert.Less(t, fleet.Proposals.ExpectedLow, int64(22))
+	assert.Greater(t, fleet.Proposals.ExpectedHigh, int64(22))
+	assert.Equal(t, int64(31), fleet.Proposals.ExpectedHigh)
+	assert.InDelta(t, 1.35, fleet.SyncCommittees.Expected, 1e-9)
+	assert.Equal(t, int64(0), fleet.SyncCommittees.ExpectedLow)
+
+	group := result[1]
+	assert.Equal(t, "lido", *group.Tag)
+	assert.InDelta(t, 2.16, group.Proposals.Expected, 1e-9)
+	assert.InDelta(t, 5.8, group.Proposals.Percentile, 0.1, "half the chance of zero proposals at 2.16")
+}
+
+func TestService_ForPeriodWithoutData(t *testing.T) {
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewService(&fakeStore{}).ForPeriod(context.Background(), to.Add(-time.Hour), to)
+	assert.ErrorIs(t, err, ErrNoNetworkBalance)
+
+	// Without monitored validators the portfolio is reported empty
+	portfolio, err := NewService(&fakeStore{balance: 32e9}).ForPortfolio(context.Background(), to.Add(-time.Hour), to)
+	require.NoError(t, err)
+	assert.Nil(t, portfolio.Tag)
+	assert.Zero(t, portfolio.Proposals.Expected)
+	assert.Equal(t, 50.0, portfolio.Proposals.Percentile)
+}
+
+func TestPoisson(t *testing.T) {
+	assert.InDelta(t, 0.3679, poissonPMF(1, 1), 1e-4)
+	assert.InDelta(t, 0.9197, poissonCDF(2, 1), 1e-4)
+	assert.Equal(t, 0.0, poissonCDF(-1, 1))
+	assert.Equal(t, int64(0), poissonQuantile(0.025, 1))
+	assert.Equal(t, int64(3), poissonQuantile(0.975, 1))
+
+	// Large means stay finite
+	assert.InDelta(t, 0.5, poissonCDF(10_000, 10_000), 0.01)
+	assert.InDelta(t, 10_196, poissonQuantile(0.975, 10_000), 2)
+}
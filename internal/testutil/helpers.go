@@ -113,6 +113,7 @@ func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 			name TEXT,
 			tags TEXT[],
 			monitored BOOLEAN DEFAULT TRUE,
+			status VARCHAR(32),
 			created_at TIMESTAMP DEFAULT NOW(),
 			updated_at TIMESTAMP DEFAULT NOW()
 		)`,
@@ -135,8 +136,311 @@ func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 			consecutive_missed_attestations INT DEFAULT 0,
 			daily_income BIGINT DEFAULT 0,
 			apr DOUBLE PRECISION DEFAULT 0,
+			network_percentile DOUBLE PRECISION,
+			network_average DOUBLE PRECISION,
 			PRIMARY KEY (time, validator_index)
 		)`,
+		`CREATE TABLE IF NOT EXISTS snapshot_gaps (
+			validator_index BIGINT NOT NULL,
+			epoch BIGINT NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'missing',
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT,
+			detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			resolved_at TIMESTAMPTZ,
+			PRIMARY KEY (validator_index, epoch)
+		)`,
+		`CREATE TABLE IF NOT EXISTS consolidation_requests (
+			id BIGSERIAL PRIMARY KEY,
+			slot BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			source_address VARCHAR(42) NOT NULL,
+			source_pubkey VARCHAR(98) NOT NULL,
+			target_pubkey VARCHAR(98) NOT NULL,
+			source_index BIGINT,
+			target_index BIGINT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			UNIQUE (slot, source_pubkey, target_pubkey)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_credential_changes (
+			validator_index BIGINT NOT NULL,
+			slot BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			from_bls_pubkey VARCHAR(98) NOT NULL,
+			to_execution_address VARCHAR(42) NOT NULL,
+			allowlisted BOOLEAN NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, slot)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_withdrawal_requests (
+			id BIGSERIAL PRIMARY KEY,
+			validator_index BIGINT NOT NULL,
+			slot BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			source_address VARCHAR(42) NOT NULL,
+			amount BIGINT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			UNIQUE (validator_index, slot, source_address, amount)
+		)`,
+		`CREATE TABLE IF NOT EXISTS discovery_rules (
+			id BIGSERIAL PRIMARY KEY,
+			kind VARCHAR(20) NOT NULL,
+			value VARCHAR(66) NOT NULL,
+			tag VARCHAR(100) NOT NULL,
+			next_block BIGINT NOT NULL DEFAULT 0,
+			enrolled INTEGER NOT NULL DEFAULT 0,
+			last_run_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			UNIQUE (kind, value, tag)
+		)`,
+		`CREATE TABLE IF NOT EXISTS discovered_deposits (
+			rule_id BIGINT NOT NULL,
+			pubkey VARCHAR(98) NOT NULL,
+			block_number BIGINT NOT NULL,
+			tx_hash VARCHAR(66) NOT NULL,
+			validator_index BIGINT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (rule_id, pubkey)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_fee_recipients (
+			validator_index BIGINT PRIMARY KEY,
+			address VARCHAR(42) NOT NULL,
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS fee_recipient_checks (
+			validator_index BIGINT NOT NULL,
+			slot BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			block_hash VARCHAR(66) NOT NULL,
+			fee_recipient VARCHAR(42) NOT NULL,
+			expected TEXT[] NOT NULL,
+			payment_to VARCHAR(42),
+			payment_gwei BIGINT,
+			status VARCHAR(20) NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, slot)
+		)`,
+		`CREATE TABLE IF NOT EXISTS alerts (
+			id BIGSERIAL PRIMARY KEY,
+			validator_index BIGINT,
+			alert_type VARCHAR(50) NOT NULL,
+			severity VARCHAR(20) NOT NULL,
+			title TEXT NOT NULL DEFAULT '',
+			message TEXT NOT NULL,
+			source VARCHAR(255) NOT NULL DEFAULT 'system',
+			details JSONB,
+			status VARCHAR(20) NOT NULL DEFAULT 'new',
+			acknowledged_at TIMESTAMPTZ,
+			resolved_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_status_transitions (
+			validator_index BIGINT NOT NULL,
+			status VARCHAR(32) NOT NULL,
+			previous_status VARCHAR(32),
+			epoch BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			observed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, status)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_deposits (
+			id BIGSERIAL PRIMARY KEY,
+			validator_index BIGINT NOT NULL,
+			slot BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			withdrawal_credentials VARCHAR(66) NOT NULL,
+			signature VARCHAR(194) NOT NULL,
+			amount BIGINT NOT NULL,
+			status VARCHAR(10) NOT NULL DEFAULT 'pending',
+			queue_position BIGINT,
+			queue_amount_ahead BIGINT,
+			credited_epoch BIGINT,
+			unexpected_reason TEXT,
+			observed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			UNIQUE (validator_index, slot, signature, amount)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_queued_withdrawals (
+			validator_index BIGINT NOT NULL,
+			withdrawable_epoch BIGINT NOT NULL,
+			amount BIGINT NOT NULL,
+			first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, withdrawable_epoch, amount)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_queued_consolidations (
+			source_index BIGINT NOT NULL,
+			target_index BIGINT NOT NULL,
+			first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (source_index, target_index)
+		)`,
+		`CREATE TABLE IF NOT EXISTS incidents (
+			id BIGSERIAL PRIMARY KEY,
+			failure_type VARCHAR(50) NOT NULL,
+			group_kind VARCHAR(10) NOT NULL,
+			group_value VARCHAR(100) NOT NULL DEFAULT '',
+			status VARCHAR(20) NOT NULL DEFAULT 'open',
+			started_at TIMESTAMPTZ NOT NULL,
+			last_alert_at TIMESTAMPTZ NOT NULL,
+			acknowledged_at TIMESTAMPTZ,
+			acknowledged_by VARCHAR(255),
+			resolved_at TIMESTAMPTZ,
+			resolved_by VARCHAR(255),
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS incident_alerts (
+			alert_id BIGINT PRIMARY KEY,
+			incident_id BIGINT NOT NULL,
+			added_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS signer_endpoints (
+			name VARCHAR(100) NOT NULL,
+			kind VARCHAR(20) NOT NULL,
+			url TEXT NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			message TEXT NOT NULL DEFAULT '',
+			key_count INTEGER,
+			checked_at TIMESTAMPTZ NOT NULL,
+			healthy_at TIMESTAMPTZ,
+			PRIMARY KEY (name, kind)
+		)`,
+		`CREATE TABLE IF NOT EXISTS signer_keys (
+			name VARCHAR(100) NOT NULL,
+			kind VARCHAR(20) NOT NULL,
+			pubkey VARCHAR(98) NOT NULL,
+			remote BOOLEAN NOT NULL DEFAULT FALSE,
+			seen_at TIMESTAMPTZ NOT NULL,
+			PRIMARY KEY (name, kind, pubkey)
+		)`,
+		`CREATE TABLE IF NOT EXISTS block_clients (
+			slot BIGINT PRIMARY KEY,
+			epoch BIGINT NOT NULL,
+			proposer_index BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			graffiti TEXT NOT NULL DEFAULT '',
+			client VARCHAR(20) NOT NULL,
+			version VARCHAR(64) NOT NULL DEFAULT '',
+			execution_client VARCHAR(20) NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS relay_proposals (
+			validator_index BIGINT NOT NULL,
+			slot BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			block_hash VARCHAR(66) NOT NULL,
+			source VARCHAR(20) NOT NULL,
+			relays TEXT[] NOT NULL DEFAULT '{}',
+			builder_pubkey VARCHAR(98),
+			promised_gwei BIGINT,
+			received_gwei BIGINT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, slot)
+		)`,
+		`CREATE TABLE IF NOT EXISTS admin_audit_log (
+			id BIGSERIAL PRIMARY KEY,
+			actor VARCHAR(255) NOT NULL,
+			auth_method VARCHAR(20) NOT NULL,
+			action VARCHAR(50) NOT NULL,
+			params JSONB,
+			success BOOLEAN NOT NULL,
+			error TEXT,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_rewards_ledger (
+			validator_index BIGINT NOT NULL,
+			epoch BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			effective_balance BIGINT NOT NULL,
+			balance_start BIGINT NOT NULL,
+			balance_end BIGINT NOT NULL,
+			attestation_rewards BIGINT NOT NULL DEFAULT 0,
+			proposal_rewards BIGINT NOT NULL DEFAULT 0,
+			sync_rewards BIGINT NOT NULL DEFAULT 0,
+			penalties BIGINT NOT NULL DEFAULT 0,
+			withdrawals BIGINT NOT NULL DEFAULT 0,
+			deposits BIGINT NOT NULL DEFAULT 0,
+			other BIGINT NOT NULL DEFAULT 0,
+			ideal_rewards BIGINT NOT NULL DEFAULT 0,
+			sync_penalties BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, epoch)
+		)`,
+		`CREATE TABLE IF NOT EXISTS network_epoch_stats (
+			epoch BIGINT PRIMARY KEY,
+			time TIMESTAMPTZ NOT NULL,
+			sample_size INT NOT NULL,
+			average_score DOUBLE PRECISION NOT NULL,
+			median_score DOUBLE PRECISION NOT NULL,
+			active_validators BIGINT NOT NULL DEFAULT 0,
+			total_active_balance BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS validator_network_ranks (
+			validator_index BIGINT NOT NULL,
+			epoch BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			score DOUBLE PRECISION NOT NULL,
+			percentile DOUBLE PRECISION NOT NULL,
+			PRIMARY KEY (validator_index, epoch)
+		)`,
+		`CREATE TABLE IF NOT EXISTS attestation_analysis_epochs (
+			epoch BIGINT PRIMARY KEY,
+			duties INT NOT NULL,
+			misses INT NOT NULL,
+			analyzed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS attestation_misses (
+			validator_index BIGINT NOT NULL,
+			epoch BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			slot BIGINT NOT NULL,
+			reason VARCHAR(32) NOT NULL,
+			inclusion_slot BIGINT,
+			inclusion_delay INT,
+			PRIMARY KEY (validator_index, epoch)
+		)`,
+		`CREATE TABLE IF NOT EXISTS proposal_analysis_epochs (
+			epoch BIGINT PRIMARY KEY,
+			duties INT NOT NULL,
+			misses INT NOT NULL,
+			analyzed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE TABLE IF NOT EXISTS proposal_misses (
+			slot BIGINT PRIMARY KEY,
+			validator_index BIGINT NOT NULL,
+			epoch BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			reason VARCHAR(32) NOT NULL,
+			block_root VARCHAR(66),
+			block_delay_ms INT,
+			relay TEXT
+		)`,
+		`CREATE TABLE IF NOT EXISTS performance_anomalies (
+			id BIGSERIAL PRIMARY KEY,
+			validator_index BIGINT,
+			tag TEXT,
+			metric VARCHAR(32) NOT NULL,
+			window_start TIMESTAMPTZ NOT NULL,
+			window_end TIMESTAMPTZ NOT NULL,
+			baseline DOUBLE PRECISION NOT NULL,
+			deviation DOUBLE PRECISION NOT NULL,
+			observed DOUBLE PRECISION NOT NULL,
+			z_score DOUBLE PRECISION NOT NULL,
+			detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_performance_anomalies_subject_window
+			ON performance_anomalies(COALESCE(validator_index, -1), COALESCE(tag, ''), metric, window_end)`,
+		`CREATE TABLE IF NOT EXISTS effective_balance_steps (
+			validator_index BIGINT NOT NULL,
+			epoch BIGINT NOT NULL,
+			time TIMESTAMPTZ NOT NULL,
+			previous_effective_balance BIGINT NOT NULL,
+			effective_balance BIGINT NOT NULL,
+			balance BIGINT NOT NULL,
+			penalties BIGINT NOT NULL DEFAULT 0,
+			withdrawals BIGINT NOT NULL DEFAULT 0,
+			detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (validator_index, epoch)
+		)`,
 	}
 
 	for _, migration := range migrations {
@@ -152,6 +456,35 @@ func RunMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 // CleanupTestDB removes all test data
 func CleanupTestDB(ctx context.Context, pool *pgxpool.Pool) error {
 	tables := []string{
+		"admin_audit_log",
+		"validator_queued_consolidations",
+		"validator_queued_withdrawals",
+		"validator_deposits",
+		"validator_status_transitions",
+		"incident_alerts",
+		"incidents",
+		"alerts",
+		"signer_keys",
+		"signer_endpoints",
+		"block_clients",
+		"relay_proposals",
+		"fee_recipient_checks",
+		"validator_fee_recipients",
+		"discovered_deposits",
+		"discovery_rules",
+		"validator_withdrawal_requests",
+		"validator_credential_changes",
+		"consolidation_requests",
+		"effective_balance_steps",
+		"performance_anomalies",
+		"proposal_misses",
+		"proposal_analysis_epochs",
+		"attestation_misses",
+		"attestation_analysis_epochs",
+		"validator_network_ranks",
+		"network_epoch_stats",
+		"validator_rewards_ledger",
+		"snapshot_gaps",
 		"validator_snapshots",
 		"validators",
 	}
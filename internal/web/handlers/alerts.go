@@ -17,15 +17,17 @@ import (
 
 // AlertsHandler handles the alerts management page
 type AlertsHandler struct {
-	repo   *repository.AlertRepository
-	logger zerolog.Logger
+	repo     *repository.AlertRepository
+	costRepo *repository.DowntimeCostRepository
+	logger   zerolog.Logger
 }
 
 // NewAlertsHandler creates a new alerts handler
-func NewAlertsHandler(repo *repository.AlertRepository, logger zerolog.Logger) *AlertsHandler {
+func NewAlertsHandler(repo *repository.AlertRepository, costRepo *repository.DowntimeCostRepository, logger zerolog.Logger) *AlertsHandler {
 	return &AlertsHandler{
-		repo:   repo,
-		logger: logger,
+		repo:     repo,
+		costRepo: costRepo,
+		logger:   logger,
 	}
 }
 
@@ -311,6 +313,7 @@ func (h *AlertsHandler) renderFull(w http.ResponseWriter, r *http.Request, alert
 	data := pages.AlertsPageData{
 		Alerts: alerts,
 		Filter: filter,
+		Cost:   h.downtimeCost(r, filter),
 	}
 
 	component := pages.AlertsPageWithLayout(data)
@@ -320,6 +323,26 @@ func (h *AlertsHandler) renderFull(w http.ResponseWriter, r *http.Request, alert
 	}
 }
 
+// downtimeCost computes the income lost over the filter's time range (defaulting to the last 24
+// hours) by the filtered validator, or by the fleet. Failures are logged and omit the report.
+func (h *AlertsHandler) downtimeCost(r *http.Request, filter models.AlertFilter) *models.DowntimeCost {
+	to := time.Now()
+	if filter.EndTime != nil {
+		to = *filter.EndTime
+	}
+	from := to.Add(-24 * time.Hour)
+	if filter.StartTime != nil {
+		from = *filter.StartTime
+	}
+
+	cost, err := h.costRepo.Cost(r.Context(), models.DowntimeScope{ValidatorIndex: filter.ValidatorIndex}, from, to)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to compute downtime cost")
+		return nil
+	}
+	return cost
+}
+
 // renderPartial renders only the alerts table or specific components
 func (h *AlertsHandler) renderPartial(w http.ResponseWriter, r *http.Request, alerts []*models.Alert, filter models.AlertFilter) {
 	target := r.Header.Get("HX-Target")
@@ -167,6 +167,14 @@ func (h *DashboardHandler) GetHealthIndicators(w http.ResponseWriter, r *http.Re
 		}
 	}
 
+	// Map validator client and remote signer status, present only when signers are scraped
+	if signerStatus, ok := status["signers"]; ok {
+		data.Signers = &components.ComponentHealth{
+			Status:  signerStatus.Status,
+			Message: signerStatus.Message,
+		}
+	}
+
 	// Render Templ component
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/birddigital/eth-validator-monitor/internal/auth"
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
+	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
+	"github.com/birddigital/eth-validator-monitor/internal/web/templates/pages"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// IncidentsHandler handles the incident list and incident detail pages
+type IncidentsHandler struct {
+	repo     *repository.IncidentRepository
+	costRepo *repository.DowntimeCostRepository
+	logger   zerolog.Logger
+}
+
+// NewIncidentsHandler creates a new incidents handler
+func NewIncidentsHandler(repo *repository.IncidentRepository, costRepo *repository.DowntimeCostRepository, logger zerolog.Logger) *IncidentsHandler {
+	return &IncidentsHandler{
+		repo:     repo,
+		costRepo: costRepo,
+		logger:   logger,
+	}
+}
+
+// ServeHTTP renders the incident list, optionally filtered by status
+func (h *IncidentsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filter := models.IncidentFilter{Limit: 50}
+	switch status := models.IncidentStatus(r.URL.Query().Get("status")); status {
+	case models.IncidentStatusOpen, models.IncidentStatusAcknowledged, models.IncidentStatusResolved:
+		filter.Status = &status
+	}
+
+	incidents, err := h.repo.ListIncidents(r.Context(), &filter)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to fetch incidents")
+		http.Error(w, "Failed to fetch incidents", http.StatusInternalServerError)
+		return
+	}
+
+	data := pages.IncidentsPageData{
+		Incidents: incidents,
+		Status:    filter.Status,
+		Now:       time.Now(),
+	}
+	if err := pages.IncidentsPageWithLayout(data).Render(r.Context(), w); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to render incidents page")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// ServeDetail renders an incident with its timeline, member alerts and the income its validators
+// lost while it lasted
+func (h *IncidentsHandler) ServeDetail(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseID(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	incident, err := h.repo.GetIncident(ctx, id)
+	if err != nil {
+		h.logger.Error().Err(err).Int64("incident_id", id).Msg("Failed to fetch incident")
+		http.Error(w, "Failed to fetch incident", http.StatusInternalServerError)
+		return
+	}
+	if incident == nil {
+		http.Error(w, "Incident not found", http.StatusNotFound)
+		return
+	}
+
+	alerts, err := h.repo.ListAlerts(ctx, id)
+	if err != nil {
+		h.logger.Error().Err(err).Int64("incident_id", id).Msg("Failed to fetch incident alerts")
+		http.Error(w, "Failed to fetch incident alerts", http.StatusInternalServerError)
+		return
+	}
+
+	data := pages.IncidentDetailPageData{
+		Incident: incident,
+		Alerts:   alerts,
+		Timeline: models.IncidentTimeline(incident, alerts),
+		Cost:     h.downtimeCost(r, incident, alerts),
+		Now:      time.Now(),
+	}
+	if err := pages.IncidentDetailPageWithLayout(data).Render(ctx, w); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to render incident page")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// downtimeCost computes the income lost by the incident's validators from its start until its
+// resolution, or until now. Failures are logged and omit the report, as do incidents about no
+// validator.
+func (h *IncidentsHandler) downtimeCost(r *http.Request, incident *models.Incident, alerts []*models.Alert) *models.DowntimeCost {
+	validators := models.IncidentValidators(alerts)
+	if len(validators) == 0 {
+		return nil
+	}
+
+	to := time.Now()
+	if incident.ResolvedAt != nil {
+		to = *incident.ResolvedAt
+	}
+	cost, err := h.costRepo.Cost(r.Context(), models.DowntimeScope{Validators: validators}, incident.StartedAt, to)
+	if err != nil {
+		h.logger.Error().Err(err).Int64("incident_id", incident.ID).Msg("Failed to compute incident downtime cost")
+		return nil
+	}
+	return cost
+}
+
+// HandleAcknowledge acknowledges an open incident and its alerts
+func (h *IncidentsHandler) HandleAcknowledge(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "acknowledge", h.repo.Acknowledge)
+}
+
+// HandleResolve resolves an incident and its alerts
+func (h *IncidentsHandler) HandleResolve(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "resolve", h.repo.Resolve)
+}
+
+// transition applies a lifecycle action as the logged in user, if any, and returns to the
+// incident page. An incident already past the action is left as is.
+func (h *IncidentsHandler) transition(w http.ResponseWriter, r *http.Request, action string,
+	apply func(ctx context.Context, id int64, by string) (bool, error)) {
+	id, ok := h.parseID(w, r)
+	if !ok {
+		return
+	}
+
+	by, _ := auth.GetUsernameFromContext(r.Context())
+	changed, err := apply(r.Context(), id, by)
+	if err != nil {
+		h.logger.Error().Err(err).Int64("incident_id", id).Str("action", action).Msg("Failed to update incident")
+		http.Error(w, "Failed to update incident", http.StatusInternalServerError)
+		return
+	}
+	if changed {
+		h.logger.Info().Int64("incident_id", id).Str("action", action).Str("by", by).Msg("Incident updated")
+	}
+
+	target := fmt.Sprintf("/incidents/%d", id)
+	if r.Header.Get("HX-Request") == "true" {
+		w.Header().Set("HX-Redirect", target)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	http.Redirect(w, r, target, http.StatusSeeOther)
+}
+
+// parseID reads the incident ID from the URL, answering 400 when it is invalid
+func (h *IncidentsHandler) parseID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		http.Error(w, "Invalid incident ID", http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
@@ -12,32 +12,57 @@ import (
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/birddigital/eth-validator-monitor/internal/database/models"
 	"github.com/birddigital/eth-validator-monitor/internal/database/repository"
 	"github.com/birddigital/eth-validator-monitor/internal/web/templates/layouts"
 	"github.com/birddigital/eth-validator-monitor/internal/web/templates/pages"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
 )
 
+// forecastIncomeWindow is the trailing income window the effective balance projection extrapolates
+const forecastIncomeWindow = 7 * 24 * time.Hour
+
+// feeRecipientHistoryLimit is the number of recent proposals shown in the fee recipient and
+// block building histories
+const feeRecipientHistoryLimit = 50
+
 // ValidatorDetailHandler handles the validator detail page and related endpoints
 type ValidatorDetailHandler struct {
-	repo   *repository.ValidatorDetailRepository
-	logger zerolog.Logger
+	repo             *repository.ValidatorDetailRepository
+	ledgerRepo       *repository.RewardsLedgerRepository
+	credentialRepo   *repository.CredentialRepository
+	feeRecipientRepo *repository.FeeRecipientRepository
+	relayRepo        *repository.RelayRepository
+	depositRepo      *repository.DepositRepository
+	logger           zerolog.Logger
 }
 
 // NewValidatorDetailHandler creates a new validator detail handler
-func NewValidatorDetailHandler(repo *repository.ValidatorDetailRepository, logger zerolog.Logger) *ValidatorDetailHandler {
+func NewValidatorDetailHandler(repo *repository.ValidatorDetailRepository, ledgerRepo *repository.RewardsLedgerRepository, credentialRepo *repository.CredentialRepository, feeRecipientRepo *repository.FeeRecipientRepository, relayRepo *repository.RelayRepository, depositRepo *repository.DepositRepository, logger zerolog.Logger) *ValidatorDetailHandler {
 	return &ValidatorDetailHandler{
-		repo:   repo,
-		logger: logger,
+		repo:             repo,
+		ledgerRepo:       ledgerRepo,
+		credentialRepo:   credentialRepo,
+		feeRecipientRepo: feeRecipientRepo,
+		relayRepo:        relayRepo,
+		depositRepo:      depositRepo,
+		logger:           logger,
 	}
 }
 
 // ValidatorPageData holds all data for the validator detail page
 type ValidatorPageData struct {
-	Validator         *repository.ValidatorDetails
-	EffectivenessData []repository.EffectivenessPoint
-	AttestationStats  []repository.AttestationStats
-	Alerts            []repository.Alert
-	Timeline          []repository.TimelineEvent
+	Validator          *repository.ValidatorDetails
+	EffectivenessData  []repository.EffectivenessPoint
+	AttestationStats   []repository.AttestationStats
+	Alerts             []repository.Alert
+	Timeline           []repository.TimelineEvent
+	Forecast           *models.EffectiveBalanceForecast // Nil without a balance or for exited validators
+	CredentialChanges  []*models.CredentialChange
+	ExitRequests       []*models.WithdrawalRequest
+	FeeRecipientChecks []*models.FeeRecipientCheck // Newest first
+	RelayProposals     []*models.RelayProposal     // Newest first
+	Deposits           []*models.ValidatorDeposit  // Newest first
 }
 
 // ServeHTTP implements http.Handler for the main validator detail page
@@ -62,6 +87,12 @@ func (h *ValidatorDetailHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		attestations  []repository.AttestationStats
 		alerts        []repository.Alert
 		timeline      []repository.TimelineEvent
+		income        map[int64]*models.IncomeSummary
+		changes       []*models.CredentialChange
+		requests      []*models.WithdrawalRequest
+		checks        []*models.FeeRecipientCheck
+		proposals     []*models.RelayProposal
+		deposits      []*models.ValidatorDeposit
 	)
 
 	g.Go(func() error {
@@ -109,6 +140,56 @@ func (h *ValidatorDetailHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		return nil
 	})
 
+	g.Go(func() error {
+		var err error
+		now := time.Now()
+		income, err = h.ledgerRepo.IncomeByValidator(gctx, []int64{validatorIndex}, now.Add(-forecastIncomeWindow), now)
+		if err != nil {
+			return fmt.Errorf("get validator income: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var err error
+		changes, err = h.credentialRepo.GetChanges(gctx, validatorIndex)
+		if err != nil {
+			return fmt.Errorf("get credential changes: %w", err)
+		}
+		requests, err = h.credentialRepo.GetWithdrawalRequests(gctx, validatorIndex)
+		if err != nil {
+			return fmt.Errorf("get withdrawal requests: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var err error
+		checks, err = h.feeRecipientRepo.GetChecks(gctx, validatorIndex, feeRecipientHistoryLimit)
+		if err != nil {
+			return fmt.Errorf("get fee recipient checks: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var err error
+		proposals, err = h.relayRepo.GetProposals(gctx, &validatorIndex, feeRecipientHistoryLimit)
+		if err != nil {
+			return fmt.Errorf("get relay proposals: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var err error
+		deposits, err = h.depositRepo.GetDeposits(gctx, validatorIndex)
+		if err != nil {
+			return fmt.Errorf("get deposits: %w", err)
+		}
+		return nil
+	})
+
 	// Wait for all queries to complete
 	if err := g.Wait(); err != nil {
 		h.logger.Error().Err(err).Int64("validator", validatorIndex).Msg("Failed to fetch validator data")
@@ -118,11 +199,17 @@ func (h *ValidatorDetailHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 
 	// Prepare template data
 	data := ValidatorPageData{
-		Validator:         details,
-		EffectivenessData: effectiveness,
-		AttestationStats:  attestations,
-		Alerts:            alerts,
-		Timeline:          timeline,
+		Validator:          details,
+		EffectivenessData:  effectiveness,
+		AttestationStats:   attestations,
+		Alerts:             alerts,
+		Timeline:           timeline,
+		Forecast:           balanceForecast(details, income[validatorIndex]),
+		CredentialChanges:  changes,
+		ExitRequests:       requests,
+		FeeRecipientChecks: checks,
+		RelayProposals:     proposals,
+		Deposits:           deposits,
 	}
 
 	// Check if this is an HTMX request (partial update)
@@ -136,9 +223,23 @@ func (h *ValidatorDetailHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	h.renderFull(w, r, data)
 }
 
+// balanceForecast projects the next effective balance step from the validator's trailing daily
+// income, or returns nil when the validator has no known balance or has exited
+func balanceForecast(details *repository.ValidatorDetails, income *models.IncomeSummary) *models.EffectiveBalanceForecast {
+	if details.CurrentBalance == nil || details.ExitEpoch != nil {
+		return nil
+	}
+
+	var dailyIncome int64
+	if income != nil {
+		dailyIncome = income.DailyIncome()
+	}
+	return models.ForecastEffectiveBalance(*details.CurrentBalance, details.EffectiveBalance, types.MaxEffectiveBalance(details.WithdrawalCredentials), dailyIncome)
+}
+
 // renderFull renders the complete validator detail page
 func (h *ValidatorDetailHandler) renderFull(w http.ResponseWriter, r *http.Request, data ValidatorPageData) {
-	pageContent := pages.ValidatorDetailPage(data.Validator, data.EffectivenessData, data.AttestationStats, data.Alerts, data.Timeline)
+	pageContent := pages.ValidatorDetailPage(data.Validator, data.EffectivenessData, data.AttestationStats, data.Alerts, data.Timeline, data.Forecast, data.CredentialChanges, data.ExitRequests, data.FeeRecipientChecks, data.RelayProposals, data.Deposits)
 	title := fmt.Sprintf("Validator %d", data.Validator.Index)
 	component := layouts.Base(title, pageContent)
 	if err := component.Render(r.Context(), w); err != nil {
@@ -289,6 +390,132 @@ func (h *ValidatorDetailHandler) exportJSON(w http.ResponseWriter, validatorInde
 	}
 }
 
+// HandleRewardsExport exports the validator's rewards ledger as CSV or JSON, summarised by
+// ?interval=day|week|month (default day) over the last ?days days (default 30, max 366)
+func (h *ValidatorDetailHandler) HandleRewardsExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	validatorIndexStr := chi.URLParam(r, "index")
+	validatorIndex, err := strconv.ParseInt(validatorIndexStr, 10, 64)
+	if err != nil {
+		h.logger.Error().Err(err).Str("index", validatorIndexStr).Msg("Invalid validator index for rewards export")
+		http.Error(w, "Invalid validator index", http.StatusBadRequest)
+		return
+	}
+
+	interval := models.LedgerPeriod(r.URL.Query().Get("interval"))
+	if interval == "" {
+		interval = models.LedgerPeriodDay
+	}
+	if !interval.IsValid() {
+		http.Error(w, "Invalid interval. Use 'day', 'week' or 'month'", http.StatusBadRequest)
+		return
+	}
+
+	days := 30
+	if d, err := strconv.Atoi(r.URL.Query().Get("days")); err == nil && d > 0 && d <= 366 {
+		days = d
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "csv" && format != "json" {
+		http.Error(w, "Invalid format. Use 'csv' or 'json'", http.StatusBadRequest)
+		return
+	}
+
+	end := time.Now()
+	summaries, err := h.ledgerRepo.Summarize(ctx, validatorIndex, interval, end.AddDate(0, 0, -days), end)
+	if err != nil {
+		h.logger.Error().Err(err).Int64("validator", validatorIndex).Msg("Failed to fetch rewards ledger for export")
+		http.Error(w, "Failed to fetch data", http.StatusInternalServerError)
+		return
+	}
+
+	rows := make([]rewardsExportRow, 0, len(summaries))
+	for _, summary := range summaries {
+		rows = append(rows, newRewardsExportRow(summary))
+	}
+
+	filename := fmt.Sprintf("validator-%d-rewards-%s.%s", validatorIndex, interval, format)
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(rows); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to encode rewards JSON export")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	header := []string{"Period Start", "Epochs", "Attestation Rewards (Gwei)", "Proposal Rewards (Gwei)", "Sync Rewards (Gwei)",
+		"Penalties (Gwei)", "Withdrawals (Gwei)", "Deposits (Gwei)", "Other (Gwei)", "Expected (Gwei)", "Actual (Gwei)", "Effectiveness (%)"}
+	if err := writer.Write(header); err != nil {
+		h.logger.Error().Err(err).Msg("Failed to write CSV header")
+		return
+	}
+
+	for _, row := range rows {
+		record := []string{
+			row.PeriodStart.Format("2006-01-02"),
+			strconv.FormatInt(row.Epochs, 10),
+			strconv.FormatInt(row.AttestationRewards, 10),
+			strconv.FormatInt(row.ProposalRewards, 10),
+			strconv.FormatInt(row.SyncRewards, 10),
+			strconv.FormatInt(row.Penalties, 10),
+			strconv.FormatInt(row.Withdrawals, 10),
+			strconv.FormatInt(row.Deposits, 10),
+			strconv.FormatInt(row.Other, 10),
+			strconv.FormatInt(row.Expected, 10),
+			strconv.FormatInt(row.Actual, 10),
+			fmt.Sprintf("%.2f", row.Effectiveness),
+		}
+		if err := writer.Write(record); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to write CSV row")
+			return
+		}
+	}
+}
+
+// rewardsExportRow is one period of an exported rewards ledger. Amounts are in Gwei.
+type rewardsExportRow struct {
+	PeriodStart        time.Time `json:"period_start"`
+	Epochs             int64     `json:"epochs"`
+	AttestationRewards int64     `json:"attestation_rewards"`
+	ProposalRewards    int64     `json:"proposal_rewards"`
+	SyncRewards        int64     `json:"sync_rewards"`
+	Penalties          int64     `json:"penalties"`
+	Withdrawals        int64     `json:"withdrawals"`
+	Deposits           int64     `json:"deposits"`
+	Other              int64     `json:"other"`
+	Expected           int64     `json:"expected"`
+	Actual             int64     `json:"actual"`
+	Effectiveness      float64   `json:"effectiveness"`
+}
+
+func newRewardsExportRow(s *models.RewardLedgerSummary) rewardsExportRow {
+	return rewardsExportRow{
+		PeriodStart:        s.PeriodStart.UTC(),
+		Epochs:             s.Epochs,
+		AttestationRewards: s.AttestationRewards,
+		ProposalRewards:    s.ProposalRewards,
+		SyncRewards:        s.SyncRewards,
+		Penalties:          s.Penalties,
+		Withdrawals:        s.Withdrawals,
+		Deposits:           s.Deposits,
+		Other:              s.Other,
+		Expected:           s.Expected(),
+		Actual:             s.Actual(),
+		Effectiveness:      s.Effectiveness(),
+	}
+}
+
 // HandleAlertsPartial handles HTMX partial updates for the alerts section
 func (h *ValidatorDetailHandler) HandleAlertsPartial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -11,6 +11,7 @@ import (
 	"github.com/birddigital/eth-validator-monitor/internal/services/validators"
 	"github.com/birddigital/eth-validator-monitor/internal/web/templates/components"
 	"github.com/birddigital/eth-validator-monitor/internal/web/templates/pages"
+	"github.com/birddigital/eth-validator-monitor/pkg/types"
 )
 
 type ValidatorListHandler struct {
@@ -60,13 +61,19 @@ func (h *ValidatorListHandler) parseFilter(r *http.Request) repository.Validator
 		sortOrder = "asc"
 	}
 
+	credentials := types.CredentialType(query.Get("credentials"))
+	if !credentials.IsValid() {
+		credentials = ""
+	}
+
 	return repository.ValidatorListFilter{
-		Search:    query.Get("search"),
-		Status:    query.Get("status"),
-		SortBy:    query.Get("sort"),
-		SortOrder: sortOrder,
-		Limit:     limit,
-		Offset:    offset,
+		Search:      query.Get("search"),
+		Status:      query.Get("status"),
+		Credentials: string(credentials),
+		SortBy:      query.Get("sort"),
+		SortOrder:   sortOrder,
+		Limit:       limit,
+		Offset:      offset,
 	}
 }
 
@@ -0,0 +1,7 @@
+-- Drop snapshot gap tracking
+BEGIN;
+
+DROP INDEX IF EXISTS idx_snapshot_gaps_validator_status;
+DROP TABLE IF EXISTS snapshot_gaps;
+
+COMMIT;
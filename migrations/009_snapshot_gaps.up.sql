@@ -0,0 +1,26 @@
+-- Migration: Track missing validator snapshot epochs for the gap repair job
+-- Gaps are recorded per validator and epoch; irreparable gaps are kept explicitly
+-- rather than interpolated so charts and uptime calculations can exclude them.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS snapshot_gaps (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'missing' CHECK (status IN ('missing', 'repaired', 'irreparable')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    PRIMARY KEY (validator_index, epoch)
+);
+
+-- Index for per-validator gap counts by status (detail page and metrics)
+CREATE INDEX IF NOT EXISTS idx_snapshot_gaps_validator_status
+ON snapshot_gaps(validator_index, status, epoch DESC);
+
+COMMENT ON TABLE snapshot_gaps IS 'Epochs with no validator snapshot, as detected by the gap repair job';
+COMMENT ON COLUMN snapshot_gaps.status IS 'missing (repair pending), repaired (backfilled from beacon state), irreparable (state unavailable)';
+COMMENT ON COLUMN snapshot_gaps.attempts IS 'Number of repair attempts made for this gap';
+
+COMMIT;
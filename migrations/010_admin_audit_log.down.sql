@@ -0,0 +1,7 @@
+-- Drop the admin audit trail
+BEGIN;
+
+DROP INDEX IF EXISTS idx_admin_audit_log_created_at;
+DROP TABLE IF EXISTS admin_audit_log;
+
+COMMIT;
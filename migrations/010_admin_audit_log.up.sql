@@ -0,0 +1,26 @@
+-- Migration: Audit trail for the collector admin control plane
+-- Every operator action (pause, resume, drain, re-collect) is recorded with who
+-- performed it, how they authenticated, its parameters and whether it succeeded.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL,
+    auth_method VARCHAR(20) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    params JSONB,
+    success BOOLEAN NOT NULL,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing the most recent actions
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_created_at
+ON admin_audit_log(created_at DESC);
+
+COMMENT ON TABLE admin_audit_log IS 'Operator actions taken through the admin REST, GraphQL and CLI interfaces';
+COMMENT ON COLUMN admin_audit_log.actor IS 'Username of the operator, or user ID when no username is available';
+COMMENT ON COLUMN admin_audit_log.auth_method IS 'session, jwt or api_key';
+
+COMMIT;
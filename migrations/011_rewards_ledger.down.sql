@@ -0,0 +1,7 @@
+-- Drop the rewards ledger
+BEGIN;
+
+DROP INDEX IF EXISTS idx_rewards_ledger_validator_time;
+DROP TABLE IF EXISTS validator_rewards_ledger;
+
+COMMIT;
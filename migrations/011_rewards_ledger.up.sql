@@ -0,0 +1,36 @@
+-- Migration: Per-epoch rewards and penalties ledger
+-- Each row breaks a validator's balance change over one epoch down into attestation,
+-- proposal and sync rewards, penalties, withdrawals and deposits, alongside the ideal
+-- attestation reward for its effective balance. Amounts are in Gwei.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS validator_rewards_ledger (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    effective_balance BIGINT NOT NULL,
+    balance_start BIGINT NOT NULL,
+    balance_end BIGINT NOT NULL,
+    attestation_rewards BIGINT NOT NULL DEFAULT 0,
+    proposal_rewards BIGINT NOT NULL DEFAULT 0,
+    sync_rewards BIGINT NOT NULL DEFAULT 0,
+    penalties BIGINT NOT NULL DEFAULT 0,
+    withdrawals BIGINT NOT NULL DEFAULT 0,
+    deposits BIGINT NOT NULL DEFAULT 0,
+    other BIGINT NOT NULL DEFAULT 0,
+    ideal_rewards BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, epoch)
+);
+
+-- Index for day/week/month summaries over a time range
+CREATE INDEX IF NOT EXISTS idx_rewards_ledger_validator_time
+ON validator_rewards_ledger(validator_index, time DESC);
+
+COMMENT ON TABLE validator_rewards_ledger IS 'Per-epoch breakdown of validator balance changes, built by the rewards ledger job';
+COMMENT ON COLUMN validator_rewards_ledger.penalties IS 'Attestation and sync committee penalties, stored as a positive amount';
+COMMENT ON COLUMN validator_rewards_ledger.other IS 'Balance change not explained by the other components (e.g. slashing)';
+COMMENT ON COLUMN validator_rewards_ledger.ideal_rewards IS 'Attestation rewards a perfectly performing validator with the same effective balance would have earned';
+
+COMMIT;
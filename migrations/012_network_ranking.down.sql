@@ -0,0 +1,12 @@
+-- Drop network percentile ranking
+BEGIN;
+
+ALTER TABLE validator_snapshots
+  DROP COLUMN IF EXISTS network_average,
+  DROP COLUMN IF EXISTS network_percentile;
+
+DROP INDEX IF EXISTS idx_network_ranks_validator_time;
+DROP TABLE IF EXISTS validator_network_ranks;
+DROP TABLE IF EXISTS network_epoch_stats;
+
+COMMIT;
@@ -0,0 +1,42 @@
+-- Migration: Network percentile ranking
+-- Each epoch a random sample of the network's active validators is scored on attestation
+-- rewards as a percentage of the ideal for their effective balance. The sample average is
+-- stored per epoch and each monitored validator gets a percentile rank against the sample,
+-- which is also copied onto the snapshots collected during that epoch.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS network_epoch_stats (
+    epoch BIGINT PRIMARY KEY,
+    time TIMESTAMPTZ NOT NULL,
+    sample_size INT NOT NULL,
+    average_score DOUBLE PRECISION NOT NULL,
+    median_score DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS validator_network_ranks (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    percentile DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (validator_index, epoch)
+);
+
+-- Index for per-validator rank history over a time range
+CREATE INDEX IF NOT EXISTS idx_network_ranks_validator_time
+ON validator_network_ranks(validator_index, time DESC);
+
+ALTER TABLE validator_snapshots
+  ADD COLUMN IF NOT EXISTS network_percentile DOUBLE PRECISION,
+  ADD COLUMN IF NOT EXISTS network_average DOUBLE PRECISION;
+
+COMMENT ON TABLE network_epoch_stats IS 'Attestation reward scores of a random sample of active network validators, per epoch';
+COMMENT ON COLUMN network_epoch_stats.average_score IS 'Mean attestation rewards as a percentage of the ideal, across the sample';
+COMMENT ON TABLE validator_network_ranks IS 'Per-epoch attestation reward score and percentile rank of monitored validators against the network sample';
+COMMENT ON COLUMN validator_network_ranks.percentile IS 'Percentage of sampled network validators scoring at or below this validator';
+COMMENT ON COLUMN validator_snapshots.network_percentile IS 'Percentile rank for the epoch the snapshot was collected in';
+COMMENT ON COLUMN validator_snapshots.network_average IS 'Network average attestation reward score for the epoch the snapshot was collected in';
+
+COMMIT;
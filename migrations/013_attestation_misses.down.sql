@@ -0,0 +1,8 @@
+-- Drop attestation miss root causes
+BEGIN;
+
+DROP INDEX IF EXISTS idx_attestation_misses_time;
+DROP TABLE IF EXISTS attestation_misses;
+DROP TABLE IF EXISTS attestation_analysis_epochs;
+
+COMMIT;
@@ -0,0 +1,37 @@
+-- Migration: Attestation miss root causes
+-- Every attestation duty of a monitored validator is checked against the canonical chain
+-- once its inclusion window has passed. Suboptimal attestations are recorded with their
+-- root cause: not included, included late, wrong head or target vote, or delayed only
+-- because the following proposers missed their blocks. Analysed epochs are tracked
+-- separately so epochs without misses are not re-analysed.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS attestation_analysis_epochs (
+    epoch BIGINT PRIMARY KEY,
+    duties INT NOT NULL,
+    misses INT NOT NULL,
+    analyzed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS attestation_misses (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    slot BIGINT NOT NULL,
+    reason VARCHAR(32) NOT NULL CHECK (reason IN ('not_included', 'wrong_target', 'wrong_head', 'late_inclusion', 'missed_block')),
+    inclusion_slot BIGINT,
+    inclusion_delay INT,
+    PRIMARY KEY (validator_index, epoch)
+);
+
+-- Index for aggregating misses over a time range
+CREATE INDEX IF NOT EXISTS idx_attestation_misses_time
+ON attestation_misses(time DESC);
+
+COMMENT ON TABLE attestation_analysis_epochs IS 'Epochs whose attestation duties have been checked against the canonical chain';
+COMMENT ON TABLE attestation_misses IS 'Root cause of each suboptimal attestation by a monitored validator';
+COMMENT ON COLUMN attestation_misses.slot IS 'Slot the validator was assigned to attest in';
+COMMENT ON COLUMN attestation_misses.inclusion_slot IS 'Slot of the first canonical block including the attestation; NULL if never included';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop missed proposal verdicts
+BEGIN;
+
+DROP INDEX IF EXISTS idx_proposal_misses_validator_time;
+DROP TABLE IF EXISTS proposal_misses;
+DROP TABLE IF EXISTS proposal_analysis_epochs;
+
+COMMIT;
@@ -0,0 +1,40 @@
+-- Migration: Missed proposal verdicts
+-- Every proposal duty of a monitored validator is checked against the canonical chain a
+-- couple of epochs after its slot. A proposal that did not become canonical is recorded
+-- with a verdict: no block was published, the block was orphaned by a reorg, the block
+-- arrived late (measured from block events relative to the slot start), or a relay
+-- delivered a payload that was never published. Analysed epochs are tracked separately
+-- so epochs without misses are not re-analysed.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS proposal_analysis_epochs (
+    epoch BIGINT PRIMARY KEY,
+    duties INT NOT NULL,
+    misses INT NOT NULL,
+    analyzed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS proposal_misses (
+    slot BIGINT PRIMARY KEY,
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    reason VARCHAR(32) NOT NULL CHECK (reason IN ('no_block', 'orphaned', 'late', 'relay_failure')),
+    block_root VARCHAR(66),
+    block_delay_ms INT,
+    relay TEXT
+);
+
+-- Index for a validator's misses over a time range
+CREATE INDEX IF NOT EXISTS idx_proposal_misses_validator_time
+ON proposal_misses(validator_index, time DESC);
+
+COMMENT ON TABLE proposal_analysis_epochs IS 'Epochs whose proposal duties have been checked against the canonical chain';
+COMMENT ON TABLE proposal_misses IS 'Verdict for each scheduled proposal by a monitored validator that did not become canonical';
+COMMENT ON COLUMN proposal_misses.time IS 'Start of the slot';
+COMMENT ON COLUMN proposal_misses.block_root IS 'Root of the non-canonical block published for the slot, if one was seen';
+COMMENT ON COLUMN proposal_misses.block_delay_ms IS 'Milliseconds between the slot start and the block event, if observed';
+COMMENT ON COLUMN proposal_misses.relay IS 'Relay that delivered a payload for the slot, for relay failures';
+
+COMMIT;
@@ -0,0 +1,6 @@
+-- Drop the sync committee penalties column from the rewards ledger
+BEGIN;
+
+ALTER TABLE validator_rewards_ledger DROP COLUMN IF EXISTS sync_penalties;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- Migration: Separate sync committee penalties in the rewards ledger
+-- Penalties combine attestation and sync committee penalties. The downtime cost
+-- calculator needs them apart to attribute losses to missed attestations or missed
+-- sync duties, so the sync share is stored alongside. Existing rows default to 0 and
+-- attribute all of their penalties to attestations.
+
+BEGIN;
+
+ALTER TABLE validator_rewards_ledger
+    ADD COLUMN IF NOT EXISTS sync_penalties BIGINT NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN validator_rewards_ledger.sync_penalties IS 'Part of penalties incurred for missed sync committee duties';
+
+COMMIT;
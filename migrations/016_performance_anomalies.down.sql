@@ -0,0 +1,7 @@
+-- Drop performance anomalies
+BEGIN;
+
+DROP INDEX IF EXISTS idx_performance_anomalies_subject_window;
+DROP TABLE IF EXISTS performance_anomalies;
+
+COMMIT;
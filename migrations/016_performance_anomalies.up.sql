@@ -0,0 +1,37 @@
+-- Migration: Performance anomalies
+-- Hourly attestation effectiveness, inclusion delay and attestation income are compared
+-- with an exponentially weighted baseline of each validator's own history, and of the
+-- average of the validators sharing a tag. A recent window that deviates from the
+-- baseline by more than the configured number of standard deviations, in the direction
+-- of worse performance, is recorded here. Each anomaly names either a validator or a
+-- tag; an alert is raised only when an anomaly starts, not for every hour it continues.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS performance_anomalies (
+    id BIGSERIAL PRIMARY KEY,
+    validator_index BIGINT REFERENCES validators(validator_index) ON DELETE CASCADE,
+    tag TEXT,
+    metric VARCHAR(32) NOT NULL CHECK (metric IN ('effectiveness', 'inclusion_delay', 'attestation_income')),
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    baseline DOUBLE PRECISION NOT NULL,
+    deviation DOUBLE PRECISION NOT NULL,
+    observed DOUBLE PRECISION NOT NULL,
+    z_score DOUBLE PRECISION NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((validator_index IS NULL) <> (tag IS NULL))
+);
+
+-- One anomaly per subject, metric and window
+CREATE UNIQUE INDEX IF NOT EXISTS idx_performance_anomalies_subject_window
+ON performance_anomalies(COALESCE(validator_index, -1), COALESCE(tag, ''), metric, window_end);
+
+COMMENT ON TABLE performance_anomalies IS 'Recent performance of a validator or tag group that deviated from its own baseline';
+COMMENT ON COLUMN performance_anomalies.tag IS 'Tag whose monitored validators were averaged, for group anomalies';
+COMMENT ON COLUMN performance_anomalies.window_end IS 'End of the recent window, on an hour boundary';
+COMMENT ON COLUMN performance_anomalies.baseline IS 'Exponentially weighted mean of the hourly values before the window';
+COMMENT ON COLUMN performance_anomalies.deviation IS 'Exponentially weighted standard deviation, floored per metric';
+COMMENT ON COLUMN performance_anomalies.observed IS 'Mean of the hourly values in the window';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop network active balance estimates
+BEGIN;
+
+ALTER TABLE network_epoch_stats
+  DROP COLUMN IF EXISTS active_validators,
+  DROP COLUMN IF EXISTS total_active_balance;
+
+COMMIT;
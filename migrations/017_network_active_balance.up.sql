@@ -0,0 +1,18 @@
+-- Migration: Network active balance
+-- The network rank job already queries the status and effective balance of a uniform random
+-- sample of the validator set each epoch. The share of the sample that is active, scaled to
+-- the size of the validator set, estimates the number of active validators and their total
+-- effective balance, which proposal luck analysis uses to work out how many proposals and sync
+-- committee seats monitored validators should expect. Epochs sampled before this migration
+-- have zero, meaning unknown.
+
+BEGIN;
+
+ALTER TABLE network_epoch_stats
+  ADD COLUMN IF NOT EXISTS active_validators BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS total_active_balance BIGINT NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN network_epoch_stats.active_validators IS 'Estimated number of active validators; 0 if unknown';
+COMMENT ON COLUMN network_epoch_stats.total_active_balance IS 'Estimated total effective balance of active validators in Gwei; 0 if unknown';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Drop effective balance steps
+BEGIN;
+
+DROP INDEX IF EXISTS idx_effective_balance_steps_time;
+DROP TABLE IF EXISTS effective_balance_steps;
+
+COMMIT;
@@ -0,0 +1,32 @@
+-- Migration: Effective balance steps
+-- An active validator's effective balance only moves when its balance crosses one of the
+-- hysteresis thresholds around it: 0.25 ETH below, or 1.25 ETH above. Each change between
+-- consecutive rewards ledger epochs is recorded here with the penalties and withdrawals of
+-- the day before, so a step down caused by penalties raises a single alert and a step
+-- caused by a withdrawal does not.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS effective_balance_steps (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    epoch BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    previous_effective_balance BIGINT NOT NULL,
+    effective_balance BIGINT NOT NULL,
+    balance BIGINT NOT NULL,
+    penalties BIGINT NOT NULL DEFAULT 0,
+    withdrawals BIGINT NOT NULL DEFAULT 0,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, epoch)
+);
+
+CREATE INDEX IF NOT EXISTS idx_effective_balance_steps_time
+ON effective_balance_steps(time DESC);
+
+COMMENT ON TABLE effective_balance_steps IS 'Changes in effective balance between consecutive rewards ledger epochs';
+COMMENT ON COLUMN effective_balance_steps.epoch IS 'First epoch with the new effective balance';
+COMMENT ON COLUMN effective_balance_steps.balance IS 'Balance at the start of the epoch in Gwei';
+COMMENT ON COLUMN effective_balance_steps.penalties IS 'Penalties over the 225 epochs before the step in Gwei';
+COMMENT ON COLUMN effective_balance_steps.withdrawals IS 'Withdrawals over the 225 epochs before the step in Gwei';
+
+COMMIT;
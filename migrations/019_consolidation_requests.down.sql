@@ -0,0 +1,8 @@
+-- Drop consolidation requests
+BEGIN;
+
+DROP INDEX IF EXISTS idx_consolidation_requests_target;
+DROP INDEX IF EXISTS idx_consolidation_requests_source;
+DROP TABLE IF EXISTS consolidation_requests;
+
+COMMIT;
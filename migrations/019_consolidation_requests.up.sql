@@ -0,0 +1,31 @@
+-- Migration: Consolidation requests
+-- Since Electra, an execution layer request can merge one validator's balance into another
+-- with compounding (0x02) credentials, or switch a validator to compounding credentials by
+-- naming it as both source and target (EIP-7251). Requests in blocks accounted by the
+-- rewards ledger that name a monitored validator are recorded here, with the indices of
+-- the monitored validators involved.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS consolidation_requests (
+    id BIGSERIAL PRIMARY KEY,
+    slot BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    source_address VARCHAR(42) NOT NULL,
+    source_pubkey VARCHAR(98) NOT NULL,
+    target_pubkey VARCHAR(98) NOT NULL,
+    source_index BIGINT REFERENCES validators(validator_index) ON DELETE SET NULL,
+    target_index BIGINT REFERENCES validators(validator_index) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (slot, source_pubkey, target_pubkey)
+);
+
+CREATE INDEX IF NOT EXISTS idx_consolidation_requests_source ON consolidation_requests(source_index, time DESC);
+CREATE INDEX IF NOT EXISTS idx_consolidation_requests_target ON consolidation_requests(target_index, time DESC);
+
+COMMENT ON TABLE consolidation_requests IS 'EIP-7251 consolidation requests naming a monitored validator';
+COMMENT ON COLUMN consolidation_requests.source_address IS 'Execution address that sent the request, which must match the source withdrawal credentials';
+COMMENT ON COLUMN consolidation_requests.source_index IS 'Source validator when monitored';
+COMMENT ON COLUMN consolidation_requests.target_index IS 'Target validator when monitored; equal to source_index for a switch to compounding';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop withdrawal credential changes and execution layer withdrawal requests
+BEGIN;
+
+DROP INDEX IF EXISTS idx_validator_withdrawal_requests_validator;
+DROP TABLE IF EXISTS validator_withdrawal_requests;
+DROP TABLE IF EXISTS validator_credential_changes;
+
+COMMIT;
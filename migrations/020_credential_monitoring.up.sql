@@ -0,0 +1,39 @@
+-- Migration: Withdrawal credential changes and execution layer withdrawal requests
+-- A BLS to execution change permanently fixes where a validator's balance is withdrawn to,
+-- and since Pectra the holder of that address can exit the validator or withdraw from it
+-- with an execution layer request (EIP-7002). Both operations are recorded here when they
+-- name a monitored validator, so an unexpected change or exit can be traced.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS validator_credential_changes (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    slot BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    from_bls_pubkey VARCHAR(98) NOT NULL,
+    to_execution_address VARCHAR(42) NOT NULL,
+    allowlisted BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, slot)
+);
+
+CREATE TABLE IF NOT EXISTS validator_withdrawal_requests (
+    id BIGSERIAL PRIMARY KEY,
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    slot BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    source_address VARCHAR(42) NOT NULL,
+    amount BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (validator_index, slot, source_address, amount)
+);
+
+CREATE INDEX IF NOT EXISTS idx_validator_withdrawal_requests_validator
+    ON validator_withdrawal_requests(validator_index, time DESC);
+
+COMMENT ON TABLE validator_credential_changes IS 'BLS to execution changes of monitored validators';
+COMMENT ON COLUMN validator_credential_changes.allowlisted IS 'Whether the address was on the allowlist for one of the validator''s groups when the change was seen';
+COMMENT ON TABLE validator_withdrawal_requests IS 'EIP-7002 execution layer withdrawal and exit requests for monitored validators';
+COMMENT ON COLUMN validator_withdrawal_requests.amount IS 'Requested amount in Gwei; zero requests a full exit';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop validator discovery rules and discovered deposits
+BEGIN;
+
+DROP INDEX IF EXISTS idx_discovered_deposits_pending;
+DROP TABLE IF EXISTS discovered_deposits;
+DROP TABLE IF EXISTS discovery_rules;
+
+COMMIT;
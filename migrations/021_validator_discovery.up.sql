@@ -0,0 +1,41 @@
+-- Migration: Validator discovery
+-- Operators with many keys register discovery rules instead of adding validators one at a
+-- time. A rule matches validators by withdrawal address or credentials, by the fee recipient
+-- of blocks they propose, or by the address that sent their deposits, and enrolls every match
+-- as a monitored validator under the rule's tag. Deposits found for a depositor rule are kept
+-- until the beacon chain assigns the validator an index.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS discovery_rules (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('withdrawal', 'fee_recipient', 'depositor')),
+    value VARCHAR(66) NOT NULL,
+    tag VARCHAR(100) NOT NULL,
+    next_block BIGINT NOT NULL DEFAULT 0,
+    enrolled INTEGER NOT NULL DEFAULT 0,
+    last_run_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, value, tag)
+);
+
+CREATE TABLE IF NOT EXISTS discovered_deposits (
+    rule_id BIGINT NOT NULL REFERENCES discovery_rules(id) ON DELETE CASCADE,
+    pubkey VARCHAR(98) NOT NULL,
+    block_number BIGINT NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    validator_index BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rule_id, pubkey)
+);
+
+CREATE INDEX IF NOT EXISTS idx_discovered_deposits_pending
+    ON discovered_deposits(rule_id) WHERE validator_index IS NULL;
+
+COMMENT ON TABLE discovery_rules IS 'Rules that enroll matching validators as monitored validators under a tag';
+COMMENT ON COLUMN discovery_rules.value IS 'Lowercase execution address, or full withdrawal credentials for withdrawal rules';
+COMMENT ON COLUMN discovery_rules.next_block IS 'First execution block not yet searched for deposits, for depositor rules';
+COMMENT ON TABLE discovered_deposits IS 'Deposits sent by the address of a depositor rule';
+COMMENT ON COLUMN discovered_deposits.validator_index IS 'Set once the beacon chain has assigned the deposited key an index';
+
+COMMIT;
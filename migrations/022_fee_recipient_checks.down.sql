@@ -0,0 +1,8 @@
+-- Drop fee recipient verification
+BEGIN;
+
+DROP INDEX IF EXISTS idx_fee_recipient_checks_validator_time;
+DROP TABLE IF EXISTS fee_recipient_checks;
+DROP TABLE IF EXISTS validator_fee_recipients;
+
+COMMIT;
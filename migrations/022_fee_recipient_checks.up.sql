@@ -0,0 +1,39 @@
+-- Migration: Fee recipient verification
+-- Every block proposed by a monitored validator is checked for where its execution rewards
+-- went. Locally built blocks must name an expected address as fee recipient; MEV-boost blocks
+-- name the builder, which pays the proposer in the block's final transaction. Expected
+-- addresses come from validator tags in the configuration, or from a per-validator override
+-- stored here.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS validator_fee_recipients (
+    validator_index BIGINT PRIMARY KEY REFERENCES validators(validator_index) ON DELETE CASCADE,
+    address VARCHAR(42) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS fee_recipient_checks (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    slot BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    block_hash VARCHAR(66) NOT NULL,
+    fee_recipient VARCHAR(42) NOT NULL,
+    expected TEXT[] NOT NULL,
+    payment_to VARCHAR(42),
+    payment_gwei BIGINT,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('compliant', 'builder_paid', 'mismatch')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, slot)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fee_recipient_checks_validator_time
+    ON fee_recipient_checks(validator_index, time DESC);
+
+COMMENT ON TABLE validator_fee_recipients IS 'Expected fee recipient per validator, overriding the addresses configured for its tags';
+COMMENT ON TABLE fee_recipient_checks IS 'Where the execution rewards of each block proposed by a monitored validator went';
+COMMENT ON COLUMN fee_recipient_checks.expected IS 'Addresses the rewards were expected to reach when the block was checked';
+COMMENT ON COLUMN fee_recipient_checks.payment_to IS 'Recipient of the block''s final transaction, for blocks whose fee recipient is a builder';
+COMMENT ON COLUMN fee_recipient_checks.payment_gwei IS 'Value of the block''s final transaction in Gwei, for blocks whose fee recipient is a builder';
+
+COMMIT;
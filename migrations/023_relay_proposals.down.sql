@@ -0,0 +1,8 @@
+-- Drop MEV-boost relay monitoring
+BEGIN;
+
+DROP INDEX IF EXISTS idx_relay_proposals_time;
+DROP INDEX IF EXISTS idx_relay_proposals_validator_time;
+DROP TABLE IF EXISTS relay_proposals;
+
+COMMIT;
@@ -0,0 +1,34 @@
+-- Migration: MEV-boost relay monitoring
+-- Every block proposed by a monitored validator is matched against the payloads the configured
+-- relays report delivering. Relay blocks record the relays, builder and the value the bid
+-- promised alongside the payment the proposer actually received; locally built blocks are
+-- flagged as fallbacks when the validator is registered with a relay.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS relay_proposals (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    slot BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    block_hash VARCHAR(66) NOT NULL,
+    source VARCHAR(20) NOT NULL CHECK (source IN ('relay', 'local', 'fallback')),
+    relays TEXT[] NOT NULL DEFAULT '{}',
+    builder_pubkey VARCHAR(98),
+    promised_gwei BIGINT,
+    received_gwei BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, slot)
+);
+
+CREATE INDEX IF NOT EXISTS idx_relay_proposals_validator_time
+    ON relay_proposals(validator_index, time DESC);
+CREATE INDEX IF NOT EXISTS idx_relay_proposals_time
+    ON relay_proposals(time DESC);
+
+COMMENT ON TABLE relay_proposals IS 'How each block proposed by a monitored validator was built, and what relay blocks paid';
+COMMENT ON COLUMN relay_proposals.source IS 'relay, local, or fallback for a local block by a validator registered with a relay';
+COMMENT ON COLUMN relay_proposals.relays IS 'Relays that reported delivering the block''s payload';
+COMMENT ON COLUMN relay_proposals.promised_gwei IS 'Bid value the relays reported for the payload, in Gwei';
+COMMENT ON COLUMN relay_proposals.received_gwei IS 'Builder payment to the proposer''s fee recipient in the block''s final transaction, in Gwei';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop graffiti and client diversity tracking
+BEGIN;
+
+DROP INDEX IF EXISTS idx_block_clients_proposer_slot;
+DROP INDEX IF EXISTS idx_block_clients_epoch;
+DROP TABLE IF EXISTS block_clients;
+
+COMMIT;
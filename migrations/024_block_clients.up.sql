@@ -0,0 +1,29 @@
+-- Migration: Graffiti and client diversity tracking
+-- The graffiti of every canonical block is fingerprinted for the consensus client that produced
+-- it, from client version codes or default client graffiti. Network client diversity per epoch
+-- is aggregated from these rows, and monitored validators are attributed to the client of their
+-- most recent proposals.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS block_clients (
+    slot BIGINT PRIMARY KEY,
+    epoch BIGINT NOT NULL,
+    proposer_index BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    graffiti TEXT NOT NULL DEFAULT '',
+    client VARCHAR(20) NOT NULL,
+    version VARCHAR(64) NOT NULL DEFAULT '',
+    execution_client VARCHAR(20) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_block_clients_epoch ON block_clients(epoch);
+CREATE INDEX IF NOT EXISTS idx_block_clients_proposer_slot ON block_clients(proposer_index, slot DESC);
+
+COMMENT ON TABLE block_clients IS 'Consensus client identified from the graffiti of each canonical block';
+COMMENT ON COLUMN block_clients.client IS 'Consensus client, or unknown when the graffiti does not identify one';
+COMMENT ON COLUMN block_clients.version IS 'Client release, or commit prefix from a client version code';
+COMMENT ON COLUMN block_clients.execution_client IS 'Execution client named by a client version code';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop validator client and remote signer health
+BEGIN;
+
+DROP INDEX IF EXISTS idx_signer_keys_pubkey;
+DROP TABLE IF EXISTS signer_keys;
+DROP TABLE IF EXISTS signer_endpoints;
+
+COMMIT;
@@ -0,0 +1,39 @@
+-- Migration: Validator client and remote signer health
+-- Validator client Prometheus endpoints, keymanager APIs and Web3Signer instances are scraped
+-- periodically. The latest outcome of each endpoint is kept, together with the keys keymanager
+-- APIs and Web3Signer report loaded, so that monitored validators whose key is loaded in no
+-- validator client, or in more than one, can be found.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS signer_endpoints (
+    name VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('metrics', 'keymanager', 'web3signer')),
+    url TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('healthy', 'degraded', 'unhealthy')),
+    message TEXT NOT NULL DEFAULT '',
+    key_count INTEGER,
+    checked_at TIMESTAMPTZ NOT NULL,
+    healthy_at TIMESTAMPTZ,
+    PRIMARY KEY (name, kind)
+);
+
+CREATE TABLE IF NOT EXISTS signer_keys (
+    name VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    pubkey VARCHAR(98) NOT NULL,
+    remote BOOLEAN NOT NULL DEFAULT FALSE,
+    seen_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (name, kind, pubkey)
+);
+
+CREATE INDEX IF NOT EXISTS idx_signer_keys_pubkey ON signer_keys(pubkey);
+
+COMMENT ON TABLE signer_endpoints IS 'Latest scrape of each validator client and remote signer endpoint';
+COMMENT ON COLUMN signer_endpoints.name IS 'Validator client or signer name; endpoints sharing a name belong to the same validator client';
+COMMENT ON COLUMN signer_endpoints.key_count IS 'Keys the endpoint reported loaded, NULL for endpoints that do not list keys';
+COMMENT ON COLUMN signer_endpoints.healthy_at IS 'Last time the endpoint was healthy';
+COMMENT ON TABLE signer_keys IS 'Keys loaded per endpoint as of its last successful scrape';
+COMMENT ON COLUMN signer_keys.remote IS 'Whether the validator client signs for the key through a remote signer';
+
+COMMIT;
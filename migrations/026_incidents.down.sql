@@ -0,0 +1,10 @@
+-- Drop correlated failure incidents
+BEGIN;
+
+DROP INDEX IF EXISTS idx_incident_alerts_incident;
+DROP INDEX IF EXISTS idx_incidents_unresolved;
+DROP INDEX IF EXISTS idx_incidents_started_at;
+DROP TABLE IF EXISTS incident_alerts;
+DROP TABLE IF EXISTS incidents;
+
+COMMIT;
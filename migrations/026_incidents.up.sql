@@ -0,0 +1,44 @@
+-- Migration: Correlated failure incidents
+-- Alerts raised close together for the same failure type on the same validator client or tag are
+-- grouped into a single incident, so that a dead beacon node surfaces as one incident rather than
+-- hundreds of alerts. Incidents are acknowledged and resolved as a whole, and each alert belongs to
+-- at most one incident.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS incidents (
+    id BIGSERIAL PRIMARY KEY,
+    failure_type VARCHAR(50) NOT NULL,
+    group_kind VARCHAR(10) NOT NULL CHECK (group_kind IN ('node', 'tag', 'fleet')),
+    group_value VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'acknowledged', 'resolved')),
+    started_at TIMESTAMPTZ NOT NULL,
+    last_alert_at TIMESTAMPTZ NOT NULL,
+    acknowledged_at TIMESTAMPTZ,
+    acknowledged_by VARCHAR(255),
+    resolved_at TIMESTAMPTZ,
+    resolved_by VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS incident_alerts (
+    alert_id BIGINT PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    incident_id BIGINT NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_incidents_started_at ON incidents(started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_incidents_unresolved ON incidents(failure_type, group_kind, group_value)
+    WHERE status <> 'resolved';
+CREATE INDEX IF NOT EXISTS idx_incident_alerts_incident ON incident_alerts(incident_id);
+
+COMMENT ON TABLE incidents IS 'Groups of alerts of one failure type raised close together on the same validator client or tag';
+COMMENT ON COLUMN incidents.failure_type IS 'Alert type shared by the member alerts';
+COMMENT ON COLUMN incidents.group_kind IS 'node: validators whose key is loaded in one validator client, tag: validators sharing a tag, fleet: anything else';
+COMMENT ON COLUMN incidents.group_value IS 'Validator client name or tag; empty for the fleet';
+COMMENT ON COLUMN incidents.started_at IS 'Creation time of the earliest member alert';
+COMMENT ON COLUMN incidents.last_alert_at IS 'Creation time of the latest member alert';
+COMMENT ON COLUMN incidents.resolved_by IS 'User who resolved the incident; NULL when it resolved itself with its member alerts';
+COMMENT ON TABLE incident_alerts IS 'Member alerts of each incident';
+
+COMMIT;
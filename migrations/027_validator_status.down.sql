@@ -0,0 +1,8 @@
+-- Drop validator lifecycle status tracking
+BEGIN;
+
+DROP INDEX IF EXISTS idx_validator_status_transitions_time;
+DROP TABLE IF EXISTS validator_status_transitions;
+ALTER TABLE validators DROP COLUMN IF EXISTS status;
+
+COMMIT;
@@ -0,0 +1,32 @@
+-- Migration: Validator lifecycle status and transition history
+-- The beacon API reports each validator's status in the consensus lifecycle, from pending through
+-- active and exited to withdrawn. The latest status is kept on the validator, and every status a
+-- validator enters is recorded with the epoch it began, so that it is known when a validator
+-- became active, started exiting or became withdrawable.
+
+BEGIN;
+
+ALTER TABLE validators ADD COLUMN IF NOT EXISTS status VARCHAR(32);
+
+CREATE TABLE IF NOT EXISTS validator_status_transitions (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    status VARCHAR(32) NOT NULL CHECK (status IN (
+        'pending_initialized', 'pending_queued', 'active_ongoing', 'active_exiting', 'active_slashed',
+        'exited_unslashed', 'exited_slashed', 'withdrawal_possible', 'withdrawal_done'
+    )),
+    previous_status VARCHAR(32),
+    epoch BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    observed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, status)
+);
+
+CREATE INDEX IF NOT EXISTS idx_validator_status_transitions_time
+    ON validator_status_transitions(time DESC);
+
+COMMENT ON COLUMN validators.status IS 'Lifecycle status last reported by the beacon node; NULL until first observed';
+COMMENT ON TABLE validator_status_transitions IS 'Lifecycle statuses entered by each validator; a validator enters each status at most once';
+COMMENT ON COLUMN validator_status_transitions.epoch IS 'Epoch the status began, or the epoch it was first observed when the chain does not record one (pending_initialized, active_exiting, active_slashed, withdrawal_done)';
+COMMENT ON COLUMN validator_status_transitions.previous_status IS 'Status on record before this one; NULL for the first status observed';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Drop deposits to monitored validators
+BEGIN;
+
+DROP INDEX IF EXISTS idx_validator_deposits_pending;
+DROP INDEX IF EXISTS idx_validator_deposits_validator;
+DROP TABLE IF EXISTS validator_deposits;
+
+COMMIT;
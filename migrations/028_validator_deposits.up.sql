@@ -0,0 +1,40 @@
+-- Migration: Deposits to monitored validators
+-- Since Pectra every deposit, whether a deposit contract operation or an execution layer deposit
+-- request (EIP-6110), waits in the beacon state's deposit queue before it is credited. Deposits
+-- naming a monitored validator are recorded from the blocks that include them and from the queue,
+-- with their place in the queue while pending, so top-ups are not mistaken for income and an
+-- unexpected deposit can be traced.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS validator_deposits (
+    id BIGSERIAL PRIMARY KEY,
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    slot BIGINT NOT NULL,
+    time TIMESTAMPTZ NOT NULL,
+    withdrawal_credentials VARCHAR(66) NOT NULL,
+    signature VARCHAR(194) NOT NULL,
+    amount BIGINT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'credited')),
+    queue_position BIGINT,
+    queue_amount_ahead BIGINT,
+    credited_epoch BIGINT,
+    unexpected_reason TEXT,
+    observed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (validator_index, slot, signature, amount)
+);
+
+CREATE INDEX IF NOT EXISTS idx_validator_deposits_validator
+    ON validator_deposits(validator_index, time DESC);
+
+CREATE INDEX IF NOT EXISTS idx_validator_deposits_pending
+    ON validator_deposits(validator_index) WHERE status = 'pending';
+
+COMMENT ON TABLE validator_deposits IS 'Deposits to monitored validators seen in blocks or in the beacon state deposit queue';
+COMMENT ON COLUMN validator_deposits.slot IS 'Slot of the block that included the deposit; 0 for deposits only seen in the queue without one';
+COMMENT ON COLUMN validator_deposits.queue_position IS 'Zero-based position in the deposit queue when last observed; NULL once credited';
+COMMENT ON COLUMN validator_deposits.queue_amount_ahead IS 'Gwei queued ahead of the deposit when last observed; NULL once credited';
+COMMENT ON COLUMN validator_deposits.credited_epoch IS 'First epoch the deposit was observed to have left the queue';
+COMMENT ON COLUMN validator_deposits.unexpected_reason IS 'Why the deposit was alerted on; NULL for expected deposits';
+
+COMMIT;
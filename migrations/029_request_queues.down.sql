@@ -0,0 +1,7 @@
+-- Drop the request queue entries of monitored validators
+BEGIN;
+
+DROP TABLE IF EXISTS validator_queued_consolidations;
+DROP TABLE IF EXISTS validator_queued_withdrawals;
+
+COMMIT;
@@ -0,0 +1,29 @@
+-- Migration: Monitored validators in the beacon state's request queues
+-- Execution layer withdrawal requests (EIP-7002) and consolidation requests (EIP-7251) wait in
+-- the beacon state's partial withdrawal and consolidation queues once processed. The queues are
+-- polled once an epoch, so a request is seen even when the block that carried it was not scanned,
+-- for example because it predates the monitor or the validator was added later.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS validator_queued_withdrawals (
+    validator_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    withdrawable_epoch BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (validator_index, withdrawable_epoch, amount)
+);
+
+CREATE TABLE IF NOT EXISTS validator_queued_consolidations (
+    source_index BIGINT NOT NULL REFERENCES validators(validator_index) ON DELETE CASCADE,
+    target_index BIGINT NOT NULL,
+    first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (source_index, target_index)
+);
+
+COMMENT ON TABLE validator_queued_withdrawals IS 'Partial withdrawal queue entries of monitored validators';
+COMMENT ON COLUMN validator_queued_withdrawals.amount IS 'Queued amount in Gwei';
+COMMENT ON TABLE validator_queued_consolidations IS 'Consolidation queue entries whose source is a monitored validator';
+COMMENT ON COLUMN validator_queued_consolidations.target_index IS 'Validator the source balance moves to; it need not be monitored';
+
+COMMIT;
@@ -0,0 +1,12 @@
+-- Drop unverified relay proposals
+BEGIN;
+
+DELETE FROM relay_proposals WHERE source = 'unverified';
+
+ALTER TABLE relay_proposals DROP CONSTRAINT IF EXISTS relay_proposals_source_check;
+ALTER TABLE relay_proposals ADD CONSTRAINT relay_proposals_source_check
+    CHECK (source IN ('relay', 'local', 'fallback'));
+
+COMMENT ON COLUMN relay_proposals.source IS 'relay, local, or fallback for a local block by a validator registered with a relay';
+
+COMMIT;
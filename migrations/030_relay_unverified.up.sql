@@ -0,0 +1,13 @@
+-- Migration: Unverified relay proposals
+-- A block whose source depends on relays that stay unreachable is retried a bounded number of
+-- times and then recorded as unverified, so one relay being down does not hold up the rest.
+
+BEGIN;
+
+ALTER TABLE relay_proposals DROP CONSTRAINT IF EXISTS relay_proposals_source_check;
+ALTER TABLE relay_proposals ADD CONSTRAINT relay_proposals_source_check
+    CHECK (source IN ('relay', 'local', 'fallback', 'unverified'));
+
+COMMENT ON COLUMN relay_proposals.source IS 'relay, local, fallback for a local block by a validator registered with a relay, or unverified when relays stayed unreachable';
+
+COMMIT;
@@ -27,6 +27,24 @@ const (
 	AlertTypeLowPeerCount         AlertType = "low_peer_count"
 	AlertTypeValidatorActivated   AlertType = "validator_activated"
 	AlertTypeRewardsMilestone     AlertType = "rewards_milestone"
+	AlertTypeCredentialChange     AlertType = "withdrawal_credentials_changed"
+	AlertTypeWithdrawalRequest    AlertType = "withdrawal_requested"
+	AlertTypeConsolidationRequest AlertType = "consolidation_requested"
+	AlertTypeFeeRecipientMismatch AlertType = "fee_recipient_mismatch"
+	AlertTypeRelayFallback        AlertType = "relay_fallback"
+	AlertTypeRelayUnderpaid       AlertType = "relay_underpaid"
+	AlertTypeRelayUnreachable     AlertType = "relay_unreachable"
+	AlertTypeClientConcentration  AlertType = "client_concentration"
+	AlertTypeSignerUnhealthy      AlertType = "signer_unhealthy"
+	AlertTypeKeyNotLoaded         AlertType = "key_not_loaded"
+	AlertTypeKeyDuplicated        AlertType = "key_duplicated"
+	AlertTypeRemoteKeyMissing     AlertType = "remote_key_missing"
+	AlertTypeValidatorExiting     AlertType = "validator_exiting"
+	AlertTypeValidatorExited      AlertType = "validator_exited"
+	AlertTypeWithdrawable         AlertType = "validator_withdrawable"
+	AlertTypeValidatorWithdrawn   AlertType = "validator_withdrawn"
+	AlertTypeUnexpectedDeposit    AlertType = "unexpected_deposit"
+	AlertTypeBeaconUnverified     AlertType = "beacon_unverified"
 )
 
 // Alert represents a system alert
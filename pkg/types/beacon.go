@@ -62,6 +62,7 @@ type ValidatorInfo struct {
 // Attestation represents a validator attestation
 type Attestation struct {
 	AggregationBits string          `json:"aggregation_bits"`
+	CommitteeBits   string          `json:"committee_bits,omitempty"` // From Electra: the committees aggregated
 	Data            AttestationData `json:"data"`
 	Signature       string          `json:"signature"`
 }
@@ -109,5 +110,6 @@ type NetworkStats struct {
 	AverageBalance       *big.Int  `json:"average_balance"`
 	TotalStaked          *big.Int  `json:"total_staked"`
 	ParticipationRate    float64   `json:"participation_rate"`
+	ClientDiversity      *ClientDiversity `json:"client_diversity,omitempty"` // Latest completed epoch the node's blocks were fetched for
 	Timestamp            time.Time `json:"timestamp"`
 }
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"context"
+	"math/big"
+)
+
+// BlockClient retrieves decoded beacon blocks
+type BlockClient interface {
+	// GetBlock retrieves the block at a slot (nil for a missed slot)
+	GetBlock(ctx context.Context, slot int) (*Block, error)
+}
+
+// Block is the part of a decoded beacon block the monitor uses: the proposer, graffiti and payload
+// details, the balance transfers it includes, and the operations and execution layer requests that
+// will change a validator's credentials or balance once processed
+type Block struct {
+	Slot               int                    `json:"slot"`
+	ProposerIndex      int                    `json:"proposer_index"`
+	Graffiti           string                 `json:"graffiti"` // Decoded graffiti text
+	FeeRecipient       string                 `json:"fee_recipient"`
+	BlockHash          string                 `json:"block_hash"`        // Execution payload block hash
+	FinalTransaction   *PayloadTransaction    `json:"final_transaction"` // Last transaction in the payload, where MEV-boost builders pay the proposer
+	Withdrawals        []Withdrawal           `json:"withdrawals"`
+	Deposits           []Deposit              `json:"deposits"`
+	Consolidations     []ConsolidationRequest `json:"consolidations"`
+	CredentialChanges  []CredentialChange     `json:"credential_changes"`
+	WithdrawalRequests []WithdrawalRequest    `json:"withdrawal_requests"`
+}
+
+// PayloadTransaction is the recipient and value of a transaction in an execution payload
+type PayloadTransaction struct {
+	To    string   `json:"to"`    // Empty for contract creation
+	Value *big.Int `json:"value"` // Wei
+}
+
+// Withdrawal is a withdrawal from a validator balance to the execution layer
+type Withdrawal struct {
+	Index          int64  `json:"index"`
+	ValidatorIndex int    `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         int64  `json:"amount"`
+}
+
+// ConsolidationRequest is an execution layer request to merge the source validator into the
+// target (EIP-7251). A request whose source and target are the same switches the validator to
+// compounding credentials.
+type ConsolidationRequest struct {
+	SourceAddress string `json:"source_address"`
+	SourcePubkey  string `json:"source_pubkey"`
+	TargetPubkey  string `json:"target_pubkey"`
+}
+
+// CredentialChange is a BLS to execution change, which permanently replaces a validator's 0x00
+// withdrawal credentials with an execution address
+type CredentialChange struct {
+	ValidatorIndex     int    `json:"validator_index"`
+	FromBLSPubkey      string `json:"from_bls_pubkey"`
+	ToExecutionAddress string `json:"to_execution_address"`
+}
+
+// WithdrawalRequest is an execution layer request from a validator's withdrawal address to
+// withdraw from it (EIP-7002). An amount of zero requests a full exit.
+type WithdrawalRequest struct {
+	SourceAddress   string `json:"source_address"`
+	ValidatorPubkey string `json:"validator_pubkey"`
+	Amount          int64  `json:"amount"`
+}
+
+// Deposit is a deposit to a validator, identified by public key: a deposit contract operation or,
+// since Pectra, an execution layer deposit request (EIP-6110). Either way it joins the state's
+// deposit queue and is only credited once it reaches the front.
+type Deposit struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                int64  `json:"amount"`
+	Signature             string `json:"signature"`
+}
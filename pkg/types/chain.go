@@ -0,0 +1,80 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+// Beacon chain parameters (mainnet preset)
+const (
+	SlotsPerEpoch  = 32
+	SecondsPerSlot = 12
+
+	SyncCommitteeSize            = 512 // Seats in each sync committee
+	EpochsPerSyncCommitteePeriod = 256 // Epochs each sync committee serves
+
+	// MainnetGenesisTime is the mainnet beacon chain genesis timestamp (Unix seconds)
+	MainnetGenesisTime int64 = 1606824023
+)
+
+// ErrStateUnavailable is returned when the beacon node no longer holds the requested
+// historical state (e.g. a pruned, non-archive node)
+var ErrStateUnavailable = errors.New("beacon state unavailable")
+
+// SpecClient retrieves the configuration of the chain the beacon node serves
+type SpecClient interface {
+	// GetForkSchedule retrieves the forks from Altair onwards that the node's chain spec
+	// schedules, in activation order
+	GetForkSchedule(ctx context.Context) ([]Fork, error)
+
+	// GetGenesis retrieves the genesis of the node's chain
+	GetGenesis(ctx context.Context) (*Genesis, error)
+}
+
+// Genesis identifies a beacon chain by its genesis
+type Genesis struct {
+	Time           int64  // Unix seconds
+	ValidatorsRoot string // Genesis validators root, mixed into signing domains
+	ForkVersion    string // 4-byte genesis fork version, hex
+}
+
+// ForkEpoch returns the activation epoch of the named fork, or math.MaxInt64 if it is not scheduled
+func ForkEpoch(forks []Fork, name string) int64 {
+	for _, fork := range forks {
+		if fork.Name == name {
+			return fork.Epoch
+		}
+	}
+	return math.MaxInt64
+}
+
+// EpochDuration is the wall-clock length of a single epoch
+const EpochDuration = SlotsPerEpoch * SecondsPerSlot * time.Second
+
+// EpochStartTime returns the wall-clock time at which the given epoch starts
+func EpochStartTime(genesis time.Time, epoch int64) time.Time {
+	return genesis.Add(time.Duration(epoch) * EpochDuration)
+}
+
+// EpochAtTime returns the epoch in progress at the given time
+func EpochAtTime(genesis time.Time, t time.Time) int64 {
+	if t.Before(genesis) {
+		return 0
+	}
+	return int64(t.Sub(genesis) / EpochDuration)
+}
+
+// SlotStartTime returns the wall-clock time at which the given slot starts
+func SlotStartTime(genesis time.Time, slot int64) time.Time {
+	return genesis.Add(time.Duration(slot) * SecondsPerSlot * time.Second)
+}
+
+// SlotAtTime returns the slot in progress at the given time
+func SlotAtTime(genesis time.Time, t time.Time) int64 {
+	if t.Before(genesis) {
+		return 0
+	}
+	return int64(t.Sub(genesis) / (SecondsPerSlot * time.Second))
+}
@@ -0,0 +1,49 @@
+package types
+
+import "context"
+
+// DiscoveryClient finds validators in the beacon state and the blocks they propose
+type DiscoveryClient interface {
+	// ScanValidators calls fn for each validator in the head state matching ids (indices or
+	// public keys), or for every validator when ids is empty. Scanning stops at the first error
+	// returned by fn.
+	ScanValidators(ctx context.Context, ids []string, fn func(ValidatorIdentity) error) error
+
+	// The proposer, fee recipient and operations of each proposed block
+	BlockClient
+}
+
+// DepositSource retrieves deposit contract events from an execution client
+type DepositSource interface {
+	// BlockNumber retrieves the number of the latest execution block
+	BlockNumber(ctx context.Context) (int64, error)
+
+	// GetDepositLogs retrieves the deposit events emitted in [fromBlock, toBlock]
+	GetDepositLogs(ctx context.Context, fromBlock, toBlock int64) ([]DepositLog, error)
+
+	// GetTransactionSender retrieves the address that sent a transaction
+	GetTransactionSender(ctx context.Context, txHash string) (string, error)
+}
+
+// ValidatorIdentity identifies a validator in the beacon state, with its lifecycle status and
+// epochs. Epochs the validator has not been scheduled for are nil.
+type ValidatorIdentity struct {
+	Index                      int64  `json:"index"`
+	Pubkey                     string `json:"pubkey"`
+	WithdrawalCredentials      string `json:"withdrawal_credentials"`
+	Status                     string `json:"status"`
+	Slashed                    bool   `json:"slashed"`
+	ActivationEligibilityEpoch *int64 `json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            *int64 `json:"activation_epoch,omitempty"`
+	ExitEpoch                  *int64 `json:"exit_epoch,omitempty"`
+	WithdrawableEpoch          *int64 `json:"withdrawable_epoch,omitempty"`
+}
+
+// DepositLog is a DepositEvent emitted by the deposit contract
+type DepositLog struct {
+	BlockNumber           int64  `json:"block_number"`
+	TxHash                string `json:"tx_hash"`
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                int64  `json:"amount"`
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// DutiesClient retrieves validator duties and the canonical chain needed to check how they were performed
+type DutiesClient interface {
+	// GetAttesterDuties retrieves the committee assignments of the given validators in an epoch
+	GetAttesterDuties(ctx context.Context, epoch int, indices []int) ([]AttesterDuty, error)
+
+	// GetBlockRoot retrieves the root of the canonical block at a slot. found is false for an empty slot.
+	GetBlockRoot(ctx context.Context, slot int) (root string, found bool, err error)
+
+	// GetBlockAttestations retrieves the attestations included in the canonical block at a slot.
+	// found is false for an empty slot.
+	GetBlockAttestations(ctx context.Context, slot int) (attestations []Attestation, found bool, err error)
+
+	// GetCommittees retrieves the size of every beacon committee in an epoch.
+	// A wrapped ErrStateUnavailable is returned when the node no longer has the state.
+	GetCommittees(ctx context.Context, epoch int) ([]Committee, error)
+}
+
+// MainnetElectraEpoch is the first mainnet epoch of the Electra fork, from which an attestation
+// aggregates the votes of several committees
+const MainnetElectraEpoch = 364032
+
+// ProposalClient retrieves proposer duties and the blocks published for them
+type ProposalClient interface {
+	// GetProposerDuties retrieves the proposer of every slot in an epoch
+	GetProposerDuties(ctx context.Context, epoch int) ([]ProposerDuty, error)
+
+	// GetBlockRoot retrieves the root of the canonical block at a slot. found is false for an empty slot.
+	GetBlockRoot(ctx context.Context, slot int) (root string, found bool, err error)
+
+	// GetBlockHeaders retrieves the headers of all blocks the node knows at a slot, canonical or not
+	GetBlockHeaders(ctx context.Context, slot int) ([]BlockHeader, error)
+
+	// SubscribeToBlockEvents streams blocks as the node receives them
+	SubscribeToBlockEvents(ctx context.Context) (<-chan BlockEvent, error)
+}
+
+// RelaySource retrieves payloads delivered by MEV-boost relays
+type RelaySource interface {
+	// GetDeliveredPayloads retrieves the payloads relays delivered to proposers at a slot, along
+	// with the relays that could not be queried
+	GetDeliveredPayloads(ctx context.Context, slot int) (payloads []RelayPayload, failed []string, err error)
+}
+
+// RelayMonitorSource retrieves delivered payloads and validator registrations from MEV-boost relays
+type RelayMonitorSource interface {
+	RelaySource
+
+	// GetRegisteredRelays returns the relays a validator has registered with, along with the
+	// relays that could not be queried
+	GetRegisteredRelays(ctx context.Context, pubkey string) (registered []string, failed []string, err error)
+}
+
+// AttesterDuty is a validator's committee assignment for an epoch
+type AttesterDuty struct {
+	ValidatorIndex          int `json:"validator_index"`
+	Slot                    int `json:"slot"`
+	CommitteeIndex          int `json:"committee_index"`
+	CommitteeLength         int `json:"committee_length"`
+	ValidatorCommitteeIndex int `json:"validator_committee_index"` // Position within the committee
+}
+
+// Committee is the size of the beacon committee with an index at a slot
+type Committee struct {
+	Slot  int `json:"slot"`
+	Index int `json:"index"`
+	Size  int `json:"size"`
+}
+
+// ProposerDuty is the validator scheduled to propose the block at a slot
+type ProposerDuty struct {
+	ValidatorIndex int    `json:"validator_index"`
+	Pubkey         string `json:"pubkey"`
+	Slot           int    `json:"slot"`
+}
+
+// BlockHeader is a block known to the beacon node at a slot
+type BlockHeader struct {
+	Root          string `json:"root"`
+	Slot          int    `json:"slot"`
+	ProposerIndex int    `json:"proposer_index"`
+	Canonical     bool   `json:"canonical"`
+}
+
+// BlockEvent is a block received by the beacon node, timestamped on arrival
+type BlockEvent struct {
+	Slot      int       `json:"slot"`
+	Block     string    `json:"block"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// RelayPayload is an execution payload a relay delivered to a proposer
+type RelayPayload struct {
+	Relay                string   `json:"relay"`
+	Slot                 int      `json:"slot"`
+	ProposerPubkey       string   `json:"proposer_pubkey"`
+	BuilderPubkey        string   `json:"builder_pubkey"`
+	BlockHash            string   `json:"block_hash"`
+	ProposerFeeRecipient string   `json:"proposer_fee_recipient"` // Address the builder promised to pay
+	Value                *big.Int `json:"value"`                  // Wei
+}
+
+// HasAggregationBit reports whether the committee member at position is set in the attestation's
+// aggregation bits. The bits are an SSZ bitlist: little-endian within each byte, with the highest
+// set bit marking the length.
+func (a Attestation) HasAggregationBit(position int) bool {
+	bits, err := hex.DecodeString(strings.TrimPrefix(a.AggregationBits, "0x"))
+	if err != nil || position < 0 || len(bits) == 0 {
+		return false
+	}
+
+	// Find the length marker so positions beyond the list are not mistaken for set bits
+	last := bits[len(bits)-1]
+	if last == 0 {
+		return false
+	}
+	length := (len(bits) - 1) * 8
+	for last > 1 {
+		last >>= 1
+		length++
+	}
+	if position >= length {
+		return false
+	}
+
+	return bits[position/8]&(1<<(position%8)) != 0
+}
+
+// CommitteeIndices returns the committees set in the attestation's committee bits, in ascending
+// order. The bits are an SSZ bitvector, little-endian within each byte. Attestations from before
+// Electra have no committee bits and return nil.
+func (a Attestation) CommitteeIndices() []int {
+	bits, err := hex.DecodeString(strings.TrimPrefix(a.CommitteeBits, "0x"))
+	if err != nil {
+		return nil
+	}
+
+	var indices []int
+	for position := 0; position < len(bits)*8; position++ {
+		if bits[position/8]&(1<<(position%8)) != 0 {
+			indices = append(indices, position)
+		}
+	}
+	return indices
+}
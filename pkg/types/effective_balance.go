@@ -0,0 +1,88 @@
+package types
+
+import "strings"
+
+// Effective balance parameters (mainnet preset, Electra), in Gwei
+const (
+	EffectiveBalanceIncrement  int64 = 1_000_000_000
+	MinActivationBalance       int64 = 32_000_000_000   // Maximum effective balance without compounding credentials
+	MaxEffectiveBalanceElectra int64 = 2048_000_000_000 // Maximum effective balance with compounding credentials
+
+	HysteresisQuotient           = 4
+	HysteresisDownwardMultiplier = 1
+	HysteresisUpwardMultiplier   = 5
+)
+
+// CredentialType is the withdrawal credential prefix of a validator
+type CredentialType string
+
+const (
+	CredentialTypeBLS         CredentialType = "0x00" // BLS withdrawal key, no withdrawals until changed
+	CredentialTypeExecution   CredentialType = "0x01" // Execution address, balance above 32 ETH swept
+	CredentialTypeCompounding CredentialType = "0x02" // Execution address, balance compounds up to 2048 ETH
+)
+
+// IsValid reports whether t is a known credential type
+func (t CredentialType) IsValid() bool {
+	switch t {
+	case CredentialTypeBLS, CredentialTypeExecution, CredentialTypeCompounding:
+		return true
+	}
+	return false
+}
+
+// Label returns a human-readable name for the credential type
+func (t CredentialType) Label() string {
+	switch t {
+	case CredentialTypeBLS:
+		return "BLS (0x00)"
+	case CredentialTypeExecution:
+		return "Execution (0x01)"
+	case CredentialTypeCompounding:
+		return "Compounding (0x02)"
+	}
+	return "Unknown"
+}
+
+// CredentialTypeOf returns the type of hex-encoded withdrawal credentials, or "" if the prefix
+// is not a known type
+func CredentialTypeOf(withdrawalCredentials string) CredentialType {
+	if len(withdrawalCredentials) < 4 {
+		return ""
+	}
+	t := CredentialType(strings.ToLower(withdrawalCredentials[:4]))
+	if !t.IsValid() {
+		return ""
+	}
+	return t
+}
+
+// MaxEffectiveBalance returns the maximum effective balance allowed by withdrawal credentials
+func MaxEffectiveBalance(withdrawalCredentials string) int64 {
+	if CredentialTypeOf(withdrawalCredentials) == CredentialTypeCompounding {
+		return MaxEffectiveBalanceElectra
+	}
+	return MinActivationBalance
+}
+
+// HysteresisThresholds returns the balances that move an effective balance at the next epoch
+// transition: it steps down once the balance falls below down, and up once the balance rises
+// above up. up is 0 when the effective balance is already at maxEffectiveBalance.
+func HysteresisThresholds(effectiveBalance, maxEffectiveBalance int64) (down, up int64) {
+	increment := EffectiveBalanceIncrement / HysteresisQuotient
+	down = effectiveBalance - increment*HysteresisDownwardMultiplier
+	if effectiveBalance < maxEffectiveBalance {
+		up = effectiveBalance + increment*HysteresisUpwardMultiplier
+	}
+	return down, up
+}
+
+// NextEffectiveBalance applies the epoch transition's hysteresis rule to a balance, returning the
+// effective balance that follows
+func NextEffectiveBalance(balance, effectiveBalance, maxEffectiveBalance int64) int64 {
+	down, up := HysteresisThresholds(effectiveBalance, maxEffectiveBalance)
+	if balance >= down && (up == 0 || balance <= up) {
+		return effectiveBalance
+	}
+	return min(balance-balance%EffectiveBalanceIncrement, maxEffectiveBalance)
+}
@@ -0,0 +1,12 @@
+package types
+
+import "context"
+
+// FeeRecipientClient retrieves proposer duties and the execution payloads of proposed blocks
+type FeeRecipientClient interface {
+	// GetProposerDuties retrieves the proposer of every slot in an epoch
+	GetProposerDuties(ctx context.Context, epoch int) ([]ProposerDuty, error)
+
+	// The proposer, fee recipient and final transaction of each proposed block
+	BlockClient
+}
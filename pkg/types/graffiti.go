@@ -0,0 +1,132 @@
+package types
+
+import (
+	"context"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ConsensusClient is a consensus layer client implementation
+type ConsensusClient string
+
+const (
+	ClientLighthouse ConsensusClient = "lighthouse"
+	ClientPrysm      ConsensusClient = "prysm"
+	ClientTeku       ConsensusClient = "teku"
+	ClientNimbus     ConsensusClient = "nimbus"
+	ClientLodestar   ConsensusClient = "lodestar"
+	ClientGrandine   ConsensusClient = "grandine"
+	ClientUnknown    ConsensusClient = "unknown"
+)
+
+// consensusClientCodes are the two-letter client codes of the engine API's ClientVersionV1, which
+// consensus clients append to graffiti
+var consensusClientCodes = map[string]ConsensusClient{
+	"LH": ClientLighthouse,
+	"PM": ClientPrysm,
+	"TK": ClientTeku,
+	"NB": ClientNimbus,
+	"LS": ClientLodestar,
+	"GR": ClientGrandine,
+}
+
+// executionClientCodes are the two-letter execution client codes of ClientVersionV1
+var executionClientCodes = map[string]string{
+	"BU": "besu",
+	"EG": "erigon",
+	"EJ": "ethereumjs",
+	"GE": "geth",
+	"NM": "nethermind",
+	"RH": "reth",
+	"TE": "trin",
+}
+
+var (
+	// clientVersionPattern matches the client version suffix: execution code, up to four hex
+	// characters of its commit, consensus code and up to four of its commit (e.g. GE1a2bLH3c4d)
+	clientVersionPattern = regexp.MustCompile(`([A-Z]{2})([0-9a-f]{0,4})([A-Z]{2})([0-9a-f]{0,4})$`)
+
+	// clientNamePattern matches a client name with an optional version, as in default graffiti
+	// such as Lighthouse/v5.1.3-441fc16
+	clientNamePattern = regexp.MustCompile(`(?i)\b(lighthouse|prysm|prysmatic|teku|nimbus|lodestar|grandine)\b(?:[/ -]?v?(\d+\.\d+(?:\.\d+)?))?`)
+)
+
+// ClientFingerprint is the client a block's graffiti identifies
+type ClientFingerprint struct {
+	Client          ConsensusClient `json:"client"`
+	Version         string          `json:"version"`          // Release, or commit prefix from a version code
+	ExecutionClient string          `json:"execution_client"` // Empty when the graffiti does not name one
+}
+
+// DecodeGraffiti returns the text of a block's 32-byte hex graffiti, with trailing padding removed
+// and invalid UTF-8 replaced
+func DecodeGraffiti(graffiti string) string {
+	raw, err := hex.DecodeString(strings.TrimPrefix(graffiti, "0x"))
+	if err != nil {
+		return ""
+	}
+
+	text := strings.TrimRight(string(raw), "\x00")
+	if !utf8.ValidString(text) {
+		text = strings.ToValidUTF8(text, "�")
+	}
+	return strings.TrimSpace(text)
+}
+
+// FingerprintGraffiti identifies the consensus client that produced a block from its graffiti
+// text. A client version code takes precedence over a client name.
+func FingerprintGraffiti(graffiti string) ClientFingerprint {
+	if m := clientVersionPattern.FindStringSubmatch(graffiti); m != nil {
+		el, elOK := executionClientCodes[m[1]]
+		cl, clOK := consensusClientCodes[m[3]]
+		if elOK && clOK {
+			return ClientFingerprint{Client: cl, Version: m[4], ExecutionClient: el}
+		}
+	}
+
+	if m := clientNamePattern.FindStringSubmatch(graffiti); m != nil {
+		client := ConsensusClient(strings.ToLower(m[1]))
+		if client == "prysmatic" {
+			client = ClientPrysm
+		}
+		return ClientFingerprint{Client: client, Version: m[2]}
+	}
+
+	return ClientFingerprint{Client: ClientUnknown}
+}
+
+// ClientDiversity is the distribution of consensus clients over the blocks of an epoch, by the
+// client each block's graffiti identifies
+type ClientDiversity struct {
+	Epoch      int                         `json:"epoch"`
+	Blocks     int                         `json:"blocks"`     // Blocks seen in the epoch
+	Identified int                         `json:"identified"` // Those whose client is known
+	Shares     map[ConsensusClient]float64 `json:"shares"`     // Share of the identified blocks per client
+}
+
+// NewClientDiversity builds the distribution of an epoch from its block counts per client
+func NewClientDiversity(epoch int, counts map[ConsensusClient]int) *ClientDiversity {
+	d := &ClientDiversity{Epoch: epoch, Shares: make(map[ConsensusClient]float64)}
+	for client, count := range counts {
+		d.Blocks += count
+		if client != ClientUnknown {
+			d.Identified += count
+		}
+	}
+	for client, count := range counts {
+		if client != ClientUnknown {
+			d.Shares[client] = float64(count) / float64(d.Identified)
+		}
+	}
+	return d
+}
+
+// BlockStream shares the blocks fetched from the beacon node, so block consumers do not each scan
+// the chain
+type BlockStream interface {
+	// SubscribeToBlocks streams the blocks fetched from the node, each canonical block once, until
+	// the context is done
+	SubscribeToBlocks(ctx context.Context) <-chan *Block
+}
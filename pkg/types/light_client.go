@@ -0,0 +1,109 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+// LightClient serves the light client sync protocol, used to verify a beacon node's chain against
+// sync committee signatures from a trusted checkpoint
+type LightClient interface {
+	// GetLightClientBootstrap retrieves the sync committee in force at a block. It returns nil
+	// if the node does not serve a bootstrap for the block.
+	GetLightClientBootstrap(ctx context.Context, blockRoot string) (*LightClientBootstrap, error)
+
+	// GetLightClientUpdates retrieves the best update of each sync committee period from startPeriod
+	GetLightClientUpdates(ctx context.Context, startPeriod, count int64) ([]LightClientUpdate, error)
+
+	// GetLightClientFinalityUpdate retrieves the latest finalized header attested by the sync
+	// committee. It returns nil if the node has none yet.
+	GetLightClientFinalityUpdate(ctx context.Context) (*LightClientUpdate, error)
+
+	// GetBlockRoot retrieves the root of the canonical block at a slot. found is false for an empty slot.
+	GetBlockRoot(ctx context.Context, slot int) (root string, found bool, err error)
+
+	// GetBlockHeader retrieves the header of the block with a root. It returns nil if the node
+	// does not know the block.
+	GetBlockHeader(ctx context.Context, blockRoot string) (*BeaconBlockHeader, error)
+
+	// GetBlockHeaders retrieves the headers of all blocks the node knows at a slot, canonical or not
+	GetBlockHeaders(ctx context.Context, slot int) ([]BlockHeader, error)
+}
+
+// ErrVerificationFailed is returned when beacon node data does not match the chain verified
+// from the trusted checkpoint
+var ErrVerificationFailed = errors.New("beacon data failed verification")
+
+// ErrBeaconUntrusted is returned for requests to a beacon node whose data failed verification
+var ErrBeaconUntrusted = errors.New("beacon node failed light client verification")
+
+// BeaconBlockHeader is the header of a beacon block, which its root commits to
+type BeaconBlockHeader struct {
+	Slot          int64  `json:"slot,string"`
+	ProposerIndex int64  `json:"proposer_index,string"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// SyncCommittee is the set of validators that sign the head of the chain for a sync committee period
+type SyncCommittee struct {
+	Pubkeys         []string `json:"pubkeys"`
+	AggregatePubkey string   `json:"aggregate_pubkey"`
+}
+
+// SyncAggregate is the sync committee's aggregate signature over the block at the previous slot
+type SyncAggregate struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"` // Hex bitvector of the members that signed
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+// LightClientBootstrap is the sync committee in force at a block, with its proof against the
+// block's state root
+type LightClientBootstrap struct {
+	Header                     BeaconBlockHeader
+	CurrentSyncCommittee       SyncCommittee
+	CurrentSyncCommitteeBranch []string
+}
+
+// LightClientUpdate is a header attested by the sync committee. Period updates also prove the next
+// sync committee, and finality updates a finalized header, against the attested state root.
+type LightClientUpdate struct {
+	AttestedHeader          BeaconBlockHeader
+	NextSyncCommittee       *SyncCommittee // Nil unless the update proves it
+	NextSyncCommitteeBranch []string
+	FinalizedHeader         *BeaconBlockHeader // Nil unless the update proves it
+	FinalityBranch          []string
+	SyncAggregate           SyncAggregate
+	SignatureSlot           int64
+}
+
+// Fork is a network upgrade and the epoch it activates at
+type Fork struct {
+	Name    string
+	Version string // 4-byte fork version, hex
+	Epoch   int64
+}
+
+// Fork names the light client distinguishes
+const (
+	ForkAltair    = "altair"
+	ForkBellatrix = "bellatrix"
+	ForkCapella   = "capella"
+	ForkDeneb     = "deneb"
+	ForkElectra   = "electra"
+	ForkFulu      = "fulu"
+)
+
+// MainnetGenesisValidatorsRoot is the mainnet genesis validators root, mixed into signing domains
+const MainnetGenesisValidatorsRoot = "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+
+// MainnetForks is the mainnet fork schedule from Altair, when sync committees were introduced
+var MainnetForks = []Fork{
+	{Name: ForkAltair, Version: "0x01000000", Epoch: 74240},
+	{Name: ForkBellatrix, Version: "0x02000000", Epoch: 144896},
+	{Name: ForkCapella, Version: "0x03000000", Epoch: 194048},
+	{Name: ForkDeneb, Version: "0x04000000", Epoch: 269568},
+	{Name: ForkElectra, Version: "0x05000000", Epoch: MainnetElectraEpoch},
+	{Name: ForkFulu, Version: "0x06000000", Epoch: 411392},
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"context"
+	"strings"
+)
+
+// RewardsClient retrieves the per-duty reward breakdowns used for rewards accounting.
+// All amounts are in Gwei.
+type RewardsClient interface {
+	// GetValidatorBalances retrieves balances at the first slot of an epoch.
+	// A wrapped ErrStateUnavailable is returned when the node no longer has the state.
+	GetValidatorBalances(ctx context.Context, epoch int, indices []int) ([]ValidatorEpochBalance, error)
+
+	// GetAttestationRewards retrieves rewards for attestation duties in an epoch, together with
+	// the ideal rewards for each effective balance
+	GetAttestationRewards(ctx context.Context, epoch int, indices []int) (*AttestationRewards, error)
+
+	// GetBlockRewards retrieves the proposer reward for the block at a slot (nil for a missed slot)
+	GetBlockRewards(ctx context.Context, slot int) (*BlockReward, error)
+
+	// GetSyncCommitteeRewards retrieves sync committee rewards for the block at a slot.
+	// Validators not in the sync committee are omitted; a missed slot returns no rewards.
+	GetSyncCommitteeRewards(ctx context.Context, slot int, indices []int) ([]SyncCommitteeReward, error)
+
+	// The withdrawals, deposits, credential changes and execution layer requests in a block
+	BlockClient
+
+	// GetPendingDeposits retrieves the deposit queue in the state at a slot, in processing order.
+	// A wrapped ErrStateUnavailable is returned when the node no longer has the state.
+	GetPendingDeposits(ctx context.Context, slot int) ([]PendingDeposit, error)
+
+	// GetPendingPartialWithdrawals retrieves the partial withdrawal queue in the state at a slot.
+	// A wrapped ErrStateUnavailable is returned when the node does not serve the queue for the state.
+	GetPendingPartialWithdrawals(ctx context.Context, slot int) ([]PendingPartialWithdrawal, error)
+
+	// GetPendingConsolidations retrieves the consolidation queue in the state at a slot.
+	// A wrapped ErrStateUnavailable is returned when the node does not serve the queue for the state.
+	GetPendingConsolidations(ctx context.Context, slot int) ([]PendingConsolidation, error)
+}
+
+// ValidatorEpochBalance is a validator's balance and effective balance at an epoch boundary
+type ValidatorEpochBalance struct {
+	Index            int    `json:"index"`
+	Balance          int64  `json:"balance"`
+	EffectiveBalance int64  `json:"effective_balance"`
+	Slashed          bool   `json:"slashed"`
+	Status           string `json:"status"` // Beacon API status, e.g. active_ongoing
+}
+
+// IsActive reports whether the validator was active (and so had attestation duties) at the epoch
+func (b ValidatorEpochBalance) IsActive() bool {
+	return strings.HasPrefix(b.Status, "active_")
+}
+
+// AttestationRewards holds attestation rewards for an epoch
+type AttestationRewards struct {
+	IdealRewards []IdealAttestationReward     `json:"ideal_rewards"`
+	TotalRewards []ValidatorAttestationReward `json:"total_rewards"`
+}
+
+// IdealAttestationReward is the reward a perfectly performing validator with the
+// given effective balance would have earned. Components may not be negative.
+type IdealAttestationReward struct {
+	EffectiveBalance int64 `json:"effective_balance"`
+	Head             int64 `json:"head"`
+	Target           int64 `json:"target"`
+	Source           int64 `json:"source"`
+	InclusionDelay   int64 `json:"inclusion_delay"`
+	Inactivity       int64 `json:"inactivity"`
+}
+
+// Total returns the sum of all ideal reward components
+func (r IdealAttestationReward) Total() int64 {
+	return r.Head + r.Target + r.Source + r.InclusionDelay + r.Inactivity
+}
+
+// IdealTotal returns the total ideal reward for an effective balance. Rewards scale linearly with
+// whole increments of effective balance, so a balance the node did not report is scaled from the
+// largest one it did; that lets compounding validators above 32 ETH be scored when the node only
+// reports the balances of the validators requested. It returns 0 without any ideal rewards.
+func (r *AttestationRewards) IdealTotal(effectiveBalance int64) int64 {
+	var base IdealAttestationReward
+	for _, ideal := range r.IdealRewards {
+		if ideal.EffectiveBalance == effectiveBalance {
+			return ideal.Total()
+		}
+		if ideal.EffectiveBalance > base.EffectiveBalance {
+			base = ideal
+		}
+	}
+
+	increments := base.EffectiveBalance / EffectiveBalanceIncrement
+	if increments == 0 {
+		return 0
+	}
+	return base.Total() * (effectiveBalance / EffectiveBalanceIncrement) / increments
+}
+
+// ValidatorAttestationReward is the reward a validator earned for its attestation duty.
+// Negative components are penalties.
+type ValidatorAttestationReward struct {
+	ValidatorIndex int   `json:"validator_index"`
+	Head           int64 `json:"head"`
+	Target         int64 `json:"target"`
+	Source         int64 `json:"source"`
+	InclusionDelay int64 `json:"inclusion_delay"`
+	Inactivity     int64 `json:"inactivity"`
+}
+
+// Components returns the reward components in a fixed order
+func (r ValidatorAttestationReward) Components() []int64 {
+	return []int64{r.Head, r.Target, r.Source, r.InclusionDelay, r.Inactivity}
+}
+
+// BlockReward is the reward paid to the proposer of a block
+type BlockReward struct {
+	Slot          int   `json:"slot"`
+	ProposerIndex int   `json:"proposer_index"`
+	Total         int64 `json:"total"`
+}
+
+// SyncCommitteeReward is a sync committee member's reward for a block (negative if it missed)
+type SyncCommitteeReward struct {
+	ValidatorIndex int   `json:"validator_index"`
+	Reward         int64 `json:"reward"`
+}
+
+// G2PointAtInfinity is the compressed BLS signature the beacon chain uses in place of a deposit
+// signature for balance it moves through the deposit queue itself
+const G2PointAtInfinity = "0xc0" + "000000000000000000000000000000000000000000000000000000000000" +
+	"000000000000000000000000000000000000000000000000000000000000" +
+	"000000000000000000000000000000000000000000000000000000000000" +
+	"0000000000"
+
+// PendingDeposit is an entry in the beacon state's deposit queue (EIP-7251). Slot is the slot of
+// the block that included a deposit request, or zero for entries that joined the queue another
+// way, such as deposit contract operations processed through the legacy bridge.
+type PendingDeposit struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                int64  `json:"amount"`
+	Signature             string `json:"signature"`
+	Slot                  int64  `json:"slot"`
+}
+
+// IsBalanceTransfer reports whether the entry re-queues part of a validator's own balance, as on a
+// switch to compounding credentials, rather than being a deposit
+func (d PendingDeposit) IsBalanceTransfer() bool {
+	return strings.EqualFold(d.Signature, G2PointAtInfinity)
+}
+
+// PendingPartialWithdrawal is an entry in the beacon state's partial withdrawal queue, added when
+// an execution layer withdrawal request (EIP-7002) is processed
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    int64 `json:"validator_index"`
+	Amount            int64 `json:"amount"`
+	WithdrawableEpoch int64 `json:"withdrawable_epoch"`
+}
+
+// PendingConsolidation is an entry in the beacon state's consolidation queue (EIP-7251). The
+// source's balance moves to the target once the source has exited.
+type PendingConsolidation struct {
+	SourceIndex int64 `json:"source_index"`
+	TargetIndex int64 `json:"target_index"`
+}
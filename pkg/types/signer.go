@@ -0,0 +1,41 @@
+package types
+
+import "context"
+
+// SignerKind is the kind of validator client or remote signer endpoint that is scraped
+type SignerKind string
+
+const (
+	SignerKindMetrics    SignerKind = "metrics"    // Validator client Prometheus endpoint
+	SignerKindKeymanager SignerKind = "keymanager" // Validator client keymanager API, which lists loaded keys
+	SignerKindWeb3Signer SignerKind = "web3signer" // Web3Signer remote signer
+)
+
+// SignerTarget is an endpoint of a validator client or remote signer. Endpoints sharing a name
+// belong to the same validator client.
+type SignerTarget struct {
+	Name  string     `json:"name"`
+	Kind  SignerKind `json:"kind"`
+	URL   string     `json:"url"`
+	Token string     `json:"-"` // Bearer token of a keymanager API
+}
+
+// LoadedKey is a validator key an endpoint reports having loaded
+type LoadedKey struct {
+	Pubkey string `json:"pubkey"`
+	Remote bool   `json:"remote"` // Signed for by a remote signer rather than a local keystore
+}
+
+// SignerCheck is the outcome of scraping one endpoint
+type SignerCheck struct {
+	Status  string      `json:"status"` // "healthy", "degraded" or "unhealthy"
+	Message string      `json:"message,omitempty"`
+	Keys    []LoadedKey `json:"keys"` // Nil for endpoints that do not list keys
+}
+
+// SignerSource scrapes validator client and remote signer endpoints
+type SignerSource interface {
+	// Check scrapes an endpoint's health and, for keymanager APIs and Web3Signer, its loaded keys.
+	// An unreachable endpoint is reported as unhealthy rather than as an error.
+	Check(ctx context.Context, target SignerTarget) *SignerCheck
+}
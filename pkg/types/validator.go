@@ -69,7 +69,7 @@ type ValidatorPerformance struct {
 	ProposalSuccess     int       `json:"proposal_success"`
 	ProposalMissed      int       `json:"proposal_missed"`
 
-	// Rewards metrics
+	// Rewards metrics (Gwei), from the rewards ledger
 	ExpectedRewards     *big.Int  `json:"expected_rewards"`
 	ActualRewards       *big.Int  `json:"actual_rewards"`
 	Effectiveness       float64   `json:"effectiveness"`